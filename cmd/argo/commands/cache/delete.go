@@ -0,0 +1,41 @@
+package cache
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/argoproj/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/simster7/argo/v2/cmd/argo/commands/client"
+	cachepkg "github.com/simster7/argo/v2/pkg/apiclient/cache"
+)
+
+func NewDeleteCommand() *cobra.Command {
+	var cacheType string
+	var command = &cobra.Command{
+		Use:   "delete CACHE_NAME KEY...",
+		Short: "delete memoization cache entries, so the steps that saved them run again",
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) < 2 {
+				cmd.HelpFunc()(cmd, args)
+				os.Exit(1)
+			}
+			ctx, apiClient := client.NewAPIClient()
+			serviceClient, err := apiClient.NewCacheServiceClient()
+			errors.CheckError(err)
+			for _, key := range args[1:] {
+				_, err := serviceClient.DeleteCacheEntry(ctx, &cachepkg.DeleteCacheEntryRequest{
+					Namespace: client.Namespace(),
+					CacheName: args[0],
+					Key:       key,
+					CacheType: cacheType,
+				})
+				errors.CheckError(err)
+				fmt.Printf("Cache entry '%s' deleted\n", key)
+			}
+		},
+	}
+	command.Flags().StringVar(&cacheType, "type", "ConfigMap", "The type of the cache. One of: ConfigMap|Database")
+	return command
+}
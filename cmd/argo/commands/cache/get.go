@@ -0,0 +1,94 @@
+package cache
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/argoproj/pkg/errors"
+	"github.com/argoproj/pkg/humanize"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	"github.com/simster7/argo/v2/cmd/argo/commands/client"
+	cachepkg "github.com/simster7/argo/v2/pkg/apiclient/cache"
+)
+
+func NewGetCommand() *cobra.Command {
+	var (
+		cacheType string
+		output    string
+	)
+	var command = &cobra.Command{
+		Use:   "get CACHE_NAME KEY",
+		Short: "display the details of a memoization cache entry",
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) != 2 {
+				cmd.HelpFunc()(cmd, args)
+				os.Exit(1)
+			}
+			ctx, apiClient := client.NewAPIClient()
+			serviceClient, err := apiClient.NewCacheServiceClient()
+			errors.CheckError(err)
+			entry, err := serviceClient.GetCacheEntry(ctx, &cachepkg.GetCacheEntryRequest{
+				Namespace: client.Namespace(),
+				CacheName: args[0],
+				Key:       args[1],
+				CacheType: cacheType,
+			})
+			errors.CheckError(err)
+			switch output {
+			case "json":
+				output, err := json.MarshalIndent(entry, "", "  ")
+				errors.CheckError(err)
+				fmt.Println(string(output))
+			case "yaml":
+				output, err := yaml.Marshal(entry)
+				errors.CheckError(err)
+				fmt.Println(string(output))
+			case "", "wide":
+				printCacheEntry(entry)
+			default:
+				log.Fatalf("Unknown output mode: %s", output)
+			}
+		},
+	}
+	command.Flags().StringVar(&cacheType, "type", "ConfigMap", "The type of the cache. One of: ConfigMap|Database")
+	command.Flags().StringVarP(&output, "output", "o", "", "Output format. One of: wide|json|yaml")
+	return command
+}
+
+func printCacheEntry(entry *cachepkg.CacheEntry) {
+	const fmtStr = "%-20s %v\n"
+	fmt.Printf(fmtStr, "Key:", entry.Key)
+	fmt.Printf(fmtStr, "Node ID:", entry.NodeID)
+	if entry.CreationTimestamp != nil {
+		fmt.Printf(fmtStr, "Created:", humanize.Timestamp(entry.CreationTimestamp.Time))
+	}
+	fmt.Printf(fmtStr, "Hits:", entry.HitCount)
+	if entry.Outputs == nil {
+		return
+	}
+	if entry.Outputs.Result != nil {
+		fmt.Printf(fmtStr, "Result:", *entry.Outputs.Result)
+	}
+	if entry.Outputs.ExitCode != nil {
+		fmt.Printf(fmtStr, "Exit Code:", *entry.Outputs.ExitCode)
+	}
+	if len(entry.Outputs.Parameters) > 0 {
+		fmt.Printf("Parameters:\n")
+		for _, param := range entry.Outputs.Parameters {
+			if param.Value == nil {
+				continue
+			}
+			fmt.Printf("  %-18s %s\n", param.Name+":", param.Value.String())
+		}
+	}
+	if len(entry.Outputs.Artifacts) > 0 {
+		fmt.Printf("Artifacts:\n")
+		for _, art := range entry.Outputs.Artifacts {
+			fmt.Printf("  %-18s %s\n", art.Name+":", art.GetKey())
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package cache
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/argoproj/pkg/errors"
+	"github.com/argoproj/pkg/humanize"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	"github.com/simster7/argo/v2/cmd/argo/commands/client"
+	cachepkg "github.com/simster7/argo/v2/pkg/apiclient/cache"
+)
+
+func NewListCommand() *cobra.Command {
+	var (
+		cacheType string
+		output    string
+	)
+	var command = &cobra.Command{
+		Use:   "list CACHE_NAME",
+		Short: "list the entries of a memoization cache",
+		Example: `# List the entries of a config map cache:
+  argo cache list my-cache
+
+# List the entries of a database cache:
+  argo cache list my-cache --type Database`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) != 1 {
+				cmd.HelpFunc()(cmd, args)
+				os.Exit(1)
+			}
+			ctx, apiClient := client.NewAPIClient()
+			serviceClient, err := apiClient.NewCacheServiceClient()
+			errors.CheckError(err)
+			list, err := serviceClient.ListCacheEntries(ctx, &cachepkg.ListCacheEntriesRequest{
+				Namespace: client.Namespace(),
+				CacheName: args[0],
+				CacheType: cacheType,
+			})
+			errors.CheckError(err)
+			switch output {
+			case "", "wide":
+				printTable(list.Items)
+			case "name":
+				for _, entry := range list.Items {
+					fmt.Println(entry.Key)
+				}
+			case "json":
+				output, err := json.MarshalIndent(list.Items, "", "  ")
+				errors.CheckError(err)
+				fmt.Println(string(output))
+			case "yaml":
+				output, err := yaml.Marshal(list.Items)
+				errors.CheckError(err)
+				fmt.Println(string(output))
+			default:
+				log.Fatalf("Unknown output mode: %s", output)
+			}
+		},
+	}
+	command.Flags().StringVar(&cacheType, "type", "ConfigMap", "The type of the cache. One of: ConfigMap|Database")
+	command.Flags().StringVarP(&output, "output", "o", "", "Output format. One of: wide|name|json|yaml")
+	return command
+}
+
+func printTable(entries []*cachepkg.CacheEntry) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+	_, _ = fmt.Fprint(w, "KEY\tNODE ID\tAGE\tHITS\n")
+	for _, entry := range entries {
+		age := "N/A"
+		if entry.CreationTimestamp != nil {
+			age = humanize.RelativeDurationShort(entry.CreationTimestamp.Time, time.Now())
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%d\n", entry.Key, entry.NodeID, age, entry.HitCount)
+	}
+	_ = w.Flush()
+}
@@ -0,0 +1,48 @@
+package cache
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/argoproj/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/simster7/argo/v2/cmd/argo/commands/client"
+	cachepkg "github.com/simster7/argo/v2/pkg/apiclient/cache"
+)
+
+func NewPruneCommand() *cobra.Command {
+	var (
+		cacheType string
+		olderThan string
+	)
+	var command = &cobra.Command{
+		Use:   "prune CACHE_NAME",
+		Short: "delete all the entries of a memoization cache, or only those older than a duration",
+		Example: `# Delete all the entries of a cache:
+  argo cache prune my-cache
+
+# Delete the entries of a cache created more than a day ago:
+  argo cache prune my-cache --older-than 24h`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) != 1 {
+				cmd.HelpFunc()(cmd, args)
+				os.Exit(1)
+			}
+			ctx, apiClient := client.NewAPIClient()
+			serviceClient, err := apiClient.NewCacheServiceClient()
+			errors.CheckError(err)
+			resp, err := serviceClient.PruneCache(ctx, &cachepkg.PruneCacheRequest{
+				Namespace: client.Namespace(),
+				CacheName: args[0],
+				CacheType: cacheType,
+				OlderThan: olderThan,
+			})
+			errors.CheckError(err)
+			fmt.Printf("%d cache entries deleted\n", resp.Deleted)
+		},
+	}
+	command.Flags().StringVar(&cacheType, "type", "ConfigMap", "The type of the cache. One of: ConfigMap|Database")
+	command.Flags().StringVar(&olderThan, "older-than", "", "Only delete entries created longer ago than this duration, e.g. 24h")
+	return command
+}
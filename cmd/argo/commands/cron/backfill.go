@@ -0,0 +1,62 @@
+package cron
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/argoproj/pkg/errors"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/simster7/argo/v2/cmd/argo/commands/client"
+	cronworkflowpkg "github.com/simster7/argo/v2/pkg/apiclient/cronworkflow"
+)
+
+// NewBackfillCommand returns a new instance of an `argo cron backfill` command
+func NewBackfillCommand() *cobra.Command {
+	var (
+		start       string
+		end         string
+		parallelism int32
+	)
+	var command = &cobra.Command{
+		Use:   "backfill CRON_WORKFLOW",
+		Short: "create the workflows a cron workflow was scheduled to run between two times",
+		Example: `# Run every scheduled time in the first week of October:
+  argo cron backfill my-cron --start 2020-10-01T00:00:00Z --end 2020-10-07T23:59:59Z
+
+# Run up to three of the backfilled workflows at the same time:
+  argo cron backfill my-cron --start 2020-10-01T00:00:00Z --end 2020-10-07T23:59:59Z --parallelism 3`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(args) != 1 {
+				cmd.HelpFunc()(cmd, args)
+				os.Exit(1)
+			}
+			startTime, err := time.Parse(time.RFC3339, start)
+			errors.CheckError(err)
+			endTime, err := time.Parse(time.RFC3339, end)
+			errors.CheckError(err)
+			ctx, apiClient := client.NewAPIClient()
+			serviceClient := apiClient.NewCronWorkflowServiceClient()
+			resp, err := serviceClient.BackfillCronWorkflow(ctx, &cronworkflowpkg.BackfillCronWorkflowRequest{
+				Name:        args[0],
+				Namespace:   client.Namespace(),
+				Start:       &metav1.Time{Time: startTime},
+				End:         &metav1.Time{Time: endTime},
+				Parallelism: parallelism,
+			})
+			errors.CheckError(err)
+			for _, wf := range resp.Items {
+				fmt.Printf("Workflow '%s' created\n", wf.Name)
+			}
+			fmt.Printf("CronWorkflow '%s' backfilled with %d workflows\n", args[0], len(resp.Items))
+		},
+	}
+	command.Flags().StringVar(&start, "start", "", "the earliest scheduled time to run, inclusive (RFC3339)")
+	command.Flags().StringVar(&end, "end", "", "the latest scheduled time to run, inclusive (RFC3339)")
+	command.Flags().Int32Var(&parallelism, "parallelism", 1, "the number of backfilled workflows that may run at the same time, ignored unless the concurrency policy is Allow")
+	_ = command.MarkFlagRequired("start")
+	_ = command.MarkFlagRequired("end")
+	return command
+}
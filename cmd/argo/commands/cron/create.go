@@ -90,7 +90,7 @@ func CreateCronWorkflows(filePaths []string, cliOpts *cliCreateOpts, submitOpts
 		if err != nil {
 			log.Fatalf("Failed to create workflow template: %v", err)
 		}
-		fmt.Print(getCronWorkflowGet(created))
+		fmt.Print(getCronWorkflowGet(created, 1))
 	}
 }
 
@@ -19,7 +19,8 @@ import (
 
 func NewGetCommand() *cobra.Command {
 	var (
-		output string
+		output   string
+		nextRuns int
 	)
 
 	var command = &cobra.Command{
@@ -41,16 +42,17 @@ func NewGetCommand() *cobra.Command {
 					Namespace: namespace,
 				})
 				errors.CheckError(err)
-				printCronWorkflow(cronWf, output)
+				printCronWorkflow(cronWf, output, nextRuns)
 			}
 		},
 	}
 
 	command.Flags().StringVarP(&output, "output", "o", "", "Output format. One of: json|yaml|wide")
+	command.Flags().IntVar(&nextRuns, "next-runs", 1, "Number of upcoming scheduled times to display")
 	return command
 }
 
-func printCronWorkflow(wf *wfv1.CronWorkflow, outFmt string) {
+func printCronWorkflow(wf *wfv1.CronWorkflow, outFmt string, nextRuns int) {
 	switch outFmt {
 	case "name":
 		fmt.Println(wf.ObjectMeta.Name)
@@ -61,24 +63,35 @@ func printCronWorkflow(wf *wfv1.CronWorkflow, outFmt string) {
 		outBytes, _ := yaml.Marshal(wf)
 		fmt.Print(string(outBytes))
 	case "wide", "":
-		fmt.Print(getCronWorkflowGet(wf))
+		fmt.Print(getCronWorkflowGet(wf, nextRuns))
 	default:
 		log.Fatalf("Unknown output format: %s", outFmt)
 	}
 }
 
-func getCronWorkflowGet(cwf *wfv1.CronWorkflow) string {
+func getCronWorkflowGet(cwf *wfv1.CronWorkflow, nextRuns int) string {
 	const fmtStr = "%-30s %v\n"
 
 	out := ""
 	out += fmt.Sprintf(fmtStr, "Name:", cwf.ObjectMeta.Name)
 	out += fmt.Sprintf(fmtStr, "Namespace:", cwf.ObjectMeta.Namespace)
 	out += fmt.Sprintf(fmtStr, "Created:", humanize.Timestamp(cwf.ObjectMeta.CreationTimestamp.Time))
-	out += fmt.Sprintf(fmtStr, "Schedule:", cwf.Spec.Schedule)
+	out += fmt.Sprintf(fmtStr, "Schedule:", getScheduleString(cwf))
 	out += fmt.Sprintf(fmtStr, "Suspended:", cwf.Spec.Suspend)
 	if cwf.Spec.Timezone != "" {
 		out += fmt.Sprintf(fmtStr, "Timezone:", cwf.Spec.Timezone)
 	}
+	if len(cwf.Spec.ExclusionWindows) > 0 {
+		out += fmt.Sprintf(fmtStr, "Exclusion Windows:", "")
+		for _, window := range cwf.Spec.ExclusionWindows {
+			var excluded []string
+			if window.Start != "" {
+				excluded = append(excluded, fmt.Sprintf("%s for %s", window.Start, window.Duration))
+			}
+			excluded = append(excluded, window.Dates...)
+			out += fmt.Sprintf(fmtStr, "  "+strings.Join(excluded, ", "), window.Timezone)
+		}
+	}
 	if cwf.Spec.StartingDeadlineSeconds != nil {
 		out += fmt.Sprintf(fmtStr, "StartingDeadlineSeconds:", *cwf.Spec.StartingDeadlineSeconds)
 	}
@@ -89,9 +102,14 @@ func getCronWorkflowGet(cwf *wfv1.CronWorkflow) string {
 		out += fmt.Sprintf(fmtStr, "LastScheduledTime:", humanize.Timestamp(cwf.Status.LastScheduledTime.Time))
 	}
 
-	next, err := GetNextRuntime(cwf)
-	if err == nil {
-		out += fmt.Sprintf(fmtStr, "NextScheduledTime:", humanize.Timestamp(next)+" (assumes workflow-controller is in UTC)")
+	next, err := GetNextRuntimes(cwf, nextRuns)
+	if err == nil && len(next) == 1 {
+		out += fmt.Sprintf(fmtStr, "NextScheduledTime:", humanize.Timestamp(next[0])+" (assumes workflow-controller is in UTC)")
+	} else if err == nil && len(next) > 1 {
+		out += fmt.Sprintf(fmtStr, "NextScheduledTimes:", "(assumes workflow-controller is in UTC)")
+		for _, t := range next {
+			out += fmt.Sprintf(fmtStr, "", humanize.Timestamp(t))
+		}
 	}
 
 	if len(cwf.Status.Active) > 0 {
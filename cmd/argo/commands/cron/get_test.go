@@ -59,7 +59,7 @@ func TestPrintCronWorkflow(t *testing.T) {
 	var cronWf v1alpha1.CronWorkflow
 	err := yaml.Unmarshal([]byte(invalidCwf), &cronWf)
 	if assert.NoError(t, err) {
-		out := getCronWorkflowGet(&cronWf)
+		out := getCronWorkflowGet(&cronWf, 1)
 		assert.Contains(t, out, expectedOut)
 	}
 }
@@ -75,3 +75,21 @@ func TestNextRuntime(t *testing.T) {
 		}
 	}
 }
+
+func TestPrintNextScheduledTimes(t *testing.T) {
+	var cronWf v1alpha1.CronWorkflow
+	err := yaml.Unmarshal([]byte(invalidCwf), &cronWf)
+	if assert.NoError(t, err) {
+		cronWf.Spec.Schedules = []v1alpha1.CronSchedule{{Schedule: "0 0 * * *"}}
+		cronWf.Spec.ExclusionWindows = []v1alpha1.CronExclusionWindow{{Dates: []string{"2020-12-25"}}}
+		out := getCronWorkflowGet(&cronWf, 3)
+		assert.Contains(t, out, "* * * * *, 0 0 * * *")
+		assert.Contains(t, out, "Exclusion Windows:")
+		assert.Contains(t, out, "2020-12-25")
+		assert.Contains(t, out, "NextScheduledTimes:")
+		next, err := GetNextRuntimes(&cronWf, 3)
+		if assert.NoError(t, err) {
+			assert.Len(t, next, 3)
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/argoproj/pkg/errors"
 	log "github.com/sirupsen/logrus"
@@ -16,7 +17,8 @@ import (
 
 func NewLintCommand() *cobra.Command {
 	var (
-		strict bool
+		strict   bool
+		nextRuns int
 	)
 	var command = &cobra.Command{
 		Use:   "lint FILE...",
@@ -36,10 +38,20 @@ func NewLintCommand() *cobra.Command {
 					if wf.Namespace == "" {
 						wf.Namespace = client.Namespace()
 					}
-					_, err := serviceClient.LintCronWorkflow(ctx, &cronworkflowpkg.LintCronWorkflowRequest{Namespace: wf.Namespace, CronWorkflow: &wf})
+					linted, err := serviceClient.LintCronWorkflow(ctx, &cronworkflowpkg.LintCronWorkflowRequest{Namespace: wf.Namespace, CronWorkflow: &wf})
 					if err != nil {
 						return err
 					}
+					if nextRuns > 0 {
+						next, err := GetNextRuntimes(linted, nextRuns)
+						if err != nil {
+							return err
+						}
+						fmt.Printf("%s will next run at:\n", linted.Name)
+						for _, t := range next {
+							fmt.Printf("  %s\n", t.Format(time.RFC3339))
+						}
+					}
 				}
 				fmt.Printf("%s is valid\n", file)
 				return nil
@@ -78,5 +90,6 @@ func NewLintCommand() *cobra.Command {
 		},
 	}
 	command.Flags().BoolVar(&strict, "strict", true, "perform strict workflow validation")
+	command.Flags().IntVar(&nextRuns, "next-runs", 0, "display the next scheduled times of each cron workflow (assumes workflow-controller is in UTC)")
 	return command
 }
@@ -1,23 +1,45 @@
 package cron
 
 import (
+	"fmt"
+	"strings"
 	"time"
 
-	"github.com/robfig/cron/v3"
-
 	"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1"
+	"github.com/simster7/argo/v2/workflow/cron/schedule"
 )
 
 // GetNextRuntime returns the next time the workflow should run in local time. It assumes the workflow-controller is in
 // UTC, but nevertheless returns the time in the local timezone.
 func GetNextRuntime(cwf *v1alpha1.CronWorkflow) (time.Time, error) {
-	cronScheduleString := cwf.Spec.Schedule
-	if cwf.Spec.Timezone != "" {
-		cronScheduleString = "CRON_TZ=" + cwf.Spec.Timezone + " " + cronScheduleString
-	}
-	cronSchedule, err := cron.ParseStandard(cronScheduleString)
+	next, err := GetNextRuntimes(cwf, 1)
 	if err != nil {
 		return time.Time{}, err
 	}
-	return cronSchedule.Next(time.Now().UTC()).Local(), nil
+	if len(next) == 0 {
+		return time.Time{}, fmt.Errorf("cron workflow is never scheduled to run")
+	}
+	return next[0], nil
+}
+
+// GetNextRuntimes returns the next n times the workflow should run in local time, skipping the times in its exclusion
+// windows. Like GetNextRuntime, it assumes the workflow-controller is in UTC.
+func GetNextRuntimes(cwf *v1alpha1.CronWorkflow, n int) ([]time.Time, error) {
+	cronSchedule, err := schedule.Parse(&cwf.Spec)
+	if err != nil {
+		return nil, err
+	}
+	next := cronSchedule.NextN(time.Now().UTC(), n)
+	for i := range next {
+		next[i] = next[i].Local()
+	}
+	return next, nil
+}
+
+func getScheduleString(cwf *v1alpha1.CronWorkflow) string {
+	var schedules []string
+	for _, s := range cwf.Spec.GetSchedules() {
+		schedules = append(schedules, s.Schedule)
+	}
+	return strings.Join(schedules, ", ")
 }
@@ -2,16 +2,20 @@ package commands
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
 	"os"
+	"sort"
 	"strings"
 	"text/tabwriter"
 
 	"github.com/argoproj/pkg/errors"
 	"github.com/argoproj/pkg/humanize"
 	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"sigs.k8s.io/yaml"
@@ -26,9 +30,13 @@ import (
 
 const onExitSuffix = "onExit"
 
+// hooksInfix precedes the name of a lifecycle hook in the name of its node
+const hooksInfix = "hooks."
+
 type getFlags struct {
 	output                  string
 	nodeFieldSelectorString string
+	children                bool
 
 	// Only used for backwards compatibility
 	status string
@@ -66,6 +74,9 @@ func NewGetCommand() *cobra.Command {
 
 # Get the latest workflow:
   argo get @latest
+
+# Get information about a workflow and the child workflows of its workflow templates:
+  argo get my-wf --children
 `,
 		Run: func(cmd *cobra.Command, args []string) {
 			if len(args) == 0 {
@@ -82,6 +93,9 @@ func NewGetCommand() *cobra.Command {
 				})
 				errors.CheckError(err)
 				printWorkflow(wf, getArgs)
+				if getArgs.children {
+					printChildWorkflows(ctx, serviceClient, wf, getArgs)
+				}
 			}
 		},
 	}
@@ -91,9 +105,41 @@ func NewGetCommand() *cobra.Command {
 	command.Flags().BoolVar(&noUtf8, "no-utf8", false, "Use plain 7-bits ascii characters")
 	command.Flags().StringVar(&getArgs.status, "status", "", "Filter by status (Pending, Running, Succeeded, Skipped, Failed, Error)")
 	command.Flags().StringVar(&getArgs.nodeFieldSelectorString, "node-field-selector", "", "selector of node to display, eg: --node-field-selector phase=abc")
+	command.Flags().BoolVar(&getArgs.children, "children", false, "Also display the child workflows of workflow templates, recursively")
 	return command
 }
 
+// printChildWorkflows prints the child workflows of the workflow's workflow templates, and their child workflows, in the
+// order they were started. A child workflow is named after its node.
+func printChildWorkflows(ctx context.Context, serviceClient workflowpkg.WorkflowServiceClient, wf *wfv1.Workflow, getArgs getFlags) {
+	var nodes []wfv1.NodeStatus
+	for _, node := range wf.Status.Nodes {
+		if node.Type == wfv1.NodeTypeWorkflow {
+			nodes = append(nodes, node)
+		}
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		if nodes[i].StartedAt.Equal(&nodes[j].StartedAt) {
+			return nodes[i].ID < nodes[j].ID
+		}
+		return nodes[i].StartedAt.Before(&nodes[j].StartedAt)
+	})
+	for _, node := range nodes {
+		child, err := serviceClient.GetWorkflow(ctx, &workflowpkg.WorkflowGetRequest{
+			Name:      node.ID,
+			Namespace: wf.Namespace,
+		})
+		if status.Code(err) == codes.NotFound {
+			// the child workflow has been deleted, or was never created
+			continue
+		}
+		errors.CheckError(err)
+		fmt.Println()
+		printWorkflow(child, getArgs)
+		printChildWorkflows(ctx, serviceClient, child, getArgs)
+	}
+}
+
 func statusToNodeFieldSelector(status string) string {
 	return fmt.Sprintf("phase=%s", status)
 }
@@ -205,6 +251,21 @@ func printWorkflowHelper(wf *wfv1.Workflow, getArgs getFlags) string {
 		}
 		mainRoot.renderNodes(w, wf, 0, " ", " ", getArgs)
 
+		// Print the trees of the workflow's lifecycle hooks, which have no parent
+		var hookNames []string
+		for _, node := range wf.Status.Nodes {
+			if strings.HasPrefix(node.Name, wf.ObjectMeta.Name+"."+hooksInfix) {
+				hookNames = append(hookNames, node.Name)
+			}
+		}
+		sort.Strings(hookNames)
+		for _, hookName := range hookNames {
+			if hookRoot, ok := roots[wf.NodeID(hookName)]; ok {
+				_, _ = fmt.Fprintf(w, "\t\t\t\t\t\n")
+				hookRoot.renderNodes(w, wf, 0, " ", " ", getArgs)
+			}
+		}
+
 		onExitID := wf.NodeID(wf.ObjectMeta.Name + "." + onExitSuffix)
 		if onExitRoot, ok := roots[onExitID]; ok {
 			_, _ = fmt.Fprintf(w, "\t\t\t\t\t\n")
@@ -267,11 +328,25 @@ func isBoundaryNode(node wfv1.NodeType) bool {
 }
 
 func isNonBoundaryParentNode(node wfv1.NodeType) bool {
-	return (node == wfv1.NodeTypeStepGroup) || (node == wfv1.NodeTypeRetry)
+	return (node == wfv1.NodeTypeStepGroup) || (node == wfv1.NodeTypeRetry) || (node == wfv1.NodeTypeLoop)
 }
 
 func isExecutionNode(node wfv1.NodeType) bool {
-	return (node == wfv1.NodeTypePod) || (node == wfv1.NodeTypeSkipped) || (node == wfv1.NodeTypeSuspend)
+	return (node == wfv1.NodeTypePod) || (node == wfv1.NodeTypeSkipped) || (node == wfv1.NodeTypeSuspend) || (node == wfv1.NodeTypeContainer) || (node == wfv1.NodeTypeHTTP) || (node == wfv1.NodeTypeWorkflow)
+}
+
+// isContainerSetNode returns whether the node is the pod of a container set, which is rendered as the boundary of the
+// nodes of its containers
+func isContainerSetNode(wf *wfv1.Workflow, node wfv1.NodeStatus) bool {
+	if node.Type != wfv1.NodeTypePod {
+		return false
+	}
+	for _, childID := range node.Children {
+		if wf.Status.Nodes[childID].Type == wfv1.NodeTypeContainer {
+			return true
+		}
+	}
+	return false
 }
 
 func insertSorted(wf *wfv1.Workflow, sortedArray []renderNode, item renderNode) []renderNode {
@@ -385,7 +460,7 @@ func convertToRenderTrees(wf *wfv1.Workflow) map[string]renderNode {
 	// 2nd Pass process everything
 	for id, status := range wf.Status.Nodes {
 		switch {
-		case isBoundaryNode(status.Type):
+		case isBoundaryNode(status.Type) || isContainerSetNode(wf, status):
 			n := boundaryNode{nodeInfo: nodeInfo{id: id}}
 			boundaryNodeMap[id] = &n
 			// Attach to my parent if needed
@@ -526,7 +601,8 @@ func printNode(w *tabwriter.Writer, node wfv1.NodeStatus, nodePrefix string, get
 	}
 	var args []interface{}
 	duration := humanize.RelativeDurationShort(node.StartedAt.Time, node.FinishedAt.Time)
-	if node.Type == wfv1.NodeTypePod {
+	if node.Type == wfv1.NodeTypePod || node.Type == wfv1.NodeTypeWorkflow {
+		// the child workflow of a workflow template, like a pod, is named after its node
 		args = []interface{}{nodePrefix, nodeName, templateName, node.ID, duration, node.Message, ""}
 	} else {
 		args = []interface{}{nodePrefix, nodeName, templateName, "", "", node.Message, ""}
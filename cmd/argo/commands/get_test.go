@@ -96,6 +96,9 @@ func TestPrintNode(t *testing.T) {
 	getArgs.output = "wide"
 	testPrintNodeImpl(t, fmt.Sprintf("%s %s\t%s/%s\t%s\t%s\t%s\t%s\t%s\t\n", nodeTypeIconMap[wfv1.NodeTypeSuspend], nodeName, nodeTemplateRefName, nodeTemplateRefName, "", "", getArtifactsString(node), nodeMessage, ""), node, nodePrefix, getArgs)
 
+	node.Type = wfv1.NodeTypeWorkflow
+	testPrintNodeImpl(t, fmt.Sprintf("%s %s\t%s/%s\t%s\t%s\t%s\t%s\t%s\t\n", jobStatusIconMap[wfv1.NodeRunning], nodeName, nodeTemplateRefName, nodeTemplateRefName, nodeID, "0s", getArtifactsString(node), nodeMessage, ""), node, nodePrefix, getArgs)
+
 	node.Type = wfv1.NodeTypePod
 	testPrintNodeImpl(t, fmt.Sprintf("%s %s\t%s/%s\t%s\t%s\t%s\t%s\t%s\t%s\n", jobStatusIconMap[wfv1.NodeRunning], nodeName, nodeTemplateRefName, nodeTemplateRefName, nodeID, "0s", getArtifactsString(node), nodeMessage, "", kubernetesNodeName), node, nodePrefix, getArgs)
 
@@ -368,4 +371,44 @@ status:
    ├─ sleep(10:ten)     sleep           many-items-z26lj-1052882686  23s         
    ├─ sleep(11:eleven)  sleep           many-items-z26lj-3011405271  22s`)
 	})
+	t.Run("ContainerSet", func(t *testing.T) {
+		var wf wfv1.Workflow
+		testutil.MustUnmarshallYAML(`
+metadata:
+  name: container-set
+status:
+  phase: Running
+  nodes:
+    container-set:
+      id: container-set
+      name: container-set
+      displayName: container-set
+      templateName: main
+      type: Pod
+      phase: Running
+      children:
+      - container-set-1
+      - container-set-2
+    container-set-1:
+      id: container-set-1
+      name: container-set.a
+      displayName: a
+      boundaryID: container-set
+      type: Container
+      phase: Succeeded
+      startedAt: "2020-06-02T16:04:21Z"
+    container-set-2:
+      id: container-set-2
+      name: container-set.b
+      displayName: b
+      boundaryID: container-set
+      type: Container
+      phase: Running
+      startedAt: "2020-06-02T16:04:31Z"
+`, &wf)
+		output := printWorkflowHelper(&wf, getFlags{})
+		assert.Regexp(t, `container-set +main +container-set`, output)
+		assert.Contains(t, output, "├─ a")
+		assert.Contains(t, output, "└─ b")
+	})
 }
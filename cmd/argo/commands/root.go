@@ -7,6 +7,7 @@ import (
 
 	"github.com/argoproj/argo"
 	"github.com/simster7/argo/v2/cmd/argo/commands/archive"
+	"github.com/simster7/argo/v2/cmd/argo/commands/cache"
 	"github.com/simster7/argo/v2/cmd/argo/commands/auth"
 	"github.com/simster7/argo/v2/cmd/argo/commands/client"
 	"github.com/simster7/argo/v2/cmd/argo/commands/clustertemplate"
@@ -106,6 +107,7 @@ If your server is behind an ingress with a path (you'll be running "argo server
 	command.AddCommand(NewNodeCommand())
 	command.AddCommand(NewTerminateCommand())
 	command.AddCommand(archive.NewArchiveCommand())
+	command.AddCommand(cache.NewCacheCommand())
 	command.AddCommand(NewVersionCommand())
 	command.AddCommand(template.NewTemplateCommand())
 	command.AddCommand(cron.NewCronWorkflowCommand())
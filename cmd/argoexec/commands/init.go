@@ -34,6 +34,11 @@ func loadArtifacts(ctx context.Context) error {
 		wfExecutor.AddError(err)
 		return err
 	}
+	err = wfExecutor.InstallExecutable()
+	if err != nil {
+		wfExecutor.AddError(err)
+		return err
+	}
 	err = wfExecutor.LoadArtifacts(ctx)
 	if err != nil {
 		wfExecutor.AddError(err)
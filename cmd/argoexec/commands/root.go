@@ -17,6 +17,7 @@ import (
 	"github.com/simster7/argo/v2/workflow/common"
 	"github.com/simster7/argo/v2/workflow/executor"
 	"github.com/simster7/argo/v2/workflow/executor/docker"
+	"github.com/simster7/argo/v2/workflow/executor/emissary"
 	"github.com/simster7/argo/v2/workflow/executor/k8sapi"
 	"github.com/simster7/argo/v2/workflow/executor/kubelet"
 	"github.com/simster7/argo/v2/workflow/executor/pns"
@@ -58,6 +59,7 @@ func NewRootCommand() *cobra.Command {
 
 	command.AddCommand(NewInitCommand())
 	command.AddCommand(NewResourceCommand())
+	command.AddCommand(NewRunCommand())
 	command.AddCommand(NewWaitCommand())
 	command.AddCommand(cmd.NewVersionCmd(CLIName))
 
@@ -94,6 +96,8 @@ func initExecutor() *executor.WorkflowExecutor {
 		cre, err = k8sapi.NewK8sAPIExecutor(clientset, config, podName, namespace)
 	case common.ContainerRuntimeExecutorKubelet:
 		cre, err = kubelet.NewKubeletExecutor()
+	case common.ContainerRuntimeExecutorEmissary:
+		cre, err = emissary.NewEmissaryExecutor(clientset, podName, namespace)
 	case common.ContainerRuntimeExecutorPNS:
 		cre, err = pns.NewPNSExecutor(clientset, podName, namespace, tmpl.Outputs.HasOutputs())
 	default:
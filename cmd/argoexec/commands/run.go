@@ -0,0 +1,224 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	wfv1 "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1"
+	"github.com/simster7/argo/v2/workflow/common"
+	"github.com/simster7/argo/v2/workflow/executor"
+	"github.com/simster7/argo/v2/workflow/executor/emissary"
+)
+
+// terminationLogPath is where a container's termination message is read from by the kubelet
+const terminationLogPath = "/dev/termination-log"
+
+type runOpts struct {
+	// name is the name of the container
+	name string
+	// dependencies are the names of the containers that must succeed before the command is run
+	dependencies []string
+	// emissary is whether the container's output, signals and outputs are handled by argoexec, for the emissary executor
+	emissary bool
+	// template is the template of the pod, whose outputs in the base image layer are saved for the emissary executor
+	template *wfv1.Template
+}
+
+func NewRunCommand() *cobra.Command {
+	var command = cobra.Command{
+		Use:   "run -- COMMAND [ARG...]",
+		Short: "Run the command of a container, once the containers it depends on have succeeded",
+		Long:  "Run the command of a container. This is used by the containers of a container set, and by all containers when using the emissary executor.",
+		Args:  cobra.MinimumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			opts := runOpts{
+				name:     os.Getenv(common.EnvVarContainerName),
+				emissary: os.Getenv(common.EnvVarContainerRuntimeExecutor) == common.ContainerRuntimeExecutorEmissary,
+			}
+			if deps := os.Getenv(common.EnvVarDependencies); deps != "" {
+				opts.dependencies = strings.Split(deps, ",")
+			}
+			if data := os.Getenv(common.EnvVarTemplate); data != "" {
+				opts.template = &wfv1.Template{}
+				if err := json.Unmarshal([]byte(data), opts.template); err != nil {
+					log.Fatalf("failed to unmarshal template: %v", err)
+				}
+			}
+			exitCode, err := runContainerCommand(opts, args)
+			if err != nil {
+				log.Fatalf("%+v", err)
+			}
+			os.Exit(exitCode)
+		},
+	}
+	return &command
+}
+
+// runContainerCommand waits for the dependencies to complete and, if they all succeeded, runs the command, forwarding
+// signals to it. The exit code of the command, or 1 if a dependency failed, is recorded and returned.
+func runContainerCommand(opts runOpts, args []string) (int, error) {
+	name := opts.name
+	if name == "" {
+		return 0, fmt.Errorf("%s is not set", common.EnvVarContainerName)
+	}
+	for _, dep := range opts.dependencies {
+		log.WithField("dependency", dep).Info("Waiting for dependency")
+		exitCode, err := waitForExitCode(dep)
+		if err != nil {
+			return 0, err
+		}
+		if exitCode != 0 {
+			message := fmt.Sprintf("dependency '%s' failed", dep)
+			log.Info(message)
+			_ = ioutil.WriteFile(terminationLogPath, []byte(message), 0644)
+			return 1, emissary.WriteExitCode(name, 1)
+		}
+	}
+
+	cmd := exec.Command(args[0], args[1:]...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if opts.emissary {
+		// record the output, so that the wait container can read it without access to the container runtime
+		if err := os.MkdirAll(filepath.Dir(emissary.StdoutPath(name)), 0777); err != nil {
+			return 0, err
+		}
+		stdout, err := os.Create(emissary.StdoutPath(name))
+		if err != nil {
+			return 0, err
+		}
+		defer func() { _ = stdout.Close() }()
+		combined, err := os.Create(emissary.CombinedPath(name))
+		if err != nil {
+			return 0, err
+		}
+		defer func() { _ = combined.Close() }()
+		cmd.Stdout = io.MultiWriter(os.Stdout, stdout, combined)
+		cmd.Stderr = io.MultiWriter(os.Stderr, combined)
+	}
+	if err := cmd.Start(); err != nil {
+		_ = ioutil.WriteFile(terminationLogPath, []byte(err.Error()), 0644)
+		return 1, emissary.WriteExitCode(name, 1)
+	}
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGTERM, os.Interrupt)
+	defer signal.Stop(signals)
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		for s := range signals {
+			log.WithField("signal", s).Info("Forwarding signal")
+			_ = cmd.Process.Signal(s)
+		}
+	}()
+	if opts.emissary {
+		// the wait container cannot signal the command, so it writes the signal to send for us to read
+		go forwardWrittenSignals(name, cmd.Process, done)
+	}
+
+	exitCode := 0
+	if err := cmd.Wait(); err != nil {
+		exitErr, ok := err.(*exec.ExitError)
+		if !ok {
+			return 0, err
+		}
+		exitCode = exitErr.ExitCode()
+		if status, ok := exitErr.Sys().(syscall.WaitStatus); ok && status.Signaled() {
+			// the same exit code a shell reports for a command killed by a signal
+			exitCode = 128 + int(status.Signal())
+		}
+	}
+	if opts.emissary && opts.template != nil {
+		if err := saveOutputs(opts.template); err != nil {
+			return 0, err
+		}
+	}
+	return exitCode, emissary.WriteExitCode(name, exitCode)
+}
+
+func forwardWrittenSignals(name string, process *os.Process, done <-chan struct{}) {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			sig, ok, err := emissary.ReadSignal(name)
+			if err != nil {
+				log.WithError(err).Warn("Failed to read signal")
+				continue
+			}
+			if ok {
+				log.WithField("signal", sig).Info("Forwarding written signal")
+				_ = process.Signal(sig)
+			}
+		}
+	}
+}
+
+// saveOutputs copies the template's outputs that are in the base image layer to the shared volume, as the wait container
+// cannot read them from this container's filesystem
+func saveOutputs(tmpl *wfv1.Template) error {
+	var paths []string
+	for _, param := range tmpl.Outputs.Parameters {
+		if param.ValueFrom != nil && param.ValueFrom.Path != "" {
+			paths = append(paths, param.ValueFrom.Path)
+		}
+	}
+	for _, art := range tmpl.Outputs.Artifacts {
+		if art.Path != "" {
+			paths = append(paths, art.Path)
+		}
+	}
+	for _, path := range paths {
+		if !executor.IsBaseImagePath(tmpl, path) {
+			continue
+		}
+		log.WithField("path", path).Info("Saving output")
+		err := emissary.SaveOutput(path)
+		if os.IsNotExist(err) {
+			// the wait container reports missing outputs, as it knows which are optional
+			log.WithField("path", path).Info("Output does not exist")
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to save output %s: %w", path, err)
+		}
+	}
+	return nil
+}
+
+// waitForExitCode waits for the exit code of the container to be recorded. If the container terminates without recording
+// one, e.g. because it was OOM killed, the wait container records one for it.
+func waitForExitCode(name string) (int, error) {
+	for {
+		data, err := ioutil.ReadFile(emissary.ExitCodePath(name))
+		if err == nil {
+			exitCode, err := strconv.Atoi(strings.TrimSpace(string(data)))
+			if err != nil {
+				return 0, fmt.Errorf("exit code of dependency '%s' is malformed: %w", name, err)
+			}
+			return exitCode, nil
+		}
+		if !os.IsNotExist(err) {
+			return 0, err
+		}
+		time.Sleep(time.Second)
+	}
+}
@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"time"
 
 	apiv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -132,6 +133,8 @@ type ArtifactRepository struct {
 	OSS *OSSArtifactRepository `json:"oss,omitempty"`
 	// GCS stores artifact in a GCS object store
 	GCS *GCSArtifactRepository `json:"gcs,omitempty"`
+	// Azure stores artifact in an Azure Blob Storage container
+	Azure *AzureArtifactRepository `json:"azure,omitempty"`
 }
 
 func (a *ArtifactRepository) IsArchiveLogs() bool {
@@ -151,6 +154,11 @@ type PersistConfig struct {
 	PostgreSQL     *PostgreSQLConfig `json:"postgresql,omitempty"`
 	MySQL          *MySQLConfig      `json:"mysql,omitempty"`
 	SkipMigration  bool              `json:"skipMigration,omitempty"`
+	// MemoizationCache configures memoization caches stored in the database
+	MemoizationCache *MemoizationCacheConfig `json:"memoizationCache,omitempty"`
+	// Synchronization stores the holders and waiters of semaphores and mutexes in the database, so that their limits
+	// apply across all the controllers sharing it
+	Synchronization *SyncConfig `json:"synchronization,omitempty"`
 }
 
 func (c PersistConfig) GetArchiveLabelSelector() (labels.Selector, error) {
@@ -167,6 +175,47 @@ func (c PersistConfig) GetClusterName() string {
 	return "default"
 }
 
+// MemoizationCacheConfig configures memoization caches stored in the database
+type MemoizationCacheConfig struct {
+	// MaxEntries is the maximum number of entries in each cache, once exceeded the oldest entries are evicted.
+	// Unlimited if zero.
+	MaxEntries int `json:"maxEntries,omitempty"`
+}
+
+func (c *MemoizationCacheConfig) GetMaxEntries() int {
+	if c == nil {
+		return 0
+	}
+	return c.MaxEntries
+}
+
+// SyncConfig configures semaphores and mutexes stored in the database
+type SyncConfig struct {
+	// ControllerName identifies this controller's holders and waiters, so it must be unique among the controllers
+	// sharing the database. Defaults to the cluster name, followed by the instance ID if there is one.
+	ControllerName string `json:"controllerName,omitempty"`
+	// LeaseDuration is how long a controller's holders and waiters are kept after it stops renewing its lease, e.g.
+	// because it crashed. Defaults to 5m.
+	LeaseDuration TTL `json:"leaseDuration,omitempty"`
+	// PollInterval is how often the controller renews its lease, and checks whether locks released by other
+	// controllers can be acquired. Defaults to 10s.
+	PollInterval TTL `json:"pollInterval,omitempty"`
+}
+
+func (c SyncConfig) GetLeaseDuration() time.Duration {
+	if c.LeaseDuration > 0 {
+		return time.Duration(c.LeaseDuration)
+	}
+	return 5 * time.Minute
+}
+
+func (c SyncConfig) GetPollInterval() time.Duration {
+	if c.PollInterval > 0 {
+		return time.Duration(c.PollInterval)
+	}
+	return 10 * time.Second
+}
+
 type ConnectionPool struct {
 	MaxIdleConns    int `json:"maxIdleConns,omitempty"`
 	MaxOpenConns    int `json:"maxOpenConns,omitempty"`
@@ -228,6 +277,14 @@ type GCSArtifactRepository struct {
 	KeyFormat string `json:"keyFormat,omitempty"`
 }
 
+// AzureArtifactRepository defines the controller configuration for an Azure Blob Storage artifact repository
+type AzureArtifactRepository struct {
+	wfv1.AzureBlobContainer `json:",inline"`
+
+	// BlobNameFormat is defines the format of how to store blob names. Can reference workflow variables
+	BlobNameFormat string `json:"blobNameFormat,omitempty"`
+}
+
 // ArtifactoryArtifactRepository defines the controller configuration for an artifactory artifact repository
 type ArtifactoryArtifactRepository struct {
 	wfv1.ArtifactoryAuth `json:",inline"`
@@ -0,0 +1,267 @@
+package sqldb
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"upper.io/db.v3"
+	"upper.io/db.v3/lib/sqlbuilder"
+
+	wfv1 "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1"
+)
+
+const memoizationCacheTableName = "argo_memoization_cache"
+
+// MemoizationCacheEntry is an entry in a memoization cache stored in the database
+type MemoizationCacheEntry struct {
+	Key       string
+	NodeID    string
+	Outputs   *wfv1.Outputs
+	CreatedAt time.Time
+	HitCount  int64
+}
+
+//go:generate mockery -name MemoizationCacheRepo
+
+type MemoizationCacheRepo interface {
+	// Load returns the entry, or nil if there is no entry for the key
+	Load(namespace, cacheName, key string) (*MemoizationCacheEntry, error)
+	// Save creates or replaces the entry for the key. If maxAge is not zero, the entry is deleted once it is older.
+	Save(namespace, cacheName, key, nodeID string, outputs *wfv1.Outputs, maxAge time.Duration) error
+	// RecordHits adds the number of hits to the hit count of the entry
+	RecordHits(namespace, cacheName, key string, hits int64) error
+	// List returns all the entries of the cache, newest first
+	List(namespace, cacheName string) ([]*MemoizationCacheEntry, error)
+	// Delete deletes the entry, returning false if there was no entry for the key
+	Delete(namespace, cacheName, key string) (bool, error)
+	// Prune deletes the entries of the cache created before the time, returning the number deleted
+	Prune(namespace, cacheName string, createdBefore time.Time) (int, error)
+	// DeleteExpired deletes all entries older than the maxAge they were saved with
+	DeleteExpired() error
+	IsEnabled() bool
+}
+
+type memoizationCacheRecord struct {
+	ClusterName string `db:"clustername"`
+	Namespace   string `db:"namespace"`
+	CacheName   string `db:"cachename"`
+	// Why is this called "cachekey" not "key"? Key is an SQL reserved word.
+	Key       string     `db:"cachekey"`
+	NodeID    string     `db:"nodeid"`
+	Outputs   string     `db:"outputs"`
+	CreatedAt time.Time  `db:"createdat"`
+	ExpiresAt *time.Time `db:"expiresat,omitempty"`
+	HitCount  int64      `db:"hitcount"`
+}
+
+type memoizationCacheRepo struct {
+	session     sqlbuilder.Database
+	clusterName string
+	// the maximum number of entries in each cache, zero for unlimited
+	maxEntries int
+}
+
+// NewMemoizationCacheRepo returns a repository of memoization cache entries. When a cache has more than maxEntries
+// entries, the oldest ones are evicted.
+func NewMemoizationCacheRepo(session sqlbuilder.Database, clusterName string, maxEntries int) MemoizationCacheRepo {
+	log.WithField("maxEntries", maxEntries).Info("Memoization cache config")
+	return &memoizationCacheRepo{session: session, clusterName: clusterName, maxEntries: maxEntries}
+}
+
+func (r *memoizationCacheRepo) IsEnabled() bool {
+	return true
+}
+
+func (r *memoizationCacheRepo) cacheCond(namespace, cacheName string) db.Cond {
+	return db.Cond{"clustername": r.clusterName, "namespace": namespace, "cachename": cacheName}
+}
+
+func (r *memoizationCacheRepo) Load(namespace, cacheName, key string) (*MemoizationCacheEntry, error) {
+	record := &memoizationCacheRecord{}
+	err := r.session.
+		Select(memoizationCacheEntryColumns...).
+		From(memoizationCacheTableName).
+		Where(r.cacheCond(namespace, cacheName)).
+		And(db.Cond{"cachekey": key}).
+		One(record)
+	if err != nil {
+		if err == db.ErrNoMoreRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return record.toEntry()
+}
+
+var memoizationCacheEntryColumns = []interface{}{"cachekey", "nodeid", "outputs", "createdat", "hitcount"}
+
+func (r memoizationCacheRecord) toEntry() (*MemoizationCacheEntry, error) {
+	var outputs *wfv1.Outputs
+	err := json.Unmarshal([]byte(r.Outputs), &outputs)
+	if err != nil {
+		return nil, err
+	}
+	return &MemoizationCacheEntry{Key: r.Key, NodeID: r.NodeID, Outputs: outputs, CreatedAt: r.CreatedAt, HitCount: r.HitCount}, nil
+}
+
+func (r *memoizationCacheRepo) RecordHits(namespace, cacheName, key string, hits int64) error {
+	_, err := r.session.
+		Update(memoizationCacheTableName).
+		Set("hitcount = hitcount + ?", hits).
+		Where(r.cacheCond(namespace, cacheName)).
+		And(db.Cond{"cachekey": key}).
+		Exec()
+	return err
+}
+
+func (r *memoizationCacheRepo) List(namespace, cacheName string) ([]*MemoizationCacheEntry, error) {
+	var records []memoizationCacheRecord
+	err := r.session.
+		Select(memoizationCacheEntryColumns...).
+		From(memoizationCacheTableName).
+		Where(r.cacheCond(namespace, cacheName)).
+		OrderBy("-createdat").
+		All(&records)
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]*MemoizationCacheEntry, len(records))
+	for i, record := range records {
+		entries[i], err = record.toEntry()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return entries, nil
+}
+
+func (r *memoizationCacheRepo) Delete(namespace, cacheName, key string) (bool, error) {
+	rs, err := r.session.
+		DeleteFrom(memoizationCacheTableName).
+		Where(r.cacheCond(namespace, cacheName)).
+		And(db.Cond{"cachekey": key}).
+		Exec()
+	if err != nil {
+		return false, err
+	}
+	rowsAffected, err := rs.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rowsAffected > 0, nil
+}
+
+func (r *memoizationCacheRepo) Prune(namespace, cacheName string, createdBefore time.Time) (int, error) {
+	rs, err := r.session.
+		DeleteFrom(memoizationCacheTableName).
+		Where(r.cacheCond(namespace, cacheName)).
+		And(db.Cond{"createdat <": createdBefore.UTC()}).
+		Exec()
+	if err != nil {
+		return 0, err
+	}
+	rowsAffected, err := rs.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	log.WithFields(log.Fields{"namespace": namespace, "cacheName": cacheName, "rowsAffected": rowsAffected}).Info("Pruned memoization cache entries")
+	return int(rowsAffected), nil
+}
+
+func (r *memoizationCacheRepo) Save(namespace, cacheName, key, nodeID string, outputs *wfv1.Outputs, maxAge time.Duration) error {
+	marshalled, err := json.Marshal(outputs)
+	if err != nil {
+		return err
+	}
+	now := time.Now().UTC()
+	record := &memoizationCacheRecord{
+		ClusterName: r.clusterName,
+		Namespace:   namespace,
+		CacheName:   cacheName,
+		Key:         key,
+		NodeID:      nodeID,
+		Outputs:     string(marshalled),
+		CreatedAt:   now,
+	}
+	if maxAge > 0 {
+		expiresAt := now.Add(maxAge)
+		record.ExpiresAt = &expiresAt
+	}
+	return r.session.Tx(context.Background(), func(sess sqlbuilder.Tx) error {
+		_, err := sess.
+			DeleteFrom(memoizationCacheTableName).
+			Where(r.cacheCond(namespace, cacheName)).
+			And(db.Cond{"cachekey": key}).
+			Exec()
+		if err != nil {
+			return err
+		}
+		_, err = sess.Collection(memoizationCacheTableName).Insert(record)
+		if err != nil {
+			return err
+		}
+		return r.evictOldest(sess, namespace, cacheName)
+	})
+}
+
+// evictOldest deletes the oldest entries of the cache, so that there are no more than maxEntries
+func (r *memoizationCacheRepo) evictOldest(sess sqlbuilder.Tx, namespace, cacheName string) error {
+	if r.maxEntries <= 0 {
+		return nil
+	}
+	count, err := sess.Collection(memoizationCacheTableName).Find(r.cacheCond(namespace, cacheName)).Count()
+	if err != nil {
+		return err
+	}
+	if count <= uint64(r.maxEntries) {
+		return nil
+	}
+	var records []memoizationCacheRecord
+	err = sess.
+		Select("cachekey").
+		From(memoizationCacheTableName).
+		Where(r.cacheCond(namespace, cacheName)).
+		OrderBy("createdat").
+		Limit(int(count) - r.maxEntries).
+		All(&records)
+	if err != nil {
+		return err
+	}
+	keys := make([]interface{}, len(records))
+	for i, record := range records {
+		keys[i] = record.Key
+	}
+	rs, err := sess.
+		DeleteFrom(memoizationCacheTableName).
+		Where(r.cacheCond(namespace, cacheName)).
+		And(db.Cond{"cachekey IN": keys}).
+		Exec()
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := rs.RowsAffected()
+	if err != nil {
+		return err
+	}
+	log.WithFields(log.Fields{"namespace": namespace, "cacheName": cacheName, "rowsAffected": rowsAffected}).Info("Evicted oldest memoization cache entries")
+	return nil
+}
+
+func (r *memoizationCacheRepo) DeleteExpired() error {
+	rs, err := r.session.
+		DeleteFrom(memoizationCacheTableName).
+		Where(db.Cond{"clustername": r.clusterName}).
+		And(db.Cond{"expiresat <": time.Now().UTC()}).
+		Exec()
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := rs.RowsAffected()
+	if err != nil {
+		return err
+	}
+	log.WithFields(log.Fields{"rowsAffected": rowsAffected}).Info("Deleted expired memoization cache entries")
+	return nil
+}
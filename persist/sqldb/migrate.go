@@ -249,6 +249,45 @@ func (m migrate) Exec(ctx context.Context) error {
 		ansiSQLChange(`create index ` + m.tableName + `_i1 on ` + m.tableName + ` (clustername,namespace,updatedat)`),
 		// index to find records that need deleting, this omits namespaces as this might be null
 		ansiSQLChange(`create index argo_archived_workflows_i2 on argo_archived_workflows (clustername,instanceid,finishedat)`),
+		// The cache key and name are at most 253 characters, as they are for config maps.
+		ansiSQLChange(`create table if not exists argo_memoization_cache (
+    clustername varchar(64) not null,
+    namespace varchar(63) not null,
+    cachename varchar(253) not null,
+    cachekey varchar(253) not null,
+    nodeid varchar(253) not null,
+    outputs json not null,
+    createdat timestamp not null default current_timestamp,
+    expiresat timestamp null,
+    primary key (clustername, namespace, cachename, cachekey)
+)`),
+		// index to find entries that need evicting
+		ansiSQLChange(`create index argo_memoization_cache_i1 on argo_memoization_cache (clustername,expiresat)`),
+		ansiSQLChange(`alter table argo_memoization_cache add column hitcount int not null default 0`),
+		// The lock name and holder key are at most 256 characters, so that the primary key fits within MySQL's limit.
+		ansiSQLChange(`create table if not exists argo_sync_limit (
+    name varchar(256) not null,
+    sizelimit int not null,
+    primary key (name)
+)`),
+		ansiSQLChange(`create table if not exists argo_sync_state (
+    name varchar(256) not null,
+    holderkey varchar(256) not null,
+    controller varchar(64) not null,
+    held boolean not null,
+    priority int not null,
+    creationtime timestamp not null default current_timestamp,
+    primary key (name, holderkey, controller)
+)`),
+		ansiSQLChange(`create table if not exists argo_sync_controller (
+    controller varchar(64) not null,
+    heartbeat timestamp not null default current_timestamp,
+    primary key (controller)
+)`),
+		// index to find the holders and waiters of controllers whose lease has expired
+		ansiSQLChange(`create index argo_sync_state_i1 on argo_sync_state (controller)`),
+		// the number of units of a semaphore held by the holder
+		ansiSQLChange(`alter table argo_sync_state add column weight int not null default 1`),
 	} {
 		err := m.applyChange(ctx, changeSchemaVersion, change)
 		if err != nil {
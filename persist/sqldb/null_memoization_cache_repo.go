@@ -0,0 +1,46 @@
+package sqldb
+
+import (
+	"fmt"
+	"time"
+
+	wfv1 "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1"
+)
+
+var NullMemoizationCacheRepo MemoizationCacheRepo = &nullMemoizationCacheRepo{}
+var MemoizationCacheNotSupportedError = fmt.Errorf("database memoization caches require persistence to be configured")
+
+type nullMemoizationCacheRepo struct {
+}
+
+func (r *nullMemoizationCacheRepo) IsEnabled() bool {
+	return false
+}
+
+func (r *nullMemoizationCacheRepo) Load(string, string, string) (*MemoizationCacheEntry, error) {
+	return nil, MemoizationCacheNotSupportedError
+}
+
+func (r *nullMemoizationCacheRepo) Save(string, string, string, string, *wfv1.Outputs, time.Duration) error {
+	return MemoizationCacheNotSupportedError
+}
+
+func (r *nullMemoizationCacheRepo) RecordHits(string, string, string, int64) error {
+	return MemoizationCacheNotSupportedError
+}
+
+func (r *nullMemoizationCacheRepo) List(string, string) ([]*MemoizationCacheEntry, error) {
+	return nil, MemoizationCacheNotSupportedError
+}
+
+func (r *nullMemoizationCacheRepo) Delete(string, string, string) (bool, error) {
+	return false, MemoizationCacheNotSupportedError
+}
+
+func (r *nullMemoizationCacheRepo) Prune(string, string, time.Time) (int, error) {
+	return 0, MemoizationCacheNotSupportedError
+}
+
+func (r *nullMemoizationCacheRepo) DeleteExpired() error {
+	return nil
+}
@@ -0,0 +1,60 @@
+package sqldb
+
+import (
+	"fmt"
+	"time"
+)
+
+var NullSyncRepo SyncRepo = &nullSyncRepo{}
+var SyncNotSupportedError = fmt.Errorf("database synchronization requires persistence to be configured")
+
+type nullSyncRepo struct {
+}
+
+func (r *nullSyncRepo) IsEnabled() bool {
+	return false
+}
+
+func (r *nullSyncRepo) SetLimit(string, int) error {
+	return SyncNotSupportedError
+}
+
+func (r *nullSyncRepo) TryAcquire(string, string, int) (bool, error) {
+	return false, SyncNotSupportedError
+}
+
+func (r *nullSyncRepo) Acquire(string, string, int) (bool, error) {
+	return false, SyncNotSupportedError
+}
+
+func (r *nullSyncRepo) Release(string, string) error {
+	return SyncNotSupportedError
+}
+
+func (r *nullSyncRepo) AddToQueue(string, string, int32, time.Time) error {
+	return SyncNotSupportedError
+}
+
+func (r *nullSyncRepo) RemoveFromQueue(string, string) error {
+	return SyncNotSupportedError
+}
+
+func (r *nullSyncRepo) GetHolders(string) (map[string]int, error) {
+	return nil, SyncNotSupportedError
+}
+
+func (r *nullSyncRepo) GetWaiters(string) ([]string, error) {
+	return nil, SyncNotSupportedError
+}
+
+func (r *nullSyncRepo) ListWaiting() ([]string, error) {
+	return nil, nil
+}
+
+func (r *nullSyncRepo) ListHeld() (map[string][]string, error) {
+	return nil, nil
+}
+
+func (r *nullSyncRepo) RenewLease(time.Duration) error {
+	return nil
+}
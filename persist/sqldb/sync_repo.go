@@ -0,0 +1,371 @@
+package sqldb
+
+import (
+	"context"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"upper.io/db.v3"
+	"upper.io/db.v3/lib/sqlbuilder"
+)
+
+const (
+	syncLimitTableName      = "argo_sync_limit"
+	syncStateTableName      = "argo_sync_state"
+	syncControllerTableName = "argo_sync_controller"
+)
+
+//go:generate mockery -name SyncRepo
+
+// SyncRepo stores the holders and waiters of semaphores and mutexes, so that their limits apply across all the
+// controllers sharing the database. Holders and waiters are recorded against the controller that added them, and
+// are deleted once its lease expires.
+type SyncRepo interface {
+	// SetLimit records the maximum number of holders of the lock. Controllers sharing a lock should agree on its limit,
+	// otherwise the most recently set limit applies.
+	SetLimit(name string, limit int) error
+	// TryAcquire acquires the given units of the lock for the holder if they are available, and the holder is the first
+	// of its waiters, if there are any
+	TryAcquire(name, key string, weight int) (bool, error)
+	// Acquire acquires the given units of the lock for the holder if they are available, regardless of its waiters
+	Acquire(name, key string, weight int) (bool, error)
+	// Release releases the lock held by the holder
+	Release(name, key string) error
+	// AddToQueue adds the holder to the waiters of the lock, unless it is already waiting or holding it
+	AddToQueue(name, key string, priority int32, creationTime time.Time) error
+	// RemoveFromQueue removes the holder from the waiters of the lock
+	RemoveFromQueue(name, key string) error
+	// GetHolders returns the number of units of the lock held by each holder, added by any controller
+	GetHolders(name string) (map[string]int, error)
+	// GetWaiters returns the keys of the waiters this controller added to the lock, in the order they may acquire it
+	GetWaiters(name string) ([]string, error)
+	// ListWaiting returns the names of the locks that this controller has waiters for
+	ListWaiting() ([]string, error)
+	// ListHeld returns the keys of the holders this controller added, by the name of the lock they hold
+	ListHeld() (map[string][]string, error)
+	// RenewLease records that this controller is alive, and deletes the holders and waiters of controllers whose
+	// lease has expired
+	RenewLease(leaseDuration time.Duration) error
+	IsEnabled() bool
+}
+
+type syncLimitRecord struct {
+	Name      string `db:"name"`
+	SizeLimit int    `db:"sizelimit"`
+}
+
+type syncStateRecord struct {
+	Name         string    `db:"name"`
+	Key          string    `db:"holderkey"`
+	Controller   string    `db:"controller"`
+	Held         bool      `db:"held"`
+	Weight       int       `db:"weight"`
+	Priority     int32     `db:"priority"`
+	CreationTime time.Time `db:"creationtime"`
+}
+
+type syncControllerRecord struct {
+	Controller string    `db:"controller"`
+	Heartbeat  time.Time `db:"heartbeat"`
+}
+
+type syncRepo struct {
+	session    sqlbuilder.Database
+	controller string
+}
+
+// NewSyncRepo returns a repository of lock holders and waiters. The controller name must be unique among the
+// controllers sharing the database.
+func NewSyncRepo(session sqlbuilder.Database, controller string) SyncRepo {
+	log.WithField("controller", controller).Info("Database synchronization config")
+	return &syncRepo{session: session, controller: controller}
+}
+
+func (r *syncRepo) IsEnabled() bool {
+	return true
+}
+
+func (r *syncRepo) SetLimit(name string, limit int) error {
+	return r.session.Tx(context.Background(), func(sess sqlbuilder.Tx) error {
+		rs, err := sess.
+			Update(syncLimitTableName).
+			Set("sizelimit", limit).
+			Where(db.Cond{"name": name}).
+			Exec()
+		if err != nil {
+			return err
+		}
+		rowsAffected, err := rs.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rowsAffected > 0 {
+			return nil
+		}
+		// MySQL reports no rows affected when the limit is unchanged, so only insert if the row does not exist
+		exists, err := sess.Collection(syncLimitTableName).Find(db.Cond{"name": name}).Exists()
+		if err != nil || exists {
+			return err
+		}
+		_, err = sess.Collection(syncLimitTableName).Insert(&syncLimitRecord{Name: name, SizeLimit: limit})
+		return err
+	})
+}
+
+func (r *syncRepo) holderCond(name, key string) db.Cond {
+	return db.Cond{"name": name, "holderkey": key, "controller": r.controller}
+}
+
+func (r *syncRepo) TryAcquire(name, key string, weight int) (bool, error) {
+	return r.acquire(name, key, weight, true)
+}
+
+func (r *syncRepo) Acquire(name, key string, weight int) (bool, error) {
+	return r.acquire(name, key, weight, false)
+}
+
+func (r *syncRepo) acquire(name, key string, weight int, checkQueue bool) (bool, error) {
+	acquired := false
+	err := r.session.Tx(context.Background(), func(sess sqlbuilder.Tx) error {
+		// Updating the limit row locks it until the transaction ends, so that controllers acquire the lock one at a time.
+		_, err := sess.
+			Update(syncLimitTableName).
+			Set("sizelimit = sizelimit").
+			Where(db.Cond{"name": name}).
+			Exec()
+		if err != nil {
+			return err
+		}
+		limit := &syncLimitRecord{}
+		err = sess.Collection(syncLimitTableName).Find(db.Cond{"name": name}).One(limit)
+		if err != nil {
+			return err
+		}
+		held, err := sess.Collection(syncStateTableName).Find(r.holderCond(name, key), db.Cond{"held": true}).Exists()
+		if err != nil {
+			return err
+		}
+		if held {
+			acquired = true
+			return nil
+		}
+		var holders []syncStateRecord
+		err = sess.
+			Select("weight").
+			From(syncStateTableName).
+			Where(db.Cond{"name": name, "held": true}).
+			All(&holders)
+		if err != nil {
+			return err
+		}
+		units := 0
+		for _, holder := range holders {
+			units += holder.Weight
+		}
+		if units+weight > limit.SizeLimit {
+			return nil
+		}
+		if checkQueue {
+			var first []syncStateRecord
+			err = sess.
+				Select("holderkey", "controller").
+				From(syncStateTableName).
+				Where(db.Cond{"name": name, "held": false}).
+				OrderBy("-priority", "creationtime", "holderkey").
+				Limit(1).
+				All(&first)
+			if err != nil {
+				return err
+			}
+			if len(first) > 0 && (first[0].Key != key || first[0].Controller != r.controller) {
+				return nil
+			}
+		}
+		rs, err := sess.
+			Update(syncStateTableName).
+			Set("held", true).
+			Set("weight", weight).
+			Where(r.holderCond(name, key)).
+			Exec()
+		if err != nil {
+			return err
+		}
+		rowsAffected, err := rs.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rowsAffected == 0 {
+			_, err = sess.Collection(syncStateTableName).Insert(&syncStateRecord{
+				Name:         name,
+				Key:          key,
+				Controller:   r.controller,
+				Held:         true,
+				Weight:       weight,
+				CreationTime: time.Now().UTC(),
+			})
+			if err != nil {
+				return err
+			}
+		}
+		acquired = true
+		return nil
+	})
+	return acquired, err
+}
+
+func (r *syncRepo) Release(name, key string) error {
+	_, err := r.session.
+		DeleteFrom(syncStateTableName).
+		Where(r.holderCond(name, key)).
+		And(db.Cond{"held": true}).
+		Exec()
+	return err
+}
+
+func (r *syncRepo) AddToQueue(name, key string, priority int32, creationTime time.Time) error {
+	exists, err := r.session.Collection(syncStateTableName).Find(r.holderCond(name, key)).Exists()
+	if err != nil || exists {
+		return err
+	}
+	_, err = r.session.Collection(syncStateTableName).Insert(&syncStateRecord{
+		Name:         name,
+		Key:          key,
+		Controller:   r.controller,
+		Priority:     priority,
+		Weight:       1,
+		CreationTime: creationTime.UTC(),
+	})
+	return err
+}
+
+func (r *syncRepo) RemoveFromQueue(name, key string) error {
+	_, err := r.session.
+		DeleteFrom(syncStateTableName).
+		Where(r.holderCond(name, key)).
+		And(db.Cond{"held": false}).
+		Exec()
+	return err
+}
+
+func (r *syncRepo) GetHolders(name string) (map[string]int, error) {
+	var records []syncStateRecord
+	err := r.session.
+		Select("holderkey", "weight").
+		From(syncStateTableName).
+		Where(db.Cond{"name": name, "held": true}).
+		All(&records)
+	if err != nil {
+		return nil, err
+	}
+	holders := make(map[string]int, len(records))
+	for _, record := range records {
+		holders[record.Key] = record.Weight
+	}
+	return holders, nil
+}
+
+func (r *syncRepo) GetWaiters(name string) ([]string, error) {
+	var records []syncStateRecord
+	err := r.session.
+		Select("holderkey").
+		From(syncStateTableName).
+		Where(db.Cond{"name": name, "controller": r.controller, "held": false}).
+		OrderBy("-priority", "creationtime", "holderkey").
+		All(&records)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]string, len(records))
+	for i, record := range records {
+		keys[i] = record.Key
+	}
+	return keys, nil
+}
+
+func (r *syncRepo) ListWaiting() ([]string, error) {
+	var records []syncStateRecord
+	err := r.session.
+		Select().
+		Distinct("name").
+		From(syncStateTableName).
+		Where(db.Cond{"controller": r.controller, "held": false}).
+		All(&records)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, len(records))
+	for i, record := range records {
+		names[i] = record.Name
+	}
+	return names, nil
+}
+
+func (r *syncRepo) ListHeld() (map[string][]string, error) {
+	var records []syncStateRecord
+	err := r.session.
+		Select("name", "holderkey").
+		From(syncStateTableName).
+		Where(db.Cond{"controller": r.controller, "held": true}).
+		All(&records)
+	if err != nil {
+		return nil, err
+	}
+	held := make(map[string][]string)
+	for _, record := range records {
+		held[record.Name] = append(held[record.Name], record.Key)
+	}
+	return held, nil
+}
+
+func (r *syncRepo) RenewLease(leaseDuration time.Duration) error {
+	now := time.Now().UTC()
+	rs, err := r.session.
+		Update(syncControllerTableName).
+		Set("heartbeat", now).
+		Where(db.Cond{"controller": r.controller}).
+		Exec()
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := rs.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		_, err = r.session.Collection(syncControllerTableName).Insert(&syncControllerRecord{Controller: r.controller, Heartbeat: now})
+		if err != nil {
+			return err
+		}
+	}
+	var expired []syncControllerRecord
+	err = r.session.
+		Select("controller").
+		From(syncControllerTableName).
+		Where(db.Cond{"heartbeat <": now.Add(-leaseDuration)}).
+		All(&expired)
+	if err != nil {
+		return err
+	}
+	for _, record := range expired {
+		err := r.session.Tx(context.Background(), func(sess sqlbuilder.Tx) error {
+			rs, err := sess.DeleteFrom(syncStateTableName).Where(db.Cond{"controller": record.Controller}).Exec()
+			if err != nil {
+				return err
+			}
+			rowsAffected, err := rs.RowsAffected()
+			if err != nil {
+				return err
+			}
+			_, err = sess.DeleteFrom(syncControllerTableName).Where(db.Cond{"controller": record.Controller}).Exec()
+			if err != nil {
+				return err
+			}
+			log.WithFields(log.Fields{"controller": record.Controller, "rowsAffected": rowsAffected}).Info("Released the locks of a controller whose lease expired")
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
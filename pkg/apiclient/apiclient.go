@@ -7,6 +7,7 @@ import (
 	log "github.com/sirupsen/logrus"
 	"k8s.io/client-go/tools/clientcmd"
 
+	cachepkg "github.com/simster7/argo/v2/pkg/apiclient/cache"
 	clusterworkflowtmplpkg "github.com/simster7/argo/v2/pkg/apiclient/clusterworkflowtemplate"
 	cronworkflowpkg "github.com/simster7/argo/v2/pkg/apiclient/cronworkflow"
 	infopkg "github.com/simster7/argo/v2/pkg/apiclient/info"
@@ -23,6 +24,7 @@ type Client interface {
 	NewWorkflowTemplateServiceClient() workflowtemplatepkg.WorkflowTemplateServiceClient
 	NewClusterWorkflowTemplateServiceClient() clusterworkflowtmplpkg.ClusterWorkflowTemplateServiceClient
 	NewInfoServiceClient() (infopkg.InfoServiceClient, error)
+	NewCacheServiceClient() (cachepkg.CacheServiceClient, error)
 }
 
 type Opts struct {
@@ -0,0 +1,31 @@
+package apiclient
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	cachepkg "github.com/simster7/argo/v2/pkg/apiclient/cache"
+)
+
+type argoKubeCacheServiceClient struct {
+	delegate cachepkg.CacheServiceServer
+}
+
+var _ cachepkg.CacheServiceClient = &argoKubeCacheServiceClient{}
+
+func (a *argoKubeCacheServiceClient) ListCacheEntries(ctx context.Context, req *cachepkg.ListCacheEntriesRequest, _ ...grpc.CallOption) (*cachepkg.CacheEntryList, error) {
+	return a.delegate.ListCacheEntries(ctx, req)
+}
+
+func (a *argoKubeCacheServiceClient) GetCacheEntry(ctx context.Context, req *cachepkg.GetCacheEntryRequest, _ ...grpc.CallOption) (*cachepkg.CacheEntry, error) {
+	return a.delegate.GetCacheEntry(ctx, req)
+}
+
+func (a *argoKubeCacheServiceClient) DeleteCacheEntry(ctx context.Context, req *cachepkg.DeleteCacheEntryRequest, _ ...grpc.CallOption) (*cachepkg.CacheEntryDeletedResponse, error) {
+	return a.delegate.DeleteCacheEntry(ctx, req)
+}
+
+func (a *argoKubeCacheServiceClient) PruneCache(ctx context.Context, req *cachepkg.PruneCacheRequest, _ ...grpc.CallOption) (*cachepkg.PruneCacheResponse, error) {
+	return a.delegate.PruneCache(ctx, req)
+}
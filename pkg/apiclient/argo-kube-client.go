@@ -10,6 +10,7 @@ import (
 	"k8s.io/client-go/tools/clientcmd"
 
 	"github.com/simster7/argo/v2/persist/sqldb"
+	cachepkg "github.com/simster7/argo/v2/pkg/apiclient/cache"
 	"github.com/simster7/argo/v2/pkg/apiclient/clusterworkflowtemplate"
 	"github.com/simster7/argo/v2/pkg/apiclient/cronworkflow"
 	infopkg "github.com/simster7/argo/v2/pkg/apiclient/info"
@@ -18,6 +19,7 @@ import (
 	"github.com/simster7/argo/v2/pkg/apiclient/workflowtemplate"
 	workflow "github.com/simster7/argo/v2/pkg/client/clientset/versioned"
 	"github.com/simster7/argo/v2/server/auth"
+	cacheserver "github.com/simster7/argo/v2/server/cache"
 	clusterworkflowtmplserver "github.com/simster7/argo/v2/server/clusterworkflowtemplate"
 	cronworkflowserver "github.com/simster7/argo/v2/server/cronworkflow"
 	"github.com/simster7/argo/v2/server/types"
@@ -90,3 +92,8 @@ func (a *argoKubeClient) NewInfoServiceClient() (infopkg.InfoServiceClient, erro
 func (a *argoKubeClient) NewClusterWorkflowTemplateServiceClient() clusterworkflowtemplate.ClusterWorkflowTemplateServiceClient {
 	return &errorTranslatingWorkflowClusterTemplateServiceClient{&argoKubeWorkflowClusterTemplateServiceClient{clusterworkflowtmplserver.NewClusterWorkflowTemplateServer(a.instanceIDService)}}
 }
+
+// NewCacheServiceClient returns a client for config map caches only, as database caches need the Argo Server
+func (a *argoKubeClient) NewCacheServiceClient() (cachepkg.CacheServiceClient, error) {
+	return &argoKubeCacheServiceClient{cacheserver.NewCacheServer(sqldb.NullMemoizationCacheRepo)}, nil
+}
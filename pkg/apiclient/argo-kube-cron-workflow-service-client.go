@@ -46,3 +46,7 @@ func (c *argoKubeCronWorkflowServiceClient) ResumeCronWorkflow(ctx context.Conte
 func (c *argoKubeCronWorkflowServiceClient) SuspendCronWorkflow(ctx context.Context, req *cronworkflowpkg.CronWorkflowSuspendRequest, _ ...grpc.CallOption) (*v1alpha1.CronWorkflow, error) {
 	return c.delegate.SuspendCronWorkflow(ctx, req)
 }
+
+func (c *argoKubeCronWorkflowServiceClient) BackfillCronWorkflow(ctx context.Context, req *cronworkflowpkg.BackfillCronWorkflowRequest, _ ...grpc.CallOption) (*cronworkflowpkg.BackfillCronWorkflowResponse, error) {
+	return c.delegate.BackfillCronWorkflow(ctx, req)
+}
@@ -8,6 +8,7 @@ import (
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/metadata"
 
+	cachepkg "github.com/simster7/argo/v2/pkg/apiclient/cache"
 	clusterworkflowtmplpkg "github.com/simster7/argo/v2/pkg/apiclient/clusterworkflowtemplate"
 	cronworkflowpkg "github.com/simster7/argo/v2/pkg/apiclient/cronworkflow"
 	infopkg "github.com/simster7/argo/v2/pkg/apiclient/info"
@@ -57,6 +58,10 @@ func (a *argoServerClient) NewInfoServiceClient() (infopkg.InfoServiceClient, er
 	return infopkg.NewInfoServiceClient(a.ClientConn), nil
 }
 
+func (a *argoServerClient) NewCacheServiceClient() (cachepkg.CacheServiceClient, error) {
+	return cachepkg.NewCacheServiceClient(a.ClientConn), nil
+}
+
 func newClientConn(opts ArgoServerOpts) (*grpc.ClientConn, error) {
 	creds := grpc.WithInsecure()
 	if opts.Secure {
@@ -542,6 +542,136 @@ func (m *CronWorkflowResumeRequest) GetNamespace() string {
 	return ""
 }
 
+type BackfillCronWorkflowRequest struct {
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// Start is the earliest scheduled time to run (inclusive).
+	Start *v1.Time `protobuf:"bytes,3,opt,name=start,proto3" json:"start,omitempty"`
+	// End is the latest scheduled time to run (inclusive).
+	End *v1.Time `protobuf:"bytes,4,opt,name=end,proto3" json:"end,omitempty"`
+	// Parallelism is the number of backfilled workflows that may run at the same time, defaults to 1.
+	Parallelism          int32    `protobuf:"varint,5,opt,name=parallelism,proto3" json:"parallelism,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BackfillCronWorkflowRequest) Reset()         { *m = BackfillCronWorkflowRequest{} }
+func (m *BackfillCronWorkflowRequest) String() string { return proto.CompactTextString(m) }
+func (*BackfillCronWorkflowRequest) ProtoMessage()    {}
+func (*BackfillCronWorkflowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_257f310938c448f8, []int{9}
+}
+func (m *BackfillCronWorkflowRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BackfillCronWorkflowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BackfillCronWorkflowRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BackfillCronWorkflowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackfillCronWorkflowRequest.Merge(m, src)
+}
+func (m *BackfillCronWorkflowRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BackfillCronWorkflowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackfillCronWorkflowRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackfillCronWorkflowRequest proto.InternalMessageInfo
+
+func (m *BackfillCronWorkflowRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *BackfillCronWorkflowRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *BackfillCronWorkflowRequest) GetStart() *v1.Time {
+	if m != nil {
+		return m.Start
+	}
+	return nil
+}
+
+func (m *BackfillCronWorkflowRequest) GetEnd() *v1.Time {
+	if m != nil {
+		return m.End
+	}
+	return nil
+}
+
+func (m *BackfillCronWorkflowRequest) GetParallelism() int32 {
+	if m != nil {
+		return m.Parallelism
+	}
+	return 0
+}
+
+type BackfillCronWorkflowResponse struct {
+	// Items are the workflows created by the backfill.
+	Items                []*v1alpha1.Workflow `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *BackfillCronWorkflowResponse) Reset()         { *m = BackfillCronWorkflowResponse{} }
+func (m *BackfillCronWorkflowResponse) String() string { return proto.CompactTextString(m) }
+func (*BackfillCronWorkflowResponse) ProtoMessage()    {}
+func (*BackfillCronWorkflowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_257f310938c448f8, []int{10}
+}
+func (m *BackfillCronWorkflowResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BackfillCronWorkflowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BackfillCronWorkflowResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BackfillCronWorkflowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackfillCronWorkflowResponse.Merge(m, src)
+}
+func (m *BackfillCronWorkflowResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BackfillCronWorkflowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackfillCronWorkflowResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackfillCronWorkflowResponse proto.InternalMessageInfo
+
+func (m *BackfillCronWorkflowResponse) GetItems() []*v1alpha1.Workflow {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*LintCronWorkflowRequest)(nil), "cronworkflow.LintCronWorkflowRequest")
 	proto.RegisterType((*CreateCronWorkflowRequest)(nil), "cronworkflow.CreateCronWorkflowRequest")
@@ -552,6 +682,8 @@ func init() {
 	proto.RegisterType((*CronWorkflowDeletedResponse)(nil), "cronworkflow.CronWorkflowDeletedResponse")
 	proto.RegisterType((*CronWorkflowSuspendRequest)(nil), "cronworkflow.CronWorkflowSuspendRequest")
 	proto.RegisterType((*CronWorkflowResumeRequest)(nil), "cronworkflow.CronWorkflowResumeRequest")
+	proto.RegisterType((*BackfillCronWorkflowRequest)(nil), "cronworkflow.BackfillCronWorkflowRequest")
+	proto.RegisterType((*BackfillCronWorkflowResponse)(nil), "cronworkflow.BackfillCronWorkflowResponse")
 }
 
 func init() {
@@ -559,54 +691,62 @@ func init() {
 }
 
 var fileDescriptor_257f310938c448f8 = []byte{
-	// 752 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0xcd, 0x4f, 0xd4, 0x4e,
-	0x18, 0xc7, 0x33, 0xcb, 0x2f, 0xbf, 0x84, 0x07, 0x89, 0x3a, 0x24, 0xb8, 0x54, 0x24, 0xa4, 0x41,
-	0x81, 0x55, 0xa6, 0x2c, 0xa0, 0x31, 0xe8, 0x85, 0x17, 0xc3, 0x05, 0x5f, 0xb2, 0xc4, 0x18, 0xbc,
-	0x95, 0xee, 0x58, 0x2a, 0xdd, 0x99, 0xda, 0x99, 0x5d, 0x62, 0x0c, 0x17, 0xef, 0x9e, 0x3c, 0x6a,
-	0x3c, 0x7b, 0xf1, 0xe5, 0xa2, 0xf1, 0x62, 0xe2, 0xd1, 0xa3, 0x89, 0xff, 0x80, 0x21, 0xfe, 0x17,
-	0x5e, 0x4c, 0x67, 0xdf, 0x3a, 0xdd, 0xad, 0x14, 0x58, 0x13, 0x6f, 0xd3, 0xed, 0x3c, 0xcf, 0x7c,
-	0x9e, 0xef, 0xf3, 0xec, 0xb7, 0x03, 0x24, 0xd8, 0x71, 0x2d, 0x3b, 0xf0, 0x1c, 0xdf, 0xa3, 0x4c,
-	0x5a, 0x4e, 0xc8, 0xd9, 0x2e, 0x0f, 0x77, 0x1e, 0xf8, 0x7c, 0x57, 0x3d, 0xcc, 0x34, 0x9f, 0x48,
-	0x10, 0x72, 0xc9, 0xf1, 0x89, 0xf8, 0x0e, 0x63, 0xd4, 0xe5, 0xdc, 0xf5, 0x69, 0x94, 0xc0, 0xb2,
-	0x19, 0xe3, 0xd2, 0x96, 0x1e, 0x67, 0xa2, 0xbe, 0xd7, 0x58, 0xd8, 0xb9, 0x2a, 0x88, 0xc7, 0xa3,
-	0xb7, 0x15, 0xdb, 0xd9, 0xf6, 0x18, 0x0d, 0x1f, 0x5b, 0x8d, 0xf3, 0x84, 0x55, 0xa1, 0xd2, 0xb6,
-	0x6a, 0x45, 0xcb, 0xa5, 0x8c, 0x86, 0xb6, 0xa4, 0xe5, 0x46, 0xd4, 0x8a, 0xeb, 0xc9, 0xed, 0xea,
-	0x16, 0x71, 0x78, 0xc5, 0xb2, 0x43, 0x97, 0x07, 0x21, 0x7f, 0xa8, 0x16, 0xed, 0xd0, 0x16, 0x61,
-	0xad, 0x68, 0xfb, 0xc1, 0xb6, 0xdd, 0x91, 0xc4, 0x7c, 0x85, 0xe0, 0xcc, 0xba, 0xc7, 0xe4, 0x4a,
-	0xc8, 0xd9, 0xbd, 0xc6, 0xee, 0x12, 0x7d, 0x54, 0xa5, 0x42, 0xe2, 0x51, 0xe8, 0x67, 0x76, 0x85,
-	0x8a, 0xc0, 0x76, 0x68, 0x1e, 0x8d, 0xa3, 0xa9, 0xfe, 0x52, 0xfb, 0x07, 0x4c, 0x41, 0x95, 0xd8,
-	0x0c, 0xca, 0xe7, 0xc6, 0xd1, 0xd4, 0xc0, 0xdc, 0x12, 0x69, 0x53, 0x91, 0x26, 0x95, 0x5a, 0x44,
-	0x02, 0x92, 0x88, 0x8a, 0xb4, 0x94, 0x6a, 0x52, 0x11, 0xed, 0x74, 0x2d, 0xad, 0xf9, 0x0b, 0xc1,
-	0xc8, 0x4a, 0x48, 0x6d, 0x49, 0xff, 0x55, 0x44, 0xbc, 0x09, 0x83, 0x8e, 0x22, 0xbc, 0x1d, 0xa8,
-	0xae, 0xe6, 0xfb, 0xd4, 0x39, 0xf3, 0xa4, 0xde, 0x56, 0x12, 0x6f, 0x6b, 0xfb, 0x88, 0xa8, 0xad,
-	0xa4, 0x16, 0x25, 0x8e, 0x85, 0x96, 0xf4, 0x4c, 0xe6, 0x33, 0x04, 0xf9, 0x75, 0x4f, 0x68, 0xed,
-	0x11, 0xd9, 0x8a, 0xdf, 0x80, 0x01, 0xdf, 0x13, 0xb2, 0xc9, 0x54, 0xaf, 0xbd, 0x98, 0x8d, 0x69,
-	0xbd, 0x1d, 0x58, 0x8a, 0x67, 0x31, 0x5f, 0x22, 0x18, 0x5e, 0xa3, 0x5d, 0xa7, 0x05, 0xc3, 0x7f,
-	0xd1, 0xe1, 0x0d, 0x10, 0xb5, 0xd6, 0x09, 0x73, 0x49, 0xc2, 0x3b, 0x00, 0x2e, 0x95, 0xba, 0x68,
-	0xb3, 0xd9, 0x00, 0xd7, 0x5a, 0x71, 0xa5, 0x58, 0x0e, 0xf3, 0x13, 0x82, 0x91, 0xbb, 0x41, 0x39,
-	0x65, 0x58, 0x86, 0xe3, 0x84, 0xcb, 0xb9, 0x3c, 0xca, 0x44, 0x99, 0x1c, 0xa2, 0xbe, 0xbf, 0x33,
-	0xe7, 0xaf, 0x11, 0x8c, 0xac, 0x52, 0x9f, 0x76, 0x47, 0x3f, 0xbc, 0xb8, 0x9b, 0x30, 0x58, 0x56,
-	0xe9, 0x8e, 0x34, 0x94, 0xab, 0xf1, 0xd0, 0x92, 0x9e, 0xc9, 0x3c, 0x07, 0x67, 0xe3, 0x8c, 0xf5,
-	0xbd, 0xe5, 0x12, 0x15, 0x01, 0x67, 0x82, 0x9a, 0xb7, 0xc0, 0x88, 0xbf, 0xde, 0xa8, 0x8a, 0x80,
-	0xb2, 0xf2, 0x91, 0x2b, 0x31, 0x6f, 0x46, 0x06, 0x10, 0x97, 0x44, 0x54, 0x2b, 0xf4, 0xc8, 0xe9,
-	0xe6, 0x3e, 0x0c, 0xc0, 0x90, 0xc6, 0x47, 0xc3, 0x9a, 0xe7, 0x50, 0xfc, 0x1e, 0xc1, 0xa9, 0xa4,
-	0x13, 0xe2, 0xf3, 0x24, 0x6e, 0xe3, 0x24, 0xc5, 0x29, 0x8d, 0xe3, 0x4f, 0x83, 0x39, 0xf7, 0xf4,
-	0xfb, 0xcf, 0xe7, 0xb9, 0x4b, 0xe6, 0xa4, 0xfa, 0x46, 0xd4, 0x8a, 0xfa, 0x47, 0x45, 0x58, 0x4f,
-	0x5a, 0x15, 0xec, 0x59, 0xbe, 0xc7, 0xe4, 0x22, 0x2a, 0xe0, 0x77, 0x08, 0x70, 0xa7, 0x37, 0xe2,
-	0x49, 0x1d, 0x3a, 0xd5, 0x3d, 0x7b, 0x81, 0x3d, 0xa3, 0xb0, 0x27, 0x4d, 0xf3, 0x60, 0xec, 0x88,
-	0xf8, 0x2d, 0x82, 0xd3, 0x1d, 0x7e, 0x86, 0x2f, 0x24, 0x55, 0xee, 0x6e, 0x78, 0xc6, 0x8d, 0x63,
-	0xf3, 0x46, 0xa9, 0xcd, 0x82, 0x62, 0x9e, 0xc0, 0x19, 0x98, 0xf1, 0x1b, 0x04, 0x27, 0x13, 0x86,
-	0x87, 0x27, 0x74, 0xdc, 0xee, 0x7e, 0xd8, 0x0b, 0x71, 0x8b, 0x0a, 0xf4, 0x22, 0x9e, 0xce, 0x30,
-	0x13, 0x6a, 0xbd, 0x87, 0x3f, 0x22, 0xc0, 0x9d, 0x0e, 0x98, 0x1c, 0x89, 0x54, 0x8f, 0xec, 0x05,
-	0xf5, 0x82, 0xa2, 0x26, 0x46, 0x76, 0xea, 0x68, 0x32, 0x5e, 0x20, 0xc0, 0x9d, 0xfe, 0x97, 0x04,
-	0x4f, 0x75, 0x48, 0x63, 0x3a, 0x39, 0xf4, 0xe9, 0x06, 0xd5, 0x90, 0xb5, 0x70, 0x08, 0x59, 0x3f,
-	0x23, 0xc0, 0x75, 0xe3, 0xf9, 0xf3, 0x3f, 0x2d, 0xc5, 0xa6, 0x7a, 0x21, 0xeb, 0x35, 0x45, 0x7d,
-	0xd9, 0x98, 0xcd, 0x4c, 0x6d, 0x85, 0x8a, 0x21, 0x52, 0xf7, 0x0b, 0x82, 0xa1, 0x86, 0x11, 0x6b,
-	0x05, 0x4c, 0xa5, 0x17, 0xa0, 0xfb, 0x76, 0x2f, 0x2a, 0xb8, 0xae, 0x2a, 0xb8, 0x62, 0x14, 0xb3,
-	0x57, 0x20, 0xea, 0x10, 0x8b, 0xa8, 0xb0, 0xbc, 0xf4, 0x75, 0x7f, 0x0c, 0x7d, 0xdb, 0x1f, 0x43,
-	0x3f, 0xf6, 0xc7, 0xd0, 0xfd, 0xf9, 0x83, 0x2e, 0xbf, 0x5d, 0xee, 0xe9, 0x5b, 0xff, 0xab, 0x3b,
-	0xef, 0xfc, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x34, 0x33, 0x8d, 0x2b, 0xcc, 0x0b, 0x00, 0x00,
+	// 870 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x97, 0x49, 0x6f, 0xfb, 0x44,
+	0x18, 0xc6, 0x35, 0x49, 0x83, 0xd4, 0x37, 0xad, 0x80, 0x29, 0x2a, 0x8e, 0x5b, 0xaa, 0xc8, 0x2a,
+	0x34, 0x0d, 0xd4, 0x6e, 0xd2, 0xb2, 0xa8, 0xb4, 0x12, 0x5d, 0x50, 0x2f, 0x65, 0x91, 0x0b, 0x42,
+	0xe5, 0x36, 0x75, 0xa6, 0xa9, 0x89, 0x37, 0x3c, 0x93, 0x54, 0x08, 0xf5, 0xc2, 0x9d, 0x13, 0x47,
+	0x10, 0x67, 0x0e, 0x6c, 0x27, 0xc4, 0x05, 0x89, 0x23, 0x47, 0x24, 0xbe, 0x00, 0xaa, 0xfa, 0x05,
+	0x38, 0x73, 0x41, 0x1e, 0x3b, 0x89, 0xed, 0xc4, 0xad, 0x9b, 0x06, 0xe9, 0x7f, 0x9b, 0xd8, 0xf3,
+	0x3e, 0xf3, 0x7b, 0x17, 0xe7, 0xd1, 0x80, 0xea, 0x75, 0xda, 0x1a, 0xf1, 0x4c, 0xc3, 0x32, 0xa9,
+	0xc3, 0x35, 0xc3, 0x77, 0x9d, 0x2b, 0xd7, 0xef, 0x5c, 0x58, 0xee, 0x95, 0xf8, 0xb1, 0xd1, 0xff,
+	0xa5, 0x7a, 0xbe, 0xcb, 0x5d, 0x3c, 0x17, 0xdf, 0x21, 0x2f, 0xb7, 0x5d, 0xb7, 0x6d, 0xd1, 0x40,
+	0x40, 0x23, 0x8e, 0xe3, 0x72, 0xc2, 0x4d, 0xd7, 0x61, 0xe1, 0x5e, 0x79, 0xbb, 0xf3, 0x06, 0x53,
+	0x4d, 0x37, 0x78, 0x6b, 0x13, 0xe3, 0xd2, 0x74, 0xa8, 0xff, 0x99, 0x16, 0x9d, 0xc7, 0x34, 0x9b,
+	0x72, 0xa2, 0xf5, 0x1a, 0x5a, 0x9b, 0x3a, 0xd4, 0x27, 0x9c, 0xb6, 0xa2, 0xa8, 0xc3, 0xb6, 0xc9,
+	0x2f, 0xbb, 0xe7, 0xaa, 0xe1, 0xda, 0x1a, 0xf1, 0xdb, 0xae, 0xe7, 0xbb, 0x9f, 0x88, 0xc5, 0x30,
+	0x74, 0x40, 0xd8, 0x6b, 0x10, 0xcb, 0xbb, 0x24, 0x23, 0x22, 0xca, 0xb7, 0x08, 0x9e, 0x3f, 0x31,
+	0x1d, 0x7e, 0xe8, 0xbb, 0xce, 0x47, 0xd1, 0x6e, 0x9d, 0x7e, 0xda, 0xa5, 0x8c, 0xe3, 0x65, 0x98,
+	0x75, 0x88, 0x4d, 0x99, 0x47, 0x0c, 0x2a, 0xa1, 0x2a, 0xaa, 0xcd, 0xea, 0xc3, 0x07, 0x98, 0xc2,
+	0x9c, 0x11, 0x0b, 0x92, 0x0a, 0x55, 0x54, 0x2b, 0x37, 0xf7, 0xd5, 0x21, 0x95, 0xda, 0xa7, 0x12,
+	0x8b, 0xa0, 0x80, 0x6a, 0x40, 0xa5, 0x0e, 0x2a, 0xd5, 0xa7, 0x52, 0x13, 0xa7, 0x27, 0x64, 0x95,
+	0x7f, 0x11, 0x54, 0x0e, 0x7d, 0x4a, 0x38, 0x7d, 0x52, 0x11, 0xf1, 0x19, 0xcc, 0x1b, 0x82, 0xf0,
+	0x3d, 0x4f, 0x74, 0x55, 0x2a, 0x8a, 0x73, 0xb6, 0xd4, 0xb0, 0xad, 0x6a, 0xbc, 0xad, 0xc3, 0x23,
+	0x82, 0xb6, 0xaa, 0xbd, 0x40, 0x38, 0x16, 0xaa, 0x27, 0x95, 0x94, 0x2f, 0x11, 0x48, 0x27, 0x26,
+	0x4b, 0xb4, 0x87, 0xe5, 0x4b, 0xfe, 0x14, 0xca, 0x96, 0xc9, 0x78, 0x9f, 0x29, 0xcc, 0xbd, 0x91,
+	0x8f, 0xe9, 0x64, 0x18, 0xa8, 0xc7, 0x55, 0x94, 0x6f, 0x10, 0x2c, 0x1e, 0xd3, 0xb1, 0xd3, 0x82,
+	0x61, 0x26, 0x38, 0x3c, 0x02, 0x11, 0xeb, 0x24, 0x61, 0x21, 0x4d, 0xf8, 0x3e, 0x40, 0x9b, 0xf2,
+	0x64, 0xd1, 0x36, 0xf3, 0x01, 0x1e, 0x0f, 0xe2, 0xf4, 0x98, 0x86, 0xf2, 0x2b, 0x82, 0xca, 0x87,
+	0x5e, 0x2b, 0x63, 0x58, 0x16, 0xe3, 0x84, 0x07, 0x05, 0x09, 0xe5, 0xa2, 0x4c, 0x0f, 0x51, 0xf1,
+	0xff, 0x99, 0xf3, 0xef, 0x10, 0x54, 0x8e, 0xa8, 0x45, 0x39, 0x9d, 0x4e, 0x71, 0xcf, 0x60, 0xbe,
+	0x25, 0xe4, 0x26, 0x1a, 0xca, 0xa3, 0x78, 0xa8, 0x9e, 0x54, 0x52, 0x5e, 0x80, 0xa5, 0x38, 0x63,
+	0xb8, 0xb7, 0xa5, 0x53, 0xe6, 0xb9, 0x0e, 0xa3, 0xca, 0xbb, 0x20, 0xc7, 0x5f, 0x9f, 0x76, 0x99,
+	0x47, 0x9d, 0xd6, 0xc4, 0x99, 0x28, 0xef, 0x40, 0x25, 0xae, 0xa7, 0x53, 0xd6, 0xb5, 0xe9, 0xe4,
+	0x72, 0xff, 0x20, 0x58, 0x3a, 0x20, 0x46, 0xe7, 0xc2, 0xb4, 0xac, 0xe9, 0x94, 0xfa, 0x2d, 0x28,
+	0x31, 0x4e, 0x7c, 0x1e, 0x95, 0xb8, 0x9e, 0xaf, 0xc4, 0x1f, 0x98, 0x36, 0xd5, 0xc3, 0x40, 0xbc,
+	0x0b, 0x45, 0xea, 0xb4, 0xa4, 0x99, 0x07, 0xc7, 0x07, 0x61, 0xb8, 0x0a, 0x65, 0x8f, 0xf8, 0xc4,
+	0xb2, 0xa8, 0x65, 0x32, 0x5b, 0x2a, 0x55, 0x51, 0xad, 0xa4, 0xc7, 0x1f, 0x29, 0x0c, 0x96, 0xc7,
+	0xa7, 0x1c, 0xb6, 0x0c, 0x9f, 0x42, 0xc9, 0xe4, 0xd4, 0x66, 0x12, 0xaa, 0x16, 0x6b, 0xe5, 0xe6,
+	0xde, 0x44, 0xc3, 0x3d, 0x50, 0x0d, 0xb5, 0x9a, 0xb7, 0x73, 0xb0, 0x90, 0x18, 0x04, 0xea, 0xf7,
+	0x4c, 0x83, 0xe2, 0x9f, 0x11, 0x3c, 0x93, 0xb6, 0x1c, 0xfc, 0xa2, 0x1a, 0xf7, 0x4b, 0x35, 0xc3,
+	0x92, 0xe4, 0xc7, 0x7f, 0x76, 0x4a, 0xf3, 0x8b, 0xbf, 0x6e, 0xbf, 0x2a, 0xbc, 0xa2, 0xac, 0x09,
+	0x33, 0xee, 0x35, 0x92, 0xee, 0xcd, 0xb4, 0xcf, 0x07, 0x8d, 0xbd, 0xd6, 0x2c, 0xd3, 0xe1, 0x3b,
+	0xa8, 0x8e, 0x7f, 0x42, 0x80, 0x47, 0x4d, 0x08, 0xaf, 0x25, 0xa1, 0x33, 0x6d, 0x6a, 0x1a, 0xd8,
+	0x1b, 0x02, 0x7b, 0x4d, 0x51, 0xee, 0xc7, 0x0e, 0x88, 0x7f, 0x44, 0xf0, 0xec, 0x88, 0x71, 0xe0,
+	0x97, 0xd2, 0x55, 0x1e, 0xef, 0x2c, 0xf2, 0xdb, 0x8f, 0xe6, 0x0d, 0xa4, 0x95, 0xba, 0x60, 0x5e,
+	0xc5, 0x39, 0x98, 0xf1, 0x0f, 0x08, 0x9e, 0x4e, 0x39, 0x0b, 0x5e, 0x4d, 0xe2, 0x8e, 0x37, 0x9e,
+	0x69, 0x14, 0xb7, 0x21, 0x40, 0x5f, 0xc6, 0xeb, 0x39, 0x66, 0x42, 0xac, 0xaf, 0xf1, 0x2f, 0x08,
+	0xf0, 0xa8, 0xd5, 0xa4, 0x47, 0x22, 0xd3, 0x8c, 0xa6, 0x41, 0xbd, 0x2d, 0xa8, 0x55, 0x39, 0x3f,
+	0x75, 0x30, 0x19, 0x5f, 0x23, 0xc0, 0xa3, 0x46, 0x93, 0x06, 0xcf, 0xb4, 0x22, 0x79, 0x3d, 0x3d,
+	0xf4, 0xd9, 0x4e, 0x10, 0x95, 0xb5, 0xfe, 0x80, 0xb2, 0xfe, 0x86, 0x00, 0x87, 0xff, 0xf0, 0x77,
+	0x7f, 0x69, 0x19, 0x7e, 0x30, 0x8d, 0xb2, 0xbe, 0x29, 0xa8, 0x5f, 0x95, 0x37, 0x73, 0x53, 0x6b,
+	0xbe, 0x60, 0x08, 0xaa, 0xfb, 0x3b, 0x82, 0x85, 0xc8, 0xf1, 0x12, 0x09, 0xd4, 0xb2, 0x13, 0x48,
+	0x1a, 0xe4, 0x34, 0x32, 0xd8, 0x15, 0x19, 0xbc, 0x26, 0x37, 0xf2, 0x67, 0xc0, 0x42, 0x88, 0x20,
+	0x85, 0xef, 0x11, 0x3c, 0x37, 0xce, 0x2d, 0x70, 0xaa, 0xf3, 0x77, 0x98, 0xa8, 0x5c, 0xcf, 0xb3,
+	0x35, 0x9a, 0x92, 0x3d, 0x41, 0xfb, 0xba, 0xd2, 0xcc, 0x4f, 0x7b, 0x1e, 0xe9, 0xed, 0xa0, 0xfa,
+	0xc1, 0xfe, 0x1f, 0x37, 0x2b, 0xe8, 0xcf, 0x9b, 0x15, 0xf4, 0xf7, 0xcd, 0x0a, 0xfa, 0x78, 0xeb,
+	0xbe, 0x4b, 0xd1, 0x98, 0xfb, 0xdb, 0xf9, 0x53, 0xe2, 0x2e, 0xb4, 0xf5, 0xdf, 0x00, 0x94, 0x2f,
+	0x08, 0x53, 0xe4, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -629,6 +769,7 @@ type CronWorkflowServiceClient interface {
 	DeleteCronWorkflow(ctx context.Context, in *DeleteCronWorkflowRequest, opts ...grpc.CallOption) (*CronWorkflowDeletedResponse, error)
 	ResumeCronWorkflow(ctx context.Context, in *CronWorkflowResumeRequest, opts ...grpc.CallOption) (*v1alpha1.CronWorkflow, error)
 	SuspendCronWorkflow(ctx context.Context, in *CronWorkflowSuspendRequest, opts ...grpc.CallOption) (*v1alpha1.CronWorkflow, error)
+	BackfillCronWorkflow(ctx context.Context, in *BackfillCronWorkflowRequest, opts ...grpc.CallOption) (*BackfillCronWorkflowResponse, error)
 }
 
 type cronWorkflowServiceClient struct {
@@ -711,6 +852,15 @@ func (c *cronWorkflowServiceClient) SuspendCronWorkflow(ctx context.Context, in
 	return out, nil
 }
 
+func (c *cronWorkflowServiceClient) BackfillCronWorkflow(ctx context.Context, in *BackfillCronWorkflowRequest, opts ...grpc.CallOption) (*BackfillCronWorkflowResponse, error) {
+	out := new(BackfillCronWorkflowResponse)
+	err := c.cc.Invoke(ctx, "/cronworkflow.CronWorkflowService/BackfillCronWorkflow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CronWorkflowServiceServer is the server API for CronWorkflowService service.
 type CronWorkflowServiceServer interface {
 	LintCronWorkflow(context.Context, *LintCronWorkflowRequest) (*v1alpha1.CronWorkflow, error)
@@ -721,6 +871,7 @@ type CronWorkflowServiceServer interface {
 	DeleteCronWorkflow(context.Context, *DeleteCronWorkflowRequest) (*CronWorkflowDeletedResponse, error)
 	ResumeCronWorkflow(context.Context, *CronWorkflowResumeRequest) (*v1alpha1.CronWorkflow, error)
 	SuspendCronWorkflow(context.Context, *CronWorkflowSuspendRequest) (*v1alpha1.CronWorkflow, error)
+	BackfillCronWorkflow(context.Context, *BackfillCronWorkflowRequest) (*BackfillCronWorkflowResponse, error)
 }
 
 // UnimplementedCronWorkflowServiceServer can be embedded to have forward compatible implementations.
@@ -751,6 +902,9 @@ func (*UnimplementedCronWorkflowServiceServer) ResumeCronWorkflow(ctx context.Co
 func (*UnimplementedCronWorkflowServiceServer) SuspendCronWorkflow(ctx context.Context, req *CronWorkflowSuspendRequest) (*v1alpha1.CronWorkflow, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SuspendCronWorkflow not implemented")
 }
+func (*UnimplementedCronWorkflowServiceServer) BackfillCronWorkflow(ctx context.Context, req *BackfillCronWorkflowRequest) (*BackfillCronWorkflowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BackfillCronWorkflow not implemented")
+}
 
 func RegisterCronWorkflowServiceServer(s *grpc.Server, srv CronWorkflowServiceServer) {
 	s.RegisterService(&_CronWorkflowService_serviceDesc, srv)
@@ -900,6 +1054,24 @@ func _CronWorkflowService_SuspendCronWorkflow_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CronWorkflowService_BackfillCronWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackfillCronWorkflowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CronWorkflowServiceServer).BackfillCronWorkflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cronworkflow.CronWorkflowService/BackfillCronWorkflow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CronWorkflowServiceServer).BackfillCronWorkflow(ctx, req.(*BackfillCronWorkflowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _CronWorkflowService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cronworkflow.CronWorkflowService",
 	HandlerType: (*CronWorkflowServiceServer)(nil),
@@ -936,6 +1108,10 @@ var _CronWorkflowService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SuspendCronWorkflow",
 			Handler:    _CronWorkflowService_SuspendCronWorkflow_Handler,
 		},
+		{
+			MethodName: "BackfillCronWorkflow",
+			Handler:    _CronWorkflowService_BackfillCronWorkflow_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/apiclient/cronworkflow/cron-workflow.proto",
@@ -1359,6 +1535,117 @@ func (m *CronWorkflowResumeRequest) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *BackfillCronWorkflowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BackfillCronWorkflowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BackfillCronWorkflowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Parallelism != 0 {
+		i = encodeVarintCronWorkflow(dAtA, i, uint64(m.Parallelism))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.End != nil {
+		{
+			size, err := m.End.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCronWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Start != nil {
+		{
+			size, err := m.Start.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCronWorkflow(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintCronWorkflow(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintCronWorkflow(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BackfillCronWorkflowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BackfillCronWorkflowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BackfillCronWorkflowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCronWorkflow(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintCronWorkflow(dAtA []byte, offset int, v uint64) int {
 	offset -= sovCronWorkflow(v)
 	base := offset
@@ -1558,6 +1845,55 @@ func (m *CronWorkflowResumeRequest) Size() (n int) {
 	return n
 }
 
+func (m *BackfillCronWorkflowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovCronWorkflow(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovCronWorkflow(uint64(l))
+	}
+	if m.Start != nil {
+		l = m.Start.Size()
+		n += 1 + l + sovCronWorkflow(uint64(l))
+	}
+	if m.End != nil {
+		l = m.End.Size()
+		n += 1 + l + sovCronWorkflow(uint64(l))
+	}
+	if m.Parallelism != 0 {
+		n += 1 + sovCronWorkflow(uint64(m.Parallelism))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BackfillCronWorkflowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovCronWorkflow(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovCronWorkflow(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2718,6 +3054,303 @@ func (m *CronWorkflowResumeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *BackfillCronWorkflowRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCronWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BackfillCronWorkflowRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BackfillCronWorkflowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCronWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCronWorkflow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCronWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Start == nil {
+				m.Start = &v1.Time{}
+			}
+			if err := m.Start.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCronWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.End == nil {
+				m.End = &v1.Time{}
+			}
+			if err := m.End.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parallelism", wireType)
+			}
+			m.Parallelism = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Parallelism |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCronWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCronWorkflow
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCronWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BackfillCronWorkflowResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCronWorkflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BackfillCronWorkflowResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BackfillCronWorkflowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCronWorkflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCronWorkflow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCronWorkflow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, &v1alpha1.Workflow{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCronWorkflow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCronWorkflow
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCronWorkflow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipCronWorkflow(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -55,3 +55,8 @@ func (c *errorTranslatingCronWorkflowServiceClient) SuspendCronWorkflow(ctx cont
 	workflow, err := c.delegate.SuspendCronWorkflow(ctx, req)
 	return workflow, grpcutil.TranslateError(err)
 }
+
+func (c *errorTranslatingCronWorkflowServiceClient) BackfillCronWorkflow(ctx context.Context, req *cronworkflowpkg.BackfillCronWorkflowRequest, _ ...grpc.CallOption) (*cronworkflowpkg.BackfillCronWorkflowResponse, error) {
+	workflows, err := c.delegate.BackfillCronWorkflow(ctx, req)
+	return workflows, grpcutil.TranslateError(err)
+}
@@ -3,6 +3,7 @@ package apiclient
 import (
 	"context"
 
+	cachepkg "github.com/simster7/argo/v2/pkg/apiclient/cache"
 	"github.com/simster7/argo/v2/pkg/apiclient/clusterworkflowtemplate"
 	cronworkflowpkg "github.com/simster7/argo/v2/pkg/apiclient/cronworkflow"
 	"github.com/simster7/argo/v2/pkg/apiclient/http1"
@@ -38,6 +39,10 @@ func (h httpClient) NewInfoServiceClient() (infopkg.InfoServiceClient, error) {
 	return http1.InfoServiceClient(h), nil
 }
 
+func (h httpClient) NewCacheServiceClient() (cachepkg.CacheServiceClient, error) {
+	return http1.CacheServiceClient(h), nil
+}
+
 func newHTTP1Client(baseUrl string, auth string) (context.Context, Client, error) {
 	return context.Background(), httpClient(http1.NewFacade(baseUrl, auth)), nil
 }
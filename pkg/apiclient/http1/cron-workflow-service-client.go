@@ -50,3 +50,8 @@ func (h CronWorkflowServiceClient) DeleteCronWorkflow(_ context.Context, in *cro
 	out := &cronworkflowpkg.CronWorkflowDeletedResponse{}
 	return out, h.Delete(in, out, "/api/v1/cron-workflows/{namespace}/{name}")
 }
+
+func (h CronWorkflowServiceClient) BackfillCronWorkflow(_ context.Context, in *cronworkflowpkg.BackfillCronWorkflowRequest, _ ...grpc.CallOption) (*cronworkflowpkg.BackfillCronWorkflowResponse, error) {
+	out := &cronworkflowpkg.BackfillCronWorkflowResponse{}
+	return out, h.Post(in, out, "/api/v1/cron-workflows/{namespace}/{name}/backfill")
+}
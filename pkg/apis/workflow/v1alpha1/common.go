@@ -33,6 +33,8 @@ type WorkflowSpecHolder interface {
 type TemplateReferenceHolder interface {
 	GetTemplateName() string
 	GetTemplateRef() *TemplateRef
+	// GetTemplate returns the inline template, if any
+	GetTemplate() *Template
 }
 
 // SubmitOpts are workflow submission options
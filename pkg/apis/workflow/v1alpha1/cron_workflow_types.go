@@ -39,7 +39,7 @@ type CronWorkflowSpec struct {
 	// WorkflowSpec is the spec of the workflow to be run
 	WorkflowSpec WorkflowSpec `json:"workflowSpec" protobuf:"bytes,1,opt,name=workflowSpec,casttype=WorkflowSpec"`
 	// Schedule is a schedule to run the Workflow in Cron format
-	Schedule string `json:"schedule" protobuf:"bytes,2,opt,name=schedule"`
+	Schedule string `json:"schedule,omitempty" protobuf:"bytes,2,opt,name=schedule"`
 	// ConcurrencyPolicy is the K8s-style concurrency policy that will be used
 	ConcurrencyPolicy ConcurrencyPolicy `json:"concurrencyPolicy,omitempty" protobuf:"bytes,3,opt,name=concurrencyPolicy,casttype=ConcurrencyPolicy"`
 	// Suspend is a flag that will stop new CronWorkflows from running if set to true
@@ -55,6 +55,48 @@ type CronWorkflowSpec struct {
 	Timezone string `json:"timezone,omitempty" protobuf:"bytes,8,opt,name=timezone"`
 	// WorkflowMetadata contains some metadata of the workflow to be run
 	WorkflowMetadata *metav1.ObjectMeta `json:"workflowMetadata,omitempty" protobuf:"bytes,9,opt,name=workflowMeta"`
+	// Schedules are additional schedules to run the Workflow at, in Cron format
+	Schedules []CronSchedule `json:"schedules,omitempty" protobuf:"bytes,10,rep,name=schedules"`
+	// ExclusionWindows are periods of time during which scheduled runs are skipped
+	ExclusionWindows []CronExclusionWindow `json:"exclusionWindows,omitempty" protobuf:"bytes,11,rep,name=exclusionWindows"`
+}
+
+// GetSchedules returns all the schedules of the CronWorkflow, with their timezone defaulted to the CronWorkflow's
+func (c *CronWorkflowSpec) GetSchedules() []CronSchedule {
+	var schedules []CronSchedule
+	if c.Schedule != "" {
+		schedules = append(schedules, CronSchedule{Schedule: c.Schedule, Timezone: c.Timezone})
+	}
+	for _, schedule := range c.Schedules {
+		if schedule.Timezone == "" {
+			schedule.Timezone = c.Timezone
+		}
+		schedules = append(schedules, schedule)
+	}
+	return schedules
+}
+
+// CronSchedule is a schedule to run a Workflow at
+type CronSchedule struct {
+	// Schedule is the schedule in Cron format
+	Schedule string `json:"schedule" protobuf:"bytes,1,opt,name=schedule"`
+	// Timezone is the timezone against which the schedule will be calculated, e.g. "Asia/Tokyo". Defaults to the
+	// CronWorkflow's timezone.
+	Timezone string `json:"timezone,omitempty" protobuf:"bytes,2,opt,name=timezone"`
+}
+
+// CronExclusionWindow is a period of time during which scheduled runs are skipped. It is either a recurring window,
+// given by a start schedule and a duration, or a list of dates, or both.
+type CronExclusionWindow struct {
+	// Start is when each occurrence of the window starts, in Cron format, e.g. "0 18 * * 5" for Fridays at 18:00
+	Start string `json:"start,omitempty" protobuf:"bytes,1,opt,name=start"`
+	// Duration is how long each occurrence of the window lasts, e.g. "60h". Required with Start.
+	Duration string `json:"duration,omitempty" protobuf:"bytes,2,opt,name=duration"`
+	// Dates are whole days that are excluded in the format YYYY-MM-DD, e.g. a holiday calendar
+	Dates []string `json:"dates,omitempty" protobuf:"bytes,3,rep,name=dates"`
+	// Timezone is the timezone against which the window will be calculated, e.g. "Asia/Tokyo". Defaults to the
+	// CronWorkflow's timezone.
+	Timezone string `json:"timezone,omitempty" protobuf:"bytes,4,opt,name=timezone"`
 }
 
 // CronWorkflowStatus is the status of a CronWorkflow
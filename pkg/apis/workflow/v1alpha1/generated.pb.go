@@ -120,10 +120,38 @@ func (m *Arguments) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Arguments proto.InternalMessageInfo
 
+func (m *ArtGCStatus) Reset()      { *m = ArtGCStatus{} }
+func (*ArtGCStatus) ProtoMessage() {}
+func (*ArtGCStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c23edafa7e7ea072, []int{3}
+}
+func (m *ArtGCStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArtGCStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ArtGCStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArtGCStatus.Merge(m, src)
+}
+func (m *ArtGCStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArtGCStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArtGCStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArtGCStatus proto.InternalMessageInfo
+
 func (m *Artifact) Reset()      { *m = Artifact{} }
 func (*Artifact) ProtoMessage() {}
 func (*Artifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{3}
+	return fileDescriptor_c23edafa7e7ea072, []int{4}
 }
 func (m *Artifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -148,10 +176,38 @@ func (m *Artifact) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Artifact proto.InternalMessageInfo
 
+func (m *ArtifactGC) Reset()      { *m = ArtifactGC{} }
+func (*ArtifactGC) ProtoMessage() {}
+func (*ArtifactGC) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c23edafa7e7ea072, []int{5}
+}
+func (m *ArtifactGC) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArtifactGC) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ArtifactGC) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArtifactGC.Merge(m, src)
+}
+func (m *ArtifactGC) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArtifactGC) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArtifactGC.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArtifactGC proto.InternalMessageInfo
+
 func (m *ArtifactLocation) Reset()      { *m = ArtifactLocation{} }
 func (*ArtifactLocation) ProtoMessage() {}
 func (*ArtifactLocation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{4}
+	return fileDescriptor_c23edafa7e7ea072, []int{6}
 }
 func (m *ArtifactLocation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -179,7 +235,7 @@ var xxx_messageInfo_ArtifactLocation proto.InternalMessageInfo
 func (m *ArtifactRepositoryRef) Reset()      { *m = ArtifactRepositoryRef{} }
 func (*ArtifactRepositoryRef) ProtoMessage() {}
 func (*ArtifactRepositoryRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{5}
+	return fileDescriptor_c23edafa7e7ea072, []int{7}
 }
 func (m *ArtifactRepositoryRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -207,7 +263,7 @@ var xxx_messageInfo_ArtifactRepositoryRef proto.InternalMessageInfo
 func (m *ArtifactRepositoryRefStatus) Reset()      { *m = ArtifactRepositoryRefStatus{} }
 func (*ArtifactRepositoryRefStatus) ProtoMessage() {}
 func (*ArtifactRepositoryRefStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{6}
+	return fileDescriptor_c23edafa7e7ea072, []int{8}
 }
 func (m *ArtifactRepositoryRefStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -235,7 +291,7 @@ var xxx_messageInfo_ArtifactRepositoryRefStatus proto.InternalMessageInfo
 func (m *ArtifactoryArtifact) Reset()      { *m = ArtifactoryArtifact{} }
 func (*ArtifactoryArtifact) ProtoMessage() {}
 func (*ArtifactoryArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{7}
+	return fileDescriptor_c23edafa7e7ea072, []int{9}
 }
 func (m *ArtifactoryArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -263,7 +319,7 @@ var xxx_messageInfo_ArtifactoryArtifact proto.InternalMessageInfo
 func (m *ArtifactoryAuth) Reset()      { *m = ArtifactoryAuth{} }
 func (*ArtifactoryAuth) ProtoMessage() {}
 func (*ArtifactoryAuth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{8}
+	return fileDescriptor_c23edafa7e7ea072, []int{10}
 }
 func (m *ArtifactoryAuth) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -288,10 +344,66 @@ func (m *ArtifactoryAuth) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ArtifactoryAuth proto.InternalMessageInfo
 
+func (m *AzureArtifact) Reset()      { *m = AzureArtifact{} }
+func (*AzureArtifact) ProtoMessage() {}
+func (*AzureArtifact) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c23edafa7e7ea072, []int{11}
+}
+func (m *AzureArtifact) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AzureArtifact) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AzureArtifact) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AzureArtifact.Merge(m, src)
+}
+func (m *AzureArtifact) XXX_Size() int {
+	return m.Size()
+}
+func (m *AzureArtifact) XXX_DiscardUnknown() {
+	xxx_messageInfo_AzureArtifact.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AzureArtifact proto.InternalMessageInfo
+
+func (m *AzureBlobContainer) Reset()      { *m = AzureBlobContainer{} }
+func (*AzureBlobContainer) ProtoMessage() {}
+func (*AzureBlobContainer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c23edafa7e7ea072, []int{12}
+}
+func (m *AzureBlobContainer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AzureBlobContainer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AzureBlobContainer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AzureBlobContainer.Merge(m, src)
+}
+func (m *AzureBlobContainer) XXX_Size() int {
+	return m.Size()
+}
+func (m *AzureBlobContainer) XXX_DiscardUnknown() {
+	xxx_messageInfo_AzureBlobContainer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AzureBlobContainer proto.InternalMessageInfo
+
 func (m *Backoff) Reset()      { *m = Backoff{} }
 func (*Backoff) ProtoMessage() {}
 func (*Backoff) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{9}
+	return fileDescriptor_c23edafa7e7ea072, []int{13}
 }
 func (m *Backoff) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -319,7 +431,7 @@ var xxx_messageInfo_Backoff proto.InternalMessageInfo
 func (m *Cache) Reset()      { *m = Cache{} }
 func (*Cache) ProtoMessage() {}
 func (*Cache) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{10}
+	return fileDescriptor_c23edafa7e7ea072, []int{14}
 }
 func (m *Cache) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -344,10 +456,38 @@ func (m *Cache) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Cache proto.InternalMessageInfo
 
+func (m *ChildWorkflow) Reset()      { *m = ChildWorkflow{} }
+func (*ChildWorkflow) ProtoMessage() {}
+func (*ChildWorkflow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c23edafa7e7ea072, []int{15}
+}
+func (m *ChildWorkflow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChildWorkflow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ChildWorkflow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChildWorkflow.Merge(m, src)
+}
+func (m *ChildWorkflow) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChildWorkflow) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChildWorkflow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChildWorkflow proto.InternalMessageInfo
+
 func (m *ClusterWorkflowTemplate) Reset()      { *m = ClusterWorkflowTemplate{} }
 func (*ClusterWorkflowTemplate) ProtoMessage() {}
 func (*ClusterWorkflowTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{11}
+	return fileDescriptor_c23edafa7e7ea072, []int{16}
 }
 func (m *ClusterWorkflowTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -375,7 +515,7 @@ var xxx_messageInfo_ClusterWorkflowTemplate proto.InternalMessageInfo
 func (m *ClusterWorkflowTemplateList) Reset()      { *m = ClusterWorkflowTemplateList{} }
 func (*ClusterWorkflowTemplateList) ProtoMessage() {}
 func (*ClusterWorkflowTemplateList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{12}
+	return fileDescriptor_c23edafa7e7ea072, []int{17}
 }
 func (m *ClusterWorkflowTemplateList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -403,7 +543,7 @@ var xxx_messageInfo_ClusterWorkflowTemplateList proto.InternalMessageInfo
 func (m *Condition) Reset()      { *m = Condition{} }
 func (*Condition) ProtoMessage() {}
 func (*Condition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{13}
+	return fileDescriptor_c23edafa7e7ea072, []int{18}
 }
 func (m *Condition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -428,10 +568,66 @@ func (m *Condition) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Condition proto.InternalMessageInfo
 
+func (m *ContainerNode) Reset()      { *m = ContainerNode{} }
+func (*ContainerNode) ProtoMessage() {}
+func (*ContainerNode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c23edafa7e7ea072, []int{19}
+}
+func (m *ContainerNode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContainerNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ContainerNode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContainerNode.Merge(m, src)
+}
+func (m *ContainerNode) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContainerNode) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContainerNode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContainerNode proto.InternalMessageInfo
+
+func (m *ContainerSetTemplate) Reset()      { *m = ContainerSetTemplate{} }
+func (*ContainerSetTemplate) ProtoMessage() {}
+func (*ContainerSetTemplate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c23edafa7e7ea072, []int{20}
+}
+func (m *ContainerSetTemplate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContainerSetTemplate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ContainerSetTemplate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContainerSetTemplate.Merge(m, src)
+}
+func (m *ContainerSetTemplate) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContainerSetTemplate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContainerSetTemplate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContainerSetTemplate proto.InternalMessageInfo
+
 func (m *ContinueOn) Reset()      { *m = ContinueOn{} }
 func (*ContinueOn) ProtoMessage() {}
 func (*ContinueOn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{14}
+	return fileDescriptor_c23edafa7e7ea072, []int{21}
 }
 func (m *ContinueOn) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -459,7 +655,7 @@ var xxx_messageInfo_ContinueOn proto.InternalMessageInfo
 func (m *Counter) Reset()      { *m = Counter{} }
 func (*Counter) ProtoMessage() {}
 func (*Counter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{15}
+	return fileDescriptor_c23edafa7e7ea072, []int{22}
 }
 func (m *Counter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -487,7 +683,7 @@ var xxx_messageInfo_Counter proto.InternalMessageInfo
 func (m *CreateS3BucketOptions) Reset()      { *m = CreateS3BucketOptions{} }
 func (*CreateS3BucketOptions) ProtoMessage() {}
 func (*CreateS3BucketOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{16}
+	return fileDescriptor_c23edafa7e7ea072, []int{23}
 }
 func (m *CreateS3BucketOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -512,10 +708,66 @@ func (m *CreateS3BucketOptions) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CreateS3BucketOptions proto.InternalMessageInfo
 
+func (m *CronExclusionWindow) Reset()      { *m = CronExclusionWindow{} }
+func (*CronExclusionWindow) ProtoMessage() {}
+func (*CronExclusionWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c23edafa7e7ea072, []int{24}
+}
+func (m *CronExclusionWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CronExclusionWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *CronExclusionWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CronExclusionWindow.Merge(m, src)
+}
+func (m *CronExclusionWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *CronExclusionWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_CronExclusionWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CronExclusionWindow proto.InternalMessageInfo
+
+func (m *CronSchedule) Reset()      { *m = CronSchedule{} }
+func (*CronSchedule) ProtoMessage() {}
+func (*CronSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c23edafa7e7ea072, []int{25}
+}
+func (m *CronSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CronSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *CronSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CronSchedule.Merge(m, src)
+}
+func (m *CronSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *CronSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_CronSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CronSchedule proto.InternalMessageInfo
+
 func (m *CronWorkflow) Reset()      { *m = CronWorkflow{} }
 func (*CronWorkflow) ProtoMessage() {}
 func (*CronWorkflow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{17}
+	return fileDescriptor_c23edafa7e7ea072, []int{26}
 }
 func (m *CronWorkflow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -543,7 +795,7 @@ var xxx_messageInfo_CronWorkflow proto.InternalMessageInfo
 func (m *CronWorkflowList) Reset()      { *m = CronWorkflowList{} }
 func (*CronWorkflowList) ProtoMessage() {}
 func (*CronWorkflowList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{18}
+	return fileDescriptor_c23edafa7e7ea072, []int{27}
 }
 func (m *CronWorkflowList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -571,7 +823,7 @@ var xxx_messageInfo_CronWorkflowList proto.InternalMessageInfo
 func (m *CronWorkflowSpec) Reset()      { *m = CronWorkflowSpec{} }
 func (*CronWorkflowSpec) ProtoMessage() {}
 func (*CronWorkflowSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{19}
+	return fileDescriptor_c23edafa7e7ea072, []int{28}
 }
 func (m *CronWorkflowSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -599,7 +851,7 @@ var xxx_messageInfo_CronWorkflowSpec proto.InternalMessageInfo
 func (m *CronWorkflowStatus) Reset()      { *m = CronWorkflowStatus{} }
 func (*CronWorkflowStatus) ProtoMessage() {}
 func (*CronWorkflowStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{20}
+	return fileDescriptor_c23edafa7e7ea072, []int{29}
 }
 func (m *CronWorkflowStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -627,7 +879,7 @@ var xxx_messageInfo_CronWorkflowStatus proto.InternalMessageInfo
 func (m *DAGTask) Reset()      { *m = DAGTask{} }
 func (*DAGTask) ProtoMessage() {}
 func (*DAGTask) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{21}
+	return fileDescriptor_c23edafa7e7ea072, []int{30}
 }
 func (m *DAGTask) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -655,7 +907,7 @@ var xxx_messageInfo_DAGTask proto.InternalMessageInfo
 func (m *DAGTemplate) Reset()      { *m = DAGTemplate{} }
 func (*DAGTemplate) ProtoMessage() {}
 func (*DAGTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{22}
+	return fileDescriptor_c23edafa7e7ea072, []int{31}
 }
 func (m *DAGTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -680,10 +932,38 @@ func (m *DAGTemplate) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DAGTemplate proto.InternalMessageInfo
 
+func (m *DatabaseCache) Reset()      { *m = DatabaseCache{} }
+func (*DatabaseCache) ProtoMessage() {}
+func (*DatabaseCache) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c23edafa7e7ea072, []int{32}
+}
+func (m *DatabaseCache) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DatabaseCache) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *DatabaseCache) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DatabaseCache.Merge(m, src)
+}
+func (m *DatabaseCache) XXX_Size() int {
+	return m.Size()
+}
+func (m *DatabaseCache) XXX_DiscardUnknown() {
+	xxx_messageInfo_DatabaseCache.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DatabaseCache proto.InternalMessageInfo
+
 func (m *Event) Reset()      { *m = Event{} }
 func (*Event) ProtoMessage() {}
 func (*Event) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{23}
+	return fileDescriptor_c23edafa7e7ea072, []int{33}
 }
 func (m *Event) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -711,7 +991,7 @@ var xxx_messageInfo_Event proto.InternalMessageInfo
 func (m *ExecutorConfig) Reset()      { *m = ExecutorConfig{} }
 func (*ExecutorConfig) ProtoMessage() {}
 func (*ExecutorConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{24}
+	return fileDescriptor_c23edafa7e7ea072, []int{34}
 }
 func (m *ExecutorConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -736,10 +1016,38 @@ func (m *ExecutorConfig) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ExecutorConfig proto.InternalMessageInfo
 
+func (m *ExportTo) Reset()      { *m = ExportTo{} }
+func (*ExportTo) ProtoMessage() {}
+func (*ExportTo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c23edafa7e7ea072, []int{35}
+}
+func (m *ExportTo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExportTo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ExportTo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportTo.Merge(m, src)
+}
+func (m *ExportTo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExportTo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportTo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportTo proto.InternalMessageInfo
+
 func (m *GCSArtifact) Reset()      { *m = GCSArtifact{} }
 func (*GCSArtifact) ProtoMessage() {}
 func (*GCSArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{25}
+	return fileDescriptor_c23edafa7e7ea072, []int{36}
 }
 func (m *GCSArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -767,7 +1075,7 @@ var xxx_messageInfo_GCSArtifact proto.InternalMessageInfo
 func (m *GCSBucket) Reset()      { *m = GCSBucket{} }
 func (*GCSBucket) ProtoMessage() {}
 func (*GCSBucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{26}
+	return fileDescriptor_c23edafa7e7ea072, []int{37}
 }
 func (m *GCSBucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -795,7 +1103,7 @@ var xxx_messageInfo_GCSBucket proto.InternalMessageInfo
 func (m *Gauge) Reset()      { *m = Gauge{} }
 func (*Gauge) ProtoMessage() {}
 func (*Gauge) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{27}
+	return fileDescriptor_c23edafa7e7ea072, []int{38}
 }
 func (m *Gauge) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -823,7 +1131,7 @@ var xxx_messageInfo_Gauge proto.InternalMessageInfo
 func (m *GitArtifact) Reset()      { *m = GitArtifact{} }
 func (*GitArtifact) ProtoMessage() {}
 func (*GitArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{28}
+	return fileDescriptor_c23edafa7e7ea072, []int{39}
 }
 func (m *GitArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -851,7 +1159,7 @@ var xxx_messageInfo_GitArtifact proto.InternalMessageInfo
 func (m *HDFSArtifact) Reset()      { *m = HDFSArtifact{} }
 func (*HDFSArtifact) ProtoMessage() {}
 func (*HDFSArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{29}
+	return fileDescriptor_c23edafa7e7ea072, []int{40}
 }
 func (m *HDFSArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -879,7 +1187,7 @@ var xxx_messageInfo_HDFSArtifact proto.InternalMessageInfo
 func (m *HDFSConfig) Reset()      { *m = HDFSConfig{} }
 func (*HDFSConfig) ProtoMessage() {}
 func (*HDFSConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{30}
+	return fileDescriptor_c23edafa7e7ea072, []int{41}
 }
 func (m *HDFSConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -907,7 +1215,7 @@ var xxx_messageInfo_HDFSConfig proto.InternalMessageInfo
 func (m *HDFSKrbConfig) Reset()      { *m = HDFSKrbConfig{} }
 func (*HDFSKrbConfig) ProtoMessage() {}
 func (*HDFSKrbConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{31}
+	return fileDescriptor_c23edafa7e7ea072, []int{42}
 }
 func (m *HDFSKrbConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -932,15 +1240,15 @@ func (m *HDFSKrbConfig) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_HDFSKrbConfig proto.InternalMessageInfo
 
-func (m *HTTPArtifact) Reset()      { *m = HTTPArtifact{} }
-func (*HTTPArtifact) ProtoMessage() {}
-func (*HTTPArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{32}
+func (m *HTTP) Reset()      { *m = HTTP{} }
+func (*HTTP) ProtoMessage() {}
+func (*HTTP) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c23edafa7e7ea072, []int{43}
 }
-func (m *HTTPArtifact) XXX_Unmarshal(b []byte) error {
+func (m *HTTP) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *HTTPArtifact) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *HTTP) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -948,22 +1256,106 @@ func (m *HTTPArtifact) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 	}
 	return b[:n], nil
 }
-func (m *HTTPArtifact) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_HTTPArtifact.Merge(m, src)
+func (m *HTTP) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HTTP.Merge(m, src)
 }
-func (m *HTTPArtifact) XXX_Size() int {
+func (m *HTTP) XXX_Size() int {
 	return m.Size()
 }
-func (m *HTTPArtifact) XXX_DiscardUnknown() {
+func (m *HTTP) XXX_DiscardUnknown() {
+	xxx_messageInfo_HTTP.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HTTP proto.InternalMessageInfo
+
+func (m *HTTPArtifact) Reset()      { *m = HTTPArtifact{} }
+func (*HTTPArtifact) ProtoMessage() {}
+func (*HTTPArtifact) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c23edafa7e7ea072, []int{44}
+}
+func (m *HTTPArtifact) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HTTPArtifact) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HTTPArtifact) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HTTPArtifact.Merge(m, src)
+}
+func (m *HTTPArtifact) XXX_Size() int {
+	return m.Size()
+}
+func (m *HTTPArtifact) XXX_DiscardUnknown() {
 	xxx_messageInfo_HTTPArtifact.DiscardUnknown(m)
 }
 
 var xxx_messageInfo_HTTPArtifact proto.InternalMessageInfo
 
+func (m *HTTPHeader) Reset()      { *m = HTTPHeader{} }
+func (*HTTPHeader) ProtoMessage() {}
+func (*HTTPHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c23edafa7e7ea072, []int{45}
+}
+func (m *HTTPHeader) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HTTPHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HTTPHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HTTPHeader.Merge(m, src)
+}
+func (m *HTTPHeader) XXX_Size() int {
+	return m.Size()
+}
+func (m *HTTPHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_HTTPHeader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HTTPHeader proto.InternalMessageInfo
+
+func (m *HTTPHeaderSource) Reset()      { *m = HTTPHeaderSource{} }
+func (*HTTPHeaderSource) ProtoMessage() {}
+func (*HTTPHeaderSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c23edafa7e7ea072, []int{46}
+}
+func (m *HTTPHeaderSource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HTTPHeaderSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HTTPHeaderSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HTTPHeaderSource.Merge(m, src)
+}
+func (m *HTTPHeaderSource) XXX_Size() int {
+	return m.Size()
+}
+func (m *HTTPHeaderSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_HTTPHeaderSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HTTPHeaderSource proto.InternalMessageInfo
+
 func (m *Header) Reset()      { *m = Header{} }
 func (*Header) ProtoMessage() {}
 func (*Header) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{33}
+	return fileDescriptor_c23edafa7e7ea072, []int{47}
 }
 func (m *Header) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -991,7 +1383,7 @@ var xxx_messageInfo_Header proto.InternalMessageInfo
 func (m *Histogram) Reset()      { *m = Histogram{} }
 func (*Histogram) ProtoMessage() {}
 func (*Histogram) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{34}
+	return fileDescriptor_c23edafa7e7ea072, []int{48}
 }
 func (m *Histogram) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1019,7 +1411,7 @@ var xxx_messageInfo_Histogram proto.InternalMessageInfo
 func (m *Inputs) Reset()      { *m = Inputs{} }
 func (*Inputs) ProtoMessage() {}
 func (*Inputs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{35}
+	return fileDescriptor_c23edafa7e7ea072, []int{49}
 }
 func (m *Inputs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1047,7 +1439,7 @@ var xxx_messageInfo_Inputs proto.InternalMessageInfo
 func (m *Item) Reset()      { *m = Item{} }
 func (*Item) ProtoMessage() {}
 func (*Item) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{36}
+	return fileDescriptor_c23edafa7e7ea072, []int{50}
 }
 func (m *Item) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1072,10 +1464,38 @@ func (m *Item) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Item proto.InternalMessageInfo
 
+func (m *LifecycleHook) Reset()      { *m = LifecycleHook{} }
+func (*LifecycleHook) ProtoMessage() {}
+func (*LifecycleHook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c23edafa7e7ea072, []int{51}
+}
+func (m *LifecycleHook) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LifecycleHook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *LifecycleHook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LifecycleHook.Merge(m, src)
+}
+func (m *LifecycleHook) XXX_Size() int {
+	return m.Size()
+}
+func (m *LifecycleHook) XXX_DiscardUnknown() {
+	xxx_messageInfo_LifecycleHook.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LifecycleHook proto.InternalMessageInfo
+
 func (m *Link) Reset()      { *m = Link{} }
 func (*Link) ProtoMessage() {}
 func (*Link) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{37}
+	return fileDescriptor_c23edafa7e7ea072, []int{52}
 }
 func (m *Link) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1100,10 +1520,38 @@ func (m *Link) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Link proto.InternalMessageInfo
 
+func (m *Loop) Reset()      { *m = Loop{} }
+func (*Loop) ProtoMessage() {}
+func (*Loop) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c23edafa7e7ea072, []int{53}
+}
+func (m *Loop) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Loop) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Loop) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Loop.Merge(m, src)
+}
+func (m *Loop) XXX_Size() int {
+	return m.Size()
+}
+func (m *Loop) XXX_DiscardUnknown() {
+	xxx_messageInfo_Loop.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Loop proto.InternalMessageInfo
+
 func (m *MemoizationStatus) Reset()      { *m = MemoizationStatus{} }
 func (*MemoizationStatus) ProtoMessage() {}
 func (*MemoizationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{38}
+	return fileDescriptor_c23edafa7e7ea072, []int{54}
 }
 func (m *MemoizationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1131,7 +1579,7 @@ var xxx_messageInfo_MemoizationStatus proto.InternalMessageInfo
 func (m *Memoize) Reset()      { *m = Memoize{} }
 func (*Memoize) ProtoMessage() {}
 func (*Memoize) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{39}
+	return fileDescriptor_c23edafa7e7ea072, []int{55}
 }
 func (m *Memoize) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1159,7 +1607,7 @@ var xxx_messageInfo_Memoize proto.InternalMessageInfo
 func (m *Metadata) Reset()      { *m = Metadata{} }
 func (*Metadata) ProtoMessage() {}
 func (*Metadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{40}
+	return fileDescriptor_c23edafa7e7ea072, []int{56}
 }
 func (m *Metadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1187,7 +1635,7 @@ var xxx_messageInfo_Metadata proto.InternalMessageInfo
 func (m *MetricLabel) Reset()      { *m = MetricLabel{} }
 func (*MetricLabel) ProtoMessage() {}
 func (*MetricLabel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{41}
+	return fileDescriptor_c23edafa7e7ea072, []int{57}
 }
 func (m *MetricLabel) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1215,7 +1663,7 @@ var xxx_messageInfo_MetricLabel proto.InternalMessageInfo
 func (m *Metrics) Reset()      { *m = Metrics{} }
 func (*Metrics) ProtoMessage() {}
 func (*Metrics) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{42}
+	return fileDescriptor_c23edafa7e7ea072, []int{58}
 }
 func (m *Metrics) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1243,7 +1691,7 @@ var xxx_messageInfo_Metrics proto.InternalMessageInfo
 func (m *Mutex) Reset()      { *m = Mutex{} }
 func (*Mutex) ProtoMessage() {}
 func (*Mutex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{43}
+	return fileDescriptor_c23edafa7e7ea072, []int{59}
 }
 func (m *Mutex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1271,7 +1719,7 @@ var xxx_messageInfo_Mutex proto.InternalMessageInfo
 func (m *MutexHolding) Reset()      { *m = MutexHolding{} }
 func (*MutexHolding) ProtoMessage() {}
 func (*MutexHolding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{44}
+	return fileDescriptor_c23edafa7e7ea072, []int{60}
 }
 func (m *MutexHolding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1299,7 +1747,7 @@ var xxx_messageInfo_MutexHolding proto.InternalMessageInfo
 func (m *MutexStatus) Reset()      { *m = MutexStatus{} }
 func (*MutexStatus) ProtoMessage() {}
 func (*MutexStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{45}
+	return fileDescriptor_c23edafa7e7ea072, []int{61}
 }
 func (m *MutexStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1327,7 +1775,7 @@ var xxx_messageInfo_MutexStatus proto.InternalMessageInfo
 func (m *NodeStatus) Reset()      { *m = NodeStatus{} }
 func (*NodeStatus) ProtoMessage() {}
 func (*NodeStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{46}
+	return fileDescriptor_c23edafa7e7ea072, []int{62}
 }
 func (m *NodeStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1355,7 +1803,7 @@ var xxx_messageInfo_NodeStatus proto.InternalMessageInfo
 func (m *NodeSynchronizationStatus) Reset()      { *m = NodeSynchronizationStatus{} }
 func (*NodeSynchronizationStatus) ProtoMessage() {}
 func (*NodeSynchronizationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{47}
+	return fileDescriptor_c23edafa7e7ea072, []int{63}
 }
 func (m *NodeSynchronizationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1383,7 +1831,7 @@ var xxx_messageInfo_NodeSynchronizationStatus proto.InternalMessageInfo
 func (m *NoneStrategy) Reset()      { *m = NoneStrategy{} }
 func (*NoneStrategy) ProtoMessage() {}
 func (*NoneStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{48}
+	return fileDescriptor_c23edafa7e7ea072, []int{64}
 }
 func (m *NoneStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1411,7 +1859,7 @@ var xxx_messageInfo_NoneStrategy proto.InternalMessageInfo
 func (m *OSSArtifact) Reset()      { *m = OSSArtifact{} }
 func (*OSSArtifact) ProtoMessage() {}
 func (*OSSArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{49}
+	return fileDescriptor_c23edafa7e7ea072, []int{65}
 }
 func (m *OSSArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1439,7 +1887,7 @@ var xxx_messageInfo_OSSArtifact proto.InternalMessageInfo
 func (m *OSSBucket) Reset()      { *m = OSSBucket{} }
 func (*OSSBucket) ProtoMessage() {}
 func (*OSSBucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{50}
+	return fileDescriptor_c23edafa7e7ea072, []int{66}
 }
 func (m *OSSBucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1467,7 +1915,7 @@ var xxx_messageInfo_OSSBucket proto.InternalMessageInfo
 func (m *Outputs) Reset()      { *m = Outputs{} }
 func (*Outputs) ProtoMessage() {}
 func (*Outputs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{51}
+	return fileDescriptor_c23edafa7e7ea072, []int{67}
 }
 func (m *Outputs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1495,7 +1943,7 @@ var xxx_messageInfo_Outputs proto.InternalMessageInfo
 func (m *ParallelSteps) Reset()      { *m = ParallelSteps{} }
 func (*ParallelSteps) ProtoMessage() {}
 func (*ParallelSteps) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{52}
+	return fileDescriptor_c23edafa7e7ea072, []int{68}
 }
 func (m *ParallelSteps) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1523,7 +1971,7 @@ var xxx_messageInfo_ParallelSteps proto.InternalMessageInfo
 func (m *Parameter) Reset()      { *m = Parameter{} }
 func (*Parameter) ProtoMessage() {}
 func (*Parameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{53}
+	return fileDescriptor_c23edafa7e7ea072, []int{69}
 }
 func (m *Parameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1551,7 +1999,7 @@ var xxx_messageInfo_Parameter proto.InternalMessageInfo
 func (m *PodGC) Reset()      { *m = PodGC{} }
 func (*PodGC) ProtoMessage() {}
 func (*PodGC) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{54}
+	return fileDescriptor_c23edafa7e7ea072, []int{70}
 }
 func (m *PodGC) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1579,7 +2027,7 @@ var xxx_messageInfo_PodGC proto.InternalMessageInfo
 func (m *Prometheus) Reset()      { *m = Prometheus{} }
 func (*Prometheus) ProtoMessage() {}
 func (*Prometheus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{55}
+	return fileDescriptor_c23edafa7e7ea072, []int{71}
 }
 func (m *Prometheus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1607,7 +2055,7 @@ var xxx_messageInfo_Prometheus proto.InternalMessageInfo
 func (m *RawArtifact) Reset()      { *m = RawArtifact{} }
 func (*RawArtifact) ProtoMessage() {}
 func (*RawArtifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{56}
+	return fileDescriptor_c23edafa7e7ea072, []int{72}
 }
 func (m *RawArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1635,7 +2083,7 @@ var xxx_messageInfo_RawArtifact proto.InternalMessageInfo
 func (m *ResourceTemplate) Reset()      { *m = ResourceTemplate{} }
 func (*ResourceTemplate) ProtoMessage() {}
 func (*ResourceTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{57}
+	return fileDescriptor_c23edafa7e7ea072, []int{73}
 }
 func (m *ResourceTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1663,7 +2111,7 @@ var xxx_messageInfo_ResourceTemplate proto.InternalMessageInfo
 func (m *RetryAffinity) Reset()      { *m = RetryAffinity{} }
 func (*RetryAffinity) ProtoMessage() {}
 func (*RetryAffinity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{58}
+	return fileDescriptor_c23edafa7e7ea072, []int{74}
 }
 func (m *RetryAffinity) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1691,7 +2139,7 @@ var xxx_messageInfo_RetryAffinity proto.InternalMessageInfo
 func (m *RetryNodeAntiAffinity) Reset()      { *m = RetryNodeAntiAffinity{} }
 func (*RetryNodeAntiAffinity) ProtoMessage() {}
 func (*RetryNodeAntiAffinity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{59}
+	return fileDescriptor_c23edafa7e7ea072, []int{75}
 }
 func (m *RetryNodeAntiAffinity) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1716,10 +2164,38 @@ func (m *RetryNodeAntiAffinity) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RetryNodeAntiAffinity proto.InternalMessageInfo
 
+func (m *RetryOn) Reset()      { *m = RetryOn{} }
+func (*RetryOn) ProtoMessage() {}
+func (*RetryOn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c23edafa7e7ea072, []int{76}
+}
+func (m *RetryOn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RetryOn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RetryOn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetryOn.Merge(m, src)
+}
+func (m *RetryOn) XXX_Size() int {
+	return m.Size()
+}
+func (m *RetryOn) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetryOn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetryOn proto.InternalMessageInfo
+
 func (m *RetryStrategy) Reset()      { *m = RetryStrategy{} }
 func (*RetryStrategy) ProtoMessage() {}
 func (*RetryStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{60}
+	return fileDescriptor_c23edafa7e7ea072, []int{77}
 }
 func (m *RetryStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1747,7 +2223,7 @@ var xxx_messageInfo_RetryStrategy proto.InternalMessageInfo
 func (m *S3Artifact) Reset()      { *m = S3Artifact{} }
 func (*S3Artifact) ProtoMessage() {}
 func (*S3Artifact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{61}
+	return fileDescriptor_c23edafa7e7ea072, []int{78}
 }
 func (m *S3Artifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1775,7 +2251,7 @@ var xxx_messageInfo_S3Artifact proto.InternalMessageInfo
 func (m *S3Bucket) Reset()      { *m = S3Bucket{} }
 func (*S3Bucket) ProtoMessage() {}
 func (*S3Bucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{62}
+	return fileDescriptor_c23edafa7e7ea072, []int{79}
 }
 func (m *S3Bucket) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1803,7 +2279,7 @@ var xxx_messageInfo_S3Bucket proto.InternalMessageInfo
 func (m *ScriptTemplate) Reset()      { *m = ScriptTemplate{} }
 func (*ScriptTemplate) ProtoMessage() {}
 func (*ScriptTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{63}
+	return fileDescriptor_c23edafa7e7ea072, []int{80}
 }
 func (m *ScriptTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1831,7 +2307,7 @@ var xxx_messageInfo_ScriptTemplate proto.InternalMessageInfo
 func (m *SemaphoreHolding) Reset()      { *m = SemaphoreHolding{} }
 func (*SemaphoreHolding) ProtoMessage() {}
 func (*SemaphoreHolding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{64}
+	return fileDescriptor_c23edafa7e7ea072, []int{81}
 }
 func (m *SemaphoreHolding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1859,7 +2335,7 @@ var xxx_messageInfo_SemaphoreHolding proto.InternalMessageInfo
 func (m *SemaphoreRef) Reset()      { *m = SemaphoreRef{} }
 func (*SemaphoreRef) ProtoMessage() {}
 func (*SemaphoreRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{65}
+	return fileDescriptor_c23edafa7e7ea072, []int{82}
 }
 func (m *SemaphoreRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1887,7 +2363,7 @@ var xxx_messageInfo_SemaphoreRef proto.InternalMessageInfo
 func (m *SemaphoreStatus) Reset()      { *m = SemaphoreStatus{} }
 func (*SemaphoreStatus) ProtoMessage() {}
 func (*SemaphoreStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{66}
+	return fileDescriptor_c23edafa7e7ea072, []int{83}
 }
 func (m *SemaphoreStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1915,7 +2391,7 @@ var xxx_messageInfo_SemaphoreStatus proto.InternalMessageInfo
 func (m *Sequence) Reset()      { *m = Sequence{} }
 func (*Sequence) ProtoMessage() {}
 func (*Sequence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{67}
+	return fileDescriptor_c23edafa7e7ea072, []int{84}
 }
 func (m *Sequence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1943,7 +2419,7 @@ var xxx_messageInfo_Sequence proto.InternalMessageInfo
 func (m *Submit) Reset()      { *m = Submit{} }
 func (*Submit) ProtoMessage() {}
 func (*Submit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{68}
+	return fileDescriptor_c23edafa7e7ea072, []int{85}
 }
 func (m *Submit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1971,7 +2447,7 @@ var xxx_messageInfo_Submit proto.InternalMessageInfo
 func (m *SubmitOpts) Reset()      { *m = SubmitOpts{} }
 func (*SubmitOpts) ProtoMessage() {}
 func (*SubmitOpts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{69}
+	return fileDescriptor_c23edafa7e7ea072, []int{86}
 }
 func (m *SubmitOpts) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1999,7 +2475,7 @@ var xxx_messageInfo_SubmitOpts proto.InternalMessageInfo
 func (m *SuppliedValueFrom) Reset()      { *m = SuppliedValueFrom{} }
 func (*SuppliedValueFrom) ProtoMessage() {}
 func (*SuppliedValueFrom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{70}
+	return fileDescriptor_c23edafa7e7ea072, []int{87}
 }
 func (m *SuppliedValueFrom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2027,7 +2503,7 @@ var xxx_messageInfo_SuppliedValueFrom proto.InternalMessageInfo
 func (m *SuspendTemplate) Reset()      { *m = SuspendTemplate{} }
 func (*SuspendTemplate) ProtoMessage() {}
 func (*SuspendTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{71}
+	return fileDescriptor_c23edafa7e7ea072, []int{88}
 }
 func (m *SuspendTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2055,7 +2531,7 @@ var xxx_messageInfo_SuspendTemplate proto.InternalMessageInfo
 func (m *Synchronization) Reset()      { *m = Synchronization{} }
 func (*Synchronization) ProtoMessage() {}
 func (*Synchronization) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{72}
+	return fileDescriptor_c23edafa7e7ea072, []int{89}
 }
 func (m *Synchronization) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2083,7 +2559,7 @@ var xxx_messageInfo_Synchronization proto.InternalMessageInfo
 func (m *SynchronizationStatus) Reset()      { *m = SynchronizationStatus{} }
 func (*SynchronizationStatus) ProtoMessage() {}
 func (*SynchronizationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{73}
+	return fileDescriptor_c23edafa7e7ea072, []int{90}
 }
 func (m *SynchronizationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2111,7 +2587,7 @@ var xxx_messageInfo_SynchronizationStatus proto.InternalMessageInfo
 func (m *TTLStrategy) Reset()      { *m = TTLStrategy{} }
 func (*TTLStrategy) ProtoMessage() {}
 func (*TTLStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{74}
+	return fileDescriptor_c23edafa7e7ea072, []int{91}
 }
 func (m *TTLStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2139,7 +2615,7 @@ var xxx_messageInfo_TTLStrategy proto.InternalMessageInfo
 func (m *TarStrategy) Reset()      { *m = TarStrategy{} }
 func (*TarStrategy) ProtoMessage() {}
 func (*TarStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{75}
+	return fileDescriptor_c23edafa7e7ea072, []int{92}
 }
 func (m *TarStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2167,7 +2643,7 @@ var xxx_messageInfo_TarStrategy proto.InternalMessageInfo
 func (m *Template) Reset()      { *m = Template{} }
 func (*Template) ProtoMessage() {}
 func (*Template) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{76}
+	return fileDescriptor_c23edafa7e7ea072, []int{93}
 }
 func (m *Template) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2195,7 +2671,7 @@ var xxx_messageInfo_Template proto.InternalMessageInfo
 func (m *TemplateRef) Reset()      { *m = TemplateRef{} }
 func (*TemplateRef) ProtoMessage() {}
 func (*TemplateRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{77}
+	return fileDescriptor_c23edafa7e7ea072, []int{94}
 }
 func (m *TemplateRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2223,7 +2699,7 @@ var xxx_messageInfo_TemplateRef proto.InternalMessageInfo
 func (m *UserContainer) Reset()      { *m = UserContainer{} }
 func (*UserContainer) ProtoMessage() {}
 func (*UserContainer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{78}
+	return fileDescriptor_c23edafa7e7ea072, []int{95}
 }
 func (m *UserContainer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2251,7 +2727,7 @@ var xxx_messageInfo_UserContainer proto.InternalMessageInfo
 func (m *ValueFrom) Reset()      { *m = ValueFrom{} }
 func (*ValueFrom) ProtoMessage() {}
 func (*ValueFrom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{79}
+	return fileDescriptor_c23edafa7e7ea072, []int{96}
 }
 func (m *ValueFrom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2279,7 +2755,7 @@ var xxx_messageInfo_ValueFrom proto.InternalMessageInfo
 func (m *Version) Reset()      { *m = Version{} }
 func (*Version) ProtoMessage() {}
 func (*Version) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{80}
+	return fileDescriptor_c23edafa7e7ea072, []int{97}
 }
 func (m *Version) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2307,7 +2783,7 @@ var xxx_messageInfo_Version proto.InternalMessageInfo
 func (m *VolumeClaimGC) Reset()      { *m = VolumeClaimGC{} }
 func (*VolumeClaimGC) ProtoMessage() {}
 func (*VolumeClaimGC) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{81}
+	return fileDescriptor_c23edafa7e7ea072, []int{98}
 }
 func (m *VolumeClaimGC) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2332,10 +2808,38 @@ func (m *VolumeClaimGC) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_VolumeClaimGC proto.InternalMessageInfo
 
+func (m *WithArtifact) Reset()      { *m = WithArtifact{} }
+func (*WithArtifact) ProtoMessage() {}
+func (*WithArtifact) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c23edafa7e7ea072, []int{99}
+}
+func (m *WithArtifact) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WithArtifact) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *WithArtifact) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WithArtifact.Merge(m, src)
+}
+func (m *WithArtifact) XXX_Size() int {
+	return m.Size()
+}
+func (m *WithArtifact) XXX_DiscardUnknown() {
+	xxx_messageInfo_WithArtifact.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WithArtifact proto.InternalMessageInfo
+
 func (m *Workflow) Reset()      { *m = Workflow{} }
 func (*Workflow) ProtoMessage() {}
 func (*Workflow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{82}
+	return fileDescriptor_c23edafa7e7ea072, []int{100}
 }
 func (m *Workflow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2363,7 +2867,7 @@ var xxx_messageInfo_Workflow proto.InternalMessageInfo
 func (m *WorkflowEventBinding) Reset()      { *m = WorkflowEventBinding{} }
 func (*WorkflowEventBinding) ProtoMessage() {}
 func (*WorkflowEventBinding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{83}
+	return fileDescriptor_c23edafa7e7ea072, []int{101}
 }
 func (m *WorkflowEventBinding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2391,7 +2895,7 @@ var xxx_messageInfo_WorkflowEventBinding proto.InternalMessageInfo
 func (m *WorkflowEventBindingList) Reset()      { *m = WorkflowEventBindingList{} }
 func (*WorkflowEventBindingList) ProtoMessage() {}
 func (*WorkflowEventBindingList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{84}
+	return fileDescriptor_c23edafa7e7ea072, []int{102}
 }
 func (m *WorkflowEventBindingList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2419,7 +2923,7 @@ var xxx_messageInfo_WorkflowEventBindingList proto.InternalMessageInfo
 func (m *WorkflowEventBindingSpec) Reset()      { *m = WorkflowEventBindingSpec{} }
 func (*WorkflowEventBindingSpec) ProtoMessage() {}
 func (*WorkflowEventBindingSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{85}
+	return fileDescriptor_c23edafa7e7ea072, []int{103}
 }
 func (m *WorkflowEventBindingSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2447,7 +2951,7 @@ var xxx_messageInfo_WorkflowEventBindingSpec proto.InternalMessageInfo
 func (m *WorkflowList) Reset()      { *m = WorkflowList{} }
 func (*WorkflowList) ProtoMessage() {}
 func (*WorkflowList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{86}
+	return fileDescriptor_c23edafa7e7ea072, []int{104}
 }
 func (m *WorkflowList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2475,7 +2979,7 @@ var xxx_messageInfo_WorkflowList proto.InternalMessageInfo
 func (m *WorkflowSpec) Reset()      { *m = WorkflowSpec{} }
 func (*WorkflowSpec) ProtoMessage() {}
 func (*WorkflowSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{87}
+	return fileDescriptor_c23edafa7e7ea072, []int{105}
 }
 func (m *WorkflowSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2503,7 +3007,7 @@ var xxx_messageInfo_WorkflowSpec proto.InternalMessageInfo
 func (m *WorkflowStatus) Reset()      { *m = WorkflowStatus{} }
 func (*WorkflowStatus) ProtoMessage() {}
 func (*WorkflowStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{88}
+	return fileDescriptor_c23edafa7e7ea072, []int{106}
 }
 func (m *WorkflowStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2531,7 +3035,7 @@ var xxx_messageInfo_WorkflowStatus proto.InternalMessageInfo
 func (m *WorkflowStep) Reset()      { *m = WorkflowStep{} }
 func (*WorkflowStep) ProtoMessage() {}
 func (*WorkflowStep) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{89}
+	return fileDescriptor_c23edafa7e7ea072, []int{107}
 }
 func (m *WorkflowStep) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2559,7 +3063,7 @@ var xxx_messageInfo_WorkflowStep proto.InternalMessageInfo
 func (m *WorkflowTemplate) Reset()      { *m = WorkflowTemplate{} }
 func (*WorkflowTemplate) ProtoMessage() {}
 func (*WorkflowTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{90}
+	return fileDescriptor_c23edafa7e7ea072, []int{108}
 }
 func (m *WorkflowTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2587,7 +3091,7 @@ var xxx_messageInfo_WorkflowTemplate proto.InternalMessageInfo
 func (m *WorkflowTemplateList) Reset()      { *m = WorkflowTemplateList{} }
 func (*WorkflowTemplateList) ProtoMessage() {}
 func (*WorkflowTemplateList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{91}
+	return fileDescriptor_c23edafa7e7ea072, []int{109}
 }
 func (m *WorkflowTemplateList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2615,7 +3119,7 @@ var xxx_messageInfo_WorkflowTemplateList proto.InternalMessageInfo
 func (m *WorkflowTemplateRef) Reset()      { *m = WorkflowTemplateRef{} }
 func (*WorkflowTemplateRef) ProtoMessage() {}
 func (*WorkflowTemplateRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{92}
+	return fileDescriptor_c23edafa7e7ea072, []int{110}
 }
 func (m *WorkflowTemplateRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2643,7 +3147,7 @@ var xxx_messageInfo_WorkflowTemplateRef proto.InternalMessageInfo
 func (m *WorkflowTemplateSpec) Reset()      { *m = WorkflowTemplateSpec{} }
 func (*WorkflowTemplateSpec) ProtoMessage() {}
 func (*WorkflowTemplateSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{93}
+	return fileDescriptor_c23edafa7e7ea072, []int{111}
 }
 func (m *WorkflowTemplateSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2671,7 +3175,7 @@ var xxx_messageInfo_WorkflowTemplateSpec proto.InternalMessageInfo
 func (m *ZipStrategy) Reset()      { *m = ZipStrategy{} }
 func (*ZipStrategy) ProtoMessage() {}
 func (*ZipStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c23edafa7e7ea072, []int{94}
+	return fileDescriptor_c23edafa7e7ea072, []int{112}
 }
 func (m *ZipStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2700,28 +3204,41 @@ func init() {
 	proto.RegisterType((*Amount)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.Amount")
 	proto.RegisterType((*ArchiveStrategy)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.ArchiveStrategy")
 	proto.RegisterType((*Arguments)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.Arguments")
+	proto.RegisterType((*ArtGCStatus)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.ArtGCStatus")
+	proto.RegisterMapType((map[string]bool)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.ArtGCStatus.StrategiesProcessedEntry")
 	proto.RegisterType((*Artifact)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.Artifact")
+	proto.RegisterType((*ArtifactGC)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.ArtifactGC")
 	proto.RegisterType((*ArtifactLocation)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.ArtifactLocation")
 	proto.RegisterType((*ArtifactRepositoryRef)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.ArtifactRepositoryRef")
 	proto.RegisterType((*ArtifactRepositoryRefStatus)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.ArtifactRepositoryRefStatus")
 	proto.RegisterType((*ArtifactoryArtifact)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.ArtifactoryArtifact")
 	proto.RegisterType((*ArtifactoryAuth)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.ArtifactoryAuth")
+	proto.RegisterType((*AzureArtifact)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.AzureArtifact")
+	proto.RegisterType((*AzureBlobContainer)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.AzureBlobContainer")
 	proto.RegisterType((*Backoff)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.Backoff")
 	proto.RegisterType((*Cache)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.Cache")
+	proto.RegisterType((*ChildWorkflow)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.ChildWorkflow")
 	proto.RegisterType((*ClusterWorkflowTemplate)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.ClusterWorkflowTemplate")
 	proto.RegisterType((*ClusterWorkflowTemplateList)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.ClusterWorkflowTemplateList")
 	proto.RegisterType((*Condition)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.Condition")
+	proto.RegisterType((*ContainerNode)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.ContainerNode")
+	proto.RegisterType((*ContainerSetTemplate)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.ContainerSetTemplate")
 	proto.RegisterType((*ContinueOn)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.ContinueOn")
 	proto.RegisterType((*Counter)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.Counter")
 	proto.RegisterType((*CreateS3BucketOptions)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.CreateS3BucketOptions")
+	proto.RegisterType((*CronExclusionWindow)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.CronExclusionWindow")
+	proto.RegisterType((*CronSchedule)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.CronSchedule")
 	proto.RegisterType((*CronWorkflow)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.CronWorkflow")
 	proto.RegisterType((*CronWorkflowList)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.CronWorkflowList")
 	proto.RegisterType((*CronWorkflowSpec)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.CronWorkflowSpec")
 	proto.RegisterType((*CronWorkflowStatus)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.CronWorkflowStatus")
 	proto.RegisterType((*DAGTask)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.DAGTask")
+	proto.RegisterMapType((LifecycleHooks)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.DAGTask.HooksEntry")
 	proto.RegisterType((*DAGTemplate)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.DAGTemplate")
+	proto.RegisterType((*DatabaseCache)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.DatabaseCache")
 	proto.RegisterType((*Event)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.Event")
 	proto.RegisterType((*ExecutorConfig)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.ExecutorConfig")
+	proto.RegisterType((*ExportTo)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.ExportTo")
 	proto.RegisterType((*GCSArtifact)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.GCSArtifact")
 	proto.RegisterType((*GCSBucket)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.GCSBucket")
 	proto.RegisterType((*Gauge)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.Gauge")
@@ -2729,12 +3246,17 @@ func init() {
 	proto.RegisterType((*HDFSArtifact)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.HDFSArtifact")
 	proto.RegisterType((*HDFSConfig)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.HDFSConfig")
 	proto.RegisterType((*HDFSKrbConfig)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.HDFSKrbConfig")
+	proto.RegisterType((*HTTP)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.HTTP")
 	proto.RegisterType((*HTTPArtifact)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.HTTPArtifact")
+	proto.RegisterType((*HTTPHeader)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.HTTPHeader")
+	proto.RegisterType((*HTTPHeaderSource)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.HTTPHeaderSource")
 	proto.RegisterType((*Header)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.Header")
 	proto.RegisterType((*Histogram)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.Histogram")
 	proto.RegisterType((*Inputs)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.Inputs")
 	proto.RegisterType((*Item)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.Item")
+	proto.RegisterType((*LifecycleHook)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.LifecycleHook")
 	proto.RegisterType((*Link)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.Link")
+	proto.RegisterType((*Loop)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.Loop")
 	proto.RegisterType((*MemoizationStatus)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.MemoizationStatus")
 	proto.RegisterType((*Memoize)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.Memoize")
 	proto.RegisterType((*Metadata)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.Metadata")
@@ -2747,6 +3269,7 @@ func init() {
 	proto.RegisterType((*MutexStatus)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.MutexStatus")
 	proto.RegisterType((*NodeStatus)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.NodeStatus")
 	proto.RegisterMapType((ResourcesDuration)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.NodeStatus.ResourcesDurationEntry")
+	proto.RegisterMapType((map[string]int32)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.NodeStatus.WithArtifactChildrenEntry")
 	proto.RegisterType((*NodeSynchronizationStatus)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.NodeSynchronizationStatus")
 	proto.RegisterType((*NoneStrategy)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.NoneStrategy")
 	proto.RegisterType((*OSSArtifact)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.OSSArtifact")
@@ -2760,11 +3283,13 @@ func init() {
 	proto.RegisterType((*ResourceTemplate)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.ResourceTemplate")
 	proto.RegisterType((*RetryAffinity)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.RetryAffinity")
 	proto.RegisterType((*RetryNodeAntiAffinity)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.RetryNodeAntiAffinity")
+	proto.RegisterType((*RetryOn)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.RetryOn")
 	proto.RegisterType((*RetryStrategy)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.RetryStrategy")
 	proto.RegisterType((*S3Artifact)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.S3Artifact")
 	proto.RegisterType((*S3Bucket)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.S3Bucket")
 	proto.RegisterType((*ScriptTemplate)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.ScriptTemplate")
 	proto.RegisterType((*SemaphoreHolding)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.SemaphoreHolding")
+	proto.RegisterMapType((map[string]int32)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.SemaphoreHolding.WeightsEntry")
 	proto.RegisterType((*SemaphoreRef)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.SemaphoreRef")
 	proto.RegisterType((*SemaphoreStatus)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.SemaphoreStatus")
 	proto.RegisterType((*Sequence)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.Sequence")
@@ -2783,18 +3308,22 @@ func init() {
 	proto.RegisterType((*ValueFrom)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.ValueFrom")
 	proto.RegisterType((*Version)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.Version")
 	proto.RegisterType((*VolumeClaimGC)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.VolumeClaimGC")
+	proto.RegisterType((*WithArtifact)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.WithArtifact")
 	proto.RegisterType((*Workflow)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.Workflow")
 	proto.RegisterType((*WorkflowEventBinding)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.WorkflowEventBinding")
 	proto.RegisterType((*WorkflowEventBindingList)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.WorkflowEventBindingList")
 	proto.RegisterType((*WorkflowEventBindingSpec)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.WorkflowEventBindingSpec")
 	proto.RegisterType((*WorkflowList)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.WorkflowList")
 	proto.RegisterType((*WorkflowSpec)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.WorkflowSpec")
+	proto.RegisterMapType((LifecycleHooks)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.WorkflowSpec.HooksEntry")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.WorkflowSpec.NodeSelectorEntry")
 	proto.RegisterType((*WorkflowStatus)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.WorkflowStatus")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.WorkflowStatus.ConfigMapParameterValuesEntry")
 	proto.RegisterMapType((Nodes)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.WorkflowStatus.NodesEntry")
 	proto.RegisterMapType((ResourcesDuration)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.WorkflowStatus.ResourcesDurationEntry")
 	proto.RegisterMapType((map[string]Template)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.WorkflowStatus.StoredTemplatesEntry")
 	proto.RegisterType((*WorkflowStep)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.WorkflowStep")
+	proto.RegisterMapType((LifecycleHooks)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.WorkflowStep.HooksEntry")
 	proto.RegisterType((*WorkflowTemplate)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.WorkflowTemplate")
 	proto.RegisterType((*WorkflowTemplateList)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.WorkflowTemplateList")
 	proto.RegisterType((*WorkflowTemplateRef)(nil), "github.com.argoproj.argo.pkg.apis.workflow.v1alpha1.WorkflowTemplateRef")
@@ -2807,480 +3336,568 @@ func init() {
 }
 
 var fileDescriptor_c23edafa7e7ea072 = []byte{
-	// 7558 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x5b, 0x6c, 0x24, 0x59,
-	0x96, 0x50, 0xa7, 0xed, 0xb4, 0x33, 0x4f, 0xda, 0x65, 0xfb, 0xd6, 0x2b, 0xdb, 0x55, 0x5d, 0xae,
-	0x8d, 0xde, 0x6e, 0x75, 0x41, 0xaf, 0xbd, 0x5d, 0x35, 0x0d, 0xbd, 0xdb, 0xdb, 0x0f, 0xa7, 0x5f,
-	0xe5, 0xae, 0xf2, 0xa3, 0x4f, 0xba, 0xaa, 0xd8, 0xae, 0x56, 0x37, 0xe1, 0xcc, 0xeb, 0xcc, 0x28,
-	0x67, 0x46, 0x64, 0x47, 0x44, 0xda, 0xed, 0x6e, 0x10, 0xbd, 0x23, 0x96, 0x65, 0xd9, 0x5d, 0x01,
-	0x42, 0x0b, 0x83, 0x56, 0xbc, 0x24, 0x56, 0xfc, 0x2c, 0xe2, 0x03, 0xc1, 0x0f, 0xd2, 0x7e, 0x20,
-	0x40, 0xc3, 0x4a, 0x88, 0xe1, 0x8b, 0x91, 0x18, 0x79, 0xa6, 0xcd, 0xcf, 0x8c, 0x60, 0x18, 0xf1,
-	0x81, 0x90, 0x4a, 0x48, 0xa0, 0xfb, 0x8c, 0x1b, 0x91, 0x91, 0x55, 0x76, 0xa4, 0xab, 0x34, 0x62,
-	0xe6, 0x2f, 0xe3, 0x9c, 0x73, 0xcf, 0xb9, 0xef, 0x7b, 0x5e, 0xf7, 0x26, 0x2c, 0x36, 0x9c, 0xb0,
-	0xd9, 0xdd, 0x99, 0xab, 0x79, 0xed, 0x79, 0xdb, 0x6f, 0x78, 0x1d, 0xdf, 0x7b, 0xc4, 0x7f, 0xcc,
-	0x77, 0xf6, 0x1a, 0xf3, 0x76, 0xc7, 0x09, 0xe6, 0x0f, 0x3c, 0x7f, 0x6f, 0xb7, 0xe5, 0x1d, 0xcc,
-	0xef, 0xbf, 0x61, 0xb7, 0x3a, 0x4d, 0xfb, 0x8d, 0xf9, 0x06, 0x75, 0xa9, 0x6f, 0x87, 0xb4, 0x3e,
-	0xd7, 0xf1, 0xbd, 0xd0, 0x23, 0xb7, 0x22, 0x26, 0x73, 0x8a, 0x09, 0xff, 0x31, 0xd7, 0xd9, 0x6b,
-	0xcc, 0x31, 0x26, 0x73, 0x8a, 0xc9, 0x9c, 0x62, 0x32, 0xf3, 0x4b, 0x86, 0xe4, 0x86, 0xc7, 0x04,
-	0x32, 0x5e, 0x3b, 0xdd, 0x5d, 0xfe, 0xc5, 0x3f, 0xf8, 0x2f, 0x21, 0x63, 0xc6, 0xda, 0x7b, 0x2b,
-	0x98, 0x73, 0x3c, 0x56, 0xa5, 0xf9, 0x9a, 0xe7, 0xd3, 0xf9, 0xfd, 0x9e, 0x7a, 0xcc, 0xdc, 0x30,
-	0x68, 0x3a, 0x5e, 0xcb, 0xa9, 0x1d, 0xce, 0xef, 0xbf, 0xb1, 0x43, 0xc3, 0xde, 0x2a, 0xcf, 0x7c,
-	0x23, 0x22, 0x6d, 0xdb, 0xb5, 0xa6, 0xe3, 0x52, 0xff, 0x30, 0x6a, 0x72, 0x9b, 0x86, 0x76, 0x9a,
-	0x80, 0xf9, 0x7e, 0xa5, 0xfc, 0xae, 0x1b, 0x3a, 0x6d, 0xda, 0x53, 0xe0, 0xcf, 0x3c, 0xad, 0x40,
-	0x50, 0x6b, 0xd2, 0xb6, 0xdd, 0x53, 0xee, 0x56, 0xbf, 0x72, 0xdd, 0xd0, 0x69, 0xcd, 0x3b, 0x6e,
-	0x18, 0x84, 0x7e, 0xb2, 0x90, 0xb5, 0x0c, 0xa3, 0x0b, 0x6d, 0xaf, 0xeb, 0x86, 0xe4, 0x6d, 0xc8,
-	0xef, 0xdb, 0xad, 0x2e, 0x2d, 0xe7, 0xae, 0xe7, 0x5e, 0x2b, 0x56, 0x5e, 0xf9, 0xf6, 0xd1, 0xec,
-	0x0b, 0xc7, 0x47, 0xb3, 0xf9, 0xfb, 0x0c, 0xf8, 0xf8, 0x68, 0xf6, 0x02, 0x75, 0x6b, 0x5e, 0xdd,
-	0x71, 0x1b, 0xf3, 0x8f, 0x02, 0xcf, 0x9d, 0xdb, 0xe8, 0xb6, 0x77, 0xa8, 0x8f, 0xa2, 0x8c, 0xf5,
-	0x47, 0x43, 0x30, 0xb9, 0xe0, 0xd7, 0x9a, 0xce, 0x3e, 0xad, 0x86, 0x8c, 0x7f, 0xe3, 0x90, 0x3c,
-	0x84, 0xe1, 0xd0, 0xf6, 0x39, 0xbb, 0xd2, 0xcd, 0xf7, 0xe7, 0x32, 0x8c, 0xf7, 0xdc, 0xb6, 0xed,
-	0x2b, 0x76, 0x95, 0xb1, 0xe3, 0xa3, 0xd9, 0xe1, 0x6d, 0xdb, 0x47, 0xc6, 0x95, 0x7c, 0x0a, 0x23,
-	0xae, 0xe7, 0xd2, 0xf2, 0x10, 0xe7, 0xbe, 0x90, 0x89, 0xfb, 0x86, 0xe7, 0xea, 0xda, 0x56, 0x0a,
-	0xc7, 0x47, 0xb3, 0x23, 0x0c, 0x82, 0x9c, 0x31, 0xab, 0xfd, 0x17, 0x4e, 0xa7, 0x3c, 0x3c, 0x40,
-	0xed, 0x3f, 0x72, 0x3a, 0xf1, 0xda, 0x7f, 0xe4, 0x74, 0x90, 0x71, 0xb5, 0x7e, 0x92, 0x83, 0xe2,
-	0x82, 0xdf, 0xe8, 0xb6, 0xa9, 0x1b, 0x06, 0xc4, 0x07, 0xe8, 0xd8, 0xbe, 0xdd, 0xa6, 0x21, 0xf5,
-	0x83, 0x72, 0xee, 0xfa, 0xf0, 0x6b, 0xa5, 0x9b, 0xef, 0x66, 0x92, 0xb8, 0xa5, 0xd8, 0x54, 0x88,
-	0x1c, 0x3e, 0xd0, 0xa0, 0x00, 0x0d, 0x29, 0xc4, 0x85, 0xa2, 0xed, 0x87, 0xce, 0xae, 0x5d, 0x0b,
-	0x83, 0xf2, 0x10, 0x17, 0xf9, 0x4e, 0x26, 0x91, 0x0b, 0x92, 0x4b, 0x65, 0x5a, 0x4a, 0x2c, 0x2a,
-	0x48, 0x80, 0x91, 0x08, 0xeb, 0x3f, 0x8c, 0x40, 0x41, 0x21, 0xc8, 0x75, 0x18, 0x71, 0xed, 0xb6,
-	0x9a, 0x69, 0xe3, 0xb2, 0xe0, 0xc8, 0x86, 0xdd, 0x66, 0xbd, 0x6f, 0xb7, 0x29, 0xa3, 0xe8, 0xd8,
-	0x61, 0x93, 0x0f, 0xaf, 0x41, 0xb1, 0x65, 0x87, 0x4d, 0xe4, 0x18, 0x72, 0x15, 0x46, 0xda, 0x5e,
-	0x9d, 0xf2, 0x01, 0xca, 0x8b, 0xd1, 0x5b, 0xf7, 0xea, 0x14, 0x39, 0x94, 0x95, 0xdf, 0xf5, 0xbd,
-	0x76, 0x79, 0x24, 0x5e, 0x7e, 0xc5, 0xf7, 0xda, 0xc8, 0x31, 0xe4, 0x77, 0x72, 0x30, 0xa5, 0xaa,
-	0x77, 0xd7, 0xab, 0xd9, 0xa1, 0xe3, 0xb9, 0xe5, 0x3c, 0x1f, 0xed, 0xe5, 0x81, 0x3a, 0x42, 0x31,
-	0xab, 0x94, 0xa5, 0xd4, 0xa9, 0x24, 0x06, 0x7b, 0x04, 0x93, 0x9b, 0x00, 0x8d, 0x96, 0xb7, 0x63,
-	0xb7, 0x58, 0x1f, 0x94, 0x47, 0x79, 0xad, 0xf5, 0x10, 0xae, 0x6a, 0x0c, 0x1a, 0x54, 0x64, 0x0f,
-	0xc6, 0x6c, 0xb1, 0xe4, 0xca, 0x63, 0xbc, 0xde, 0x4b, 0x19, 0xeb, 0x1d, 0x5b, 0xb6, 0x95, 0xd2,
-	0xf1, 0xd1, 0xec, 0x98, 0x04, 0xa2, 0x92, 0x40, 0x5e, 0x87, 0x82, 0xd7, 0x61, 0x55, 0xb5, 0x5b,
-	0xe5, 0xc2, 0xf5, 0xdc, 0x6b, 0x85, 0xca, 0x94, 0xac, 0x5e, 0x61, 0x53, 0xc2, 0x51, 0x53, 0x90,
-	0x1b, 0x30, 0x16, 0x74, 0x77, 0xd8, 0x68, 0x95, 0x8b, 0xbc, 0x2d, 0x93, 0x92, 0x78, 0xac, 0x2a,
-	0xc0, 0xa8, 0xf0, 0xe4, 0x4d, 0x28, 0xf9, 0xb4, 0xd6, 0xf5, 0x03, 0xca, 0x86, 0xaf, 0x0c, 0x9c,
-	0xf7, 0x79, 0x49, 0x5e, 0xc2, 0x08, 0x85, 0x26, 0x9d, 0xf5, 0x9f, 0x46, 0xa1, 0xa7, 0x5f, 0xc9,
-	0x1b, 0x50, 0x92, 0xf5, 0xbd, 0xeb, 0x35, 0x02, 0x3e, 0xbd, 0x0a, 0x95, 0x49, 0xc6, 0x67, 0x21,
-	0x02, 0xa3, 0x49, 0x43, 0x1e, 0xc0, 0x50, 0x70, 0x4b, 0xee, 0x22, 0xef, 0x65, 0xea, 0xbf, 0xea,
-	0x2d, 0xbd, 0x04, 0x46, 0x8f, 0x8f, 0x66, 0x87, 0xaa, 0xb7, 0x70, 0x28, 0xb8, 0xc5, 0xf6, 0x8f,
-	0x86, 0x13, 0x0e, 0xb4, 0x7f, 0xac, 0x3a, 0xa1, 0x66, 0xcd, 0xf7, 0x8f, 0x55, 0x27, 0x44, 0xc6,
-	0x95, 0xed, 0x7e, 0xcd, 0x30, 0xec, 0xf0, 0xe9, 0x9d, 0x75, 0xf7, 0xbb, 0xbd, 0xbd, 0xbd, 0xa5,
-	0xd9, 0xf3, 0xf5, 0xc3, 0x20, 0xc8, 0x19, 0x93, 0x2f, 0x59, 0x4f, 0x0a, 0x9c, 0xe7, 0x1f, 0xca,
-	0x75, 0x71, 0x7b, 0xa0, 0x75, 0xe1, 0xf9, 0x87, 0x5a, 0x9c, 0x1c, 0x13, 0x8d, 0x40, 0x53, 0x1a,
-	0x6f, 0x5d, 0x7d, 0x37, 0xe0, 0xcb, 0x20, 0x73, 0xeb, 0x96, 0x56, 0xaa, 0x89, 0xd6, 0x2d, 0xad,
-	0x54, 0x91, 0x33, 0x66, 0x63, 0xe3, 0xdb, 0x07, 0x72, 0xd5, 0x64, 0x1b, 0x1b, 0xb4, 0x0f, 0xe2,
-	0x63, 0x83, 0xf6, 0x01, 0x32, 0xae, 0x8c, 0xb9, 0x17, 0x04, 0x7c, 0x91, 0x64, 0x65, 0xbe, 0x59,
-	0xad, 0xc6, 0x99, 0x6f, 0x56, 0xab, 0xc8, 0xb8, 0xf2, 0x59, 0x55, 0x0b, 0xf8, 0xa2, 0xca, 0x3c,
-	0xab, 0x16, 0x13, 0xcc, 0x57, 0x17, 0xab, 0xc8, 0xb8, 0x5a, 0x9f, 0xc1, 0x45, 0x85, 0x41, 0xda,
-	0xf1, 0x02, 0x87, 0x0f, 0x0d, 0xdd, 0x25, 0xf3, 0x50, 0xac, 0x79, 0xee, 0xae, 0xd3, 0x58, 0xb7,
-	0x3b, 0x72, 0xd3, 0xd6, 0xbb, 0xfd, 0xa2, 0x42, 0x60, 0x44, 0x43, 0x5e, 0x82, 0xe1, 0x3d, 0x7a,
-	0x28, 0x77, 0xef, 0x92, 0x24, 0x1d, 0xbe, 0x43, 0x0f, 0x91, 0xc1, 0x7f, 0xb5, 0xf0, 0xad, 0x7f,
-	0x38, 0xfb, 0xc2, 0x57, 0xdf, 0xbb, 0xfe, 0x82, 0xf5, 0x87, 0x43, 0x70, 0x25, 0x55, 0x66, 0x35,
-	0xb4, 0xc3, 0x6e, 0x40, 0xfe, 0x41, 0x0e, 0x2e, 0xda, 0x69, 0x78, 0xa9, 0x56, 0x7c, 0x30, 0xd0,
-	0x94, 0x8c, 0x71, 0xac, 0xbc, 0x24, 0xeb, 0x99, 0xde, 0x09, 0x98, 0x5e, 0x0f, 0xd6, 0x37, 0xec,
-	0xc4, 0x0a, 0x3a, 0x76, 0x8d, 0xca, 0x06, 0xeb, 0xbe, 0xd9, 0x50, 0x08, 0x8c, 0x68, 0xd8, 0xde,
-	0x58, 0xa7, 0xbb, 0x76, 0xb7, 0x25, 0x36, 0x87, 0x42, 0xb4, 0x37, 0x2e, 0x09, 0x30, 0x2a, 0xbc,
-	0xd1, 0x4f, 0x7f, 0x9c, 0x83, 0xf3, 0x29, 0x0b, 0x89, 0x75, 0x74, 0xd7, 0x6f, 0xc9, 0x31, 0xd1,
-	0x1d, 0x7d, 0x0f, 0xef, 0x22, 0x83, 0x93, 0xdf, 0xca, 0xc1, 0xa4, 0xb1, 0xb2, 0x16, 0xba, 0xf2,
-	0x48, 0xcd, 0x7e, 0x56, 0xc4, 0x78, 0x55, 0x2e, 0x4b, 0x89, 0x93, 0x09, 0x04, 0x26, 0xa5, 0x5a,
-	0xff, 0x39, 0x07, 0x49, 0x22, 0x62, 0xc3, 0xb9, 0x6e, 0x40, 0x7d, 0xd6, 0x35, 0x55, 0x5a, 0xf3,
-	0x69, 0x28, 0x07, 0xf5, 0x95, 0x39, 0xa1, 0xc9, 0xb2, 0x5a, 0xcc, 0x31, 0xbd, 0x7d, 0x6e, 0xff,
-	0x8d, 0x39, 0x41, 0x71, 0x87, 0x1e, 0x56, 0x69, 0x8b, 0x32, 0x1e, 0x15, 0x72, 0x7c, 0x34, 0x7b,
-	0xee, 0x5e, 0x8c, 0x01, 0x26, 0x18, 0x32, 0x11, 0x1d, 0x3b, 0x08, 0x0e, 0x3c, 0xbf, 0x2e, 0x45,
-	0x0c, 0x9d, 0x5a, 0xc4, 0x56, 0x8c, 0x01, 0x26, 0x18, 0x5a, 0xff, 0x26, 0x07, 0x63, 0x15, 0xbb,
-	0xb6, 0xe7, 0xed, 0xee, 0xb2, 0x53, 0xb2, 0xde, 0xf5, 0x85, 0x2e, 0x21, 0xc6, 0x44, 0x9f, 0x92,
-	0x4b, 0x12, 0x8e, 0x9a, 0x82, 0x6c, 0xc3, 0xa8, 0xe8, 0x0e, 0x59, 0xa9, 0x5f, 0x36, 0x2a, 0xa5,
-	0x35, 0x78, 0x3e, 0x1c, 0x4c, 0x83, 0x9f, 0x13, 0x1a, 0xfc, 0xdc, 0x9a, 0x1b, 0x6e, 0x32, 0xad,
-	0xd8, 0x71, 0x1b, 0x15, 0x38, 0x3e, 0x9a, 0x1d, 0x5d, 0xe1, 0x3c, 0x50, 0xf2, 0x62, 0x07, 0x6a,
-	0xdb, 0xfe, 0x5c, 0x89, 0xe3, 0x73, 0xac, 0x18, 0x1d, 0xa8, 0xeb, 0x11, 0x0a, 0x4d, 0x3a, 0xeb,
-	0x13, 0xc8, 0x2f, 0xda, 0xb5, 0x26, 0x25, 0xf7, 0x92, 0x8b, 0xbd, 0x74, 0xf3, 0xb5, 0xb4, 0xde,
-	0xd2, 0x0b, 0xdf, 0xec, 0xb0, 0x89, 0x7e, 0x5b, 0x82, 0xf5, 0xc3, 0x1c, 0x5c, 0x5e, 0x6c, 0x75,
-	0x83, 0x90, 0xfa, 0x0f, 0xe4, 0xbc, 0xda, 0xa6, 0xed, 0x4e, 0xcb, 0x0e, 0x29, 0xf9, 0xf3, 0x50,
-	0x60, 0xd6, 0x53, 0xdd, 0x0e, 0x6d, 0x29, 0xb1, 0x7f, 0x57, 0xf0, 0x99, 0xc9, 0xa8, 0x59, 0x1d,
-	0x36, 0x77, 0x1e, 0xd1, 0x5a, 0xb8, 0x4e, 0x43, 0x3b, 0xd2, 0x96, 0x22, 0x18, 0x6a, 0xae, 0x64,
-	0x0f, 0x46, 0x82, 0x0e, 0xad, 0xc9, 0x8e, 0x5e, 0xcb, 0x34, 0xf9, 0x93, 0xd5, 0xae, 0x76, 0x68,
-	0x2d, 0x52, 0x2d, 0xd9, 0x17, 0x72, 0x21, 0xd6, 0xff, 0xc8, 0xc1, 0x95, 0x3e, 0x4d, 0xbd, 0xeb,
-	0x04, 0x21, 0xf9, 0xb8, 0xa7, 0xb9, 0x73, 0x27, 0x6b, 0x2e, 0x2b, 0xcd, 0x1b, 0xab, 0x67, 0x95,
-	0x82, 0x18, 0x4d, 0xfd, 0x0c, 0xf2, 0x4e, 0x48, 0xdb, 0x4a, 0xab, 0xbf, 0x9b, 0xa9, 0xad, 0x7d,
-	0xaa, 0x5f, 0x99, 0x50, 0x56, 0xe1, 0x1a, 0x13, 0x81, 0x42, 0x92, 0xf5, 0xef, 0x73, 0xc0, 0x06,
-	0xbd, 0xee, 0x48, 0x2d, 0x6c, 0x24, 0x3c, 0xec, 0x28, 0xed, 0x5e, 0xed, 0xaa, 0x23, 0xdb, 0x87,
-	0x1d, 0x66, 0x46, 0x4e, 0x68, 0x42, 0x06, 0x40, 0x4e, 0x4a, 0x3e, 0x81, 0xd1, 0x80, 0x6f, 0xf8,
-	0x72, 0x07, 0x5d, 0x91, 0x85, 0x46, 0xc5, 0x31, 0xf0, 0xf8, 0x68, 0xf6, 0x44, 0xb6, 0xf7, 0x9c,
-	0xe6, 0x2d, 0xca, 0xa1, 0xe4, 0xca, 0xf6, 0xdc, 0x36, 0x0d, 0x02, 0xbb, 0x41, 0xe5, 0x7a, 0xd0,
-	0x7b, 0xee, 0xba, 0x00, 0xa3, 0xc2, 0x5b, 0xbf, 0x0e, 0xb0, 0xe8, 0xb9, 0xa1, 0xe3, 0x76, 0xe9,
-	0xa6, 0x4b, 0x5e, 0x86, 0x3c, 0xf5, 0x7d, 0xcf, 0x97, 0xba, 0xa4, 0x6e, 0xfe, 0x32, 0x03, 0xa2,
-	0xc0, 0x91, 0x57, 0xd9, 0x3a, 0x76, 0x5a, 0xb4, 0xce, 0x6b, 0x5f, 0xa8, 0x9c, 0x53, 0xb5, 0x5f,
-	0xe1, 0x50, 0x94, 0x58, 0x6b, 0x0e, 0xc6, 0x16, 0x99, 0xa9, 0x4d, 0x7d, 0xc6, 0xd7, 0x34, 0xb6,
-	0x27, 0x62, 0xc6, 0xb6, 0x32, 0xaa, 0xb7, 0xe1, 0xe2, 0xa2, 0x4f, 0xd9, 0x4c, 0xbb, 0x55, 0xe9,
-	0xd6, 0xf6, 0x68, 0x28, 0x34, 0xed, 0x80, 0xbc, 0x0d, 0x13, 0x1e, 0x9f, 0xe5, 0x77, 0xbd, 0xda,
-	0x9e, 0xe3, 0x36, 0xe4, 0x41, 0x72, 0x51, 0x72, 0x99, 0xd8, 0x34, 0x91, 0x18, 0xa7, 0xb5, 0xfe,
-	0x64, 0x08, 0xc6, 0x17, 0x7d, 0xcf, 0x55, 0x63, 0xfb, 0x1c, 0x56, 0x5f, 0x23, 0xb6, 0xfa, 0xb2,
-	0x99, 0x57, 0x66, 0x95, 0xfb, 0xad, 0x3c, 0xe2, 0xe9, 0x79, 0x24, 0xf4, 0xee, 0xd5, 0xc1, 0x45,
-	0x71, 0x76, 0xd1, 0x90, 0xc6, 0x27, 0x96, 0xf5, 0xdd, 0x1c, 0x4c, 0x99, 0xe4, 0xcf, 0x61, 0x7d,
-	0xef, 0xc6, 0xd7, 0xf7, 0xc2, 0xc0, 0x4d, 0xec, 0xb3, 0xa8, 0xff, 0x4f, 0x3e, 0xde, 0x34, 0xd6,
-	0xcd, 0xcc, 0x6a, 0x1e, 0x3f, 0x30, 0x00, 0xb2, 0x7d, 0x0b, 0x03, 0x6d, 0xa8, 0x7c, 0x38, 0x7f,
-	0x51, 0x56, 0x62, 0xdc, 0x84, 0x3e, 0x4e, 0x7c, 0x63, 0x4c, 0x38, 0x3b, 0x6e, 0x83, 0x5a, 0x93,
-	0xd6, 0xbb, 0x2d, 0xa5, 0x7a, 0xe9, 0x8e, 0xab, 0x4a, 0x38, 0x6a, 0x0a, 0xf2, 0x31, 0x4c, 0xd7,
-	0x3c, 0xb7, 0xd6, 0xf5, 0x7d, 0xea, 0xd6, 0x0e, 0xb7, 0xb8, 0xaf, 0x4f, 0x6e, 0x07, 0x73, 0xb2,
-	0xd8, 0xf4, 0x62, 0x92, 0xe0, 0x71, 0x1a, 0x10, 0x7b, 0x19, 0x09, 0x93, 0x37, 0xe8, 0x50, 0xb7,
-	0xce, 0xad, 0xb2, 0x82, 0x69, 0xf2, 0x72, 0x30, 0x2a, 0x3c, 0xb9, 0x07, 0x97, 0x83, 0x90, 0x29,
-	0x48, 0x6e, 0x63, 0x89, 0xda, 0xf5, 0x96, 0xe3, 0x32, 0x75, 0xc5, 0x73, 0xeb, 0x01, 0x37, 0xb4,
-	0x86, 0x2b, 0x57, 0x8e, 0x8f, 0x66, 0x2f, 0x57, 0xd3, 0x49, 0xb0, 0x5f, 0x59, 0xf2, 0x09, 0xcc,
-	0x04, 0xdd, 0x5a, 0x8d, 0x06, 0xc1, 0x6e, 0xb7, 0xf5, 0x81, 0xb7, 0x13, 0xdc, 0x76, 0x02, 0xa6,
-	0x6b, 0xdd, 0x75, 0xda, 0x4e, 0xc8, 0x8d, 0xa9, 0x7c, 0xe5, 0xda, 0xf1, 0xd1, 0xec, 0x4c, 0xb5,
-	0x2f, 0x15, 0x3e, 0x81, 0x03, 0x41, 0xb8, 0x24, 0x36, 0xb2, 0x1e, 0xde, 0x63, 0x9c, 0xf7, 0xcc,
-	0xf1, 0xd1, 0xec, 0xa5, 0x95, 0x54, 0x0a, 0xec, 0x53, 0x92, 0x8d, 0x60, 0xe8, 0xb4, 0xe9, 0x17,
-	0x9e, 0x4b, 0xb9, 0xc5, 0x64, 0x8c, 0xe0, 0xb6, 0x84, 0xa3, 0xa6, 0x20, 0x8f, 0xa2, 0xc9, 0xc7,
-	0x16, 0x85, 0x34, 0x83, 0x4e, 0xbf, 0x5b, 0x5d, 0x38, 0x3e, 0x9a, 0x9d, 0x7a, 0x60, 0x70, 0x62,
-	0x0b, 0x0b, 0x63, 0xbc, 0xad, 0x7f, 0x37, 0x04, 0xa4, 0x77, 0x23, 0x20, 0x77, 0x60, 0xd4, 0xae,
-	0x85, 0xce, 0x3e, 0x95, 0x7e, 0xba, 0x97, 0xd3, 0x54, 0x23, 0x21, 0x0a, 0xe9, 0x2e, 0x65, 0x33,
-	0x84, 0x46, 0xbb, 0xc7, 0x02, 0x2f, 0x8a, 0x92, 0x05, 0xf1, 0x60, 0xba, 0x65, 0x07, 0xa1, 0x9a,
-	0xab, 0x75, 0xd6, 0x64, 0xb9, 0x49, 0xfe, 0xa9, 0x93, 0x35, 0x8a, 0x95, 0xa8, 0x5c, 0x64, 0x33,
-	0xf7, 0x6e, 0x92, 0x11, 0xf6, 0xf2, 0x26, 0x3e, 0x40, 0x4d, 0x1d, 0x91, 0x6c, 0x8f, 0xcc, 0xee,
-	0x69, 0xd4, 0x27, 0x6d, 0xb4, 0xf5, 0x6b, 0x50, 0x80, 0x86, 0x14, 0xeb, 0xc7, 0xa3, 0x30, 0xb6,
-	0xb4, 0xb0, 0xba, 0x6d, 0x07, 0x7b, 0x27, 0x70, 0xfc, 0xb1, 0x09, 0x21, 0x95, 0x8d, 0xe4, 0x92,
-	0x56, 0x4a, 0x08, 0x6a, 0x0a, 0xe2, 0x41, 0xd1, 0x56, 0x6e, 0x54, 0xb9, 0xe5, 0xbf, 0x9b, 0xd1,
-	0xb0, 0x91, 0x5c, 0x4c, 0x37, 0xa6, 0x04, 0x61, 0x24, 0x83, 0x04, 0x50, 0x52, 0xc2, 0x99, 0x11,
-	0x3a, 0x32, 0x88, 0x6f, 0x3b, 0xe2, 0x23, 0xfc, 0x21, 0x06, 0x00, 0x4d, 0x29, 0xe4, 0x1b, 0x30,
-	0x5e, 0xa7, 0x6c, 0xe7, 0xa0, 0x6e, 0xcd, 0xa1, 0x6c, 0x93, 0x18, 0x66, 0xfd, 0xc2, 0x36, 0xcb,
-	0x25, 0x03, 0x8e, 0x31, 0x2a, 0xf2, 0x08, 0x8a, 0x07, 0x4e, 0xd8, 0xe4, 0x7b, 0x7a, 0x79, 0x94,
-	0x0f, 0xf5, 0xaf, 0x64, 0xaa, 0x28, 0xe3, 0x10, 0x75, 0xcb, 0x03, 0xc5, 0x13, 0x23, 0xf6, 0xcc,
-	0x08, 0x66, 0x1f, 0xdc, 0xd7, 0xcc, 0x77, 0x83, 0x62, 0xbc, 0x00, 0x47, 0x60, 0x44, 0x43, 0x02,
-	0x18, 0x67, 0x1f, 0x55, 0xfa, 0x59, 0x97, 0xad, 0x10, 0xe9, 0x2d, 0xc9, 0xe6, 0x81, 0x56, 0x4c,
-	0x44, 0x8f, 0x3c, 0x30, 0xd8, 0x62, 0x4c, 0x08, 0x9b, 0x7d, 0x07, 0x4d, 0xea, 0x4a, 0x97, 0xa4,
-	0x9e, 0x7d, 0x0f, 0x9a, 0xd4, 0x45, 0x8e, 0x21, 0x1e, 0x5f, 0x1f, 0x52, 0xf9, 0xe3, 0xbe, 0xc8,
-	0xac, 0x5e, 0xc1, 0x48, 0x87, 0xac, 0x9c, 0x93, 0x8b, 0x43, 0x7e, 0xa3, 0x21, 0x82, 0xa9, 0x8e,
-	0x9e, 0xbb, 0xfc, 0xb9, 0x13, 0x96, 0x4b, 0xbc, 0x52, 0x7a, 0xa7, 0xd8, 0xe4, 0x50, 0x94, 0x58,
-	0xe1, 0x34, 0x60, 0x83, 0x1b, 0x94, 0xc7, 0xe3, 0x0a, 0xac, 0x98, 0x01, 0x01, 0x2a, 0xbc, 0xf5,
-	0xaf, 0x73, 0x50, 0x62, 0xeb, 0x4d, 0xad, 0x91, 0x57, 0x61, 0x34, 0xb4, 0xfd, 0x86, 0xb4, 0xae,
-	0x0d, 0x11, 0xdb, 0x1c, 0x8a, 0x12, 0x4b, 0x6c, 0xc8, 0x87, 0x76, 0xb0, 0xa7, 0xf4, 0x8a, 0x5f,
-	0xcb, 0xd4, 0x6c, 0xb9, 0xd0, 0x23, 0x95, 0x82, 0x7d, 0x05, 0x28, 0x38, 0x93, 0xd7, 0xa0, 0xc0,
-	0xce, 0x81, 0x15, 0x3b, 0x50, 0xbe, 0x8f, 0x71, 0xb6, 0xb0, 0x57, 0x24, 0x0c, 0x35, 0xd6, 0x7a,
-	0x13, 0xf2, 0xcb, 0xfb, 0xd4, 0xe5, 0x07, 0x44, 0x20, 0x8d, 0xcb, 0xa4, 0x45, 0xad, 0x8c, 0x4e,
-	0xd4, 0x14, 0xd6, 0xc7, 0x70, 0x6e, 0xf9, 0x73, 0x5a, 0xeb, 0x86, 0x9e, 0x2f, 0x8c, 0x50, 0xf2,
-	0x01, 0x90, 0x80, 0xfa, 0xfb, 0x4e, 0x8d, 0x2e, 0xd4, 0x6a, 0x4c, 0xf9, 0xde, 0x88, 0xf6, 0x9f,
-	0x19, 0xc9, 0x89, 0x54, 0x7b, 0x28, 0x30, 0xa5, 0x94, 0xf5, 0xf7, 0x72, 0x50, 0x32, 0xbc, 0x67,
-	0x6c, 0xf7, 0x69, 0x2c, 0x56, 0x85, 0x6a, 0x2e, 0x15, 0xa1, 0x77, 0xb3, 0xba, 0xe4, 0x04, 0x97,
-	0x68, 0xd5, 0x68, 0x10, 0x46, 0x32, 0x9e, 0xe2, 0x56, 0xb3, 0xfe, 0x65, 0x0e, 0xa2, 0x72, 0x6c,
-	0xdc, 0x77, 0xa2, 0xaa, 0x19, 0xe3, 0x2e, 0xf9, 0x4a, 0x2c, 0xf9, 0x2a, 0x07, 0x97, 0xe3, 0x8d,
-	0xe5, 0x06, 0xfd, 0xe9, 0x9d, 0x25, 0xb3, 0x52, 0xc0, 0xe5, 0x6a, 0x3a, 0x37, 0xec, 0x27, 0xc6,
-	0xba, 0x0f, 0xf9, 0x55, 0xbb, 0xdb, 0xa0, 0x27, 0x32, 0x8b, 0xd8, 0x2c, 0xf2, 0xa9, 0xdd, 0x0a,
-	0xd5, 0x61, 0x29, 0x67, 0x11, 0x4a, 0x18, 0x6a, 0xac, 0xf5, 0x47, 0x23, 0x50, 0x32, 0x9c, 0xe8,
-	0x6c, 0x03, 0xf0, 0x69, 0xc7, 0x4b, 0x1e, 0x3f, 0x48, 0x3b, 0x1e, 0x72, 0x0c, 0x9b, 0x6e, 0x3e,
-	0xdd, 0x77, 0x02, 0xc7, 0x73, 0x93, 0xc7, 0x0f, 0x4a, 0x38, 0x6a, 0x0a, 0x32, 0x0b, 0xf9, 0x3a,
-	0xed, 0x84, 0x4d, 0x3e, 0x99, 0x47, 0x2a, 0x45, 0x56, 0xd5, 0x25, 0x06, 0x40, 0x01, 0x67, 0x04,
-	0xbb, 0x34, 0xac, 0x35, 0xcb, 0x23, 0x7c, 0xcb, 0xe6, 0x04, 0x2b, 0x0c, 0x80, 0x02, 0x9e, 0xe2,
-	0x02, 0xcb, 0x3f, 0x7b, 0x17, 0xd8, 0xe8, 0x19, 0xbb, 0xc0, 0x48, 0x07, 0xce, 0x07, 0x41, 0x73,
-	0xcb, 0x77, 0xf6, 0xed, 0x90, 0x46, 0xb3, 0x67, 0xec, 0x34, 0x72, 0x2e, 0x1f, 0x1f, 0xcd, 0x9e,
-	0xaf, 0x56, 0x6f, 0x27, 0xb9, 0x60, 0x1a, 0x6b, 0x52, 0x85, 0x8b, 0x8e, 0x1b, 0xd0, 0x5a, 0xd7,
-	0xa7, 0x6b, 0x0d, 0xd7, 0xf3, 0xe9, 0x6d, 0x2f, 0x60, 0xec, 0x64, 0x6c, 0x4a, 0xbb, 0x72, 0xd7,
-	0xd2, 0x88, 0x30, 0xbd, 0xac, 0xf5, 0x27, 0x39, 0x18, 0x37, 0xe3, 0x06, 0x24, 0x00, 0x68, 0x2e,
-	0xad, 0x54, 0xc5, 0x56, 0x22, 0x57, 0xf8, 0x7b, 0x99, 0xc3, 0x11, 0x82, 0x4d, 0xa4, 0x2f, 0x45,
-	0x30, 0x34, 0xc4, 0x9c, 0x20, 0xf4, 0xf9, 0x32, 0xe4, 0x77, 0x3d, 0xbf, 0x46, 0xe5, 0x1e, 0xaa,
-	0x57, 0xc9, 0x0a, 0x03, 0xa2, 0xc0, 0x59, 0x3f, 0xcc, 0x81, 0x21, 0x81, 0xfc, 0x25, 0x98, 0x60,
-	0x32, 0xee, 0xf8, 0x3b, 0xb1, 0xd6, 0x54, 0x32, 0xb7, 0x46, 0x73, 0x8a, 0xdc, 0x0e, 0x31, 0x30,
-	0xc6, 0xe5, 0x91, 0x3f, 0x0d, 0x45, 0xbb, 0x5e, 0xf7, 0x69, 0x10, 0x50, 0x71, 0xc4, 0x14, 0x85,
-	0xb3, 0x70, 0x41, 0x01, 0x31, 0xc2, 0xb3, 0x65, 0xd8, 0xac, 0xef, 0x06, 0x6c, 0x66, 0x4b, 0x0b,
-	0x4d, 0x2f, 0x43, 0x26, 0x84, 0xc1, 0x51, 0x53, 0x58, 0xbf, 0x37, 0x02, 0x71, 0xd9, 0xa4, 0x0e,
-	0x93, 0x7b, 0xfe, 0xce, 0x22, 0x77, 0x68, 0x66, 0x71, 0x2d, 0x9f, 0x3f, 0x3e, 0x9a, 0x9d, 0xbc,
-	0x13, 0xe7, 0x80, 0x49, 0x96, 0x52, 0xca, 0x1d, 0x7a, 0x18, 0xda, 0x3b, 0x59, 0x36, 0x4c, 0x25,
-	0xc5, 0xe4, 0x80, 0x49, 0x96, 0xe4, 0x4d, 0x28, 0xed, 0xf9, 0x3b, 0x6a, 0x91, 0x27, 0xfd, 0xb9,
-	0x77, 0x22, 0x14, 0x9a, 0x74, 0xac, 0x0b, 0xf7, 0xfc, 0x1d, 0xb6, 0x29, 0xaa, 0x28, 0xb8, 0xee,
-	0xc2, 0x3b, 0x12, 0x8e, 0x9a, 0x82, 0x74, 0x80, 0xec, 0xa9, 0xde, 0xd3, 0xee, 0x5b, 0xb9, 0x17,
-	0x9d, 0xdc, 0xfb, 0x7b, 0x89, 0x1d, 0xa6, 0x77, 0x7a, 0xf8, 0x60, 0x0a, 0x6f, 0xf2, 0xeb, 0x70,
-	0x79, 0xcf, 0xdf, 0x91, 0x47, 0xc5, 0x96, 0xef, 0xb8, 0x35, 0xa7, 0x13, 0x0b, 0x7f, 0xeb, 0xe3,
-	0xe4, 0x4e, 0x3a, 0x19, 0xf6, 0x2b, 0x6f, 0xfd, 0x3e, 0x5b, 0xc7, 0x46, 0x74, 0xf3, 0x69, 0x51,
-	0x92, 0x5d, 0x18, 0x6b, 0x52, 0xbb, 0x4e, 0x7d, 0xa5, 0xfb, 0xbc, 0x9d, 0x6d, 0x55, 0x70, 0x1e,
-	0x91, 0x66, 0x26, 0xbe, 0x03, 0x54, 0xcc, 0xad, 0x4d, 0x18, 0x15, 0xb0, 0x13, 0xd8, 0x41, 0xfa,
-	0x24, 0x1c, 0x7a, 0x82, 0x83, 0xf0, 0x5b, 0x39, 0x28, 0x72, 0x73, 0xba, 0xc1, 0x74, 0x6a, 0x5d,
-	0x64, 0xf8, 0x09, 0x87, 0xe7, 0x2e, 0x8c, 0x89, 0x73, 0x3f, 0xe0, 0x67, 0x52, 0xd6, 0xb6, 0x8a,
-	0x9c, 0xa1, 0xa8, 0xad, 0x42, 0xa7, 0x08, 0x50, 0x31, 0xb7, 0xfe, 0x7b, 0x0e, 0x46, 0xd7, 0xdc,
-	0x4e, 0xf7, 0x67, 0x24, 0xbd, 0x65, 0x1d, 0x46, 0x98, 0x25, 0x14, 0x4f, 0xa2, 0x1a, 0xaf, 0xbc,
-	0x62, 0x26, 0x50, 0x95, 0xe3, 0x09, 0x54, 0x68, 0x1f, 0x28, 0xe7, 0xb3, 0x28, 0x63, 0x84, 0xfb,
-	0x5a, 0x30, 0x72, 0xd7, 0x71, 0xf7, 0x4e, 0x36, 0x4f, 0x82, 0x9a, 0xd7, 0xe9, 0x99, 0x27, 0x55,
-	0x06, 0x44, 0x81, 0x53, 0xf3, 0x7f, 0x38, 0x7d, 0xfe, 0x5b, 0xdf, 0xcc, 0xc1, 0xf4, 0x3a, 0x6d,
-	0x7b, 0xce, 0x17, 0x76, 0xe4, 0x3b, 0x67, 0x85, 0x9a, 0x4e, 0x28, 0x1d, 0xdf, 0xba, 0xd0, 0x6d,
-	0x27, 0x44, 0x06, 0x7f, 0x8a, 0x2e, 0xca, 0x43, 0xc6, 0x6c, 0xab, 0xdc, 0x88, 0xf6, 0xac, 0x28,
-	0x64, 0xac, 0x10, 0x18, 0xd1, 0x58, 0xff, 0x34, 0x07, 0x63, 0xa2, 0x12, 0x54, 0xf1, 0xce, 0xf5,
-	0xe1, 0xfd, 0x10, 0xf2, 0xbc, 0x9c, 0xdc, 0x6d, 0x7f, 0x35, 0x9b, 0x81, 0xc6, 0x38, 0x08, 0x8d,
-	0x8c, 0xff, 0x44, 0xc1, 0x93, 0xa9, 0xcd, 0x6d, 0xfb, 0xf3, 0x05, 0x1d, 0x29, 0xd0, 0x6a, 0xf3,
-	0x3a, 0x87, 0xa2, 0xc4, 0x5a, 0x5f, 0x0d, 0x43, 0x41, 0xb9, 0x8e, 0xc8, 0x6f, 0xe6, 0xa0, 0x64,
-	0xbb, 0xae, 0x17, 0xda, 0xc2, 0xb3, 0x22, 0x26, 0xf9, 0x46, 0xa6, 0x8a, 0x29, 0xa6, 0x73, 0x0b,
-	0x11, 0xc3, 0x65, 0x37, 0xf4, 0x0f, 0xa3, 0x4d, 0xdf, 0xc0, 0xa0, 0x29, 0x97, 0x7c, 0x06, 0xa3,
-	0x2d, 0x7b, 0x87, 0xb6, 0xd4, 0x9c, 0x5f, 0x1b, 0xac, 0x06, 0x77, 0x39, 0x2f, 0x21, 0x5c, 0xf7,
-	0x83, 0x00, 0xa2, 0x14, 0x34, 0xf3, 0x2e, 0x4c, 0x25, 0x2b, 0x4a, 0xa6, 0x8c, 0xf1, 0x13, 0x43,
-	0x76, 0x21, 0xb6, 0x9d, 0xa9, 0x09, 0x3f, 0xf4, 0x56, 0x6e, 0xe6, 0x57, 0xa0, 0x64, 0x88, 0x39,
-	0x4d, 0x51, 0xeb, 0x43, 0x28, 0xad, 0xd3, 0xd0, 0x77, 0x6a, 0x9c, 0xc1, 0xd3, 0x66, 0xcd, 0x89,
-	0x76, 0xd4, 0x2f, 0xd8, 0x24, 0x64, 0x2c, 0x03, 0xe2, 0x01, 0x74, 0x7c, 0xaf, 0x4d, 0xc3, 0x26,
-	0xed, 0xaa, 0x11, 0xcd, 0xa6, 0xfc, 0x6d, 0x69, 0x36, 0xc2, 0x17, 0x10, 0x7d, 0xa3, 0x21, 0xc2,
-	0xba, 0x01, 0xf9, 0xf5, 0x6e, 0x48, 0x3f, 0x7f, 0xfa, 0xaa, 0xb7, 0x1e, 0xc2, 0x38, 0x27, 0xbd,
-	0xed, 0xb5, 0xd8, 0x86, 0xc2, 0xda, 0xd6, 0x66, 0xdf, 0x49, 0xbb, 0x89, 0x13, 0xa1, 0xc0, 0xb1,
-	0x99, 0xdd, 0xf4, 0x5a, 0x75, 0xea, 0xcb, 0x1e, 0xd0, 0x23, 0x7a, 0x9b, 0x43, 0x51, 0x62, 0xad,
-	0x1f, 0xe5, 0xa0, 0xc4, 0x0b, 0xca, 0x8d, 0xa0, 0x05, 0x63, 0x4d, 0x21, 0x47, 0xf6, 0x42, 0x36,
-	0x6f, 0xbf, 0x59, 0x61, 0xe3, 0x90, 0x14, 0x00, 0x54, 0x22, 0x98, 0xb4, 0x03, 0xdb, 0x09, 0x99,
-	0xb4, 0xa1, 0x33, 0x97, 0xf6, 0x40, 0x70, 0x46, 0x25, 0xc2, 0xfa, 0x67, 0x53, 0x00, 0x1b, 0x5e,
-	0x9d, 0xca, 0xa6, 0xce, 0xc0, 0x90, 0x53, 0x97, 0x9d, 0x08, 0xb2, 0xd0, 0xd0, 0xda, 0x12, 0x0e,
-	0x39, 0x75, 0x3d, 0x2a, 0x43, 0x7d, 0xf7, 0xe2, 0x37, 0xa1, 0x54, 0x77, 0x82, 0x4e, 0xcb, 0x3e,
-	0xdc, 0x48, 0xd1, 0xd4, 0x96, 0x22, 0x14, 0x9a, 0x74, 0xe4, 0x75, 0x19, 0x2f, 0x15, 0x5a, 0x5a,
-	0x39, 0x11, 0x2f, 0x2d, 0xb0, 0xea, 0x19, 0xa1, 0xd2, 0xb7, 0x60, 0x5c, 0xf9, 0x06, 0xb9, 0x94,
-	0x3c, 0x2f, 0x75, 0x41, 0x45, 0x4f, 0xb6, 0x0d, 0x1c, 0xc6, 0x28, 0x93, 0xbe, 0xcb, 0xd1, 0xe7,
-	0xe2, 0xbb, 0x5c, 0x82, 0xa9, 0x20, 0xf4, 0x7c, 0x5a, 0x57, 0x14, 0x6b, 0x4b, 0x65, 0x12, 0x6b,
-	0xe8, 0x54, 0x35, 0x81, 0xc7, 0x9e, 0x12, 0x64, 0x0b, 0x2e, 0x1c, 0x24, 0x42, 0xd1, 0xbc, 0xf1,
-	0xe7, 0x39, 0xa7, 0xab, 0x92, 0xd3, 0x85, 0x07, 0x29, 0x34, 0x98, 0x5a, 0x92, 0xbc, 0x0d, 0x13,
-	0xaa, 0x9a, 0xfc, 0xa8, 0x2c, 0x5f, 0xe0, 0xac, 0xb4, 0x2d, 0xb3, 0x6d, 0x22, 0x31, 0x4e, 0x4b,
-	0x7e, 0x19, 0xf2, 0x9d, 0xa6, 0x1d, 0x50, 0xe9, 0xea, 0x54, 0x7e, 0xa4, 0xfc, 0x16, 0x03, 0x3e,
-	0x3e, 0x9a, 0x2d, 0xb2, 0x31, 0xe3, 0x1f, 0x28, 0x08, 0xc9, 0x4d, 0x80, 0x1d, 0xaf, 0xeb, 0xd6,
-	0x6d, 0xff, 0x70, 0x6d, 0x49, 0x46, 0x3a, 0xb4, 0x0e, 0x53, 0xd1, 0x18, 0x34, 0xa8, 0xcc, 0xa0,
-	0x75, 0xf1, 0xc9, 0x41, 0x6b, 0xf2, 0x10, 0x8a, 0x3c, 0x2a, 0x44, 0xeb, 0x0b, 0xa1, 0x74, 0x5b,
-	0x9e, 0x26, 0x80, 0xa0, 0x4f, 0xe6, 0xaa, 0x62, 0x82, 0x11, 0x3f, 0xf2, 0x09, 0xc0, 0xae, 0xe3,
-	0x3a, 0x41, 0x93, 0x73, 0x2f, 0x9d, 0x9a, 0xbb, 0x6e, 0xe7, 0x8a, 0xe6, 0x82, 0x06, 0x47, 0xf2,
-	0x31, 0x4c, 0xd3, 0x20, 0x74, 0xda, 0x76, 0x48, 0xeb, 0x3a, 0x6d, 0xa5, 0xcc, 0x03, 0x61, 0x3a,
-	0x2e, 0xb7, 0x9c, 0x24, 0x78, 0x9c, 0x06, 0xc4, 0x5e, 0x46, 0xe4, 0x2d, 0x28, 0x74, 0x7c, 0xaf,
-	0xc1, 0x0c, 0xcb, 0xf2, 0x4c, 0x6c, 0xba, 0x14, 0xb6, 0x24, 0xfc, 0xb1, 0xf1, 0x1b, 0x35, 0x35,
-	0xf9, 0x6f, 0x39, 0x98, 0xf6, 0x69, 0xe0, 0x75, 0xfd, 0x1a, 0x0d, 0x74, 0xc5, 0x2e, 0xf2, 0x4d,
-	0xe9, 0x7e, 0xc6, 0x84, 0x73, 0xb5, 0xd3, 0xcc, 0x61, 0x92, 0xb1, 0x38, 0x65, 0xa9, 0x6a, 0x70,
-	0x0f, 0xfe, 0x71, 0x1a, 0xf0, 0x9b, 0xdf, 0x9f, 0x9d, 0xed, 0xbd, 0xe3, 0xa0, 0x99, 0xb3, 0x99,
-	0xfe, 0xd7, 0xbe, 0x3f, 0x3b, 0xa5, 0xbe, 0xa3, 0x7e, 0xea, 0x69, 0x17, 0x3b, 0x42, 0x3a, 0x5e,
-	0x7d, 0x6d, 0x4b, 0xfa, 0x97, 0xf5, 0x11, 0xb2, 0xc5, 0x80, 0x28, 0x70, 0xe4, 0x35, 0x28, 0xd4,
-	0x6d, 0xda, 0xf6, 0x5c, 0x5a, 0x2f, 0x4f, 0x44, 0xae, 0xb7, 0x25, 0x09, 0x43, 0x8d, 0x25, 0x9f,
-	0xc2, 0xa8, 0xc3, 0xd5, 0xff, 0xf2, 0x39, 0x3e, 0x61, 0xb2, 0x99, 0x19, 0xc2, 0x82, 0x10, 0x69,
-	0x4e, 0xe2, 0x37, 0x4a, 0xb6, 0xa4, 0x06, 0x63, 0x5e, 0x37, 0xe4, 0x12, 0x26, 0xb9, 0x84, 0x6c,
-	0x0e, 0xeb, 0x4d, 0xc1, 0x43, 0x64, 0x3d, 0xcb, 0x0f, 0x54, 0x9c, 0x59, 0x7b, 0x6b, 0x4d, 0xa7,
-	0x55, 0xf7, 0xa9, 0x5b, 0x9e, 0xe2, 0x3e, 0x0b, 0xde, 0xde, 0x45, 0x09, 0x43, 0x8d, 0x25, 0x7f,
-	0x16, 0x26, 0xbc, 0x6e, 0xc8, 0x57, 0x2f, 0x1b, 0xe5, 0xa0, 0x3c, 0xcd, 0xc9, 0xa7, 0x79, 0x3a,
-	0x86, 0x89, 0xc0, 0x38, 0x1d, 0xdb, 0xcf, 0x9b, 0x5e, 0x10, 0xb2, 0x0f, 0xbe, 0xa5, 0x5d, 0x8a,
-	0xef, 0xe7, 0xb7, 0x0d, 0x1c, 0xc6, 0x28, 0xc9, 0xef, 0xe4, 0x60, 0xba, 0x9d, 0x54, 0xdb, 0xcb,
-	0x97, 0x79, 0x67, 0xac, 0x64, 0x54, 0xfc, 0x12, 0xdc, 0x44, 0x68, 0xb1, 0x07, 0x8c, 0xbd, 0x72,
-	0x79, 0xa6, 0x66, 0x70, 0xe8, 0xd6, 0x9a, 0xbe, 0xe7, 0xc6, 0x6b, 0xf4, 0x22, 0xaf, 0xd1, 0x46,
-	0xf6, 0x15, 0x93, 0xc6, 0xb5, 0xf2, 0xe2, 0xf1, 0xd1, 0xec, 0xc5, 0x54, 0x14, 0xa6, 0xd7, 0x63,
-	0x66, 0x09, 0x2e, 0xa5, 0xaf, 0xba, 0xa7, 0x29, 0x9d, 0xc3, 0xa6, 0xd2, 0xb9, 0x02, 0x2f, 0xf6,
-	0xad, 0x14, 0xdb, 0xb2, 0x95, 0xf2, 0x92, 0x8b, 0x6f, 0xd9, 0x3d, 0x9a, 0xc7, 0x39, 0x18, 0x37,
-	0xef, 0x9f, 0xf0, 0xe0, 0x82, 0x91, 0xf7, 0x4b, 0x3c, 0x28, 0x7a, 0xd5, 0xb3, 0x08, 0x2e, 0x6c,
-	0x56, 0x7b, 0x82, 0x0b, 0x1a, 0x84, 0x91, 0x8c, 0xa7, 0x05, 0x17, 0xfe, 0xc5, 0x10, 0x44, 0xe5,
-	0xc8, 0xeb, 0x50, 0xa0, 0x6e, 0xbd, 0xe3, 0x39, 0x6e, 0x98, 0x0c, 0xcb, 0x2c, 0x4b, 0x38, 0x6a,
-	0x0a, 0x23, 0x14, 0x31, 0xf4, 0xc4, 0x50, 0x44, 0x13, 0x26, 0x6d, 0x9e, 0x7e, 0x10, 0xf9, 0x90,
-	0x87, 0x4f, 0xe5, 0x43, 0xd6, 0xe9, 0xa8, 0x71, 0x2e, 0x98, 0x64, 0xcb, 0x24, 0x05, 0x51, 0x71,
-	0x2e, 0x69, 0x24, 0x93, 0xa4, 0x6a, 0x9c, 0x0b, 0x26, 0xd9, 0x5a, 0xff, 0x6a, 0x08, 0xd4, 0xbe,
-	0xf2, 0xb3, 0xe0, 0x09, 0x21, 0x16, 0x8c, 0xfa, 0x34, 0x50, 0xd9, 0xcd, 0x45, 0xb1, 0x77, 0x23,
-	0x87, 0xa0, 0xc4, 0xb0, 0x6d, 0x95, 0x7e, 0xee, 0x84, 0x8b, 0x5e, 0x5d, 0x69, 0xbd, 0x7c, 0x5b,
-	0x5d, 0x96, 0x30, 0xd4, 0x58, 0xeb, 0x00, 0x26, 0x58, 0xbb, 0x5a, 0x2d, 0xda, 0xaa, 0x86, 0xb4,
-	0x13, 0x90, 0x5d, 0xc8, 0x07, 0xec, 0xc7, 0x40, 0xa6, 0x48, 0x94, 0xd3, 0x41, 0x3b, 0x86, 0xcb,
-	0x84, 0xf1, 0x45, 0xc1, 0xde, 0xfa, 0x2f, 0x43, 0x50, 0xd4, 0x3d, 0x7a, 0x02, 0x3f, 0xcc, 0xcd,
-	0x28, 0xab, 0x5b, 0xcc, 0xf1, 0xb2, 0x91, 0xd1, 0xcd, 0x54, 0xc2, 0x05, 0xf7, 0x50, 0x24, 0xed,
-	0xea, 0xf4, 0x6e, 0xf2, 0x7a, 0xdc, 0x61, 0x77, 0xc9, 0x74, 0x16, 0x19, 0xf4, 0xd2, 0x73, 0xb7,
-	0x07, 0x45, 0xfe, 0x63, 0x45, 0xdd, 0x6b, 0xca, 0x3a, 0x77, 0xee, 0x2b, 0x2e, 0xc2, 0x01, 0xaf,
-	0x3f, 0x31, 0xe2, 0x9f, 0xb8, 0x8f, 0x94, 0x3f, 0xd1, 0x7d, 0xa4, 0x1b, 0x30, 0x42, 0xdd, 0x6e,
-	0x9b, 0xe7, 0x1a, 0x14, 0xf9, 0xc9, 0x31, 0xb2, 0xec, 0x76, 0xdb, 0xf1, 0xc6, 0x70, 0x12, 0x6b,
-	0x05, 0x98, 0x5e, 0xb1, 0xba, 0x48, 0xde, 0x81, 0x42, 0x20, 0x77, 0x40, 0xd9, 0xb9, 0xbf, 0xa0,
-	0xc3, 0xbb, 0x12, 0xfe, 0xf8, 0x68, 0x76, 0x82, 0x13, 0x2b, 0x00, 0xea, 0x22, 0xd6, 0x6f, 0x8d,
-	0x80, 0x61, 0x4d, 0x9f, 0x60, 0x98, 0xea, 0x09, 0x07, 0xc9, 0xfb, 0x59, 0x1d, 0x24, 0xca, 0xeb,
-	0x20, 0xe6, 0x77, 0xdc, 0x27, 0xc2, 0xea, 0xd1, 0xa4, 0xad, 0x8e, 0x1c, 0x57, 0x5d, 0x8f, 0xdb,
-	0xb4, 0xd5, 0x41, 0x8e, 0xd1, 0xa9, 0x08, 0x23, 0x7d, 0x53, 0x11, 0x1e, 0x42, 0xbe, 0x61, 0x77,
-	0x1b, 0x54, 0x3a, 0xe1, 0xb3, 0x39, 0xb9, 0x78, 0x54, 0x55, 0x38, 0xb9, 0xf8, 0x4f, 0x14, 0x3c,
-	0xd9, 0x5c, 0x6a, 0x2a, 0xbf, 0xb1, 0x34, 0x04, 0xb3, 0xcd, 0x25, 0xed, 0x7d, 0x16, 0x73, 0x49,
-	0x7f, 0x62, 0xc4, 0x9f, 0x69, 0x6a, 0x35, 0x91, 0xf6, 0x2a, 0x23, 0x82, 0xbf, 0x96, 0x31, 0xa3,
-	0x82, 0xf3, 0x10, 0x9a, 0x9a, 0xfc, 0x40, 0xc5, 0xd9, 0x9a, 0x87, 0x92, 0x71, 0x25, 0x87, 0xf5,
-	0xaf, 0x4e, 0xbf, 0x34, 0xfa, 0x77, 0xc9, 0x0e, 0x6d, 0xe4, 0x18, 0xeb, 0x0f, 0x86, 0x41, 0xeb,
-	0xc5, 0x66, 0xae, 0x84, 0x5d, 0x33, 0xb2, 0xf7, 0x63, 0x89, 0x5b, 0x9e, 0x8b, 0x12, 0xcb, 0xac,
-	0xc7, 0x36, 0xf5, 0x1b, 0xfa, 0xf4, 0x96, 0x6b, 0x5e, 0x5b, 0x8f, 0xeb, 0x26, 0x12, 0xe3, 0xb4,
-	0xec, 0xec, 0x6c, 0xdb, 0xae, 0xb3, 0x4b, 0x83, 0x30, 0x19, 0xdc, 0x5a, 0x97, 0x70, 0xd4, 0x14,
-	0x64, 0x15, 0xa6, 0x03, 0x1a, 0x6e, 0x1e, 0xb8, 0xd4, 0xd7, 0x09, 0x65, 0x32, 0xc3, 0xf0, 0x45,
-	0x65, 0x2c, 0x54, 0x93, 0x04, 0xd8, 0x5b, 0x86, 0x5b, 0xe2, 0x22, 0xb9, 0x4f, 0x27, 0x6a, 0xc9,
-	0x85, 0x1d, 0x59, 0xe2, 0x09, 0x3c, 0xf6, 0x94, 0x60, 0x5c, 0x76, 0x6d, 0xa7, 0xd5, 0xf5, 0x69,
-	0xc4, 0x65, 0x34, 0xce, 0x65, 0x25, 0x81, 0xc7, 0x9e, 0x12, 0x3c, 0x2e, 0xde, 0xb2, 0x1b, 0x41,
-	0x79, 0xcc, 0x88, 0x8b, 0x33, 0x00, 0x0a, 0xb8, 0xf5, 0xf7, 0x73, 0x30, 0x81, 0x34, 0xf4, 0x0f,
-	0x17, 0x76, 0x99, 0xa5, 0x18, 0x1e, 0x92, 0xdf, 0xcd, 0xc1, 0x94, 0xeb, 0xd5, 0xe9, 0x82, 0x1b,
-	0x3a, 0x0a, 0x38, 0xd0, 0x25, 0x20, 0xce, 0x7e, 0x23, 0xc1, 0x51, 0xa4, 0x06, 0x26, 0xa1, 0xd8,
-	0x23, 0xd9, 0xba, 0x0c, 0x17, 0x53, 0x19, 0x58, 0x7f, 0x65, 0x58, 0xd6, 0x5c, 0x8f, 0xf7, 0x87,
-	0x90, 0x6f, 0xf1, 0x34, 0xc9, 0x5c, 0xc6, 0x5b, 0x1e, 0xbc, 0x7b, 0x44, 0x1e, 0xa5, 0xe0, 0x44,
-	0x96, 0xa0, 0xe4, 0x33, 0x19, 0x32, 0x89, 0x55, 0xcc, 0x3e, 0x2b, 0xba, 0x34, 0xa9, 0x51, 0x8f,
-	0xe3, 0x9f, 0x68, 0x16, 0x23, 0x2e, 0x8c, 0xed, 0x88, 0x8b, 0x2b, 0x52, 0xcd, 0xca, 0xb6, 0x30,
-	0xe5, 0xe5, 0x17, 0x7e, 0x7e, 0xa9, 0x9b, 0x30, 0x8f, 0xa3, 0x9f, 0xa8, 0x84, 0x90, 0x16, 0x14,
-	0x6c, 0x35, 0x72, 0x23, 0x03, 0x84, 0x9f, 0x63, 0x13, 0x43, 0xa8, 0x0e, 0x7a, 0xa4, 0xb4, 0x04,
-	0xeb, 0x5b, 0x39, 0x80, 0xe8, 0x6e, 0x26, 0xd9, 0x83, 0x42, 0x70, 0x2b, 0xa6, 0x4e, 0x67, 0xcc,
-	0x36, 0x93, 0x4c, 0x8c, 0x3c, 0x24, 0x09, 0x41, 0x2d, 0xe0, 0x69, 0xba, 0xf4, 0x5f, 0xcf, 0x83,
-	0x2e, 0xf5, 0x8c, 0x54, 0xe9, 0x57, 0x99, 0x1a, 0xd6, 0x88, 0x2e, 0x00, 0x69, 0x3a, 0xe4, 0x50,
-	0x94, 0x58, 0xa6, 0x8a, 0xa9, 0x64, 0x08, 0xb9, 0xab, 0xf0, 0xfe, 0x54, 0x79, 0x13, 0xa8, 0xb1,
-	0x69, 0xca, 0x79, 0xfe, 0xb9, 0x29, 0xe7, 0xa3, 0xcf, 0x44, 0x39, 0x67, 0xf6, 0x9a, 0xef, 0xb5,
-	0xe8, 0x02, 0x6e, 0x48, 0x57, 0x9e, 0xb6, 0xd7, 0x50, 0x80, 0x51, 0xe1, 0xc9, 0x9b, 0x50, 0xea,
-	0x06, 0xb4, 0xba, 0x74, 0x67, 0xd1, 0xa7, 0xf5, 0x40, 0xe6, 0x99, 0x68, 0xe7, 0xee, 0xbd, 0x08,
-	0x85, 0x26, 0x1d, 0xf9, 0xc7, 0x39, 0x28, 0xd7, 0xf8, 0x25, 0x0e, 0x31, 0x40, 0x6b, 0xbb, 0x1b,
-	0x5e, 0xb8, 0xe5, 0xd3, 0x80, 0xba, 0xa1, 0xcc, 0x5f, 0xfe, 0x20, 0x63, 0x06, 0x7f, 0xca, 0xcd,
-	0x90, 0xca, 0xd5, 0xe3, 0xa3, 0xd9, 0xf2, 0x62, 0x1f, 0x79, 0xd8, 0xb7, 0x26, 0xd6, 0x5f, 0xcd,
-	0xc1, 0xb9, 0x6a, 0xcd, 0x77, 0x3a, 0xa1, 0x3e, 0x0b, 0x37, 0xf8, 0x3d, 0xb0, 0xd0, 0x66, 0xfb,
-	0x93, 0x5c, 0x31, 0x2f, 0xf5, 0xc9, 0x04, 0x10, 0x44, 0xb1, 0x3b, 0xa1, 0x02, 0x84, 0x11, 0x0b,
-	0x36, 0x23, 0xc5, 0x69, 0x9b, 0x9c, 0xb9, 0x55, 0x0e, 0x45, 0x89, 0xb5, 0x1e, 0xc1, 0x54, 0x95,
-	0xb6, 0xed, 0x4e, 0x93, 0x67, 0xe6, 0x88, 0xa0, 0xc0, 0x3c, 0x14, 0x03, 0x05, 0x4b, 0x5e, 0x40,
-	0xd5, 0xc4, 0x18, 0xd1, 0x90, 0x57, 0x44, 0xcc, 0x42, 0x85, 0xf4, 0x8b, 0x42, 0x6b, 0x10, 0x81,
-	0x8e, 0x00, 0x15, 0xce, 0x3a, 0x80, 0xf1, 0xa8, 0x38, 0xdd, 0x25, 0x0d, 0x98, 0xac, 0x19, 0x89,
-	0x0d, 0xd1, 0x3d, 0xd3, 0x93, 0xe7, 0x40, 0xf0, 0xa4, 0x8e, 0xc5, 0x38, 0x13, 0x4c, 0x72, 0xb5,
-	0xfe, 0x57, 0x0e, 0x26, 0xb5, 0x64, 0xe9, 0x3c, 0xe8, 0x24, 0xe3, 0x2c, 0xcb, 0x19, 0xd3, 0x61,
-	0xe3, 0x9d, 0xf7, 0x84, 0x58, 0x4b, 0x27, 0x19, 0x6b, 0x39, 0x6b, 0x89, 0x3d, 0x5e, 0x8f, 0x3f,
-	0x1c, 0x82, 0x82, 0xce, 0xc7, 0xfd, 0x10, 0xf2, 0x5c, 0x7d, 0x1b, 0xec, 0x60, 0xe4, 0xaa, 0x20,
-	0x0a, 0x4e, 0x8c, 0x25, 0x77, 0x5c, 0x67, 0xbe, 0x51, 0x59, 0x14, 0x96, 0xa0, 0xed, 0x87, 0x28,
-	0x38, 0x91, 0x3b, 0x30, 0x4c, 0xdd, 0xba, 0x3c, 0x21, 0x4f, 0xcf, 0x90, 0x5f, 0xb1, 0x5e, 0x76,
-	0xeb, 0xc8, 0xb8, 0xf0, 0xab, 0x62, 0x9e, 0xdf, 0xb6, 0x43, 0xa9, 0xf9, 0x47, 0x57, 0xc5, 0x38,
-	0x14, 0x25, 0xd6, 0xfa, 0x9f, 0x43, 0x30, 0x5a, 0xed, 0xee, 0xb0, 0xb3, 0xfe, 0xef, 0xe4, 0xe0,
-	0x7c, 0x32, 0x84, 0x11, 0x4d, 0xcc, 0xdb, 0x67, 0x72, 0x95, 0x11, 0xe9, 0x6e, 0xe5, 0x8a, 0xac,
-	0xca, 0xf9, 0x14, 0x24, 0xa6, 0xd5, 0x20, 0x76, 0x71, 0x6c, 0xf8, 0x19, 0x5d, 0xdb, 0x34, 0xf2,
-	0xfb, 0x87, 0xce, 0x24, 0xbf, 0x7f, 0xa2, 0x5f, 0x6e, 0xbf, 0xf5, 0x6f, 0x47, 0x00, 0x44, 0x9f,
-	0x6f, 0x76, 0xc2, 0x93, 0x18, 0x93, 0x6f, 0xc1, 0xb8, 0x7a, 0x4e, 0x67, 0x23, 0x8a, 0x0c, 0x6a,
-	0xd7, 0xed, 0xaa, 0x81, 0xc3, 0x18, 0x25, 0x33, 0xaf, 0xa9, 0x1b, 0xfa, 0x87, 0xe2, 0xd4, 0x1f,
-	0x89, 0x9b, 0xd7, 0xcb, 0x1a, 0x83, 0x06, 0x15, 0x99, 0x8b, 0x39, 0x8f, 0xc4, 0x1d, 0x80, 0x73,
-	0x4f, 0x70, 0xfc, 0xbc, 0x0d, 0x13, 0xfa, 0x6b, 0xc5, 0x69, 0xa9, 0xb4, 0x2a, 0x6d, 0xa3, 0x6c,
-	0x99, 0x48, 0x8c, 0xd3, 0x92, 0x77, 0xe1, 0x5c, 0x3c, 0x59, 0x57, 0x9e, 0x8f, 0x97, 0x64, 0xe9,
-	0x73, 0xf1, 0x1c, 0x5f, 0x4c, 0x50, 0xb3, 0x79, 0x5e, 0xf7, 0x0f, 0xb1, 0xeb, 0xca, 0x83, 0x52,
-	0xcf, 0xf3, 0x25, 0x0e, 0x45, 0x89, 0x65, 0x5d, 0xc8, 0x4a, 0x52, 0x5f, 0xc0, 0xf9, 0x89, 0x58,
-	0x88, 0xba, 0xb0, 0x6a, 0xe0, 0x30, 0x46, 0xc9, 0x24, 0x48, 0x4b, 0x1e, 0xe2, 0x2b, 0x29, 0x61,
-	0x8b, 0x77, 0xe0, 0x9c, 0x17, 0x37, 0x9e, 0x44, 0x04, 0xeb, 0x1b, 0x27, 0x9c, 0xaa, 0xb1, 0xb2,
-	0x22, 0x1b, 0x36, 0x61, 0x6b, 0x25, 0xf8, 0x5b, 0xe7, 0x61, 0xba, 0xda, 0xed, 0x74, 0x5a, 0x0e,
-	0xad, 0x6b, 0xdf, 0x8a, 0xf5, 0x1e, 0x4c, 0xca, 0x7b, 0x60, 0xfa, 0x80, 0x3d, 0xd5, 0x65, 0x71,
-	0xeb, 0x88, 0x9d, 0x18, 0x71, 0xa7, 0x33, 0x71, 0x93, 0xc7, 0x62, 0x56, 0x87, 0x98, 0x79, 0x08,
-	0x8a, 0x15, 0x92, 0x7a, 0xaa, 0x3e, 0x54, 0x69, 0x06, 0x83, 0x24, 0xde, 0xf0, 0xc8, 0xbc, 0xd8,
-	0x67, 0xcd, 0xf4, 0x04, 0xeb, 0xc7, 0x39, 0x48, 0xf7, 0xe7, 0x93, 0xcf, 0x7a, 0x9b, 0xb9, 0x34,
-	0x58, 0x33, 0x65, 0x0c, 0xa1, 0x7f, 0x4b, 0xed, 0x78, 0x4b, 0xdf, 0xcf, 0xde, 0x52, 0x29, 0xaa,
-	0xb7, 0xbd, 0xff, 0x3b, 0x07, 0xa5, 0xed, 0xed, 0xbb, 0xda, 0x4c, 0x44, 0xb8, 0x14, 0x88, 0x9b,
-	0x7c, 0x0b, 0xbb, 0x21, 0xf5, 0x17, 0xbd, 0x76, 0xa7, 0x45, 0xf5, 0xe4, 0x90, 0xd7, 0xeb, 0xaa,
-	0xa9, 0x14, 0xd8, 0xa7, 0x24, 0x59, 0x83, 0xf3, 0x26, 0x46, 0xda, 0xf7, 0xbc, 0x51, 0x79, 0x99,
-	0x71, 0xdd, 0x8b, 0xc6, 0xb4, 0x32, 0x49, 0x56, 0xd2, 0xc8, 0x97, 0xcf, 0x2f, 0xf5, 0xb0, 0x92,
-	0x68, 0x4c, 0x2b, 0x63, 0x6d, 0x42, 0xc9, 0x78, 0xd8, 0x8b, 0xbc, 0x0f, 0x53, 0x35, 0xaf, 0xdd,
-	0xf1, 0x69, 0x10, 0x38, 0x9e, 0x7b, 0x97, 0xee, 0xd3, 0x96, 0x6c, 0x32, 0x37, 0xc6, 0x17, 0x13,
-	0x38, 0xec, 0xa1, 0xb6, 0xfe, 0xf9, 0x15, 0xd0, 0xb7, 0xc3, 0x7e, 0x7e, 0xc7, 0x2c, 0x53, 0x9e,
-	0x46, 0x4d, 0xc7, 0x6b, 0xf3, 0x83, 0xc7, 0x6b, 0xf5, 0x5e, 0x9c, 0x88, 0xd9, 0x36, 0xa2, 0x98,
-	0xed, 0xe8, 0x19, 0xc4, 0x6c, 0xb5, 0x9a, 0xd9, 0x13, 0xb7, 0xfd, 0xed, 0x1c, 0x8c, 0xbb, 0x5e,
-	0x9d, 0x2a, 0xad, 0x9c, 0xfb, 0x99, 0x4a, 0x37, 0x37, 0x07, 0xea, 0x44, 0x11, 0x8c, 0x94, 0x1c,
-	0x45, 0xb8, 0x5e, 0x1f, 0x54, 0x26, 0x0a, 0x63, 0xa2, 0xc9, 0x8a, 0xe1, 0xf5, 0x10, 0xd7, 0xdc,
-	0xae, 0xa6, 0x19, 0x13, 0x4f, 0xf3, 0x67, 0x90, 0x3d, 0x43, 0xdb, 0x2a, 0x0e, 0xe0, 0xc0, 0x50,
-	0xd9, 0x7d, 0x86, 0xd7, 0x51, 0xdd, 0x80, 0x8d, 0x14, 0x2f, 0x0b, 0x46, 0x45, 0x28, 0x5f, 0x3e,
-	0xc8, 0xc5, 0xbd, 0xdc, 0x22, 0xcc, 0x8f, 0x12, 0x43, 0x1a, 0x2a, 0x14, 0x53, 0xe2, 0x9d, 0x5b,
-	0xc9, 0x1c, 0xc8, 0xd2, 0xd1, 0x9d, 0xf4, 0x58, 0x0c, 0xf9, 0xc0, 0xb4, 0x44, 0xc7, 0x4f, 0x62,
-	0x89, 0x4e, 0xf4, 0xb5, 0x42, 0x1b, 0x30, 0x1a, 0x70, 0x3b, 0x97, 0xe7, 0x2f, 0x94, 0x6e, 0x2e,
-	0x66, 0x3b, 0x48, 0x62, 0xa6, 0xb2, 0xe8, 0x1d, 0x01, 0x43, 0xc9, 0x9e, 0x78, 0x50, 0x50, 0x49,
-	0x16, 0x32, 0x05, 0x62, 0x39, 0xa3, 0xb3, 0x2b, 0xee, 0xa3, 0x56, 0x97, 0x9d, 0x04, 0x14, 0xb5,
-	0x10, 0xf2, 0x10, 0x86, 0xeb, 0x76, 0x43, 0x26, 0x43, 0xbc, 0x9f, 0xf9, 0xf6, 0x9e, 0x12, 0xc3,
-	0xed, 0x96, 0xa5, 0x85, 0x55, 0x64, 0x5c, 0xc9, 0x5e, 0x74, 0xbb, 0x7d, 0x6a, 0x90, 0x03, 0x38,
-	0xae, 0x02, 0x09, 0xab, 0xbc, 0xe7, 0x7e, 0xfc, 0x32, 0x8c, 0xed, 0x7b, 0xad, 0x6e, 0x5b, 0x66,
-	0x51, 0x94, 0x6e, 0xce, 0xa4, 0x8d, 0xf6, 0x7d, 0x4e, 0x12, 0x6d, 0x02, 0xe2, 0x3b, 0x40, 0x55,
-	0x96, 0x7c, 0x33, 0x07, 0xe7, 0xd8, 0xd2, 0xd1, 0xf3, 0x20, 0x28, 0x93, 0x01, 0x66, 0xea, 0xbd,
-	0x80, 0x1d, 0xad, 0x6a, 0x86, 0x69, 0x45, 0x78, 0x2d, 0x26, 0x01, 0x13, 0x12, 0x49, 0x07, 0x0a,
-	0x81, 0x53, 0xa7, 0x35, 0xdb, 0x0f, 0xca, 0xe7, 0xcf, 0x4c, 0x7a, 0xe4, 0x7b, 0x94, 0xbc, 0x51,
-	0x4b, 0x21, 0x7f, 0x99, 0xbf, 0xf9, 0x24, 0x5f, 0xb8, 0x93, 0xef, 0x1a, 0x5e, 0x38, 0xcb, 0x77,
-	0x0d, 0xcf, 0x8b, 0x07, 0x9f, 0x62, 0x12, 0x30, 0x29, 0x92, 0xfc, 0x46, 0x0e, 0x2e, 0x8a, 0x6b,
-	0xee, 0xc9, 0x37, 0x0e, 0x2e, 0x66, 0xb4, 0xa4, 0x79, 0xc6, 0xc7, 0x42, 0x1a, 0x4b, 0x4c, 0x97,
-	0x44, 0xbe, 0x84, 0x09, 0xdf, 0x74, 0xc5, 0xf3, 0xe4, 0x9a, 0x81, 0xbc, 0xce, 0xfa, 0x95, 0x44,
-	0x9e, 0xd8, 0x13, 0x03, 0x61, 0x5c, 0x16, 0x79, 0x03, 0x4a, 0x1d, 0xb9, 0xb9, 0x39, 0x41, 0x9b,
-	0xe7, 0xe5, 0x0c, 0x8b, 0x43, 0x78, 0x2b, 0x02, 0xa3, 0x49, 0x43, 0xee, 0x41, 0x29, 0xf4, 0x5a,
-	0xd4, 0x97, 0x59, 0xe4, 0x65, 0x3e, 0x5f, 0xae, 0xa5, 0x4d, 0xfe, 0x6d, 0x4d, 0x16, 0xf9, 0x20,
-	0x23, 0x58, 0x80, 0x26, 0x1f, 0x66, 0x09, 0xaa, 0x47, 0x30, 0x7c, 0x6e, 0xa8, 0xbe, 0x18, 0xb7,
-	0x04, 0xab, 0x26, 0x12, 0xe3, 0xb4, 0x64, 0x15, 0xa6, 0x3b, 0xbe, 0xe3, 0xf9, 0x4e, 0x78, 0xb8,
-	0xd8, 0xb2, 0x83, 0x80, 0x33, 0x10, 0x89, 0x74, 0x3a, 0xfe, 0xb4, 0x95, 0x24, 0xc0, 0xde, 0x32,
-	0xe4, 0x35, 0x28, 0x28, 0x60, 0xf9, 0x0a, 0x57, 0xef, 0xc6, 0x45, 0x12, 0x9e, 0x80, 0xa1, 0xc6,
-	0xf6, 0xb9, 0xb3, 0x7b, 0x35, 0xcb, 0x9d, 0x5d, 0x52, 0x87, 0xab, 0x76, 0x37, 0xf4, 0xf8, 0x75,
-	0x95, 0x78, 0x91, 0x6d, 0x6f, 0x8f, 0xba, 0xe5, 0xeb, 0xfc, 0x78, 0xbb, 0x7e, 0x7c, 0x34, 0x7b,
-	0x75, 0xe1, 0x09, 0x74, 0xf8, 0x44, 0x2e, 0xa4, 0x0d, 0x05, 0x2a, 0xef, 0x1d, 0x97, 0x7f, 0x61,
-	0x80, 0x73, 0x25, 0x7e, 0x79, 0x59, 0x65, 0x45, 0x08, 0x18, 0x6a, 0x11, 0x64, 0x1b, 0x4a, 0x4d,
-	0x2f, 0x08, 0x17, 0x5a, 0x8e, 0x1d, 0xd0, 0xa0, 0xfc, 0x12, 0x9f, 0x27, 0xa9, 0x47, 0xe2, 0x6d,
-	0x45, 0x16, 0x4d, 0x93, 0xdb, 0x51, 0x49, 0x34, 0xd9, 0x10, 0xca, 0xdd, 0xee, 0x5d, 0x3e, 0x6a,
-	0x9e, 0x1b, 0xd2, 0xcf, 0xc3, 0xf2, 0x35, 0xde, 0x96, 0x57, 0xd3, 0x38, 0x6f, 0x79, 0xf5, 0x6a,
-	0x9c, 0x5a, 0x6c, 0x0c, 0x09, 0x20, 0x26, 0x79, 0x32, 0x93, 0xbf, 0xe3, 0xd5, 0xab, 0x1d, 0x5a,
-	0xdb, 0xb2, 0xc3, 0x5a, 0xb3, 0x3c, 0x1b, 0xf7, 0x9a, 0x6c, 0x19, 0x38, 0x8c, 0x51, 0x92, 0x1a,
-	0x8c, 0xb5, 0x45, 0x72, 0x7e, 0xf9, 0xe5, 0x01, 0xd4, 0x47, 0x99, 0xe0, 0x2f, 0x0e, 0x1f, 0xf9,
-	0x81, 0x8a, 0x33, 0xf9, 0xdb, 0x39, 0x98, 0x4c, 0xe4, 0x8f, 0x95, 0x7f, 0x71, 0x90, 0x23, 0x2f,
-	0xce, 0xab, 0xf2, 0x2a, 0xef, 0xa4, 0x38, 0xf0, 0x71, 0x2f, 0x08, 0x93, 0x95, 0x10, 0xad, 0xe7,
-	0xf7, 0x63, 0xca, 0xaf, 0x0c, 0xd4, 0x7a, 0xce, 0x43, 0xb5, 0x9e, 0x7f, 0xa0, 0xe2, 0x4c, 0x6e,
-	0xc0, 0x58, 0xe8, 0xb4, 0xa9, 0xd7, 0x0d, 0xcb, 0xaf, 0xc6, 0x03, 0x22, 0xdb, 0x02, 0x8c, 0x0a,
-	0x3f, 0xf3, 0x1e, 0x4c, 0xf7, 0x28, 0xc4, 0xa7, 0xba, 0xbe, 0xf1, 0x03, 0x66, 0x00, 0x1b, 0x26,
-	0xc8, 0x59, 0x1b, 0x6e, 0xab, 0x30, 0x2d, 0x5f, 0xcc, 0x66, 0xda, 0x52, 0xab, 0xab, 0x9f, 0xc3,
-	0x33, 0x22, 0xe7, 0x98, 0x24, 0xc0, 0xde, 0x32, 0x6c, 0xc6, 0xd6, 0xc4, 0x7b, 0x68, 0x22, 0x55,
-	0x7c, 0x24, 0xee, 0xa4, 0x5a, 0x34, 0x70, 0x18, 0xa3, 0xb4, 0xfe, 0x49, 0x0e, 0x26, 0x62, 0x27,
-	0xf7, 0x99, 0x47, 0x55, 0x56, 0x80, 0xb4, 0x1d, 0xdf, 0xf7, 0x7c, 0xa1, 0xfe, 0xac, 0xb3, 0x3d,
-	0x29, 0x90, 0xd7, 0xe2, 0xf9, 0x75, 0xcc, 0xf5, 0x1e, 0x2c, 0xa6, 0x94, 0xb0, 0x7e, 0x73, 0x18,
-	0xa2, 0x4c, 0x20, 0x7d, 0x07, 0x39, 0xd7, 0xf7, 0x0e, 0xf2, 0xeb, 0x50, 0x78, 0x14, 0x78, 0xee,
-	0x56, 0x74, 0x53, 0x59, 0x0f, 0xc5, 0x07, 0xd5, 0xcd, 0x0d, 0x4e, 0xa9, 0x29, 0x38, 0xf5, 0x67,
-	0x2b, 0x4e, 0x2b, 0xec, 0xbd, 0xcf, 0xfb, 0xc1, 0x87, 0x02, 0x8e, 0x9a, 0x82, 0x3f, 0xba, 0xb6,
-	0x4f, 0xb5, 0xcf, 0x31, 0x7a, 0x74, 0x8d, 0x01, 0x51, 0xe0, 0xc8, 0x3c, 0x14, 0xb5, 0xcb, 0x52,
-	0x7a, 0x50, 0x75, 0x4f, 0x69, 0xd7, 0x26, 0x46, 0x34, 0x5c, 0x13, 0x93, 0x6e, 0x39, 0x69, 0x7d,
-	0xae, 0x64, 0xd4, 0x61, 0x13, 0xbe, 0x3d, 0xb1, 0x4d, 0x2b, 0x30, 0x6a, 0x29, 0x66, 0x4e, 0x58,
-	0xfe, 0x84, 0x39, 0x61, 0x6c, 0x1c, 0xc6, 0xee, 0x53, 0x9f, 0x3f, 0x2f, 0x70, 0x03, 0xc6, 0xf6,
-	0xc5, 0xcf, 0x64, 0x36, 0xa9, 0xa4, 0x40, 0x85, 0x67, 0xbd, 0xb1, 0xd3, 0x75, 0x5a, 0xf5, 0xa5,
-	0x68, 0x69, 0xe8, 0xde, 0xa8, 0x28, 0x04, 0x46, 0x34, 0xac, 0x40, 0x83, 0x29, 0xaa, 0xed, 0xb6,
-	0x13, 0x26, 0xef, 0xe7, 0xad, 0x2a, 0x04, 0x46, 0x34, 0xe4, 0x55, 0x18, 0x6d, 0x38, 0xe1, 0xb6,
-	0xdd, 0x48, 0x46, 0x2e, 0x56, 0x39, 0x14, 0x25, 0x96, 0x3b, 0xc5, 0x9d, 0x70, 0xdb, 0xa7, 0xdc,
-	0xc9, 0xd6, 0x73, 0x3f, 0x65, 0xd5, 0xc0, 0x61, 0x8c, 0x92, 0x57, 0xc9, 0x93, 0x2d, 0x93, 0xce,
-	0xea, 0xa8, 0x4a, 0x0a, 0x81, 0x11, 0x0d, 0x9b, 0x55, 0x35, 0xaf, 0xdd, 0x71, 0x5a, 0x32, 0xb3,
-	0xc8, 0x98, 0x55, 0x8b, 0x12, 0x8e, 0x9a, 0x82, 0x51, 0xb3, 0x7d, 0x61, 0xd7, 0xf3, 0xdb, 0xc9,
-	0xa7, 0xa6, 0xb6, 0x24, 0x1c, 0x35, 0x85, 0x75, 0x1f, 0x26, 0xc4, 0xfa, 0x58, 0x6c, 0xd9, 0x4e,
-	0x7b, 0x75, 0x91, 0x2c, 0xf7, 0x64, 0xaa, 0xdd, 0x48, 0xc9, 0x54, 0xbb, 0x18, 0x2b, 0x94, 0x92,
-	0xb1, 0xf6, 0xc7, 0x43, 0x50, 0x78, 0x8e, 0x2f, 0xef, 0xd5, 0x62, 0x2f, 0xef, 0x9d, 0xc1, 0x33,
-	0x6d, 0x69, 0xaf, 0xee, 0xed, 0x25, 0x5e, 0xdd, 0x5b, 0x1c, 0x30, 0x29, 0xf3, 0x89, 0x2f, 0xee,
-	0xfd, 0x28, 0x07, 0xfa, 0x9e, 0x0f, 0xdf, 0x10, 0x2a, 0x8e, 0xcb, 0x83, 0x99, 0xcf, 0xbe, 0x33,
-	0xbd, 0x58, 0x67, 0xae, 0x0f, 0xd4, 0x4a, 0xb3, 0xea, 0x7d, 0x1f, 0x12, 0xfd, 0x61, 0x0e, 0xca,
-	0x69, 0x05, 0x9e, 0xc3, 0x2b, 0x83, 0x6e, 0xfc, 0x95, 0xc1, 0xb5, 0x33, 0x6b, 0x6c, 0x9f, 0xd7,
-	0x06, 0xbf, 0xd7, 0xa7, 0xa9, 0xfc, 0x9d, 0xbf, 0x4f, 0xd5, 0x81, 0x90, 0x1b, 0x20, 0xee, 0x20,
-	0xb8, 0xa6, 0x1f, 0x26, 0x9f, 0xc2, 0x68, 0xc0, 0x23, 0x7f, 0x72, 0x6c, 0xdf, 0xce, 0x78, 0x32,
-	0x30, 0x16, 0xd2, 0x1b, 0xc4, 0x7f, 0xa3, 0x64, 0x6b, 0x7d, 0x27, 0x07, 0xe3, 0xcf, 0xf1, 0x8d,
-	0xc8, 0x9d, 0xf8, 0xe8, 0xbd, 0x33, 0xd0, 0xe8, 0xf5, 0x19, 0xb1, 0xdf, 0xbf, 0x02, 0xb1, 0xb7,
-	0x19, 0x89, 0x0b, 0x45, 0xa5, 0x7b, 0xa9, 0xf4, 0xec, 0x77, 0x06, 0x72, 0xb8, 0x46, 0xdb, 0xbf,
-	0x82, 0x04, 0x18, 0x89, 0x48, 0x04, 0x51, 0x87, 0x4e, 0x14, 0x44, 0x7d, 0xee, 0xce, 0xfc, 0x74,
-	0x5b, 0x76, 0xe4, 0x99, 0xd8, 0xb2, 0x57, 0xcf, 0xdc, 0x96, 0x7d, 0xe9, 0xd9, 0xdb, 0xb2, 0x86,
-	0xb3, 0x2f, 0x3f, 0x80, 0xb3, 0xef, 0x4b, 0xb8, 0xb0, 0x1f, 0x1d, 0xbd, 0x7a, 0xbe, 0xc8, 0x87,
-	0xef, 0x6e, 0xa4, 0x5a, 0xb0, 0x4c, 0x8d, 0x08, 0x42, 0xea, 0x86, 0xc6, 0xa1, 0x1d, 0x5d, 0x26,
-	0xbd, 0x9f, 0xc2, 0x0e, 0x53, 0x85, 0x24, 0x5d, 0x3d, 0x63, 0x27, 0x70, 0xf5, 0xfc, 0x41, 0xdf,
-	0x87, 0xed, 0x0b, 0x67, 0xfe, 0xb0, 0xfd, 0x8b, 0xa7, 0x7e, 0xd4, 0xfe, 0x95, 0xc8, 0xdd, 0x2b,
-	0x22, 0xf2, 0xe9, 0x8e, 0xda, 0xdf, 0x4b, 0x86, 0x59, 0x80, 0xf7, 0x76, 0x75, 0x60, 0x35, 0xe3,
-	0x0c, 0x42, 0x2d, 0xa5, 0x01, 0x42, 0x2d, 0x09, 0x3f, 0xdc, 0xf8, 0x19, 0xf9, 0xe1, 0x5c, 0x98,
-	0x72, 0xda, 0x76, 0x83, 0x6e, 0x75, 0x5b, 0x2d, 0x91, 0x80, 0x18, 0x94, 0x27, 0x38, 0xef, 0xd4,
-	0xf4, 0xb2, 0xbb, 0x5e, 0xcd, 0x6e, 0x25, 0x9f, 0x12, 0xd5, 0x59, 0xd6, 0x6b, 0x09, 0x4e, 0xd8,
-	0xc3, 0x9b, 0x4d, 0x4b, 0x7e, 0x61, 0x90, 0x86, 0xac, 0xb7, 0x79, 0x14, 0x42, 0xfe, 0x1d, 0xca,
-	0xed, 0x08, 0x8c, 0x26, 0x0d, 0xb9, 0x03, 0xc5, 0xba, 0x1b, 0xc8, 0xb4, 0xe2, 0x49, 0xbe, 0x4b,
-	0xfd, 0x12, 0xdb, 0xdb, 0x96, 0x36, 0xaa, 0x3a, 0xa1, 0xf8, 0x6a, 0xca, 0x8d, 0x53, 0x8d, 0xc7,
-	0xa8, 0x3c, 0x59, 0xe7, 0xcc, 0xe4, 0x7b, 0x53, 0x22, 0x6c, 0x70, 0xbd, 0x8f, 0x2b, 0x69, 0x69,
-	0x43, 0x3d, 0x8f, 0x35, 0x21, 0xc5, 0xc9, 0x57, 0xa4, 0x22, 0x0e, 0xc6, 0x5b, 0x89, 0xd3, 0x4f,
-	0x7c, 0x2b, 0xf1, 0x1e, 0x5c, 0x0e, 0xc3, 0x56, 0x2c, 0x1a, 0x2d, 0x2f, 0x1b, 0xf3, 0x9b, 0xe7,
-	0x79, 0xf1, 0xbc, 0xee, 0xf6, 0xf6, 0xdd, 0x34, 0x12, 0xec, 0x57, 0x96, 0x87, 0x65, 0xc3, 0x96,
-	0x76, 0x25, 0x5f, 0x1b, 0x24, 0x2c, 0x1b, 0x85, 0xfd, 0x65, 0x58, 0x36, 0x02, 0xa0, 0x29, 0x85,
-	0x6c, 0xf6, 0x73, 0xa2, 0x9f, 0xe7, 0x7b, 0xcc, 0xe9, 0x5d, 0xe2, 0xa6, 0x17, 0xf6, 0xc2, 0x13,
-	0xbd, 0xb0, 0x3d, 0x5e, 0xe3, 0x8b, 0xa7, 0xf0, 0x1a, 0x3f, 0xe4, 0xb7, 0x89, 0x57, 0x17, 0xa5,
-	0xc7, 0x3d, 0x9b, 0xc6, 0xc6, 0x6f, 0xfd, 0x88, 0xcc, 0x09, 0xfe, 0x13, 0x05, 0x4f, 0xb2, 0x05,
-	0x17, 0x3a, 0x5e, 0xbd, 0xc7, 0xe9, 0xcc, 0x5d, 0xec, 0xc6, 0x6b, 0x00, 0x5b, 0x29, 0x34, 0x98,
-	0x5a, 0x92, 0x6f, 0xe0, 0x11, 0x9c, 0x5f, 0x3e, 0xcf, 0xcb, 0x0d, 0x3c, 0x02, 0xa3, 0x49, 0x93,
-	0xf4, 0xc1, 0xbe, 0xf8, 0xcc, 0x7c, 0xb0, 0x33, 0xcf, 0xc1, 0x07, 0x7b, 0xe5, 0xc4, 0x3e, 0xd8,
-	0xbf, 0x08, 0xe7, 0x3b, 0x5e, 0x7d, 0xc9, 0x09, 0xfc, 0x2e, 0x4f, 0x39, 0xae, 0x74, 0xeb, 0x0d,
-	0x1a, 0x72, 0x27, 0x6e, 0xe9, 0xe6, 0x4d, 0xb3, 0x92, 0xe2, 0xcf, 0xf4, 0xe6, 0xe4, 0x9f, 0xe9,
-	0xf1, 0x45, 0x9e, 0x28, 0xc5, 0xed, 0x1e, 0x9e, 0x3a, 0x92, 0x82, 0xc4, 0x34, 0x39, 0xa6, 0x0b,
-	0xf8, 0xfa, 0x33, 0x73, 0x01, 0xbf, 0x0f, 0x85, 0xa0, 0xd9, 0x0d, 0xeb, 0xde, 0x81, 0xcb, 0xbd,
-	0xf9, 0x45, 0xfd, 0x38, 0x79, 0xa1, 0x2a, 0xe1, 0x8f, 0x8f, 0x66, 0xa7, 0xd4, 0x6f, 0xc3, 0xca,
-	0x97, 0x10, 0xf2, 0xb7, 0xfa, 0xe4, 0x6c, 0x5a, 0x67, 0x9c, 0xb3, 0x79, 0xf9, 0x54, 0xf9, 0x9a,
-	0x69, 0xae, 0xed, 0x97, 0x7f, 0x1a, 0x5c, 0xdb, 0xbf, 0x9b, 0x83, 0x89, 0x7d, 0xd3, 0x71, 0x22,
-	0x3d, 0xee, 0xd9, 0x02, 0x75, 0x31, 0x17, 0x4c, 0xc5, 0x62, 0x7b, 0x55, 0x0c, 0xf4, 0x38, 0x09,
-	0xc0, 0xb8, 0xf0, 0xde, 0xb0, 0xe1, 0x2b, 0xcf, 0x2f, 0x6c, 0x38, 0xb8, 0x5b, 0xfd, 0x3f, 0x4e,
-	0xc3, 0xb9, 0xc4, 0xa3, 0xe5, 0xfa, 0xbd, 0x92, 0xdc, 0x49, 0xdf, 0x2b, 0x89, 0x3d, 0x28, 0x32,
-	0xf4, 0x4c, 0x1f, 0x14, 0x19, 0x7e, 0x3e, 0x0f, 0x8a, 0x4c, 0x3d, 0x8b, 0x07, 0x45, 0xa6, 0x4f,
-	0xf5, 0xa0, 0x88, 0xf1, 0xa0, 0xcb, 0xc8, 0x53, 0x1e, 0x74, 0x59, 0x80, 0x49, 0x95, 0xe5, 0x46,
-	0xe5, 0x83, 0x12, 0xc2, 0x91, 0xaa, 0xef, 0xb6, 0x2c, 0xc6, 0xd1, 0x98, 0xa4, 0x27, 0x7f, 0x01,
-	0xf2, 0x2e, 0x2f, 0x38, 0x3a, 0xc0, 0x63, 0x64, 0xf1, 0x89, 0xc4, 0xd5, 0x72, 0xf9, 0x1e, 0x98,
-	0x4a, 0x80, 0xc8, 0x73, 0xd8, 0x63, 0xf5, 0x03, 0x85, 0x50, 0xf2, 0x31, 0x94, 0xbd, 0xdd, 0xdd,
-	0x96, 0x67, 0xd7, 0xa3, 0x47, 0x4f, 0x94, 0x6f, 0x57, 0x24, 0xec, 0x5e, 0x97, 0x0c, 0xca, 0x9b,
-	0x7d, 0xe8, 0xb0, 0x2f, 0x07, 0x66, 0x3d, 0x4d, 0xc6, 0x1f, 0x09, 0x0a, 0xca, 0x45, 0xde, 0xcc,
-	0x3f, 0x77, 0x16, 0xcd, 0x8c, 0xbf, 0x48, 0x24, 0x1b, 0x1c, 0xdd, 0x2a, 0x8a, 0x63, 0x31, 0x59,
-	0x13, 0xe2, 0xc3, 0xa5, 0x4e, 0x9a, 0x6d, 0x19, 0xc8, 0x34, 0xb4, 0x27, 0x59, 0xb8, 0xd7, 0xa4,
-	0x94, 0x4b, 0xa9, 0xd6, 0x69, 0x80, 0x7d, 0x38, 0x9b, 0xcf, 0xa1, 0x14, 0x9e, 0xd9, 0x73, 0x28,
-	0xf1, 0xbf, 0x0f, 0x98, 0x78, 0x1e, 0x7f, 0x1f, 0x40, 0x7e, 0x92, 0xfa, 0x0a, 0x8f, 0x30, 0xc9,
-	0x3e, 0x3a, 0x8b, 0xc1, 0xfe, 0xa9, 0x7b, 0x89, 0xe7, 0xef, 0xe6, 0x60, 0x46, 0x4c, 0xa9, 0xb4,
-	0x7f, 0x9c, 0x92, 0xc9, 0x64, 0x67, 0xe0, 0xca, 0xe7, 0xe1, 0xc1, 0x6a, 0x4c, 0x10, 0xf7, 0x3d,
-	0x3f, 0x41, 0x38, 0xf9, 0xed, 0x14, 0x15, 0x62, 0x72, 0x00, 0x87, 0x45, 0xfa, 0xdb, 0x2e, 0xe7,
-	0x8f, 0x4f, 0xa2, 0x35, 0xfc, 0xa3, 0xbe, 0x2e, 0x14, 0xc2, 0x6b, 0xb4, 0x75, 0x76, 0x2e, 0x14,
-	0xf3, 0xcd, 0x99, 0xd3, 0x38, 0x52, 0x66, 0x0e, 0xc5, 0xf3, 0x72, 0x7d, 0x1f, 0x37, 0xbc, 0x67,
-	0x1e, 0xe3, 0x59, 0xdf, 0x17, 0x8c, 0xf6, 0x47, 0xf3, 0x61, 0xc5, 0xdf, 0xc8, 0xc1, 0x85, 0xb4,
-	0x8d, 0x2c, 0xa5, 0x16, 0xd5, 0x78, 0x2d, 0x06, 0xf3, 0xda, 0x9a, 0x75, 0x38, 0x9b, 0x27, 0x77,
-	0xfe, 0xe6, 0xa8, 0xe1, 0x69, 0x0e, 0x69, 0xe7, 0xe7, 0x29, 0xde, 0x99, 0x52, 0xbc, 0x63, 0x7f,
-	0x08, 0x92, 0x7f, 0x8e, 0x7f, 0x08, 0x32, 0x9a, 0xe1, 0x0f, 0x41, 0xc6, 0x9e, 0xe7, 0x1f, 0x82,
-	0x14, 0x4e, 0xf8, 0x87, 0x20, 0xc5, 0x9f, 0x9a, 0x3f, 0x04, 0xb1, 0xbe, 0xce, 0xc1, 0xd4, 0xff,
-	0xef, 0xff, 0xa3, 0xf8, 0x03, 0x23, 0xd4, 0xfb, 0x1c, 0xff, 0x40, 0xf1, 0x51, 0x3c, 0x78, 0xb6,
-	0x7c, 0x26, 0x8d, 0xec, 0x13, 0x44, 0xfb, 0x0c, 0xd2, 0xcc, 0xf7, 0x93, 0xdd, 0x3d, 0x8c, 0xe5,
-	0x24, 0x0d, 0x9d, 0x38, 0x27, 0xe9, 0xff, 0xa6, 0xf4, 0x2a, 0x3f, 0xdb, 0xbf, 0x7c, 0x56, 0x7f,
-	0xed, 0x76, 0x21, 0xed, 0xaf, 0xdd, 0x12, 0x7f, 0xe5, 0x96, 0xfc, 0x6b, 0xaf, 0xa1, 0x67, 0xf8,
-	0xd7, 0x5e, 0x13, 0x50, 0x32, 0xff, 0x9a, 0x7f, 0xee, 0xdb, 0x5f, 0x5f, 0x7b, 0xe1, 0x3b, 0x5f,
-	0x5f, 0x7b, 0xe1, 0xbb, 0x5f, 0x5f, 0x7b, 0xe1, 0xab, 0xe3, 0x6b, 0xb9, 0x6f, 0x1f, 0x5f, 0xcb,
-	0x7d, 0xe7, 0xf8, 0x5a, 0xee, 0xbb, 0xc7, 0xd7, 0x72, 0x3f, 0x38, 0xbe, 0x96, 0xfb, 0x1b, 0xff,
-	0xf5, 0xda, 0x0b, 0x1f, 0x15, 0x54, 0xdb, 0xfe, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf0, 0x8d,
-	0xf7, 0x18, 0xff, 0x82, 0x00, 0x00,
+	// 8963 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x5d, 0x70, 0x24, 0xc9,
+	0x71, 0x18, 0x7c, 0x3d, 0x98, 0x01, 0x06, 0x85, 0xdf, 0xed, 0xfd, 0xeb, 0xc3, 0xed, 0x2d, 0x56,
+	0x7d, 0xbc, 0xd3, 0xdd, 0xf7, 0x51, 0x58, 0xdd, 0x1e, 0x69, 0x9f, 0x8e, 0x3f, 0x77, 0x98, 0xc1,
+	0x02, 0x8b, 0xdb, 0x5d, 0x00, 0xcc, 0xc1, 0xdd, 0x8a, 0x77, 0x34, 0xe9, 0xc6, 0x4c, 0x61, 0xd0,
+	0x87, 0x99, 0xee, 0xb9, 0xee, 0x9e, 0xdd, 0xc5, 0x51, 0x32, 0x8f, 0xb4, 0x15, 0xb4, 0x2d, 0xd1,
+	0x76, 0x84, 0xc3, 0xb2, 0x1c, 0x0a, 0x4b, 0x72, 0xd8, 0x0a, 0x3b, 0xc2, 0xf2, 0xa3, 0xe5, 0x07,
+	0x3b, 0xe8, 0x08, 0x87, 0x7f, 0x68, 0xda, 0x0f, 0x7c, 0x13, 0x1f, 0xe4, 0x15, 0x09, 0xbd, 0xc8,
+	0xe1, 0x1f, 0x86, 0x1f, 0x6c, 0x47, 0xac, 0x1f, 0xec, 0xc8, 0xfa, 0xeb, 0xaa, 0x9e, 0x9e, 0x05,
+	0x30, 0x8d, 0x05, 0x19, 0x41, 0xbd, 0xcd, 0x64, 0x66, 0x65, 0x56, 0x55, 0xd7, 0x4f, 0x56, 0x66,
+	0x56, 0x16, 0xa9, 0xb7, 0xfd, 0x64, 0xaf, 0xbf, 0xb3, 0xd4, 0x0c, 0xbb, 0xd7, 0xbd, 0xa8, 0x1d,
+	0xf6, 0xa2, 0xf0, 0x03, 0xf6, 0xe3, 0x7a, 0x6f, 0xbf, 0x7d, 0xdd, 0xeb, 0xf9, 0xf1, 0xf5, 0x07,
+	0x61, 0xb4, 0xbf, 0xdb, 0x09, 0x1f, 0x5c, 0xbf, 0xff, 0xaa, 0xd7, 0xe9, 0xed, 0x79, 0xaf, 0x5e,
+	0x6f, 0xd3, 0x80, 0x46, 0x5e, 0x42, 0x5b, 0x4b, 0xbd, 0x28, 0x4c, 0x42, 0xfb, 0xb5, 0x94, 0xc9,
+	0x92, 0x64, 0xc2, 0x7e, 0x2c, 0xf5, 0xf6, 0xdb, 0x4b, 0xc8, 0x64, 0x49, 0x32, 0x59, 0x92, 0x4c,
+	0x16, 0x7e, 0x4e, 0x93, 0xdc, 0x0e, 0x51, 0x20, 0xf2, 0xda, 0xe9, 0xef, 0xb2, 0x7f, 0xec, 0x0f,
+	0xfb, 0xc5, 0x65, 0x2c, 0xb8, 0xfb, 0xaf, 0xc7, 0x4b, 0x7e, 0x88, 0x55, 0xba, 0xde, 0x0c, 0x23,
+	0x7a, 0xfd, 0xfe, 0x40, 0x3d, 0x16, 0x5e, 0xd1, 0x68, 0x7a, 0x61, 0xc7, 0x6f, 0x1e, 0x5c, 0xbf,
+	0xff, 0xea, 0x0e, 0x4d, 0x06, 0xab, 0xbc, 0xf0, 0xa9, 0x94, 0xb4, 0xeb, 0x35, 0xf7, 0xfc, 0x80,
+	0x46, 0x07, 0x69, 0x93, 0xbb, 0x34, 0xf1, 0xf2, 0x04, 0x5c, 0x1f, 0x56, 0x2a, 0xea, 0x07, 0x89,
+	0xdf, 0xa5, 0x03, 0x05, 0xfe, 0xcc, 0x51, 0x05, 0xe2, 0xe6, 0x1e, 0xed, 0x7a, 0x03, 0xe5, 0x5e,
+	0x1b, 0x56, 0xae, 0x9f, 0xf8, 0x9d, 0xeb, 0x7e, 0x90, 0xc4, 0x49, 0x94, 0x2d, 0xe4, 0xde, 0x24,
+	0xe3, 0xcb, 0xdd, 0xb0, 0x1f, 0x24, 0xf6, 0x67, 0x48, 0xe5, 0xbe, 0xd7, 0xe9, 0x53, 0xc7, 0xba,
+	0x66, 0xbd, 0x3c, 0x59, 0x7b, 0xf1, 0x3b, 0x8f, 0x16, 0x9f, 0x39, 0x7c, 0xb4, 0x58, 0x79, 0x17,
+	0x81, 0x8f, 0x1f, 0x2d, 0x5e, 0xa0, 0x41, 0x33, 0x6c, 0xf9, 0x41, 0xfb, 0xfa, 0x07, 0x71, 0x18,
+	0x2c, 0x6d, 0xf4, 0xbb, 0x3b, 0x34, 0x02, 0x5e, 0xc6, 0xfd, 0xbd, 0x12, 0x99, 0x5b, 0x8e, 0x9a,
+	0x7b, 0xfe, 0x7d, 0xda, 0x48, 0x90, 0x7f, 0xfb, 0xc0, 0x7e, 0x9f, 0x8c, 0x25, 0x5e, 0xc4, 0xd8,
+	0x4d, 0xdd, 0x78, 0x6b, 0x69, 0x84, 0xef, 0xbd, 0xb4, 0xed, 0x45, 0x92, 0x5d, 0x6d, 0xe2, 0xf0,
+	0xd1, 0xe2, 0xd8, 0xb6, 0x17, 0x01, 0x72, 0xb5, 0xbf, 0x42, 0xca, 0x41, 0x18, 0x50, 0xa7, 0xc4,
+	0xb8, 0x2f, 0x8f, 0xc4, 0x7d, 0x23, 0x0c, 0x54, 0x6d, 0x6b, 0xd5, 0xc3, 0x47, 0x8b, 0x65, 0x84,
+	0x00, 0x63, 0x8c, 0xb5, 0xff, 0xc8, 0xef, 0x39, 0x63, 0x05, 0x6a, 0xff, 0x9e, 0xdf, 0x33, 0x6b,
+	0xff, 0x9e, 0xdf, 0x03, 0xe4, 0xea, 0xfe, 0xc8, 0x22, 0x93, 0xcb, 0x51, 0xbb, 0xdf, 0xa5, 0x41,
+	0x12, 0xdb, 0x11, 0x21, 0x3d, 0x2f, 0xf2, 0xba, 0x34, 0xa1, 0x51, 0xec, 0x58, 0xd7, 0xc6, 0x5e,
+	0x9e, 0xba, 0xf1, 0xf9, 0x91, 0x24, 0x6e, 0x49, 0x36, 0x35, 0x5b, 0x7c, 0x3e, 0xa2, 0x40, 0x31,
+	0x68, 0x52, 0xec, 0x80, 0x4c, 0x7a, 0x51, 0xe2, 0xef, 0x7a, 0xcd, 0x24, 0x76, 0x4a, 0x4c, 0xe4,
+	0xe7, 0x46, 0x12, 0xb9, 0x2c, 0xb8, 0xd4, 0xce, 0x09, 0x89, 0x93, 0x12, 0x12, 0x43, 0x2a, 0xc2,
+	0xfd, 0xb8, 0x44, 0xa6, 0x96, 0xa3, 0x64, 0xad, 0xde, 0x48, 0xbc, 0xa4, 0x1f, 0xdb, 0xff, 0xc8,
+	0x22, 0xe7, 0x63, 0xde, 0x39, 0x3e, 0x8d, 0xb7, 0xa2, 0xb0, 0x49, 0xe3, 0x98, 0xb6, 0x44, 0xeb,
+	0xbf, 0x38, 0x6a, 0x55, 0x24, 0xff, 0xa5, 0xc6, 0x20, 0xef, 0x9b, 0x41, 0x12, 0x1d, 0xd4, 0x9e,
+	0x13, 0xd5, 0x3c, 0x9f, 0x43, 0x01, 0x79, 0x55, 0x5a, 0x58, 0x25, 0xce, 0x30, 0x6e, 0xf6, 0x3c,
+	0x19, 0xdb, 0xa7, 0x07, 0x7c, 0xca, 0x00, 0xfe, 0xb4, 0x2f, 0xc8, 0x69, 0x84, 0x23, 0xb3, 0x2a,
+	0xe6, 0xc7, 0x1b, 0xa5, 0xd7, 0x2d, 0xf7, 0xdb, 0x15, 0x52, 0x95, 0x7d, 0x63, 0x5f, 0x23, 0xe5,
+	0xc0, 0xeb, 0xca, 0xc9, 0x36, 0x2d, 0x2a, 0x55, 0xde, 0xf0, 0xba, 0x38, 0x00, 0xbd, 0x2e, 0x45,
+	0x8a, 0x9e, 0x97, 0xec, 0x39, 0x25, 0x93, 0x62, 0xcb, 0x4b, 0xf6, 0x80, 0x61, 0xec, 0x2b, 0xa4,
+	0xdc, 0x0d, 0x5b, 0x94, 0x8d, 0xd1, 0x0a, 0x1f, 0xc0, 0x77, 0xc3, 0x16, 0x05, 0x06, 0xc5, 0xf2,
+	0xbb, 0x51, 0xd8, 0x75, 0xca, 0x66, 0xf9, 0xd5, 0x28, 0xec, 0x02, 0xc3, 0xd8, 0xbf, 0x6a, 0x91,
+	0x79, 0xf9, 0x85, 0xee, 0x84, 0x4d, 0x2f, 0xf1, 0xc3, 0xc0, 0xa9, 0xb0, 0x01, 0x7f, 0xb3, 0xd0,
+	0x58, 0x90, 0xcc, 0x6a, 0x8e, 0x90, 0x3a, 0x9f, 0xc5, 0xc0, 0x80, 0x60, 0xfb, 0x06, 0x21, 0xed,
+	0x4e, 0xb8, 0xe3, 0x75, 0xb0, 0x0f, 0x9c, 0x71, 0x56, 0x6b, 0x35, 0x8a, 0xd7, 0x14, 0x06, 0x34,
+	0x2a, 0x7b, 0x9f, 0x4c, 0x78, 0x7c, 0xd5, 0x71, 0x26, 0x58, 0xbd, 0x57, 0x46, 0xac, 0xb7, 0xb1,
+	0x72, 0xd5, 0xa6, 0x0e, 0x1f, 0x2d, 0x4e, 0x08, 0x20, 0x48, 0x09, 0xf6, 0x27, 0x49, 0x35, 0xec,
+	0x61, 0x55, 0xbd, 0x8e, 0x53, 0xc5, 0x8f, 0x5b, 0x9b, 0x17, 0xd5, 0xab, 0x6e, 0x0a, 0x38, 0x28,
+	0x0a, 0xfb, 0x15, 0x32, 0x11, 0xf7, 0x77, 0xf0, 0x6b, 0x39, 0x93, 0xac, 0x2d, 0x73, 0x82, 0x78,
+	0xa2, 0xc1, 0xc1, 0x20, 0xf1, 0xf6, 0xa7, 0xc9, 0x54, 0x44, 0x9b, 0xfd, 0x28, 0xa6, 0xf8, 0xf9,
+	0x1c, 0xc2, 0x78, 0x9f, 0x17, 0xe4, 0x53, 0x90, 0xa2, 0x40, 0xa7, 0xb3, 0x43, 0x42, 0x64, 0x27,
+	0xae, 0xd5, 0x9d, 0x29, 0xd6, 0xfe, 0x37, 0x0b, 0x7d, 0xb7, 0xb5, 0x7a, 0x6d, 0x16, 0x7b, 0x3b,
+	0xfd, 0x0f, 0x9a, 0x08, 0x77, 0x8b, 0x68, 0x18, 0xbb, 0x46, 0xaa, 0x62, 0xb6, 0x88, 0xf1, 0x5f,
+	0x7b, 0x49, 0x76, 0x87, 0xec, 0xc8, 0xc7, 0x8f, 0x16, 0xed, 0xb4, 0x84, 0x84, 0x82, 0x2a, 0xe7,
+	0xfe, 0xfe, 0x04, 0x19, 0x18, 0x1a, 0xf6, 0xab, 0x64, 0x4a, 0x74, 0xf9, 0x9d, 0xb0, 0x1d, 0x33,
+	0xde, 0xd5, 0xda, 0x1c, 0x76, 0xc5, 0x72, 0x0a, 0x06, 0x9d, 0xc6, 0xbe, 0x47, 0x4a, 0xf1, 0x6b,
+	0x4e, 0xa9, 0x40, 0x17, 0x34, 0x5e, 0x53, 0x0b, 0xd9, 0xf8, 0xe1, 0xa3, 0xc5, 0x52, 0xe3, 0x35,
+	0x28, 0xc5, 0xaf, 0xe1, 0x2e, 0xd0, 0xf6, 0x93, 0x42, 0xbb, 0xc0, 0x9a, 0x9f, 0x28, 0xd6, 0x6c,
+	0x17, 0x58, 0xf3, 0x13, 0x40, 0xae, 0xb8, 0x87, 0xed, 0x25, 0x49, 0xcf, 0x29, 0x17, 0xd8, 0xc3,
+	0x6e, 0x6d, 0x6f, 0x6f, 0x29, 0xf6, 0x6c, 0x09, 0x40, 0x08, 0x30, 0xc6, 0xf6, 0x57, 0xb1, 0x27,
+	0x39, 0x2e, 0x8c, 0x0e, 0xc4, 0xd4, 0xbe, 0x55, 0x68, 0x88, 0x84, 0xd1, 0x81, 0x12, 0x27, 0xbe,
+	0x89, 0x42, 0x80, 0x2e, 0x8d, 0xb5, 0xae, 0xb5, 0x1b, 0x3b, 0xe3, 0x45, 0x5a, 0xb7, 0xb2, 0xda,
+	0xc8, 0xb4, 0x6e, 0x65, 0xb5, 0x01, 0x8c, 0x31, 0x7e, 0x9b, 0xc8, 0x7b, 0xe0, 0x4c, 0x14, 0xf8,
+	0x36, 0xe0, 0x3d, 0x30, 0xbf, 0x0d, 0x78, 0x0f, 0x00, 0xb9, 0x22, 0xf3, 0x30, 0x8e, 0x9d, 0x6a,
+	0x01, 0xe6, 0x9b, 0x8d, 0x86, 0xc9, 0x7c, 0xb3, 0xd1, 0x00, 0xe4, 0xca, 0x46, 0x55, 0x33, 0x76,
+	0x26, 0x0b, 0x30, 0x5f, 0xab, 0x67, 0x98, 0xaf, 0xd5, 0x1b, 0x80, 0x5c, 0xed, 0x26, 0xa9, 0x78,
+	0x1f, 0xf5, 0x23, 0xbe, 0x8e, 0x4c, 0xdd, 0xa8, 0x8d, 0xf6, 0xb9, 0x91, 0x83, 0x12, 0x30, 0x89,
+	0x7a, 0x20, 0x03, 0x01, 0xe7, 0xed, 0x7e, 0x48, 0x2e, 0x4a, 0x2c, 0xd0, 0x5e, 0x18, 0xfb, 0xec,
+	0xfb, 0xd3, 0x5d, 0xfb, 0x3a, 0x99, 0x6c, 0x86, 0xc1, 0xae, 0xdf, 0xbe, 0xeb, 0xf5, 0xc4, 0xb2,
+	0xa0, 0x14, 0x83, 0xba, 0x44, 0x40, 0x4a, 0x63, 0x3f, 0xcf, 0x77, 0x50, 0xbe, 0xcb, 0x4d, 0x09,
+	0xd2, 0xb1, 0xdb, 0xf4, 0x80, 0x6d, 0xa7, 0x6f, 0x54, 0x7f, 0xe3, 0x77, 0x16, 0x9f, 0xf9, 0xf8,
+	0x0f, 0xaf, 0x3d, 0xe3, 0xfe, 0x6e, 0x89, 0x3c, 0x97, 0x2b, 0x53, 0x68, 0x14, 0xbf, 0x6d, 0x91,
+	0x8b, 0x5e, 0x1e, 0x5e, 0x68, 0xa0, 0x6f, 0x17, 0x1a, 0xf7, 0x06, 0xc7, 0xda, 0xf3, 0xa2, 0x9e,
+	0xf9, 0x9d, 0x00, 0x17, 0xbd, 0x61, 0x7d, 0x83, 0x3b, 0x7b, 0xdc, 0xf3, 0x9a, 0xd4, 0x29, 0x99,
+	0x7d, 0xb3, 0x21, 0x11, 0x90, 0xd2, 0xe0, 0x1e, 0xd2, 0xa2, 0xbb, 0x5e, 0xbf, 0xc3, 0x57, 0xa0,
+	0x6a, 0xba, 0x87, 0xac, 0x70, 0x30, 0x48, 0xbc, 0xd6, 0x4f, 0xdf, 0xb6, 0xc8, 0xf9, 0x9c, 0xd9,
+	0x8a, 0x1d, 0xdd, 0x8f, 0x3a, 0x8e, 0x65, 0x76, 0xf4, 0x3b, 0x70, 0x07, 0x10, 0x6e, 0x7f, 0xd3,
+	0x22, 0x73, 0xda, 0xf4, 0x5d, 0xee, 0x0b, 0xd5, 0x63, 0xf4, 0x3d, 0xd5, 0xe0, 0x55, 0xbb, 0x2c,
+	0x24, 0xce, 0x65, 0x10, 0x90, 0x95, 0xea, 0xfe, 0x81, 0x45, 0xb2, 0x44, 0xb6, 0x47, 0x66, 0xfb,
+	0x31, 0x8d, 0xb0, 0x6b, 0x1a, 0xb4, 0x19, 0xd1, 0x44, 0x7c, 0xd4, 0x17, 0x97, 0xf8, 0xa1, 0x07,
+	0x6b, 0xb1, 0xd4, 0x0c, 0x23, 0xba, 0x74, 0xff, 0xd5, 0x25, 0x4e, 0x71, 0x9b, 0x1e, 0x34, 0x68,
+	0x87, 0x22, 0x8f, 0x9a, 0x7d, 0xf8, 0x68, 0x71, 0xf6, 0x1d, 0x83, 0x01, 0x64, 0x18, 0xa2, 0x88,
+	0x9e, 0x17, 0xc7, 0x0f, 0xc2, 0xa8, 0x25, 0x44, 0x94, 0x4e, 0x2c, 0x62, 0xcb, 0x60, 0x00, 0x19,
+	0x86, 0xee, 0xbf, 0xb3, 0xc8, 0x8c, 0x31, 0xb3, 0xec, 0xbf, 0x69, 0x11, 0x9b, 0xcd, 0xa8, 0x5a,
+	0x27, 0xdc, 0xa9, 0x87, 0x41, 0xe2, 0xe1, 0xb1, 0x4d, 0x34, 0x6e, 0x6d, 0xf4, 0xa9, 0x6b, 0xb0,
+	0xab, 0x2d, 0x88, 0xbe, 0xb7, 0x07, 0x71, 0x90, 0x23, 0x1e, 0x55, 0xc7, 0x9d, 0x4e, 0xb8, 0x93,
+	0x55, 0x3d, 0x91, 0x08, 0x18, 0xc6, 0xfd, 0x5f, 0x25, 0x92, 0xc3, 0x0c, 0x55, 0x24, 0x1a, 0xb4,
+	0x7a, 0xa1, 0x1f, 0x24, 0x62, 0xa0, 0x29, 0x15, 0xe9, 0xa6, 0x80, 0x83, 0xa2, 0x10, 0x6b, 0x85,
+	0x68, 0x72, 0x69, 0x60, 0xad, 0x10, 0x15, 0x4c, 0x69, 0xec, 0x36, 0x99, 0xf7, 0x9a, 0x4d, 0x3c,
+	0xad, 0xb2, 0x9e, 0x67, 0x1f, 0x69, 0xec, 0x24, 0x1f, 0xe9, 0x02, 0xd3, 0x45, 0x33, 0x2c, 0x60,
+	0x80, 0x29, 0x8e, 0x85, 0xd8, 0x8b, 0xb7, 0xc3, 0x7d, 0x1a, 0x08, 0x31, 0xe5, 0x13, 0x8f, 0x85,
+	0xc6, 0x72, 0x43, 0x63, 0x00, 0x19, 0x86, 0xa8, 0xf4, 0xf5, 0x63, 0xda, 0x58, 0xb9, 0x5d, 0x8f,
+	0x68, 0x2b, 0x76, 0x2a, 0xa6, 0xd2, 0xf7, 0x4e, 0x8a, 0x02, 0x9d, 0xce, 0xfd, 0xd7, 0x16, 0x99,
+	0xa8, 0x79, 0xcd, 0xfd, 0x70, 0x77, 0x17, 0x7b, 0xbb, 0xd5, 0x8f, 0xb8, 0xda, 0x9e, 0xe9, 0xed,
+	0x15, 0x01, 0x07, 0x45, 0x61, 0x6f, 0x93, 0x71, 0x3e, 0xa3, 0xc4, 0xb8, 0xfe, 0x79, 0xad, 0x2d,
+	0xca, 0x5e, 0xc0, 0x06, 0x16, 0xda, 0x0b, 0x96, 0xb8, 0xbd, 0x60, 0x69, 0x3d, 0x48, 0x36, 0xf1,
+	0x0c, 0xee, 0x07, 0xed, 0x1a, 0x39, 0x7c, 0xb4, 0x38, 0xbe, 0xca, 0x78, 0x80, 0xe0, 0x85, 0xcd,
+	0xe8, 0x7a, 0x0f, 0xa5, 0x38, 0xf6, 0x35, 0x26, 0xd3, 0x66, 0xdc, 0x4d, 0x51, 0xa0, 0xd3, 0xb9,
+	0xff, 0xd1, 0x22, 0x95, 0xba, 0xd7, 0xdc, 0xa3, 0xf6, 0x3b, 0xd9, 0x0d, 0x63, 0xea, 0xc6, 0xcb,
+	0x79, 0xbd, 0xac, 0x36, 0x0f, 0xbd, 0xa3, 0x67, 0x86, 0x6e, 0x2b, 0x1d, 0x52, 0x6d, 0x79, 0x89,
+	0xb7, 0xe3, 0xc5, 0xd2, 0x46, 0x30, 0xda, 0x46, 0xb8, 0x22, 0x98, 0xb0, 0xca, 0xd6, 0xa6, 0x59,
+	0xdf, 0x0a, 0x10, 0x28, 0x09, 0xee, 0xaf, 0x97, 0xc8, 0x4c, 0x7d, 0xcf, 0xef, 0xb4, 0xee, 0x09,
+	0x06, 0x38, 0xb1, 0xcf, 0x4b, 0x6e, 0xdb, 0xb4, 0xdb, 0xeb, 0x78, 0x09, 0x4d, 0xf7, 0xa2, 0xd1,
+	0x74, 0xb0, 0x7b, 0x83, 0xfc, 0x6a, 0x97, 0xf1, 0x28, 0x9b, 0x83, 0x80, 0x3c, 0xe9, 0x76, 0x88,
+	0xa7, 0x7e, 0x61, 0x76, 0x10, 0xdd, 0xf2, 0xf9, 0x11, 0x57, 0x77, 0xc1, 0x45, 0x3f, 0xf6, 0x0b,
+	0x10, 0xa4, 0x32, 0xdc, 0x3f, 0xb1, 0xc8, 0xe5, 0x7a, 0xa7, 0x1f, 0x27, 0x34, 0xca, 0x56, 0xd2,
+	0xfe, 0xf3, 0xa4, 0xda, 0xa5, 0x89, 0x87, 0x9d, 0xe8, 0x58, 0x47, 0x0c, 0x49, 0x56, 0x0d, 0xa4,
+	0xc6, 0xa1, 0xb0, 0xb9, 0xf3, 0x01, 0x6d, 0x26, 0x77, 0x69, 0xe2, 0xa5, 0x07, 0xc4, 0x14, 0x06,
+	0x8a, 0xab, 0xbd, 0x4f, 0xca, 0x71, 0x8f, 0x36, 0x45, 0x4b, 0xd7, 0x4f, 0xa5, 0xd3, 0x1b, 0x3d,
+	0xda, 0x4c, 0x97, 0x44, 0xfc, 0x07, 0x4c, 0x88, 0xfb, 0xdf, 0x2d, 0xf2, 0xdc, 0x90, 0xa6, 0xde,
+	0xf1, 0xe3, 0xc4, 0xfe, 0xd2, 0x40, 0x73, 0x97, 0x8e, 0xd7, 0x5c, 0x2c, 0xcd, 0x1a, 0xab, 0x66,
+	0xb7, 0x84, 0x68, 0x4d, 0xfd, 0x90, 0x54, 0xfc, 0x84, 0x76, 0xa5, 0x2d, 0xe7, 0xce, 0x48, 0x6d,
+	0x1d, 0x52, 0xfd, 0xda, 0x8c, 0xb4, 0x05, 0xae, 0xa3, 0x08, 0xe0, 0x92, 0xdc, 0x7f, 0x6f, 0x11,
+	0x9c, 0x7b, 0x2d, 0x5f, 0x9c, 0xda, 0xca, 0xc9, 0x41, 0x4f, 0x1a, 0x34, 0xa4, 0x82, 0x54, 0xde,
+	0x3e, 0xe8, 0xa1, 0xf1, 0x70, 0x46, 0x11, 0x22, 0x00, 0x18, 0xa9, 0xfd, 0x65, 0x32, 0x1e, 0x33,
+	0xdd, 0x4d, 0x2c, 0xfe, 0xab, 0xa2, 0xd0, 0x38, 0xd7, 0xe8, 0x1e, 0x3f, 0x5a, 0x3c, 0x96, 0xc5,
+	0x75, 0x49, 0xf1, 0xe6, 0xe5, 0x40, 0x70, 0x45, 0xf5, 0xa9, 0x4b, 0xe3, 0xd8, 0x6b, 0x53, 0xb1,
+	0x2e, 0x29, 0xf5, 0xe9, 0x2e, 0x07, 0x83, 0xc4, 0xbb, 0x7f, 0xcb, 0x22, 0x33, 0x6a, 0xcb, 0xd9,
+	0xc0, 0xd3, 0xf5, 0x86, 0xbe, 0x39, 0xf1, 0xef, 0xf5, 0xfc, 0x90, 0x75, 0x49, 0xec, 0xb2, 0x4f,
+	0xde, 0xbb, 0x3e, 0x45, 0xa6, 0x5b, 0xb4, 0x47, 0x83, 0x16, 0x0d, 0x9a, 0x3e, 0xe5, 0xdf, 0x69,
+	0xb2, 0x36, 0x7f, 0xf8, 0x68, 0x71, 0x7a, 0x45, 0x83, 0x83, 0x41, 0xe5, 0xfe, 0x67, 0x8b, 0x5c,
+	0x50, 0xec, 0x1a, 0x34, 0x51, 0x93, 0xe7, 0x3e, 0x21, 0x8a, 0xb7, 0xb4, 0x19, 0x8e, 0xb6, 0xc2,
+	0x19, 0xcd, 0x4e, 0x27, 0x94, 0x02, 0xc7, 0xa0, 0x49, 0xb2, 0xbf, 0x48, 0xa6, 0xef, 0x87, 0x9d,
+	0x7e, 0x97, 0xde, 0xc5, 0x1d, 0x53, 0x0e, 0xb7, 0xc5, 0xbc, 0x9e, 0x79, 0x37, 0xa5, 0xab, 0x5d,
+	0x10, 0x6c, 0xa7, 0x35, 0x60, 0x0c, 0x06, 0x2b, 0xf7, 0x8b, 0x84, 0x09, 0xf5, 0x83, 0x3e, 0xdd,
+	0x0c, 0xec, 0x17, 0x48, 0x85, 0x46, 0x51, 0x18, 0x89, 0xf3, 0xbf, 0x1a, 0x82, 0x37, 0x11, 0x08,
+	0x1c, 0x67, 0xbf, 0x84, 0x7b, 0x9a, 0xdf, 0xa1, 0x2d, 0x6e, 0x6d, 0xab, 0xcd, 0xca, 0x11, 0xb4,
+	0xca, 0xa0, 0x20, 0xb0, 0xee, 0x12, 0x99, 0xa8, 0xa3, 0x10, 0x1a, 0x21, 0x5f, 0xdd, 0xcc, 0x3d,
+	0x63, 0x98, 0xb9, 0xa5, 0x39, 0x7b, 0x9b, 0x5c, 0xac, 0x47, 0x14, 0x67, 0xfb, 0x6b, 0xb5, 0x7e,
+	0x73, 0x9f, 0x26, 0xdc, 0xc0, 0x13, 0xdb, 0x9f, 0x21, 0x33, 0x21, 0x5b, 0x69, 0xee, 0x84, 0xcd,
+	0x7d, 0x3f, 0x68, 0x0b, 0xbd, 0xfc, 0xa2, 0xe0, 0x32, 0xb3, 0xa9, 0x23, 0xc1, 0xa4, 0x75, 0xff,
+	0x99, 0x45, 0xce, 0xd7, 0xa3, 0x30, 0xb8, 0xf9, 0xb0, 0xd9, 0xe9, 0xc7, 0x7e, 0x18, 0xdc, 0xf3,
+	0x83, 0x56, 0xf8, 0x00, 0xab, 0x14, 0x27, 0x5e, 0x94, 0x64, 0xab, 0xd4, 0x40, 0x20, 0x70, 0x9c,
+	0xb1, 0xd9, 0x97, 0x8e, 0xdc, 0xec, 0x17, 0x49, 0xa5, 0xe5, 0x25, 0x34, 0x76, 0xc6, 0xd8, 0x30,
+	0x63, 0x07, 0xb8, 0x15, 0x04, 0x00, 0x87, 0x23, 0x3b, 0xf4, 0x25, 0x7c, 0x84, 0x36, 0xf4, 0xb2,
+	0xc9, 0x6e, 0x5b, 0xc0, 0x41, 0x51, 0xb8, 0x1f, 0x90, 0x69, 0xac, 0x78, 0xa3, 0xb9, 0x47, 0x5b,
+	0xfd, 0x0e, 0x33, 0x85, 0xc5, 0xe2, 0x77, 0x56, 0xf3, 0x90, 0x34, 0x50, 0x8d, 0x35, 0x6a, 0x25,
+	0xab, 0x74, 0xa4, 0xac, 0xef, 0x96, 0xb8, 0x30, 0xb5, 0x95, 0x3e, 0xfd, 0x7d, 0xa2, 0x6d, 0xec,
+	0x13, 0xa3, 0xd9, 0x3e, 0xf5, 0x2a, 0x0f, 0xdb, 0x23, 0xec, 0x50, 0xad, 0x78, 0x63, 0x05, 0x34,
+	0x7c, 0x43, 0x14, 0x63, 0x97, 0x0e, 0x7c, 0x73, 0x09, 0x74, 0xbf, 0x6f, 0x91, 0x79, 0x9d, 0xfc,
+	0x0c, 0x76, 0xa2, 0x5d, 0x73, 0x27, 0x5a, 0x2e, 0xdc, 0xc4, 0x21, 0xdb, 0xcf, 0xd7, 0xab, 0x66,
+	0xd3, 0xb0, 0x9b, 0xd1, 0xa4, 0x3d, 0xfd, 0x40, 0x03, 0x88, 0xf6, 0x2d, 0x17, 0xda, 0xfa, 0xd9,
+	0xe7, 0xfc, 0x84, 0x5c, 0xc1, 0x74, 0xe8, 0xe3, 0xcc, 0x7f, 0x30, 0x84, 0x1b, 0xd3, 0xa4, 0x74,
+	0xe4, 0x34, 0xf9, 0x12, 0x39, 0xd7, 0x0c, 0x83, 0x66, 0x3f, 0x8a, 0x68, 0xd0, 0x3c, 0xd8, 0x62,
+	0xbe, 0x48, 0xb1, 0x71, 0x2d, 0x89, 0x62, 0xe7, 0xea, 0x59, 0x82, 0xc7, 0x79, 0x40, 0x18, 0x64,
+	0xc4, 0xed, 0xd1, 0x31, 0x6e, 0x2d, 0x4e, 0xd9, 0xb4, 0x25, 0x34, 0x38, 0x18, 0x24, 0xde, 0x7e,
+	0x87, 0x5c, 0x66, 0x6b, 0x8e, 0x1f, 0xb4, 0x57, 0xa8, 0xd7, 0xea, 0xf8, 0x01, 0x9e, 0x91, 0xc3,
+	0x40, 0x1c, 0x53, 0xc6, 0x6a, 0xcf, 0x1d, 0x3e, 0x5a, 0xbc, 0xdc, 0xc8, 0x27, 0x81, 0x61, 0x65,
+	0xed, 0x2f, 0x93, 0x85, 0xb8, 0xdf, 0x44, 0xef, 0xc9, 0x6e, 0xbf, 0xf3, 0x76, 0xb8, 0x13, 0xdf,
+	0xf2, 0x63, 0x3c, 0xe0, 0xdf, 0xf1, 0xbb, 0x7e, 0xc2, 0xcc, 0x84, 0x95, 0xda, 0xd5, 0xc3, 0x47,
+	0x8b, 0x0b, 0x8d, 0xa1, 0x54, 0xf0, 0x04, 0x0e, 0x36, 0x90, 0x4b, 0x7c, 0xb9, 0x1f, 0xe0, 0x3d,
+	0xc1, 0x78, 0x2f, 0x1c, 0x3e, 0x5a, 0xbc, 0xb4, 0x9a, 0x4b, 0x01, 0x43, 0x4a, 0x1a, 0x4b, 0x57,
+	0xf5, 0xa8, 0xa5, 0xcb, 0xfe, 0x20, 0x1d, 0x7c, 0x38, 0x29, 0x9c, 0xc9, 0x11, 0x57, 0x2b, 0x76,
+	0x4c, 0xbd, 0xa7, 0x71, 0xc2, 0x89, 0x05, 0x06, 0x6f, 0x3b, 0x22, 0x93, 0x72, 0xe4, 0xc4, 0x0e,
+	0x29, 0x38, 0xd5, 0xe4, 0x68, 0x4c, 0x75, 0x18, 0x09, 0x89, 0x21, 0x15, 0x63, 0xff, 0x35, 0x8b,
+	0xcc, 0x53, 0x73, 0xf3, 0x8a, 0x9d, 0xa9, 0x6b, 0x63, 0x23, 0x9f, 0x68, 0x72, 0x76, 0xc3, 0xd4,
+	0x67, 0x94, 0x41, 0xc4, 0x30, 0x20, 0xdb, 0xfd, 0xb7, 0x25, 0x62, 0x0f, 0xae, 0x86, 0xf6, 0x6d,
+	0x32, 0xee, 0x35, 0x13, 0xf4, 0x0a, 0x71, 0xc5, 0xe8, 0x85, 0x3c, 0xf5, 0x84, 0xf7, 0x37, 0xd0,
+	0x5d, 0x8a, 0xd3, 0x84, 0xa6, 0x4b, 0xe8, 0x32, 0x2b, 0x0a, 0x82, 0x85, 0x1d, 0x92, 0x73, 0x1d,
+	0x2f, 0x4e, 0x64, 0x87, 0xb4, 0xf0, 0xbb, 0x8b, 0x9d, 0xe2, 0xff, 0x3b, 0xde, 0x97, 0xc5, 0x12,
+	0xb5, 0x8b, 0x38, 0x7d, 0xef, 0x64, 0x19, 0xc1, 0x20, 0x6f, 0x74, 0x07, 0x37, 0xa5, 0x46, 0xcb,
+	0x37, 0xf0, 0x51, 0x4f, 0x69, 0x4a, 0x31, 0x36, 0xd4, 0x3a, 0xc1, 0x19, 0x34, 0x29, 0xee, 0xef,
+	0x4c, 0x91, 0x89, 0x95, 0xe5, 0xb5, 0x6d, 0x2f, 0xde, 0x3f, 0x86, 0x6b, 0x12, 0x67, 0x85, 0x50,
+	0x44, 0x07, 0x36, 0x74, 0x01, 0x07, 0x45, 0x61, 0x1e, 0x3a, 0xc7, 0x9e, 0xfe, 0xa1, 0xd3, 0x8e,
+	0xc9, 0x54, 0xa2, 0x1d, 0xb9, 0xcb, 0x45, 0x02, 0x10, 0x52, 0x3e, 0xdc, 0xdd, 0xa1, 0x01, 0x40,
+	0x97, 0x32, 0xa0, 0xdf, 0x57, 0x8e, 0xa3, 0xdf, 0xdb, 0x1f, 0x90, 0xc9, 0x07, 0x7e, 0xb2, 0xc7,
+	0x36, 0x36, 0x67, 0x9c, 0x7d, 0xea, 0x5f, 0x18, 0xa9, 0xa2, 0xc8, 0x21, 0xed, 0x96, 0x7b, 0x92,
+	0x27, 0xa4, 0xec, 0xd1, 0xdc, 0x86, 0x7f, 0x58, 0x40, 0x80, 0x33, 0x61, 0x9a, 0xdb, 0xee, 0x49,
+	0x04, 0xa4, 0x34, 0x76, 0x4c, 0xa6, 0xf1, 0x4f, 0x83, 0x7e, 0xd8, 0xc7, 0x19, 0x22, 0x9c, 0x21,
+	0xa3, 0x85, 0x09, 0x48, 0x26, 0xbc, 0x47, 0xee, 0x69, 0x6c, 0xc1, 0x10, 0x82, 0xa3, 0xef, 0xc1,
+	0x1e, 0x0d, 0x9c, 0x49, 0x73, 0xf4, 0xdd, 0xdb, 0xa3, 0x01, 0x30, 0x0c, 0xfa, 0x3d, 0x9b, 0xea,
+	0x9c, 0xe0, 0x90, 0x02, 0x4e, 0xbf, 0xf4, 0xb8, 0xc1, 0xfd, 0x9e, 0xe9, 0x7f, 0xd0, 0x44, 0xe0,
+	0x29, 0x03, 0x97, 0x29, 0x3f, 0x61, 0x4e, 0xd6, 0xc9, 0x74, 0xa5, 0xd8, 0x64, 0x50, 0x10, 0x58,
+	0x6e, 0xae, 0xc7, 0x8f, 0x1b, 0x3b, 0xd3, 0xe6, 0x79, 0x93, 0x8f, 0x80, 0x18, 0x24, 0xde, 0xfe,
+	0x0b, 0xa4, 0xb2, 0x17, 0x86, 0xfb, 0xb1, 0x33, 0x73, 0x6d, 0x6c, 0x64, 0x3d, 0x50, 0x4c, 0xd8,
+	0xa5, 0x5b, 0xc8, 0x89, 0x47, 0x37, 0x2c, 0x4a, 0x55, 0x89, 0xc1, 0x1e, 0x3f, 0x5a, 0x9c, 0xbd,
+	0xe3, 0xef, 0xd2, 0xe6, 0x41, 0xb3, 0x43, 0x19, 0x04, 0xb8, 0x58, 0xdb, 0x23, 0xe3, 0x7e, 0x80,
+	0x9b, 0xb3, 0x33, 0x5b, 0xe0, 0xa3, 0x2a, 0x03, 0x01, 0xb3, 0x0c, 0xae, 0x33, 0x86, 0x20, 0x18,
+	0xdb, 0xf7, 0x48, 0xb9, 0x13, 0x86, 0x3d, 0x67, 0xee, 0x9a, 0x35, 0xf2, 0xa8, 0xbe, 0x13, 0x86,
+	0x3d, 0xee, 0xf7, 0xc3, 0x5f, 0xc0, 0x18, 0xda, 0x0f, 0xf8, 0xb0, 0x94, 0x36, 0x74, 0x67, 0xbe,
+	0x88, 0x8a, 0xa7, 0x31, 0x4a, 0x87, 0xa6, 0x84, 0x80, 0x21, 0x68, 0xe1, 0x97, 0x08, 0x49, 0xbb,
+	0x3a, 0x27, 0xf4, 0xe3, 0x17, 0xf5, 0xd0, 0x8f, 0x51, 0x8f, 0xe3, 0xc6, 0xf7, 0xd2, 0xc3, 0x47,
+	0xfe, 0x95, 0x45, 0xa6, 0xf0, 0x8b, 0xcb, 0x65, 0xf5, 0x25, 0x32, 0x9e, 0x78, 0x51, 0x9b, 0xca,
+	0x63, 0xa3, 0x1a, 0x95, 0xdb, 0x0c, 0x0a, 0x02, 0x6b, 0x7b, 0xa4, 0x92, 0x78, 0xf1, 0xbe, 0xd4,
+	0xc7, 0x3f, 0x5b, 0x64, 0xa8, 0xa5, 0xaa, 0x38, 0xfe, 0x8b, 0x81, 0x73, 0xb6, 0x5f, 0x26, 0x55,
+	0xd4, 0x9f, 0x56, 0xbd, 0x58, 0x3a, 0xaa, 0x98, 0xa1, 0x74, 0x55, 0xc0, 0x40, 0x61, 0xdd, 0x57,
+	0xc9, 0x8c, 0x61, 0x51, 0x3d, 0x7a, 0xb3, 0x71, 0x3f, 0x4d, 0x2a, 0x37, 0xef, 0xd3, 0x80, 0xe9,
+	0x62, 0xb1, 0x30, 0xfc, 0x0e, 0x1c, 0x3a, 0x05, 0x1c, 0x14, 0x85, 0xfb, 0x25, 0x32, 0x7b, 0xf3,
+	0x21, 0x6d, 0xf6, 0x93, 0x30, 0xe2, 0x06, 0x62, 0xfb, 0x6d, 0x62, 0xc7, 0x34, 0xba, 0xef, 0x37,
+	0xa9, 0xf0, 0x00, 0x6c, 0xa4, 0x82, 0x95, 0x87, 0xa4, 0x31, 0x40, 0x01, 0x39, 0xa5, 0xdc, 0x98,
+	0x54, 0x6f, 0x3e, 0xec, 0x85, 0x51, 0xb2, 0x1d, 0xda, 0x6d, 0x32, 0xd7, 0xd4, 0x8c, 0xd3, 0xa9,
+	0x95, 0xf7, 0xf8, 0x76, 0xec, 0xf3, 0xe8, 0x18, 0xab, 0x9b, 0x4c, 0x20, 0xcb, 0xd5, 0xfd, 0xbb,
+	0x16, 0x99, 0xd2, 0xfc, 0xbe, 0xb8, 0xb1, 0xb6, 0xeb, 0x0d, 0x6e, 0xa0, 0x70, 0xac, 0x02, 0x1b,
+	0xeb, 0x9a, 0xe4, 0x92, 0x6e, 0x08, 0x0a, 0x04, 0xa9, 0x8c, 0x23, 0x7c, 0xb5, 0xee, 0xef, 0x5b,
+	0x24, 0x2d, 0x87, 0xe3, 0x73, 0x27, 0xad, 0x9a, 0x36, 0x3e, 0x05, 0x5f, 0x81, 0xb5, 0x3f, 0xb6,
+	0xc8, 0x65, 0xb3, 0x87, 0x53, 0xe7, 0xce, 0x89, 0x3c, 0x70, 0x72, 0xed, 0xbb, 0xdc, 0xc8, 0xe7,
+	0x06, 0xc3, 0xc4, 0xb8, 0xef, 0x92, 0xca, 0x9a, 0xd7, 0x6f, 0xd3, 0x63, 0x19, 0x87, 0x70, 0xb4,
+	0x47, 0xd4, 0xeb, 0x24, 0x52, 0x0f, 0x14, 0xa3, 0x1d, 0x04, 0x0c, 0x14, 0xd6, 0xfd, 0xbd, 0x32,
+	0x99, 0xd2, 0xc2, 0x3f, 0x70, 0xb0, 0x47, 0xb4, 0x17, 0x66, 0x07, 0x3b, 0x7a, 0x89, 0x81, 0x61,
+	0x70, 0x8c, 0x47, 0xf4, 0xbe, 0x1f, 0xe7, 0x58, 0x79, 0x40, 0xc0, 0x41, 0x51, 0x30, 0x2b, 0x0f,
+	0xed, 0x25, 0x7b, 0x6c, 0xd2, 0x95, 0x85, 0x95, 0x07, 0x01, 0xc0, 0xe1, 0x48, 0xb0, 0x4b, 0x93,
+	0xe6, 0x9e, 0x53, 0x4e, 0xcd, 0x40, 0xab, 0x08, 0x00, 0x0e, 0xcf, 0xf1, 0xab, 0x56, 0x9e, 0xbe,
+	0x5f, 0x75, 0xfc, 0x94, 0xfd, 0xaa, 0x76, 0x8f, 0x9c, 0x8f, 0xe3, 0xbd, 0xad, 0xc8, 0xbf, 0xef,
+	0x25, 0x34, 0x1d, 0x3d, 0x13, 0x27, 0x91, 0xc3, 0x1c, 0x29, 0x8d, 0xc6, 0xad, 0x2c, 0x17, 0xc8,
+	0x63, 0x6d, 0x37, 0xc8, 0x45, 0x3f, 0x88, 0x69, 0xb3, 0x1f, 0xd1, 0xf5, 0x76, 0x10, 0x46, 0xf4,
+	0x56, 0x18, 0x23, 0x3b, 0x11, 0x18, 0xa6, 0xe2, 0x03, 0xd6, 0xf3, 0x88, 0x20, 0xbf, 0xac, 0xfb,
+	0x5d, 0x8b, 0x4c, 0xeb, 0x11, 0x2f, 0x76, 0x4c, 0xc8, 0xde, 0xca, 0x6a, 0x83, 0x2f, 0x0c, 0x8e,
+	0x55, 0x40, 0xd3, 0xb9, 0xa5, 0xd8, 0xa4, 0x47, 0x81, 0x14, 0x06, 0x9a, 0x98, 0x63, 0xc4, 0x1d,
+	0xbe, 0x40, 0x2a, 0xbb, 0x61, 0xd4, 0xa4, 0x62, 0xad, 0x57, 0xb3, 0x64, 0x15, 0x81, 0xc0, 0x71,
+	0xe8, 0xf9, 0xd1, 0x24, 0xd8, 0x5f, 0x23, 0x33, 0x28, 0xe3, 0x76, 0xb4, 0x63, 0xb4, 0xa6, 0x36,
+	0x72, 0x6b, 0x14, 0xa7, 0xd4, 0xf8, 0x6a, 0x80, 0xc1, 0x94, 0x67, 0xff, 0xff, 0x64, 0xd2, 0x6b,
+	0xb5, 0x22, 0x1a, 0xc7, 0xca, 0xf8, 0xce, 0xbc, 0x87, 0xcb, 0x12, 0x08, 0x29, 0x1e, 0xa7, 0x21,
+	0x86, 0x18, 0xe1, 0xc8, 0x76, 0xc6, 0xcc, 0x69, 0x88, 0x42, 0x10, 0x0e, 0x8a, 0xc2, 0xfd, 0x56,
+	0x99, 0x98, 0xb2, 0xed, 0x16, 0x99, 0xdb, 0x8f, 0x76, 0xea, 0x6c, 0x8b, 0x1b, 0x25, 0x5e, 0x81,
+	0xed, 0x07, 0xb7, 0x4d, 0x0e, 0x90, 0x65, 0x29, 0xa4, 0xdc, 0xa6, 0x07, 0x89, 0xb7, 0x33, 0xca,
+	0x82, 0x29, 0xa5, 0xe8, 0x1c, 0x20, 0xcb, 0x12, 0x3d, 0xbc, 0xfb, 0xd1, 0x8e, 0x9c, 0xe4, 0x59,
+	0x0f, 0xef, 0xed, 0x14, 0x05, 0x3a, 0x1d, 0x76, 0xe1, 0x7e, 0xb4, 0x83, 0x8b, 0x62, 0x37, 0x6b,
+	0x60, 0xbe, 0x2d, 0xe0, 0xa0, 0x28, 0xec, 0x1e, 0xb1, 0xf7, 0x65, 0xef, 0xa9, 0x7d, 0xd0, 0xa9,
+	0x9c, 0x70, 0x1b, 0xbd, 0x84, 0x3b, 0xf8, 0xed, 0x01, 0x3e, 0x90, 0xc3, 0xdb, 0xfe, 0x22, 0xb9,
+	0xbc, 0x1f, 0xed, 0x88, 0xad, 0x62, 0x2b, 0xf2, 0x83, 0xa6, 0xdf, 0x33, 0x62, 0x4f, 0xd5, 0x76,
+	0x72, 0x3b, 0x9f, 0x0c, 0x86, 0x95, 0x77, 0xff, 0x53, 0x89, 0xb0, 0x28, 0x3c, 0xdc, 0x02, 0xbb,
+	0x34, 0xd9, 0x0b, 0x5b, 0xd9, 0x2d, 0xf0, 0x2e, 0x83, 0x82, 0xc0, 0xca, 0xd0, 0x9c, 0xd2, 0x90,
+	0xd0, 0x9c, 0x0f, 0xc8, 0xc4, 0x1e, 0xf5, 0x5a, 0x34, 0x92, 0xd6, 0x80, 0x37, 0x47, 0x0e, 0x15,
+	0xbc, 0xc5, 0xf8, 0xa4, 0x07, 0x13, 0xfe, 0x3f, 0x06, 0x29, 0xc0, 0x7e, 0x83, 0xcc, 0xe2, 0xd6,
+	0x15, 0xf6, 0x13, 0x69, 0xf2, 0x2b, 0x33, 0x93, 0x1f, 0x5b, 0x86, 0xb7, 0x0d, 0x0c, 0x64, 0x28,
+	0x59, 0xd8, 0x48, 0xd8, 0xe2, 0x71, 0x86, 0x7a, 0xd8, 0x48, 0xd8, 0x3a, 0x00, 0x86, 0xb1, 0x57,
+	0xc8, 0xbc, 0x30, 0xe0, 0x29, 0x3b, 0x84, 0xe8, 0x6d, 0x65, 0xf5, 0x69, 0x64, 0xf0, 0x30, 0x50,
+	0x02, 0x9d, 0x75, 0xd3, 0x7a, 0xdc, 0xe3, 0x51, 0xa1, 0x4d, 0xbb, 0x69, 0xff, 0x71, 0x1d, 0xf8,
+	0x33, 0xa3, 0xf5, 0xdf, 0x11, 0x7d, 0x87, 0xe1, 0x3d, 0x24, 0xed, 0xe4, 0x63, 0xd8, 0x51, 0x5e,
+	0xd0, 0x0f, 0x0c, 0xc3, 0xd4, 0x8d, 0x88, 0x4c, 0xb2, 0x1f, 0x18, 0xb8, 0xed, 0x8c, 0x15, 0xf0,
+	0x50, 0xa4, 0x55, 0x6b, 0x84, 0xfd, 0xa8, 0x49, 0xf9, 0xfa, 0xf7, 0xae, 0xe4, 0x0d, 0xa9, 0x18,
+	0x37, 0x24, 0xf3, 0x59, 0x6a, 0xfb, 0x7d, 0x32, 0x1d, 0xcb, 0x25, 0x24, 0xd5, 0x71, 0x8f, 0xb9,
+	0xd4, 0xb0, 0xa3, 0x55, 0x43, 0x2b, 0x0e, 0x06, 0x33, 0x77, 0x93, 0x8c, 0x9f, 0x6a, 0xaf, 0xb9,
+	0xbf, 0x61, 0x91, 0x49, 0x66, 0xc9, 0x6d, 0xa3, 0x25, 0x43, 0x15, 0x19, 0x7b, 0x42, 0x47, 0xef,
+	0x92, 0x09, 0xae, 0x92, 0xc6, 0x4e, 0xb9, 0xc0, 0x30, 0xe1, 0xd7, 0x69, 0xd2, 0x61, 0xc2, 0xd5,
+	0xdd, 0x18, 0x24, 0x73, 0xf7, 0xbf, 0x5a, 0x64, 0x7c, 0x3d, 0xe8, 0xf5, 0x7f, 0x4a, 0x6e, 0x7e,
+	0xdc, 0x25, 0x65, 0xb4, 0x3f, 0x99, 0xf7, 0x8b, 0xa6, 0x6b, 0x2f, 0xea, 0x77, 0x8b, 0x1c, 0xf3,
+	0x6e, 0x11, 0x78, 0x0f, 0xa4, 0x87, 0x5e, 0x1c, 0x80, 0xd3, 0xf0, 0xc6, 0x3f, 0x28, 0x91, 0x19,
+	0xe3, 0x8c, 0x6c, 0x58, 0x23, 0xad, 0x93, 0x59, 0x23, 0x4b, 0x67, 0x6f, 0x8d, 0x1c, 0x3b, 0x13,
+	0x6b, 0xe4, 0x0d, 0x42, 0xe8, 0xc3, 0x1e, 0x6a, 0x33, 0xb8, 0xc4, 0x96, 0xcd, 0xcb, 0x14, 0x37,
+	0x15, 0x06, 0x34, 0x2a, 0xb7, 0x43, 0xca, 0x77, 0xfc, 0x60, 0xff, 0x78, 0x33, 0x30, 0x6e, 0x86,
+	0xbd, 0x81, 0x19, 0xd8, 0x40, 0x20, 0x70, 0x9c, 0x5c, 0x94, 0xc7, 0xf2, 0x17, 0x65, 0xf7, 0x5b,
+	0x16, 0x61, 0x46, 0x1d, 0x64, 0x86, 0xf7, 0xda, 0x3a, 0xd9, 0x33, 0xd7, 0x3b, 0x08, 0x04, 0x8e,
+	0x43, 0xbf, 0x7b, 0xd7, 0x7b, 0xb8, 0x9e, 0x50, 0xee, 0xdf, 0xe6, 0x5f, 0xae, 0x92, 0xaa, 0x7e,
+	0x77, 0x75, 0x24, 0x98, 0xb4, 0x28, 0xa1, 0x45, 0x3b, 0xde, 0x41, 0x76, 0xf6, 0xaf, 0x20, 0x10,
+	0x38, 0x0e, 0xf5, 0xd5, 0x73, 0x77, 0x69, 0x37, 0xf4, 0x3f, 0xf2, 0xd2, 0x50, 0x12, 0x6c, 0xc4,
+	0x9e, 0x9f, 0x88, 0x18, 0x04, 0xd5, 0x88, 0x5b, 0x18, 0xc1, 0xbf, 0xe7, 0x1f, 0x75, 0x20, 0x66,
+	0x01, 0x8e, 0xa8, 0xaf, 0x6d, 0xa4, 0x8a, 0x53, 0x1a, 0x24, 0x22, 0x11, 0x90, 0xd2, 0xd8, 0x9f,
+	0x15, 0x05, 0x30, 0x48, 0x46, 0x7c, 0xb5, 0xab, 0x46, 0x01, 0x11, 0x4e, 0x93, 0xfe, 0x81, 0xb4,
+	0x00, 0x53, 0x37, 0xbc, 0x87, 0xcb, 0x6d, 0xea, 0x54, 0x32, 0xea, 0x06, 0x83, 0x82, 0xc0, 0xba,
+	0xff, 0xc4, 0x22, 0x13, 0xbc, 0xa9, 0x54, 0xb6, 0xc0, 0x1a, 0xd2, 0x82, 0xf7, 0x49, 0x85, 0xf1,
+	0x17, 0x33, 0xe5, 0x8d, 0xd1, 0xcc, 0xac, 0xc8, 0x81, 0x1f, 0x3e, 0xd9, 0x4f, 0xe0, 0x3c, 0xb5,
+	0xfa, 0x8e, 0x3d, 0xb1, 0xbe, 0x1f, 0x8f, 0x91, 0xaa, 0xf4, 0x82, 0xd9, 0xbf, 0x62, 0x91, 0x29,
+	0x2f, 0x08, 0xc2, 0x44, 0x0c, 0x04, 0xbe, 0x68, 0x6e, 0x8c, 0x54, 0x31, 0xc9, 0x74, 0x69, 0x39,
+	0x65, 0xc8, 0xed, 0xa8, 0x4a, 0xbf, 0xd5, 0x30, 0xa0, 0xcb, 0xb5, 0x3f, 0x24, 0xe3, 0x1d, 0x6f,
+	0x87, 0x76, 0xe4, 0x1a, 0xba, 0x5e, 0xac, 0x06, 0x77, 0x18, 0x2f, 0x2e, 0x5c, 0xf5, 0x03, 0x07,
+	0x82, 0x10, 0xb4, 0xf0, 0x79, 0x32, 0x9f, 0xad, 0xe8, 0x51, 0x17, 0xd0, 0x26, 0x35, 0x0b, 0xe2,
+	0xc2, 0x2f, 0x90, 0x29, 0x4d, 0xcc, 0x49, 0x8a, 0xba, 0x5f, 0x20, 0x53, 0x77, 0x69, 0x12, 0xf9,
+	0x4d, 0xc6, 0xe0, 0xa8, 0x51, 0x73, 0xac, 0x1d, 0xfa, 0x23, 0x32, 0xc1, 0x59, 0xc6, 0x68, 0xd1,
+	0xef, 0x45, 0x21, 0x2a, 0xc3, 0xb4, 0x2f, 0xbf, 0xe8, 0x68, 0x3a, 0xee, 0x96, 0x62, 0xc3, 0x2d,
+	0xfa, 0xe9, 0x7f, 0xd0, 0x44, 0xb8, 0xaf, 0x90, 0xca, 0xdd, 0x7e, 0x42, 0x1f, 0x1e, 0xc3, 0xfc,
+	0xf8, 0x3e, 0x99, 0x66, 0xa4, 0xb7, 0xc2, 0x0e, 0x6e, 0x50, 0xd8, 0xb6, 0x2e, 0xfe, 0xcf, 0x2e,
+	0x57, 0x8c, 0x08, 0x38, 0x0e, 0x47, 0xf6, 0x5e, 0xd8, 0x69, 0xa9, 0xb0, 0x66, 0xf5, 0x45, 0x6f,
+	0x31, 0x28, 0x08, 0x2c, 0x86, 0x77, 0x4d, 0xb1, 0x82, 0x62, 0xb9, 0xe9, 0x90, 0x89, 0x3d, 0x2e,
+	0x47, 0xf4, 0xc2, 0x68, 0x56, 0x6d, 0xbd, 0xc2, 0x9a, 0xbe, 0xca, 0x01, 0x20, 0x45, 0xa0, 0xb4,
+	0x07, 0x9e, 0x8f, 0xae, 0x7a, 0xa7, 0x74, 0xea, 0xd2, 0xee, 0x71, 0xce, 0x20, 0x45, 0xb8, 0xbf,
+	0x75, 0x9e, 0x10, 0x0c, 0x31, 0x13, 0x4d, 0x5d, 0x20, 0x25, 0x5f, 0x9e, 0x8b, 0x88, 0x28, 0x54,
+	0x5a, 0x5f, 0x81, 0x92, 0xdf, 0x52, 0x5f, 0xa5, 0x34, 0x74, 0x07, 0xfa, 0x34, 0x99, 0x6a, 0xf9,
+	0x71, 0xaf, 0xe3, 0x1d, 0x6c, 0xe4, 0x1c, 0x4a, 0x57, 0x52, 0x14, 0xe8, 0x74, 0xf6, 0x27, 0x45,
+	0x90, 0x62, 0xd9, 0x38, 0x73, 0xc8, 0x20, 0xc5, 0x2a, 0x56, 0x4f, 0x8b, 0x4f, 0x7c, 0x9d, 0x4c,
+	0xcb, 0x3d, 0x95, 0x49, 0xe1, 0xab, 0xaa, 0x0a, 0x65, 0xdb, 0xd6, 0x70, 0x60, 0x50, 0x66, 0xf7,
+	0xfc, 0xf1, 0x33, 0xd9, 0xf3, 0xf1, 0x70, 0x95, 0x84, 0x11, 0x6d, 0x49, 0x8a, 0xf5, 0x15, 0xc7,
+	0xce, 0x1c, 0xae, 0x32, 0x78, 0x18, 0x28, 0x61, 0x6f, 0x91, 0x0b, 0xd9, 0xc8, 0x61, 0xd6, 0xf8,
+	0xf3, 0x8c, 0xd3, 0x15, 0xc1, 0xe9, 0xc2, 0xbd, 0x1c, 0x1a, 0xc8, 0x2d, 0x89, 0x7b, 0xb7, 0xac,
+	0x26, 0x53, 0x10, 0x9c, 0x0b, 0x8c, 0x95, 0xda, 0xbb, 0xb7, 0x75, 0x24, 0x98, 0xb4, 0xf6, 0xcf,
+	0x93, 0x4a, 0x6f, 0xcf, 0x8b, 0xa9, 0x33, 0x61, 0xd8, 0xe9, 0x2b, 0x5b, 0x08, 0xc4, 0x9d, 0x10,
+	0xbf, 0x19, 0xfb, 0x03, 0x9c, 0x10, 0x55, 0x9f, 0x9d, 0xb0, 0x1f, 0xb4, 0xbc, 0xe8, 0x60, 0x7d,
+	0xc5, 0xa9, 0x9a, 0xaa, 0x4f, 0x4d, 0x61, 0x40, 0xa3, 0xd2, 0x23, 0x45, 0x27, 0x9f, 0x1c, 0x29,
+	0x6a, 0xbf, 0x4f, 0x26, 0x59, 0x80, 0x0b, 0x6d, 0x2d, 0x27, 0x0e, 0x39, 0x71, 0x18, 0x40, 0x1a,
+	0x60, 0x21, 0x99, 0x40, 0xca, 0xcf, 0xfe, 0x32, 0x21, 0xbb, 0x7e, 0xe0, 0xc7, 0x7b, 0x8c, 0xfb,
+	0xd4, 0x89, 0xb9, 0xab, 0x76, 0xae, 0x2a, 0x2e, 0xa0, 0x71, 0xc4, 0x10, 0x23, 0x1a, 0x27, 0x7e,
+	0xd7, 0x4b, 0x68, 0x4b, 0xc5, 0xec, 0x3b, 0xec, 0x80, 0xaf, 0x42, 0x8c, 0x6e, 0x66, 0x09, 0x1e,
+	0xe7, 0x01, 0x61, 0x90, 0x91, 0xfd, 0x3a, 0xa9, 0xf6, 0xa2, 0xb0, 0x8d, 0xfa, 0xa4, 0xb3, 0x60,
+	0x0c, 0x97, 0xea, 0x96, 0x80, 0x3f, 0xd6, 0x7e, 0x83, 0xa2, 0xb6, 0xff, 0x8b, 0x45, 0xce, 0x45,
+	0x34, 0x66, 0x07, 0xcd, 0x58, 0x55, 0xec, 0x22, 0x5b, 0x94, 0xde, 0x1d, 0xf1, 0x6e, 0xbf, 0x5c,
+	0x69, 0x96, 0x20, 0xcb, 0x98, 0xef, 0xb2, 0x54, 0x36, 0x78, 0x00, 0xff, 0x38, 0x0f, 0xf8, 0x8d,
+	0x3f, 0x5a, 0x5c, 0x1c, 0x4c, 0x27, 0xa1, 0x98, 0xe3, 0x48, 0xff, 0xab, 0x7f, 0xb4, 0x38, 0x2f,
+	0xff, 0xa7, 0xfd, 0x34, 0xd0, 0x2e, 0xdc, 0x42, 0x7a, 0x61, 0x6b, 0x7d, 0xcb, 0x99, 0x36, 0xb7,
+	0x90, 0x2d, 0x04, 0x02, 0xc7, 0xa1, 0x97, 0xa1, 0xe5, 0xd1, 0x6e, 0x18, 0xd0, 0x96, 0x33, 0x93,
+	0x7a, 0x19, 0x56, 0x04, 0x0c, 0x14, 0xd6, 0xfe, 0x0a, 0xfa, 0x72, 0xf1, 0x38, 0x29, 0x7c, 0xb9,
+	0xa3, 0x1d, 0x5b, 0xf9, 0x89, 0x54, 0x7a, 0x72, 0xf1, 0x37, 0x08, 0xb6, 0x76, 0x93, 0x4c, 0x84,
+	0xfd, 0x84, 0x49, 0xe0, 0xce, 0xdc, 0xd1, 0x7c, 0x88, 0x9b, 0x9c, 0x07, 0xbf, 0x5d, 0x2d, 0xfe,
+	0x80, 0xe4, 0x8c, 0xed, 0x6d, 0xe2, 0x0d, 0x8a, 0x88, 0x06, 0xce, 0x3c, 0x33, 0xcf, 0xb2, 0xf6,
+	0xd6, 0x05, 0x0c, 0x14, 0xd6, 0xfe, 0xb3, 0x64, 0x26, 0xec, 0x27, 0x6c, 0xf6, 0xe2, 0x57, 0x8e,
+	0x9d, 0x73, 0x8c, 0xfc, 0x1c, 0x8b, 0xbf, 0xd5, 0x11, 0x60, 0xd2, 0xe1, 0x7a, 0xbe, 0x17, 0xc6,
+	0x09, 0xfe, 0x61, 0x4b, 0xda, 0x25, 0x73, 0x3d, 0xbf, 0xa5, 0xe1, 0xc0, 0xa0, 0xc4, 0xb0, 0xc2,
+	0x73, 0xdd, 0xec, 0xe1, 0xc0, 0xb9, 0xcc, 0x3a, 0x63, 0x75, 0x44, 0xc5, 0x2f, 0xc3, 0x8d, 0x07,
+	0x08, 0x0d, 0x80, 0x61, 0x50, 0x2e, 0xbb, 0xe9, 0x18, 0x1f, 0x04, 0xcd, 0xbd, 0x28, 0x0c, 0xcc,
+	0x1a, 0x3d, 0x7b, 0xcd, 0x1a, 0x59, 0x19, 0x66, 0x33, 0x26, 0x8f, 0x6b, 0xed, 0x59, 0xf4, 0x64,
+	0xe4, 0xa2, 0x20, 0xbf, 0x1e, 0xf6, 0x1d, 0x32, 0x83, 0x2e, 0x5f, 0xbc, 0x30, 0x4a, 0xbd, 0x38,
+	0x0c, 0x9c, 0xe7, 0x8c, 0x0b, 0xe2, 0x33, 0xab, 0x3a, 0xf2, 0x71, 0x16, 0x00, 0x66, 0x61, 0x1c,
+	0x1a, 0xf4, 0xa1, 0x9f, 0xd4, 0xf1, 0x72, 0xfc, 0x15, 0x76, 0xee, 0x63, 0x43, 0xe3, 0xa6, 0x80,
+	0x81, 0xc2, 0xda, 0xff, 0xd8, 0x22, 0x17, 0x74, 0x97, 0xbd, 0x1c, 0x3d, 0xce, 0xf3, 0x05, 0xd2,
+	0x4a, 0x68, 0x4b, 0xc9, 0xbd, 0x1c, 0xde, 0x7c, 0x35, 0x49, 0x37, 0xc6, 0x1c, 0x12, 0xc8, 0xad,
+	0xd4, 0xc2, 0x0a, 0xb9, 0x94, 0xbf, 0x36, 0x1d, 0xa5, 0x9a, 0x8f, 0xe9, 0x5a, 0xfd, 0x1a, 0x79,
+	0x76, 0x68, 0xb5, 0x8e, 0x62, 0x54, 0xd1, 0x75, 0xfc, 0x55, 0xf2, 0xec, 0xd0, 0x31, 0x80, 0x3b,
+	0xa4, 0xd4, 0x15, 0x2d, 0x73, 0x87, 0x1c, 0x50, 0xf4, 0x66, 0xc9, 0xb4, 0x9e, 0x59, 0x85, 0xb9,
+	0xad, 0xb5, 0xbb, 0xd0, 0x68, 0x81, 0x09, 0x1b, 0xa7, 0xe1, 0xb6, 0xde, 0x6c, 0x0c, 0xb8, 0xad,
+	0x15, 0x08, 0x52, 0x19, 0x47, 0xb9, 0xad, 0xff, 0x69, 0x89, 0xa4, 0xe5, 0x4e, 0x78, 0x85, 0x31,
+	0x75, 0x72, 0x97, 0x9e, 0xe8, 0xe4, 0xde, 0x23, 0x73, 0x1e, 0xb3, 0x62, 0x8f, 0x78, 0x71, 0x31,
+	0xbd, 0x3d, 0x6b, 0x72, 0x81, 0x2c, 0x5b, 0x94, 0x14, 0xa7, 0xc5, 0x4f, 0x7e, 0x77, 0x51, 0x49,
+	0x6a, 0x98, 0x5c, 0x20, 0xcb, 0xd6, 0xfd, 0xe7, 0x25, 0x22, 0x97, 0xf1, 0x9f, 0x06, 0x43, 0xa6,
+	0xed, 0x92, 0xf1, 0x88, 0xc6, 0xf2, 0x32, 0xf6, 0x24, 0xdf, 0x2a, 0x81, 0x41, 0x40, 0x60, 0x8c,
+	0xa5, 0x4a, 0x24, 0x5e, 0xc9, 0x5f, 0xaa, 0xdc, 0x07, 0x64, 0x06, 0xdb, 0xd5, 0xe9, 0xd0, 0x4e,
+	0x23, 0xa1, 0xbd, 0x18, 0xe3, 0xe6, 0x63, 0xfc, 0x51, 0xe8, 0xe4, 0x97, 0x06, 0xc2, 0xd2, 0x9e,
+	0x7e, 0x91, 0x84, 0xf6, 0x62, 0xe0, 0xec, 0xdd, 0x6f, 0x56, 0xc8, 0xa4, 0xea, 0xd1, 0x63, 0x18,
+	0xfb, 0x6e, 0xa4, 0x97, 0xd0, 0xf9, 0x18, 0x77, 0xb4, 0x0b, 0xe8, 0xa8, 0x81, 0x2f, 0x07, 0x07,
+	0xfc, 0x82, 0xa8, 0xba, 0x8d, 0x6e, 0x7f, 0xd2, 0xb4, 0xb7, 0x5f, 0xd2, 0x6d, 0xbd, 0x1a, 0x3d,
+	0x27, 0xb2, 0xf7, 0x75, 0x0f, 0x47, 0xb9, 0xc0, 0x82, 0xa0, 0x7c, 0x19, 0xc3, 0x5d, 0x1b, 0x99,
+	0x34, 0x33, 0x95, 0x63, 0xa5, 0x99, 0x79, 0x85, 0x94, 0x69, 0xd0, 0xef, 0xb2, 0x00, 0xcd, 0x49,
+	0xb6, 0x51, 0x97, 0x6f, 0x06, 0xfd, 0xae, 0xd9, 0x18, 0x46, 0xa2, 0xae, 0xc1, 0x4d, 0xe4, 0x5f,
+	0x83, 0x53, 0x1d, 0xaf, 0x1d, 0x33, 0xff, 0x1c, 0x19, 0xe7, 0x19, 0xbd, 0x9c, 0x6a, 0x81, 0x50,
+	0x39, 0x16, 0x00, 0xca, 0x86, 0x64, 0x83, 0x31, 0x03, 0xc1, 0x14, 0x8d, 0x90, 0x31, 0x0d, 0x62,
+	0x9f, 0xc5, 0x43, 0x4f, 0x32, 0x4d, 0x32, 0x3d, 0x84, 0x48, 0x04, 0xa4, 0x34, 0x76, 0x1b, 0xc7,
+	0x30, 0x8f, 0x6d, 0x12, 0x07, 0x9c, 0xd1, 0xa6, 0x95, 0x0c, 0x90, 0x92, 0x53, 0x80, 0xff, 0x03,
+	0xc5, 0xdc, 0x5d, 0x25, 0xa8, 0xf2, 0xae, 0xd5, 0xed, 0xcf, 0x0d, 0xa4, 0x92, 0xf9, 0x99, 0x9c,
+	0x54, 0x32, 0x33, 0x8c, 0x38, 0x27, 0x8b, 0xcc, 0x37, 0xcb, 0x44, 0x33, 0xf4, 0x1c, 0x63, 0x48,
+	0xb7, 0x32, 0xb6, 0xbb, 0xb7, 0x46, 0xb5, 0xdd, 0x49, 0x83, 0x18, 0xef, 0x78, 0xd3, 0x5c, 0x87,
+	0xf5, 0xd8, 0xa3, 0x9d, 0x9e, 0x33, 0x66, 0xd6, 0xe3, 0x16, 0xed, 0xf4, 0x80, 0x61, 0x54, 0xac,
+	0x6b, 0x79, 0x68, 0xac, 0xeb, 0xfb, 0xa4, 0xd2, 0xf6, 0xfa, 0xc2, 0xa2, 0x3b, 0xaa, 0xfd, 0x95,
+	0xc5, 0x36, 0x71, 0xfb, 0x2b, 0xfb, 0x09, 0x9c, 0x27, 0xce, 0xbb, 0x3d, 0xe9, 0x22, 0x73, 0xc6,
+	0x0b, 0xcc, 0x3b, 0xe5, 0x68, 0xe3, 0xf3, 0x4e, 0xfd, 0x85, 0x94, 0x3f, 0x1e, 0x22, 0x9a, 0xfc,
+	0x0a, 0x9e, 0x33, 0x51, 0xe0, 0x10, 0x21, 0xae, 0xf1, 0xf1, 0x43, 0x84, 0xf8, 0x03, 0x92, 0xb3,
+	0x7b, 0x9d, 0x4c, 0x69, 0x29, 0x5d, 0xb0, 0x7f, 0xd5, 0x25, 0x27, 0xad, 0x7f, 0x31, 0x02, 0x11,
+	0x18, 0xc6, 0xfd, 0xcd, 0x31, 0xa2, 0x8e, 0x6c, 0x7a, 0x64, 0xa5, 0xd7, 0xd4, 0x6e, 0xd5, 0x1b,
+	0x37, 0x03, 0xc2, 0x00, 0x04, 0x96, 0x39, 0x25, 0x68, 0xd4, 0x56, 0x9a, 0x8e, 0x53, 0x32, 0x0d,
+	0x1b, 0x77, 0x75, 0x24, 0x98, 0xb4, 0xa8, 0x67, 0x74, 0xbd, 0xc0, 0xdf, 0xa5, 0x71, 0x92, 0x0d,
+	0x31, 0xb9, 0x2b, 0xe0, 0xa0, 0x28, 0xec, 0x35, 0x72, 0x2e, 0xa6, 0xc9, 0xe6, 0x83, 0x80, 0x46,
+	0xea, 0xc6, 0x82, 0xb8, 0xc7, 0xf3, 0xac, 0x3c, 0xc7, 0x36, 0xb2, 0x04, 0x30, 0x58, 0x26, 0xd7,
+	0x03, 0x5f, 0x39, 0xa9, 0x07, 0x1e, 0xb9, 0x08, 0x15, 0x7d, 0xa8, 0x1f, 0x7f, 0x35, 0x83, 0x87,
+	0x81, 0x12, 0x2c, 0x3a, 0xad, 0xe3, 0xb5, 0x63, 0x67, 0x42, 0x8b, 0x4e, 0x43, 0x00, 0x70, 0xb8,
+	0xfb, 0x5b, 0x16, 0x99, 0x01, 0x9a, 0x44, 0x07, 0xcb, 0xbb, 0x68, 0xc4, 0x48, 0x0e, 0xec, 0x5f,
+	0xb3, 0xc8, 0x7c, 0x10, 0xb6, 0xe8, 0x72, 0x90, 0xf8, 0x12, 0x58, 0x28, 0xbf, 0x0b, 0x63, 0xbf,
+	0x91, 0xe1, 0xc8, 0x2f, 0xe0, 0x64, 0xa1, 0x30, 0x20, 0xd9, 0xbd, 0x4c, 0x2e, 0xe6, 0x32, 0x40,
+	0x9d, 0x77, 0x82, 0x61, 0x36, 0x03, 0x8c, 0x3c, 0x92, 0xbb, 0x3e, 0xdf, 0xdc, 0x2b, 0x7c, 0x9a,
+	0x48, 0xa5, 0x20, 0x86, 0x14, 0x6f, 0xbf, 0x48, 0x26, 0x22, 0x76, 0xea, 0x91, 0x41, 0x4a, 0x6c,
+	0xa0, 0xf3, 0x83, 0x50, 0x0c, 0x12, 0x67, 0x7f, 0x9e, 0xcc, 0x0a, 0x83, 0xd4, 0x96, 0x97, 0x24,
+	0x34, 0x92, 0x99, 0x17, 0x2e, 0x89, 0xee, 0x9f, 0xbd, 0x6b, 0x60, 0x21, 0x43, 0xed, 0xfe, 0xfd,
+	0xb2, 0xe8, 0x59, 0x35, 0x1e, 0xbf, 0x40, 0x2a, 0x1d, 0x76, 0x59, 0xca, 0x1a, 0x31, 0x3b, 0x04,
+	0xfb, 0x7c, 0xfc, 0x36, 0x15, 0xe7, 0x64, 0xaf, 0x60, 0x5e, 0xb3, 0x24, 0x92, 0x57, 0xd9, 0xf8,
+	0xec, 0x70, 0xd3, 0xbc, 0x66, 0x0a, 0xf5, 0xd8, 0xfc, 0x0b, 0x7a, 0x31, 0x3b, 0x20, 0x13, 0x3b,
+	0x3c, 0xe1, 0x85, 0x33, 0x56, 0x60, 0xe1, 0x10, 0x49, 0x33, 0x98, 0x2e, 0x22, 0x33, 0x68, 0x3c,
+	0x4e, 0x7f, 0x82, 0x14, 0x82, 0x99, 0x23, 0x3c, 0x39, 0xb2, 0xca, 0x05, 0x82, 0xd4, 0x8c, 0x81,
+	0xcb, 0xf7, 0x40, 0xf9, 0x0f, 0x94, 0x84, 0x8c, 0xa3, 0xb6, 0x72, 0x1c, 0x47, 0xad, 0xdd, 0xc6,
+	0x31, 0xc2, 0xc6, 0x96, 0xb8, 0x32, 0xf2, 0xd9, 0xd1, 0x2b, 0xb8, 0x19, 0xa4, 0x27, 0x39, 0x01,
+	0x00, 0xc9, 0x1d, 0x83, 0x28, 0x48, 0x9a, 0x18, 0xcd, 0xde, 0x27, 0xd5, 0xf8, 0x35, 0xe3, 0xdc,
+	0x36, 0xe2, 0x5d, 0x10, 0xc1, 0x44, 0x8b, 0xdf, 0x16, 0x10, 0x50, 0x02, 0x8e, 0x3a, 0xb4, 0xfd,
+	0xf5, 0x0a, 0x51, 0xa5, 0x9e, 0xd2, 0x99, 0xed, 0x25, 0xd4, 0xf7, 0xdb, 0x69, 0x56, 0x13, 0x45,
+	0x07, 0x0c, 0x0a, 0x02, 0x8b, 0x3a, 0xbf, 0x8c, 0xe7, 0x14, 0x4b, 0x32, 0xfb, 0xd8, 0x32, 0xf4,
+	0x13, 0x14, 0x36, 0xef, 0x14, 0x58, 0x39, 0xb3, 0x53, 0xe0, 0xf8, 0x53, 0x39, 0x05, 0xa2, 0x61,
+	0x20, 0x0a, 0x3b, 0x74, 0x19, 0x36, 0x84, 0xce, 0x9b, 0x0e, 0x27, 0x0e, 0x06, 0x89, 0xcf, 0xa6,
+	0xbc, 0xa9, 0x1e, 0x2f, 0xe5, 0x8d, 0xfd, 0x0f, 0x2c, 0xe2, 0x34, 0xd9, 0x6d, 0x7c, 0xfe, 0x81,
+	0xd6, 0x77, 0x37, 0xc2, 0x64, 0x2b, 0xa2, 0x31, 0x0d, 0x12, 0x67, 0xb2, 0xc0, 0xda, 0x9f, 0x7b,
+	0xc5, 0xbf, 0x76, 0xe5, 0xf0, 0xd1, 0xa2, 0x53, 0x1f, 0x22, 0x0f, 0x86, 0xd6, 0xc4, 0xfd, 0xcb,
+	0x16, 0x99, 0x6d, 0x34, 0x23, 0xbf, 0x97, 0x26, 0x69, 0x38, 0xed, 0x1c, 0x12, 0x2f, 0x91, 0x71,
+	0xae, 0xaa, 0x64, 0x47, 0x2e, 0x0f, 0xd1, 0x02, 0x81, 0xc5, 0x54, 0x69, 0xf3, 0x0d, 0xda, 0xf5,
+	0x7a, 0x7b, 0x2c, 0xba, 0x98, 0x7b, 0xfb, 0xd8, 0x39, 0x40, 0xc0, 0xb2, 0x99, 0xd9, 0x14, 0x31,
+	0xa4, 0x34, 0xb8, 0x15, 0x71, 0x37, 0xa5, 0xb1, 0x15, 0x71, 0x0f, 0x66, 0x0c, 0x12, 0x67, 0xff,
+	0x32, 0x99, 0x78, 0x40, 0xfd, 0xf6, 0x5e, 0x22, 0xa3, 0x13, 0x61, 0xc4, 0x0b, 0x62, 0x66, 0x7d,
+	0x97, 0xee, 0x71, 0xa6, 0xdc, 0xbc, 0x96, 0x5a, 0x9b, 0x38, 0x14, 0xa4, 0xcc, 0x85, 0x37, 0xc8,
+	0xb4, 0x4e, 0x79, 0x22, 0x8b, 0xd7, 0x6f, 0x5b, 0x64, 0x3a, 0x6d, 0x3a, 0xdd, 0x3d, 0xb3, 0xab,
+	0x1c, 0xf8, 0x25, 0x79, 0x03, 0x78, 0xa5, 0xd2, 0x2f, 0xc9, 0xdb, 0x02, 0x02, 0xeb, 0xfe, 0x4f,
+	0x8b, 0xcc, 0xa9, 0x1a, 0x0a, 0x53, 0x5c, 0x2f, 0xeb, 0x24, 0xbe, 0x79, 0x2a, 0x1d, 0xfe, 0x04,
+	0x47, 0x71, 0x2f, 0xeb, 0x28, 0x3e, 0x6d, 0x89, 0x03, 0x36, 0xc4, 0xdf, 0x2d, 0x91, 0xaa, 0xba,
+	0x12, 0xf8, 0x05, 0x52, 0x61, 0x0a, 0x7e, 0x31, 0xd5, 0x84, 0x1d, 0x16, 0x80, 0x73, 0x42, 0x96,
+	0x3c, 0xe5, 0x46, 0xa9, 0x08, 0x4b, 0x23, 0x41, 0xc7, 0x6d, 0x32, 0x86, 0x97, 0xeb, 0xc7, 0x46,
+	0x64, 0xc8, 0x92, 0x38, 0xde, 0x0c, 0x5a, 0x80, 0x5c, 0x58, 0x62, 0x93, 0x30, 0xea, 0x7a, 0x89,
+	0x38, 0x1b, 0xa6, 0x89, 0x4d, 0x18, 0x14, 0x04, 0xd6, 0xfd, 0x1f, 0x25, 0x32, 0xde, 0xe8, 0xef,
+	0xa0, 0xb6, 0xf5, 0xb7, 0xcf, 0x28, 0xe3, 0x94, 0x4a, 0xa0, 0x7c, 0xec, 0xac, 0x53, 0x7a, 0x02,
+	0x8f, 0xb1, 0xa7, 0x94, 0xe8, 0xe9, 0xd4, 0x83, 0xfa, 0x66, 0x86, 0xe6, 0xb4, 0xfa, 0x37, 0x65,
+	0x42, 0x78, 0x9f, 0x6f, 0xf6, 0x92, 0xe3, 0x98, 0x1b, 0x5e, 0x27, 0xd3, 0x32, 0xed, 0xfa, 0x46,
+	0x1a, 0xd6, 0xa0, 0xfc, 0x4e, 0x6b, 0x1a, 0x0e, 0x0c, 0x4a, 0xa6, 0x1d, 0xe2, 0xaa, 0xc6, 0x55,
+	0x9b, 0x6c, 0x18, 0x9f, 0xc2, 0x80, 0x46, 0x65, 0x2f, 0x19, 0xa6, 0x58, 0x7e, 0x0d, 0x79, 0xf6,
+	0x09, 0x66, 0xd4, 0xcf, 0x90, 0x19, 0xf5, 0x6f, 0xd5, 0xef, 0xc8, 0xf0, 0x77, 0x75, 0x8a, 0xdd,
+	0xd2, 0x91, 0x60, 0xd2, 0xe2, 0x39, 0xc4, 0xbc, 0x54, 0xe5, 0x4c, 0x98, 0xe7, 0x10, 0xf3, 0x2e,
+	0x16, 0x64, 0xa8, 0x71, 0x9c, 0xb7, 0xa2, 0x03, 0xe8, 0x07, 0x42, 0x1b, 0x50, 0xe3, 0x7c, 0x85,
+	0x41, 0x41, 0x60, 0xb1, 0x0b, 0xb1, 0x24, 0x8d, 0x38, 0x5c, 0xd8, 0xb1, 0x54, 0x17, 0x36, 0x34,
+	0x1c, 0x18, 0x94, 0x28, 0x41, 0xd8, 0x7a, 0x88, 0x39, 0x93, 0x32, 0xd6, 0x9a, 0x1e, 0x99, 0x0d,
+	0xcd, 0xe3, 0x35, 0x77, 0xbf, 0x7f, 0xea, 0x98, 0x43, 0xd5, 0x28, 0xcb, 0xc3, 0xe5, 0x4d, 0x18,
+	0x64, 0xf8, 0xbb, 0xe7, 0xc9, 0xb9, 0x46, 0xbf, 0xd7, 0xeb, 0xf8, 0xb4, 0xa5, 0x2c, 0x95, 0xee,
+	0x9b, 0x64, 0x4e, 0xe4, 0xe3, 0x50, 0x5a, 0xc4, 0x89, 0xd2, 0xfc, 0xb9, 0xff, 0x62, 0x8c, 0xcc,
+	0x65, 0x5c, 0x38, 0x68, 0x29, 0x37, 0xb7, 0xfe, 0x51, 0xcd, 0xcb, 0xfa, 0x66, 0xc9, 0x67, 0x48,
+	0xae, 0xe6, 0xf0, 0xbe, 0x8c, 0x91, 0x2a, 0x12, 0x35, 0xc8, 0xc2, 0x8a, 0xf8, 0x3a, 0x6b, 0xc4,
+	0x56, 0xf5, 0x09, 0x51, 0x92, 0xa4, 0xca, 0x71, 0x0a, 0xad, 0x51, 0xd3, 0x4a, 0x41, 0x63, 0xd0,
+	0x04, 0xd9, 0x94, 0x4c, 0x30, 0xf9, 0x54, 0x46, 0x87, 0x17, 0x69, 0x55, 0x1a, 0x5e, 0xc2, 0x59,
+	0x82, 0xe4, 0xed, 0xfe, 0x37, 0x8b, 0xe4, 0xbb, 0x5a, 0xed, 0x0f, 0x07, 0x3f, 0xe2, 0x4a, 0xb1,
+	0x66, 0x73, 0xc6, 0x4f, 0xf8, 0x8e, 0x9e, 0xf9, 0x1d, 0xdf, 0x1a, 0xbd, 0xc5, 0x42, 0xd4, 0xc0,
+	0xd7, 0x74, 0xff, 0xb7, 0x45, 0xa6, 0xb6, 0xb7, 0xef, 0x28, 0x33, 0x04, 0x90, 0x4b, 0x31, 0xbf,
+	0x4e, 0xb2, 0xbc, 0x9b, 0xd0, 0xa8, 0x1e, 0x76, 0x7b, 0x1d, 0xaa, 0x86, 0xbe, 0x48, 0xe2, 0xd2,
+	0xc8, 0xa5, 0x80, 0x21, 0x25, 0xed, 0x75, 0x72, 0x5e, 0xc7, 0x08, 0xfb, 0x96, 0xd0, 0xbc, 0xf8,
+	0xbd, 0xbf, 0x41, 0x34, 0xe4, 0x95, 0xc9, 0xb2, 0x12, 0x46, 0x2e, 0x67, 0x2c, 0x9f, 0x95, 0x40,
+	0x43, 0x5e, 0x19, 0x77, 0x93, 0x4c, 0x69, 0xcf, 0x5b, 0xd8, 0x6f, 0x91, 0xf9, 0x66, 0xd8, 0x95,
+	0x67, 0xfc, 0x3b, 0xf4, 0x3e, 0xed, 0x88, 0x26, 0x33, 0x63, 0x54, 0x3d, 0x83, 0x83, 0x01, 0x6a,
+	0xf7, 0xff, 0x3c, 0x4f, 0x54, 0xc0, 0xfb, 0x9f, 0x26, 0xf1, 0x18, 0x29, 0x84, 0xae, 0xa9, 0x42,
+	0x69, 0x2a, 0xc5, 0x43, 0x69, 0xd4, 0x4e, 0x93, 0x09, 0xa7, 0x69, 0xa7, 0xe1, 0x34, 0xe3, 0xa7,
+	0x10, 0x4e, 0xa3, 0xd6, 0x92, 0x81, 0x90, 0x9a, 0xbf, 0x62, 0x91, 0x69, 0x34, 0x59, 0xca, 0xb3,
+	0x09, 0xb3, 0xb3, 0x4e, 0xdd, 0xd8, 0x2c, 0xd4, 0x89, 0x4b, 0x1b, 0x1a, 0x47, 0x7e, 0x38, 0x53,
+	0xdb, 0xb0, 0x8e, 0x02, 0x43, 0xb4, 0xbd, 0xaa, 0x59, 0xd5, 0xb8, 0x9b, 0xeb, 0x4a, 0xde, 0x91,
+	0xea, 0x48, 0x7b, 0xd9, 0xbe, 0xa6, 0x4b, 0x4e, 0x16, 0xb0, 0x41, 0xc9, 0xc0, 0x6b, 0xcd, 0xea,
+	0x2e, 0x20, 0x9a, 0x5a, 0xe9, 0x92, 0x71, 0x1e, 0x65, 0x25, 0xde, 0x64, 0x60, 0x5e, 0x1e, 0x1e,
+	0x81, 0x05, 0x02, 0x63, 0xb7, 0xa5, 0xdb, 0x76, 0xaa, 0x40, 0x0e, 0x46, 0xc3, 0x13, 0x9c, 0xef,
+	0xb7, 0xb5, 0xdf, 0xd6, 0x8d, 0x09, 0xd3, 0xc7, 0x31, 0x26, 0xcc, 0x3c, 0x21, 0x91, 0xf2, 0x78,
+	0xcc, 0x4c, 0x15, 0x2c, 0xb4, 0x6c, 0xea, 0x46, 0x7d, 0xb4, 0x8d, 0xc4, 0xb0, 0x76, 0x48, 0xe7,
+	0x23, 0xc2, 0x40, 0xb0, 0xb7, 0x43, 0xbc, 0xcf, 0x2e, 0x6c, 0x16, 0xb3, 0x05, 0xee, 0xae, 0x65,
+	0x7d, 0x34, 0xf2, 0xca, 0x3d, 0x87, 0x82, 0x12, 0x62, 0x7f, 0x8d, 0x4c, 0x37, 0xb5, 0x7c, 0x99,
+	0xce, 0xcf, 0x16, 0x48, 0xfd, 0x9a, 0x97, 0x78, 0x93, 0xdf, 0x64, 0xd3, 0x31, 0x60, 0x08, 0xc4,
+	0xb4, 0x27, 0xec, 0x51, 0x87, 0x97, 0x0b, 0xf8, 0x72, 0xf1, 0xee, 0xdd, 0xc0, 0x63, 0x0e, 0x1d,
+	0x52, 0x95, 0x94, 0xce, 0x2b, 0x05, 0xec, 0xd2, 0x46, 0x9e, 0x62, 0xde, 0x8f, 0xf2, 0x1f, 0x28,
+	0x09, 0xf8, 0x44, 0x41, 0xcb, 0x6b, 0x3b, 0x73, 0x05, 0x96, 0x5d, 0x2d, 0x59, 0x09, 0x3f, 0xdd,
+	0xae, 0x2c, 0xaf, 0x01, 0x72, 0xc5, 0x67, 0x5b, 0x64, 0x2e, 0xba, 0xf9, 0x22, 0x8a, 0x8c, 0xa9,
+	0x28, 0x73, 0xfb, 0xd4, 0x40, 0x36, 0xbb, 0x9b, 0x64, 0x82, 0xa7, 0x19, 0xe5, 0x81, 0x82, 0x53,
+	0x37, 0x16, 0x86, 0x27, 0x2b, 0x4d, 0x17, 0x53, 0xfe, 0x3f, 0x06, 0x59, 0xd6, 0xfe, 0x86, 0x45,
+	0x66, 0x71, 0x09, 0xaa, 0xa7, 0x59, 0x57, 0xed, 0x02, 0x33, 0x1e, 0xef, 0x49, 0xa7, 0x33, 0x55,
+	0x1d, 0x97, 0xd6, 0x0d, 0x09, 0x90, 0x91, 0x68, 0xf7, 0x48, 0x35, 0xf6, 0x5b, 0xb4, 0xe9, 0x45,
+	0xb1, 0x73, 0xfe, 0xd4, 0xa4, 0xa7, 0x66, 0x78, 0xc1, 0x1b, 0x94, 0x14, 0xfb, 0x2f, 0xb1, 0x67,
+	0x01, 0xc4, 0x4b, 0x2b, 0xe2, 0x89, 0xa0, 0x0b, 0xa7, 0xf9, 0x44, 0xd0, 0x79, 0xfe, 0x26, 0x80,
+	0x21, 0x01, 0xb2, 0x22, 0xed, 0xaf, 0xe3, 0xe3, 0x0e, 0x2c, 0x1f, 0x5b, 0x36, 0x23, 0xe1, 0xc5,
+	0x11, 0xed, 0x2d, 0x2c, 0xa8, 0x71, 0x39, 0x8f, 0x25, 0xe4, 0x4b, 0xb2, 0xbf, 0x4a, 0x66, 0x22,
+	0xdd, 0x65, 0xc6, 0xe2, 0x47, 0x0b, 0x79, 0x87, 0x24, 0x27, 0x1e, 0xbb, 0x6a, 0x80, 0xc0, 0x94,
+	0x85, 0x8f, 0xe2, 0xf4, 0xc4, 0x26, 0xe1, 0xc7, 0x5d, 0x16, 0x7a, 0x3a, 0xc6, 0x95, 0x99, 0xad,
+	0x14, 0x0c, 0x3a, 0x8d, 0xfd, 0x0e, 0x99, 0x4a, 0xc2, 0x8e, 0xba, 0x31, 0xe7, 0xb0, 0xf1, 0x72,
+	0x35, 0x6f, 0xf0, 0x6f, 0x2b, 0xb2, 0xd4, 0x1c, 0x9f, 0xc2, 0x62, 0xd0, 0xf9, 0xa0, 0xbd, 0x40,
+	0x66, 0x04, 0x8c, 0x98, 0x39, 0xe3, 0x59, 0xd3, 0x5e, 0xd0, 0xd0, 0x91, 0x60, 0xd2, 0xa2, 0x1f,
+	0xbb, 0x17, 0xf9, 0x61, 0xe4, 0x27, 0x07, 0xf5, 0x8e, 0x17, 0xc7, 0x8c, 0x01, 0x8f, 0x15, 0x57,
+	0x7e, 0xec, 0xad, 0x2c, 0x01, 0x0c, 0x96, 0x41, 0xa7, 0x8b, 0x04, 0x3a, 0xcf, 0xa5, 0x31, 0xa1,
+	0xb2, 0x2c, 0x28, 0xec, 0x90, 0xb4, 0x3f, 0x57, 0x46, 0x49, 0xfb, 0x63, 0xb7, 0xc8, 0x15, 0xaf,
+	0x9f, 0x84, 0xec, 0x86, 0xaf, 0x59, 0x84, 0xa5, 0xf6, 0x77, 0xae, 0x31, 0x35, 0xe1, 0xda, 0xe1,
+	0xa3, 0xc5, 0x2b, 0xcb, 0x4f, 0xa0, 0x83, 0x27, 0x72, 0xb1, 0xbb, 0x18, 0x80, 0xc3, 0x53, 0x17,
+	0x39, 0x3f, 0x53, 0x60, 0x7f, 0x36, 0xf3, 0x1f, 0xc9, 0x30, 0x1c, 0x0e, 0x03, 0x25, 0xc2, 0xde,
+	0x26, 0x53, 0x7b, 0x61, 0x9c, 0x2c, 0x77, 0x7c, 0x2f, 0xa6, 0xb1, 0x08, 0x95, 0xcd, 0x55, 0x2d,
+	0x6e, 0x49, 0xb2, 0x74, 0x98, 0xdc, 0x4a, 0x4b, 0x82, 0xce, 0xc6, 0xa6, 0xcc, 0x03, 0xd5, 0x67,
+	0x5f, 0x2d, 0x0c, 0x12, 0xfa, 0x30, 0x71, 0xae, 0xb2, 0xb6, 0xbc, 0x94, 0xc7, 0x79, 0x2b, 0x6c,
+	0x35, 0x4c, 0x6a, 0xbe, 0x30, 0x64, 0x80, 0x90, 0xe5, 0x89, 0x86, 0xa1, 0x5e, 0xd8, 0xc2, 0x6c,
+	0xab, 0x5b, 0x1e, 0x26, 0xba, 0x59, 0x34, 0x6d, 0x6b, 0x5b, 0x1a, 0x0e, 0x0c, 0x4a, 0x0c, 0x48,
+	0xe9, 0xf2, 0xfb, 0x67, 0xce, 0x0b, 0x05, 0xd4, 0x70, 0x71, 0x87, 0x8d, 0x6f, 0x3e, 0xe2, 0x0f,
+	0x48, 0xce, 0xf6, 0xaf, 0x5b, 0x64, 0x2e, 0x13, 0x22, 0xed, 0x7c, 0xa2, 0xc8, 0x96, 0x67, 0xf2,
+	0xaa, 0xbd, 0xc4, 0x3a, 0xc9, 0x04, 0x3e, 0x1e, 0x04, 0x41, 0xb6, 0x12, 0xbc, 0xf5, 0xec, 0x0a,
+	0xa8, 0xf3, 0x62, 0xa1, 0xd6, 0x33, 0x1e, 0xb2, 0xf5, 0xec, 0x0f, 0x48, 0xce, 0xe8, 0x1b, 0x14,
+	0x29, 0x22, 0x9c, 0x97, 0x4c, 0xdf, 0xa0, 0xc8, 0x24, 0x01, 0x12, 0xbf, 0xf0, 0x26, 0x39, 0x37,
+	0x70, 0xb0, 0x38, 0xd1, 0x0d, 0xc5, 0x1f, 0xa0, 0x21, 0x41, 0x3b, 0xca, 0x9d, 0xf6, 0x01, 0x78,
+	0x8d, 0x9c, 0x13, 0xef, 0x6f, 0xa2, 0xd6, 0xd9, 0xe9, 0xab, 0xe7, 0x2e, 0xb4, 0x08, 0x1c, 0xc8,
+	0x12, 0xc0, 0x60, 0x19, 0x1c, 0xb1, 0x4d, 0x9e, 0x67, 0x9f, 0xdf, 0x86, 0x2a, 0x9b, 0xa6, 0xcc,
+	0xba, 0x86, 0x03, 0x83, 0xd2, 0xfd, 0x87, 0x16, 0x99, 0x31, 0x76, 0xee, 0x53, 0x77, 0x30, 0xae,
+	0x12, 0xbb, 0xeb, 0x47, 0x51, 0x18, 0xbd, 0x6b, 0xe6, 0x78, 0xc7, 0x1a, 0xb2, 0xe4, 0x2a, 0x77,
+	0x07, 0xb0, 0x90, 0x53, 0xc2, 0xfd, 0x0f, 0x65, 0x92, 0x46, 0x5f, 0xaa, 0x8c, 0x42, 0xd6, 0xd0,
+	0x8c, 0x42, 0x9f, 0x24, 0x55, 0xbc, 0xf8, 0xbf, 0x95, 0xe6, 0x1d, 0x52, 0x9f, 0xe2, 0xed, 0xc6,
+	0xe6, 0x06, 0xa3, 0x54, 0x14, 0x8c, 0xfa, 0xc3, 0x55, 0xbf, 0x93, 0x0c, 0x66, 0xe7, 0x79, 0xfb,
+	0x0b, 0x1c, 0x0e, 0x8a, 0x82, 0x25, 0x92, 0xbf, 0x4f, 0x95, 0x65, 0x3a, 0x4d, 0x24, 0x8f, 0x40,
+	0xe0, 0x38, 0x74, 0x8e, 0x2a, 0xc3, 0xb6, 0xb0, 0xb3, 0xab, 0x9e, 0x52, 0x06, 0x70, 0x48, 0x69,
+	0x98, 0x26, 0x26, 0x8c, 0xb7, 0xce, 0x78, 0x81, 0x7b, 0x20, 0x03, 0x16, 0x60, 0xbe, 0x4c, 0x4b,
+	0x30, 0x28, 0x29, 0x7a, 0x1c, 0x6e, 0xe5, 0xb8, 0x71, 0xb8, 0xd9, 0x9c, 0x1d, 0xd5, 0x53, 0xcc,
+	0xd9, 0x91, 0xe7, 0x2c, 0x9d, 0x7c, 0x2a, 0x79, 0xef, 0x7e, 0x65, 0x8c, 0x4c, 0xbc, 0x4b, 0x23,
+	0x16, 0xfb, 0xf2, 0x0a, 0x99, 0xb8, 0xcf, 0x7f, 0x66, 0xef, 0x21, 0x08, 0x0a, 0x90, 0x78, 0xfc,
+	0xa6, 0x3b, 0x7d, 0xbf, 0xd3, 0x5a, 0x49, 0x27, 0xb8, 0xfa, 0xa6, 0x35, 0x89, 0x80, 0x94, 0x06,
+	0x0b, 0xb4, 0x51, 0xdd, 0xee, 0x76, 0xfd, 0x24, 0x7b, 0x5d, 0x7f, 0x4d, 0x22, 0x20, 0xa5, 0x41,
+	0xdf, 0x42, 0xdb, 0x4f, 0xb6, 0xbd, 0x76, 0xd6, 0x4b, 0xb7, 0xc6, 0xa0, 0x20, 0xb0, 0xcc, 0x01,
+	0xe4, 0x27, 0xdb, 0x11, 0x65, 0x26, 0xd7, 0x81, 0x8b, 0xa4, 0x6b, 0x1a, 0x0e, 0x0c, 0x4a, 0x56,
+	0xa5, 0x50, 0xb4, 0xcc, 0x19, 0xcf, 0x54, 0x49, 0x22, 0x20, 0xa5, 0xc1, 0xb9, 0x81, 0x86, 0x41,
+	0xbf, 0x23, 0xe2, 0x2c, 0xb5, 0xb9, 0x51, 0x17, 0x70, 0x50, 0x14, 0x48, 0x8d, 0xab, 0x1b, 0x3a,
+	0x13, 0xb3, 0xe9, 0xad, 0xb7, 0x04, 0x1c, 0x14, 0x85, 0xfb, 0x2e, 0x99, 0xe1, 0xb3, 0xbc, 0xde,
+	0xf1, 0xfc, 0xee, 0x5a, 0xdd, 0xbe, 0x39, 0x10, 0xb7, 0xfb, 0x4a, 0x4e, 0xdc, 0xee, 0x45, 0xa3,
+	0x50, 0x4e, 0xfc, 0xee, 0x8f, 0x2c, 0x62, 0xa4, 0xdd, 0x54, 0x4f, 0x97, 0x5a, 0x27, 0x7b, 0xba,
+	0xb4, 0xf4, 0xe3, 0x7a, 0xba, 0x14, 0x47, 0x1a, 0xea, 0x14, 0x0d, 0xdc, 0x4e, 0xb9, 0x2d, 0x38,
+	0x1d, 0x69, 0x12, 0x01, 0x29, 0x8d, 0xfb, 0xed, 0x12, 0xa9, 0x9e, 0xe1, 0xfb, 0x06, 0x4d, 0xe3,
+	0x7d, 0x83, 0x53, 0x48, 0x86, 0x9f, 0xf7, 0xb6, 0xc1, 0x7e, 0xe6, 0x6d, 0x83, 0x7a, 0x31, 0x31,
+	0x4f, 0x7e, 0xd7, 0x00, 0xdf, 0x45, 0x91, 0xa4, 0x6c, 0x21, 0xaf, 0xf9, 0x01, 0x0b, 0x55, 0x78,
+	0xfa, 0x9d, 0x19, 0x1a, 0x9d, 0x79, 0xb7, 0x50, 0x2b, 0xf5, 0xaa, 0x0f, 0x7d, 0x58, 0xe8, 0x4f,
+	0x2c, 0xe2, 0xe4, 0x15, 0x38, 0x83, 0xb7, 0x1c, 0x02, 0xf3, 0x2d, 0x87, 0xf5, 0x53, 0x6b, 0xec,
+	0x90, 0x37, 0x1d, 0xfe, 0x70, 0x48, 0x53, 0xb1, 0x37, 0xec, 0xaf, 0xc8, 0x8d, 0xdc, 0x2a, 0xe0,
+	0x55, 0xe4, 0x5c, 0xf3, 0x95, 0x80, 0xaf, 0x90, 0xf1, 0x98, 0xf9, 0xf5, 0x9d, 0x52, 0x01, 0xeb,
+	0x3f, 0x0f, 0x0d, 0x10, 0xd6, 0x50, 0xf6, 0x1b, 0x04, 0x5b, 0xf7, 0x7b, 0xb8, 0xd0, 0x9d, 0xdd,
+	0x4b, 0x1c, 0x3b, 0xe6, 0xd7, 0xfb, 0x5c, 0xa1, 0xaf, 0x37, 0xe4, 0x8b, 0xfd, 0xea, 0x22, 0x31,
+	0x5e, 0xc0, 0x40, 0x5f, 0xb3, 0xd4, 0x99, 0xe5, 0x55, 0xa6, 0x82, 0xc9, 0xa5, 0xd5, 0x52, 0x2a,
+	0x21, 0x31, 0xa4, 0x22, 0x32, 0x21, 0x12, 0xa5, 0x63, 0x85, 0x48, 0x9c, 0xb9, 0x33, 0x2b, 0xdf,
+	0x06, 0x51, 0x7e, 0x2a, 0x36, 0x88, 0x2b, 0xa7, 0x6e, 0x83, 0x78, 0xfe, 0xe9, 0xdb, 0x20, 0x34,
+	0x23, 0x6d, 0xa5, 0x80, 0x91, 0xf6, 0xab, 0xe4, 0xc2, 0xfd, 0x54, 0xd9, 0x50, 0xe3, 0x45, 0x84,
+	0x49, 0xbf, 0x92, 0x6b, 0x79, 0x40, 0xc5, 0x29, 0x4e, 0x68, 0x90, 0x68, 0x6a, 0x4a, 0x7a, 0x9d,
+	0xf7, 0xdd, 0x1c, 0x76, 0x90, 0x2b, 0x24, 0x6b, 0xa2, 0x9b, 0x38, 0x86, 0x89, 0xee, 0x37, 0x87,
+	0xbe, 0x59, 0x5b, 0x3d, 0xf5, 0x37, 0x6b, 0x9f, 0x3d, 0xf1, 0x7b, 0xb5, 0x2f, 0xa6, 0x66, 0x7a,
+	0x1e, 0x6f, 0x93, 0x6f, 0x60, 0xff, 0x56, 0xd6, 0xcd, 0xc8, 0x5f, 0x23, 0x69, 0x14, 0x56, 0x33,
+	0x4e, 0xc1, 0xd5, 0x38, 0x55, 0xc0, 0xd5, 0x98, 0xb1, 0x9f, 0x4e, 0x9f, 0x92, 0xfd, 0x34, 0x20,
+	0xf3, 0x7e, 0x17, 0xaf, 0x62, 0xf4, 0x3b, 0x1d, 0x7e, 0xba, 0x92, 0xaf, 0x00, 0xe4, 0x9e, 0x9b,
+	0x50, 0xc9, 0xec, 0x64, 0xdf, 0x2a, 0x51, 0xca, 0xe9, 0x7a, 0x86, 0x13, 0x0c, 0xf0, 0xc6, 0x61,
+	0xc9, 0x72, 0x19, 0xd0, 0x04, 0x7b, 0xdb, 0x99, 0x4d, 0x9f, 0x53, 0xbf, 0x95, 0x82, 0x41, 0xa7,
+	0xb1, 0x6f, 0x93, 0xc9, 0x56, 0x10, 0x8b, 0x6b, 0x1b, 0x73, 0x6c, 0x95, 0xfa, 0x39, 0x5c, 0xdb,
+	0x56, 0x36, 0x1a, 0xea, 0xc2, 0xc6, 0x95, 0x9c, 0x64, 0x18, 0x0a, 0x0f, 0x69, 0x79, 0xfb, 0x2e,
+	0x63, 0x26, 0xb2, 0xfe, 0x72, 0x77, 0xcf, 0xb5, 0x21, 0x26, 0xc0, 0x95, 0x0d, 0x99, 0xa4, 0x78,
+	0x46, 0x88, 0xe3, 0x7f, 0x21, 0xe5, 0xa0, 0x3d, 0xc6, 0x70, 0xee, 0x89, 0x8f, 0x31, 0xbc, 0x43,
+	0x2e, 0x27, 0x49, 0xc7, 0x88, 0xc6, 0x10, 0x79, 0x50, 0x58, 0x52, 0x9c, 0x0a, 0x7f, 0xc4, 0x08,
+	0x43, 0x4f, 0x72, 0x48, 0x60, 0x58, 0x59, 0x16, 0x96, 0x90, 0x74, 0x94, 0x0b, 0xe0, 0x6a, 0x91,
+	0xb0, 0x84, 0x34, 0xec, 0x45, 0x84, 0x25, 0xa4, 0x00, 0xd0, 0xa5, 0xd8, 0x9b, 0xc3, 0x9c, 0x1f,
+	0xe7, 0xd9, 0x1a, 0x73, 0x72, 0x57, 0x86, 0x6e, 0x3d, 0xbf, 0xf0, 0x44, 0xeb, 0xf9, 0x80, 0xb5,
+	0xff, 0xe2, 0x09, 0xac, 0xfd, 0xef, 0xb3, 0x44, 0x27, 0x6b, 0x75, 0xe7, 0x52, 0x01, 0x8d, 0x8d,
+	0xdd, 0xfa, 0xe4, 0x91, 0x43, 0xec, 0x27, 0x70, 0x9e, 0x98, 0xa8, 0xa8, 0x17, 0xb6, 0x06, 0x9c,
+	0x05, 0xce, 0x65, 0x23, 0xf3, 0xcc, 0x85, 0xad, 0x1c, 0x1a, 0xc8, 0x2d, 0xc9, 0x16, 0xf0, 0x14,
+	0xce, 0xf2, 0xe2, 0x54, 0xc4, 0x02, 0x9e, 0x82, 0x41, 0xa7, 0xc9, 0xda, 0xce, 0x9f, 0x7d, 0x6a,
+	0xb6, 0xf3, 0x85, 0x33, 0xb0, 0x9d, 0x3f, 0x77, 0x6c, 0xdb, 0xf9, 0x2f, 0x93, 0xf3, 0xbd, 0xb0,
+	0xb5, 0xe2, 0xc7, 0x51, 0x9f, 0xdd, 0x9a, 0xa8, 0xf5, 0x5b, 0x6d, 0x9a, 0x30, 0xe3, 0xfb, 0xd4,
+	0x8d, 0x1b, 0x7a, 0x25, 0x7b, 0x6c, 0x11, 0x58, 0xba, 0xff, 0xea, 0x0e, 0x4d, 0xf8, 0xc7, 0xcc,
+	0x96, 0x62, 0xe7, 0x1e, 0x16, 0x3a, 0x95, 0x83, 0x84, 0x3c, 0x39, 0xba, 0xe9, 0xfe, 0xda, 0x53,
+	0x33, 0xdd, 0xbf, 0x45, 0xaa, 0xf1, 0x5e, 0x3f, 0x69, 0x85, 0x0f, 0x02, 0xe6, 0x85, 0x99, 0x54,
+	0x4f, 0xc0, 0x55, 0x1b, 0x02, 0xfe, 0x18, 0x6f, 0x4b, 0x8a, 0xdf, 0x9a, 0x5d, 0x43, 0x40, 0x86,
+	0xbe, 0x01, 0xec, 0xfe, 0x58, 0xdf, 0x00, 0xce, 0x73, 0x49, 0xbc, 0xf0, 0x93, 0xe0, 0x92, 0xf8,
+	0x35, 0x8b, 0xcc, 0xdc, 0xd7, 0x4d, 0x45, 0xce, 0x27, 0x0a, 0x38, 0x58, 0x0d, 0xa3, 0x53, 0xcd,
+	0xc5, 0xb5, 0xca, 0x00, 0x3d, 0xce, 0x02, 0xc0, 0x14, 0x3e, 0xe8, 0xee, 0x7d, 0xf1, 0x0c, 0xdd,
+	0xbd, 0x21, 0x21, 0x52, 0x27, 0x5b, 0xab, 0x33, 0xe7, 0xc9, 0xa8, 0x19, 0x11, 0x97, 0x15, 0x1b,
+	0x1e, 0x05, 0x9e, 0xfe, 0x07, 0x4d, 0x84, 0xfd, 0x17, 0x2d, 0xf9, 0x22, 0xd1, 0xcf, 0x16, 0x78,
+	0x40, 0xd8, 0xd0, 0xde, 0x46, 0x78, 0x96, 0xe8, 0x6b, 0x64, 0x5e, 0x9e, 0xec, 0x84, 0x61, 0x3b,
+	0x16, 0x81, 0x34, 0x05, 0xcf, 0x90, 0x2c, 0x86, 0x72, 0x3b, 0xc3, 0x1a, 0x06, 0x84, 0x15, 0x76,
+	0x43, 0xfd, 0x98, 0xdf, 0x08, 0xfa, 0xe3, 0x0b, 0x64, 0x36, 0xf3, 0x16, 0x9e, 0x4a, 0xa0, 0x67,
+	0x1d, 0x37, 0x81, 0x9e, 0x91, 0xe1, 0xae, 0xf4, 0x54, 0x33, 0xdc, 0x8d, 0x9d, 0x4d, 0x86, 0xbb,
+	0xf9, 0xa7, 0x91, 0xe1, 0xee, 0xdc, 0x89, 0x32, 0xdc, 0x69, 0x19, 0x06, 0xcb, 0x47, 0x64, 0x18,
+	0x5c, 0x26, 0x73, 0x32, 0xb6, 0x97, 0x8a, 0x0c, 0x67, 0xdc, 0x61, 0xa0, 0x2e, 0x65, 0xd6, 0x4d,
+	0x34, 0x64, 0xe9, 0xed, 0x5f, 0x22, 0x95, 0x20, 0x6c, 0xa9, 0x83, 0xef, 0xc6, 0x29, 0x98, 0x62,
+	0xd9, 0x61, 0x4c, 0x4c, 0x67, 0x19, 0xae, 0x54, 0x61, 0xb0, 0xc7, 0xf2, 0x07, 0x70, 0xa1, 0xf6,
+	0x97, 0x88, 0x13, 0xee, 0xee, 0x76, 0x42, 0xaf, 0x95, 0xa6, 0xce, 0x92, 0x3e, 0x0c, 0x7e, 0x09,
+	0xe3, 0x9a, 0x60, 0xe0, 0x6c, 0x0e, 0xa1, 0x83, 0xa1, 0x1c, 0xf0, 0xcc, 0x3c, 0x67, 0x66, 0xad,
+	0x8c, 0x9d, 0x49, 0xd6, 0xcc, 0x5f, 0x3c, 0x8d, 0x66, 0x9a, 0x29, 0x32, 0x45, 0x83, 0xd3, 0xeb,
+	0xb0, 0x26, 0x16, 0xb2, 0x35, 0xb1, 0x23, 0x72, 0xa9, 0x97, 0x67, 0x51, 0x88, 0x9d, 0x89, 0x23,
+	0xed, 0x1a, 0x32, 0xd5, 0xf3, 0xa5, 0x5c, 0x9b, 0x44, 0x0c, 0x43, 0x38, 0xeb, 0xf9, 0xf9, 0xaa,
+	0x4f, 0x2d, 0x3f, 0x9f, 0xf9, 0x2a, 0xe5, 0xcc, 0x59, 0xbc, 0x4a, 0x69, 0xff, 0x28, 0x37, 0x2d,
+	0x24, 0x3f, 0x88, 0xbf, 0x77, 0x1a, 0x1f, 0xfb, 0x27, 0x2e, 0x35, 0xe4, 0xdf, 0xb1, 0xc8, 0x02,
+	0x1f, 0x52, 0x59, 0x7d, 0x8e, 0xbd, 0x66, 0x3c, 0x7b, 0x5a, 0x0e, 0x1c, 0xe6, 0xcc, 0x6f, 0x18,
+	0x82, 0x10, 0x0e, 0x4f, 0x10, 0x8e, 0xe1, 0xe4, 0x03, 0x8a, 0xe3, 0x5c, 0x01, 0x33, 0x55, 0x7e,
+	0xb2, 0xc1, 0xf3, 0x87, 0xc7, 0xd1, 0x15, 0xff, 0xde, 0x50, 0xc3, 0x99, 0xcd, 0x6a, 0xb4, 0x75,
+	0x7a, 0x86, 0x33, 0x3d, 0x09, 0xe2, 0x89, 0xcc, 0x67, 0xdf, 0xd0, 0x7c, 0x94, 0x6b, 0x75, 0xce,
+	0xc6, 0x39, 0x5f, 0xc0, 0x60, 0xb0, 0x1c, 0x29, 0x3e, 0x5c, 0xa1, 0x59, 0xce, 0x70, 0x87, 0x01,
+	0x79, 0xf6, 0xbf, 0xc4, 0xcb, 0xf3, 0xd2, 0x9f, 0xae, 0x22, 0x19, 0x58, 0xb4, 0x41, 0xec, 0x5c,
+	0x60, 0x33, 0xc9, 0x3b, 0x8d, 0x99, 0x54, 0x1f, 0x22, 0x83, 0x4f, 0x28, 0xb5, 0xde, 0x0f, 0x23,
+	0x83, 0xa1, 0x95, 0x5c, 0x38, 0xe0, 0x69, 0xa3, 0x87, 0x6a, 0x54, 0xef, 0x98, 0x1a, 0xd5, 0x9b,
+	0x05, 0x73, 0x3c, 0xea, 0xca, 0xdc, 0xd7, 0x2d, 0x72, 0x21, 0x6f, 0x3f, 0xc8, 0xa9, 0x45, 0xc3,
+	0xac, 0x45, 0x31, 0x75, 0x55, 0xaf, 0xc3, 0xe9, 0x24, 0x89, 0xbc, 0x4d, 0x9e, 0x7f, 0xe2, 0x17,
+	0x3a, 0x51, 0xa8, 0xd5, 0x77, 0x89, 0xe6, 0xf3, 0x49, 0x68, 0xef, 0x4f, 0x2f, 0x1b, 0x8d, 0x74,
+	0xd9, 0xc8, 0x78, 0xfb, 0xb7, 0x72, 0x86, 0x6f, 0xff, 0x8e, 0x8f, 0xf0, 0xf6, 0xef, 0xc4, 0x59,
+	0xbe, 0xfd, 0x5b, 0x3d, 0xe6, 0xdb, 0xbf, 0x93, 0x3f, 0x39, 0x6f, 0xff, 0xa6, 0xe7, 0xe7, 0xe9,
+	0xd3, 0x38, 0x3f, 0x27, 0xb4, 0x57, 0xec, 0x59, 0xdf, 0x99, 0xa7, 0xfd, 0xac, 0xef, 0xec, 0xd3,
+	0x7e, 0xd6, 0x77, 0xee, 0xa7, 0xe3, 0x59, 0xdf, 0x1f, 0x5a, 0x64, 0x3e, 0xab, 0x6e, 0x9d, 0x41,
+	0x14, 0xcb, 0xbe, 0x11, 0xc5, 0xb2, 0x7e, 0x2a, 0xb6, 0xc8, 0xa1, 0x11, 0x2c, 0x3f, 0xd0, 0xa2,
+	0x75, 0x24, 0xf1, 0x19, 0xc4, 0x3f, 0x7c, 0x60, 0xc6, 0x3f, 0xdc, 0x3c, 0x95, 0x46, 0x0e, 0x89,
+	0x83, 0xf8, 0x90, 0xe4, 0x59, 0x60, 0x8f, 0x97, 0x1c, 0xc2, 0x08, 0x07, 0x2e, 0x1d, 0x3b, 0x1c,
+	0xf8, 0xff, 0xe6, 0xf4, 0x2a, 0x53, 0xd4, 0xbf, 0x4a, 0xa6, 0x1f, 0x68, 0x4a, 0x7d, 0xa1, 0x1b,
+	0xff, 0xc6, 0xa9, 0x41, 0xd5, 0x4a, 0x87, 0x82, 0x21, 0xcc, 0xfe, 0x20, 0x15, 0x8e, 0x9f, 0xe3,
+	0xc8, 0x04, 0x2b, 0xc3, 0x86, 0x2f, 0x53, 0x70, 0xef, 0x69, 0x9c, 0xd8, 0xdd, 0x4c, 0x83, 0xb7,
+	0x3b, 0x43, 0xa6, 0xde, 0xf3, 0x7b, 0xca, 0xac, 0xba, 0xf4, 0x9d, 0x1f, 0x5e, 0x7d, 0xe6, 0x7b,
+	0x3f, 0xbc, 0xfa, 0xcc, 0xf7, 0x7f, 0x78, 0xf5, 0x99, 0x8f, 0x0f, 0xaf, 0x5a, 0xdf, 0x39, 0xbc,
+	0x6a, 0x7d, 0xef, 0xf0, 0xaa, 0xf5, 0xfd, 0xc3, 0xab, 0xd6, 0x0f, 0x0e, 0xaf, 0x5a, 0x7f, 0xe3,
+	0x8f, 0xaf, 0x3e, 0xf3, 0x5e, 0x55, 0xb6, 0xed, 0xff, 0x0d, 0x00, 0x95, 0x6a, 0xe1, 0x8a, 0xc8,
+	0xa2, 0x00, 0x00,
 }
 
 func (m *Amount) Marshal() (dAtA []byte, err error) {
@@ -3421,7 +4038,7 @@ func (m *Arguments) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Artifact) Marshal() (dAtA []byte, err error) {
+func (m *ArtGCStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3431,25 +4048,87 @@ func (m *Artifact) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Artifact) MarshalTo(dAtA []byte) (int, error) {
+func (m *ArtGCStatus) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Artifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ArtGCStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i--
-	if m.RecurseMode {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
+	if len(m.StrategiesProcessed) > 0 {
+		keysForStrategiesProcessed := make([]string, 0, len(m.StrategiesProcessed))
+		for k := range m.StrategiesProcessed {
+			keysForStrategiesProcessed = append(keysForStrategiesProcessed, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForStrategiesProcessed)
+		for iNdEx := len(keysForStrategiesProcessed) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.StrategiesProcessed[string(keysForStrategiesProcessed[iNdEx])]
+			baseI := i
+			i--
+			if v {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+			i -= len(keysForStrategiesProcessed[iNdEx])
+			copy(dAtA[i:], keysForStrategiesProcessed[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForStrategiesProcessed[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	i--
-	dAtA[i] = 0x50
-	i -= len(m.SubPath)
+	return len(dAtA) - i, nil
+}
+
+func (m *Artifact) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Artifact) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Artifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ArtifactGC != nil {
+		{
+			size, err := m.ArtifactGC.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	i--
+	if m.RecurseMode {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x50
+	i -= len(m.SubPath)
 	copy(dAtA[i:], m.SubPath)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SubPath)))
 	i--
@@ -3512,6 +4191,34 @@ func (m *Artifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ArtifactGC) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArtifactGC) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArtifactGC) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Strategy)
+	copy(dAtA[i:], m.Strategy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Strategy)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ArtifactLocation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3532,6 +4239,18 @@ func (m *ArtifactLocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Azure != nil {
+		{
+			size, err := m.Azure.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
 	if m.GCS != nil {
 		{
 			size, err := m.GCS.MarshalToSizedBuffer(dAtA[:i])
@@ -3805,6 +4524,109 @@ func (m *ArtifactoryAuth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AzureArtifact) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AzureArtifact) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AzureArtifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Blob)
+	copy(dAtA[i:], m.Blob)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Blob)))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.AzureBlobContainer.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AzureBlobContainer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AzureBlobContainer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AzureBlobContainer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.UseSDKCreds {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x28
+	if m.SASTokenSecret != nil {
+		{
+			size, err := m.SASTokenSecret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.AccountKeySecret != nil {
+		{
+			size, err := m.AccountKeySecret.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	i -= len(m.Container)
+	copy(dAtA[i:], m.Container)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Container)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Endpoint)
+	copy(dAtA[i:], m.Endpoint)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Endpoint)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Backoff) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3870,6 +4692,18 @@ func (m *Cache) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Database != nil {
+		{
+			size, err := m.Database.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.ConfigMap != nil {
 		{
 			size, err := m.ConfigMap.MarshalToSizedBuffer(dAtA[:i])
@@ -3885,6 +4719,51 @@ func (m *Cache) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ChildWorkflow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChildWorkflow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChildWorkflow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Arguments.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.WorkflowTemplateRef != nil {
+		{
+			size, err := m.WorkflowTemplateRef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ClusterWorkflowTemplate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4013,7 +4892,7 @@ func (m *Condition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ContinueOn) Marshal() (dAtA []byte, err error) {
+func (m *ContainerNode) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4023,36 +4902,39 @@ func (m *ContinueOn) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ContinueOn) MarshalTo(dAtA []byte) (int, error) {
+func (m *ContainerNode) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ContinueOn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ContainerNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i--
-	if m.Failed {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
+	if len(m.Dependencies) > 0 {
+		for iNdEx := len(m.Dependencies) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Dependencies[iNdEx])
+			copy(dAtA[i:], m.Dependencies[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Dependencies[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	i--
-	dAtA[i] = 0x10
-	i--
-	if m.Error {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
+	{
+		size, err := m.Container.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x8
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *Counter) Marshal() (dAtA []byte, err error) {
+func (m *ContainerSetTemplate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4062,23 +4944,113 @@ func (m *Counter) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Counter) MarshalTo(dAtA []byte) (int, error) {
+func (m *ContainerSetTemplate) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Counter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ContainerSetTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.Value)
-	copy(dAtA[i:], m.Value)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Value)))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
+	if len(m.VolumeMounts) > 0 {
+		for iNdEx := len(m.VolumeMounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VolumeMounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Containers) > 0 {
+		for iNdEx := len(m.Containers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Containers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContinueOn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContinueOn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContinueOn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.Failed {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x10
+	i--
+	if m.Error {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *Counter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Counter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Counter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Value)
+	copy(dAtA[i:], m.Value)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Value)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
 
 func (m *CreateS3BucketOptions) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
@@ -4111,6 +5083,86 @@ func (m *CreateS3BucketOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CronExclusionWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CronExclusionWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CronExclusionWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Timezone)
+	copy(dAtA[i:], m.Timezone)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Timezone)))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Dates) > 0 {
+		for iNdEx := len(m.Dates) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Dates[iNdEx])
+			copy(dAtA[i:], m.Dates[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Dates[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	i -= len(m.Duration)
+	copy(dAtA[i:], m.Duration)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Duration)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Start)
+	copy(dAtA[i:], m.Start)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Start)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CronSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CronSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CronSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Timezone)
+	copy(dAtA[i:], m.Timezone)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Timezone)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Schedule)
+	copy(dAtA[i:], m.Schedule)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Schedule)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *CronWorkflow) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4231,6 +5283,34 @@ func (m *CronWorkflowSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ExclusionWindows) > 0 {
+		for iNdEx := len(m.ExclusionWindows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExclusionWindows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.Schedules) > 0 {
+		for iNdEx := len(m.Schedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if m.WorkflowMetadata != nil {
 		{
 			size, err := m.WorkflowMetadata.MarshalToSizedBuffer(dAtA[:i])
@@ -4377,6 +5457,73 @@ func (m *DAGTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.WithArtifact != nil {
+		{
+			size, err := m.WithArtifact.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.Loop != nil {
+		{
+			size, err := m.Loop.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.Inline != nil {
+		{
+			size, err := m.Inline.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.Hooks) > 0 {
+		keysForHooks := make([]string, 0, len(m.Hooks))
+		for k := range m.Hooks {
+			keysForHooks = append(keysForHooks, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForHooks)
+		for iNdEx := len(keysForHooks) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Hooks[string(keysForHooks[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForHooks[iNdEx])
+			copy(dAtA[i:], keysForHooks[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForHooks[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
 	i -= len(m.Depends)
 	copy(dAtA[i:], m.Depends)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Depends)))
@@ -4531,6 +5678,34 @@ func (m *DAGTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DatabaseCache) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DatabaseCache) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DatabaseCache) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Event) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4587,7 +5762,42 @@ func (m *ExecutorConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *GCSArtifact) Marshal() (dAtA []byte, err error) {
+func (m *ExportTo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExportTo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExportTo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConfigMapKeyRef != nil {
+		{
+			size, err := m.ConfigMapKeyRef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GCSArtifact) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4959,6 +6169,68 @@ func (m *HDFSKrbConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HTTP) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HTTP) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HTTP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.SuccessCondition)
+	copy(dAtA[i:], m.SuccessCondition)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SuccessCondition)))
+	i--
+	dAtA[i] = 0x32
+	i -= len(m.Body)
+	copy(dAtA[i:], m.Body)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Body)))
+	i--
+	dAtA[i] = 0x2a
+	if m.TimeoutSeconds != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.TimeoutSeconds))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Headers) > 0 {
+		for iNdEx := len(m.Headers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Headers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	i -= len(m.URL)
+	copy(dAtA[i:], m.URL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Method)
+	copy(dAtA[i:], m.Method)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Method)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *HTTPArtifact) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5001,6 +6273,86 @@ func (m *HTTPArtifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HTTPHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HTTPHeader) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HTTPHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ValueFrom != nil {
+		{
+			size, err := m.ValueFrom.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	i -= len(m.Value)
+	copy(dAtA[i:], m.Value)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Value)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *HTTPHeaderSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HTTPHeaderSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HTTPHeaderSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SecretKeyRef != nil {
+		{
+			size, err := m.SecretKeyRef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Header) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5157,6 +6509,61 @@ func (m *Item) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *LifecycleHook) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LifecycleHook) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LifecycleHook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Expression)
+	copy(dAtA[i:], m.Expression)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Expression)))
+	i--
+	dAtA[i] = 0x22
+	if m.TemplateRef != nil {
+		{
+			size, err := m.TemplateRef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Arguments.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Template)
+	copy(dAtA[i:], m.Template)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Template)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Link) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5195,6 +6602,42 @@ func (m *Link) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Loop) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Loop) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Loop) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Delay)
+	copy(dAtA[i:], m.Delay)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Delay)))
+	i--
+	dAtA[i] = 0x1a
+	i = encodeVarintGenerated(dAtA, i, uint64(m.MaxIterations))
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.Until)
+	copy(dAtA[i:], m.Until)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Until)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *MemoizationStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5215,6 +6658,16 @@ func (m *MemoizationStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.MaxAge)
+	copy(dAtA[i:], m.MaxAge)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.MaxAge)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.CacheType)
+	copy(dAtA[i:], m.CacheType)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CacheType)))
+	i--
+	dAtA[i] = 0x22
 	i -= len(m.CacheName)
 	copy(dAtA[i:], m.CacheName)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CacheName)))
@@ -5554,24 +7007,62 @@ func (m *NodeStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.Progress)
-	copy(dAtA[i:], m.Progress)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Progress)))
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0xd2
-	if m.SynchronizationStatus != nil {
-		{
-			size, err := m.SynchronizationStatus.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
+	if len(m.WithArtifactChildren) > 0 {
+		keysForWithArtifactChildren := make([]string, 0, len(m.WithArtifactChildren))
+		for k := range m.WithArtifactChildren {
+			keysForWithArtifactChildren = append(keysForWithArtifactChildren, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForWithArtifactChildren)
+		for iNdEx := len(keysForWithArtifactChildren) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.WithArtifactChildren[string(keysForWithArtifactChildren[iNdEx])]
+			baseI := i
+			i = encodeVarintGenerated(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(keysForWithArtifactChildren[iNdEx])
+			copy(dAtA[i:], keysForWithArtifactChildren[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForWithArtifactChildren[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xea
+		}
+	}
+	if m.ExitCode != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.ExitCode))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe0
+	}
+	i -= len(m.FailureReason)
+	copy(dAtA[i:], m.FailureReason)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.FailureReason)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xda
+	i -= len(m.Progress)
+	copy(dAtA[i:], m.Progress)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Progress)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xd2
+	if m.SynchronizationStatus != nil {
+		{
+			size, err := m.SynchronizationStatus.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
 		i--
 		dAtA[i] = 0xca
 	}
@@ -6046,6 +7537,43 @@ func (m *Parameter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExportTo != nil {
+		{
+			size, err := m.ExportTo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	i--
+	if m.Sensitive {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x48
+	if m.Schema != nil {
+		{
+			size, err := m.Schema.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	i -= len(m.Type)
+	copy(dAtA[i:], m.Type)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
+	i--
+	dAtA[i] = 0x3a
 	if len(m.Enum) > 0 {
 		for iNdEx := len(m.Enum) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Enum[iNdEx])
@@ -6361,6 +7889,50 @@ func (m *RetryNodeAntiAffinity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RetryOn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RetryOn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RetryOn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.MessagePattern)
+	copy(dAtA[i:], m.MessagePattern)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.MessagePattern)))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Reasons) > 0 {
+		for iNdEx := len(m.Reasons) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Reasons[iNdEx])
+			copy(dAtA[i:], m.Reasons[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Reasons[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ExitCodes) > 0 {
+		for iNdEx := len(m.ExitCodes) - 1; iNdEx >= 0; iNdEx-- {
+			i = encodeVarintGenerated(dAtA, i, uint64(m.ExitCodes[iNdEx]))
+			i--
+			dAtA[i] = 0x8
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *RetryStrategy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6381,6 +7953,25 @@ func (m *RetryStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RetryOn) > 0 {
+		for iNdEx := len(m.RetryOn) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RetryOn[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	i -= len(m.Expression)
+	copy(dAtA[i:], m.Expression)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Expression)))
+	i--
+	dAtA[i] = 0x2a
 	if m.Affinity != nil {
 		{
 			size, err := m.Affinity.MarshalToSizedBuffer(dAtA[:i])
@@ -6614,6 +8205,28 @@ func (m *SemaphoreHolding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Weights) > 0 {
+		keysForWeights := make([]string, 0, len(m.Weights))
+		for k := range m.Weights {
+			keysForWeights = append(keysForWeights, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForWeights)
+		for iNdEx := len(keysForWeights) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Weights[string(keysForWeights[iNdEx])]
+			baseI := i
+			i = encodeVarintGenerated(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(keysForWeights[iNdEx])
+			copy(dAtA[i:], keysForWeights[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForWeights[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Holders) > 0 {
 		for iNdEx := len(m.Holders) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Holders[iNdEx])
@@ -6651,6 +8264,9 @@ func (m *SemaphoreRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Weight))
+	i--
+	dAtA[i] = 0x10
 	if m.ConfigMapKeyRef != nil {
 		{
 			size, err := m.ConfigMapKeyRef.MarshalToSizedBuffer(dAtA[:i])
@@ -6997,6 +8613,34 @@ func (m *Synchronization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Mutexes) > 0 {
+		for iNdEx := len(m.Mutexes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Mutexes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Semaphores) > 0 {
+		for iNdEx := len(m.Semaphores) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Semaphores[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.Mutex != nil {
 		{
 			size, err := m.Mutex.MarshalToSizedBuffer(dAtA[:i])
@@ -7157,6 +8801,48 @@ func (m *Template) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Workflow != nil {
+		{
+			size, err := m.Workflow.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xca
+	}
+	if m.HTTP != nil {
+		{
+			size, err := m.HTTP.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xc2
+	}
+	if m.ContainerSet != nil {
+		{
+			size, err := m.ContainerSet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xba
+	}
 	i -= len(m.Timeout)
 	copy(dAtA[i:], m.Timeout)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Timeout)))
@@ -7709,6 +9395,30 @@ func (m *ValueFrom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ConfigMapKeyRef != nil {
+		{
+			size, err := m.ConfigMapKeyRef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.SecretKeyRef != nil {
+		{
+			size, err := m.SecretKeyRef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
 	i -= len(m.Event)
 	copy(dAtA[i:], m.Event)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Event)))
@@ -7847,6 +9557,47 @@ func (m *VolumeClaimGC) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *WithArtifact) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WithArtifact) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WithArtifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.BatchSize))
+	i--
+	dAtA[i] = 0x18
+	{
+		size, err := m.ArtifactLocation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.From)
+	copy(dAtA[i:], m.From)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.From)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Workflow) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8102,9 +9853,9 @@ func (m *WorkflowSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.RetryStrategy != nil {
+	if m.TemplateDefaults != nil {
 		{
-			size, err := m.RetryStrategy.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.TemplateDefaults.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -8114,15 +9865,74 @@ func (m *WorkflowSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x2
 		i--
-		dAtA[i] = 0xaa
+		dAtA[i] = 0xc2
 	}
-	if m.VolumeClaimGC != nil {
-		{
-			size, err := m.VolumeClaimGC.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
+	if len(m.Hooks) > 0 {
+		keysForHooks := make([]string, 0, len(m.Hooks))
+		for k := range m.Hooks {
+			keysForHooks = append(keysForHooks, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForHooks)
+		for iNdEx := len(keysForHooks) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Hooks[string(keysForHooks[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForHooks[iNdEx])
+			copy(dAtA[i:], keysForHooks[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForHooks[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if m.ArtifactGC != nil {
+		{
+			size, err := m.ArtifactGC.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xb2
+	}
+	if m.RetryStrategy != nil {
+		{
+			size, err := m.RetryStrategy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xaa
+	}
+	if m.VolumeClaimGC != nil {
+		{
+			size, err := m.VolumeClaimGC.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
@@ -8540,6 +10350,46 @@ func (m *WorkflowStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ConfigMapParameterValues) > 0 {
+		keysForConfigMapParameterValues := make([]string, 0, len(m.ConfigMapParameterValues))
+		for k := range m.ConfigMapParameterValues {
+			keysForConfigMapParameterValues = append(keysForConfigMapParameterValues, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForConfigMapParameterValues)
+		for iNdEx := len(keysForConfigMapParameterValues) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.ConfigMapParameterValues[string(keysForConfigMapParameterValues[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForConfigMapParameterValues[iNdEx])
+			copy(dAtA[i:], keysForConfigMapParameterValues[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForConfigMapParameterValues[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if m.ArtifactGCStatus != nil {
+		{
+			size, err := m.ArtifactGCStatus.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
 	if m.ArtifactRepositoryRef != nil {
 		{
 			size, err := m.ArtifactRepositoryRef.MarshalToSizedBuffer(dAtA[:i])
@@ -8773,6 +10623,71 @@ func (m *WorkflowStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.WithArtifact != nil {
+		{
+			size, err := m.WithArtifact.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.Loop != nil {
+		{
+			size, err := m.Loop.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.Inline != nil {
+		{
+			size, err := m.Inline.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.Hooks) > 0 {
+		keysForHooks := make([]string, 0, len(m.Hooks))
+		for k := range m.Hooks {
+			keysForHooks = append(keysForHooks, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForHooks)
+		for iNdEx := len(keysForHooks) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Hooks[string(keysForHooks[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForHooks[iNdEx])
+			copy(dAtA[i:], keysForHooks[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForHooks[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x62
+		}
+	}
 	i -= len(m.OnExit)
 	copy(dAtA[i:], m.OnExit)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.OnExit)))
@@ -9119,6 +11034,23 @@ func (m *Arguments) Size() (n int) {
 	return n
 }
 
+func (m *ArtGCStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.StrategiesProcessed) > 0 {
+		for k, v := range m.StrategiesProcessed {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + 1
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
 func (m *Artifact) Size() (n int) {
 	if m == nil {
 		return 0
@@ -9146,6 +11078,21 @@ func (m *Artifact) Size() (n int) {
 	l = len(m.SubPath)
 	n += 1 + l + sovGenerated(uint64(l))
 	n += 2
+	if m.ArtifactGC != nil {
+		l = m.ArtifactGC.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *ArtifactGC) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Strategy)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -9190,6 +11137,10 @@ func (m *ArtifactLocation) Size() (n int) {
 		l = m.GCS.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Azure != nil {
+		l = m.Azure.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -9250,6 +11201,41 @@ func (m *ArtifactoryAuth) Size() (n int) {
 	return n
 }
 
+func (m *AzureArtifact) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AzureBlobContainer.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Blob)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *AzureBlobContainer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Endpoint)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Container)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.AccountKeySecret != nil {
+		l = m.AccountKeySecret.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.SASTokenSecret != nil {
+		l = m.SASTokenSecret.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 2
+	return n
+}
+
 func (m *Backoff) Size() (n int) {
 	if m == nil {
 		return 0
@@ -9277,6 +11263,25 @@ func (m *Cache) Size() (n int) {
 		l = m.ConfigMap.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Database != nil {
+		l = m.Database.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *ChildWorkflow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WorkflowTemplateRef != nil {
+		l = m.WorkflowTemplateRef.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = m.Arguments.Size()
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -9325,6 +11330,44 @@ func (m *Condition) Size() (n int) {
 	return n
 }
 
+func (m *ContainerNode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Container.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Dependencies) > 0 {
+		for _, s := range m.Dependencies {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ContainerSetTemplate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Containers) > 0 {
+		for _, e := range m.Containers {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.VolumeMounts) > 0 {
+		for _, e := range m.VolumeMounts {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *ContinueOn) Size() (n int) {
 	if m == nil {
 		return 0
@@ -9357,49 +11400,83 @@ func (m *CreateS3BucketOptions) Size() (n int) {
 	return n
 }
 
-func (m *CronWorkflow) Size() (n int) {
+func (m *CronExclusionWindow) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.ObjectMeta.Size()
+	l = len(m.Start)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Spec.Size()
+	l = len(m.Duration)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Status.Size()
+	if len(m.Dates) > 0 {
+		for _, s := range m.Dates {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.Timezone)
 	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *CronWorkflowList) Size() (n int) {
+func (m *CronSchedule) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.ListMeta.Size()
+	l = len(m.Schedule)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Timezone)
 	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
 	return n
 }
 
-func (m *CronWorkflowSpec) Size() (n int) {
+func (m *CronWorkflow) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.WorkflowSpec.Size()
+	l = m.ObjectMeta.Size()
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Schedule)
+	l = m.Spec.Size()
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.ConcurrencyPolicy)
+	l = m.Status.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *CronWorkflowList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CronWorkflowSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.WorkflowSpec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Schedule)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ConcurrencyPolicy)
 	n += 1 + l + sovGenerated(uint64(l))
 	n += 2
 	if m.StartingDeadlineSeconds != nil {
@@ -9417,6 +11494,18 @@ func (m *CronWorkflowSpec) Size() (n int) {
 		l = m.WorkflowMetadata.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if len(m.Schedules) > 0 {
+		for _, e := range m.Schedules {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.ExclusionWindows) > 0 {
+		for _, e := range m.ExclusionWindows {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -9489,6 +11578,27 @@ func (m *DAGTask) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.Depends)
 	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Hooks) > 0 {
+		for k, v := range m.Hooks {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if m.Inline != nil {
+		l = m.Inline.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Loop != nil {
+		l = m.Loop.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.WithArtifact != nil {
+		l = m.WithArtifact.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -9512,6 +11622,17 @@ func (m *DAGTemplate) Size() (n int) {
 	return n
 }
 
+func (m *DatabaseCache) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *Event) Size() (n int) {
 	if m == nil {
 		return 0
@@ -9534,6 +11655,19 @@ func (m *ExecutorConfig) Size() (n int) {
 	return n
 }
 
+func (m *ExportTo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ConfigMapKeyRef != nil {
+		l = m.ConfigMapKeyRef.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *GCSArtifact) Size() (n int) {
 	if m == nil {
 		return 0
@@ -9669,6 +11803,32 @@ func (m *HDFSKrbConfig) Size() (n int) {
 	return n
 }
 
+func (m *HTTP) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Method)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.URL)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Headers) > 0 {
+		for _, e := range m.Headers {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.TimeoutSeconds != nil {
+		n += 1 + sovGenerated(uint64(*m.TimeoutSeconds))
+	}
+	l = len(m.Body)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.SuccessCondition)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *HTTPArtifact) Size() (n int) {
 	if m == nil {
 		return 0
@@ -9686,6 +11846,36 @@ func (m *HTTPArtifact) Size() (n int) {
 	return n
 }
 
+func (m *HTTPHeader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Value)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.ValueFrom != nil {
+		l = m.ValueFrom.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *HTTPHeaderSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SecretKeyRef != nil {
+		l = m.SecretKeyRef.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *Header) Size() (n int) {
 	if m == nil {
 		return 0
@@ -9750,6 +11940,25 @@ func (m *Item) Size() (n int) {
 	return n
 }
 
+func (m *LifecycleHook) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Template)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Arguments.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.TemplateRef != nil {
+		l = m.TemplateRef.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Expression)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *Link) Size() (n int) {
 	if m == nil {
 		return 0
@@ -9765,6 +11974,20 @@ func (m *Link) Size() (n int) {
 	return n
 }
 
+func (m *Loop) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Until)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.MaxIterations))
+	l = len(m.Delay)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *MemoizationStatus) Size() (n int) {
 	if m == nil {
 		return 0
@@ -9776,6 +11999,10 @@ func (m *MemoizationStatus) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.CacheName)
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.CacheType)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.MaxAge)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -9976,6 +12203,19 @@ func (m *NodeStatus) Size() (n int) {
 	}
 	l = len(m.Progress)
 	n += 2 + l + sovGenerated(uint64(l))
+	l = len(m.FailureReason)
+	n += 2 + l + sovGenerated(uint64(l))
+	if m.ExitCode != nil {
+		n += 2 + sovGenerated(uint64(*m.ExitCode))
+	}
+	if len(m.WithArtifactChildren) > 0 {
+		for k, v := range m.WithArtifactChildren {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + sovGenerated(uint64(v))
+			n += mapEntrySize + 2 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -10101,6 +12341,17 @@ func (m *Parameter) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	l = len(m.Type)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Schema != nil {
+		l = m.Schema.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 2
+	if m.ExportTo != nil {
+		l = m.ExportTo.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -10207,6 +12458,28 @@ func (m *RetryNodeAntiAffinity) Size() (n int) {
 	return n
 }
 
+func (m *RetryOn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ExitCodes) > 0 {
+		for _, e := range m.ExitCodes {
+			n += 1 + sovGenerated(uint64(e))
+		}
+	}
+	if len(m.Reasons) > 0 {
+		for _, s := range m.Reasons {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.MessagePattern)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *RetryStrategy) Size() (n int) {
 	if m == nil {
 		return 0
@@ -10227,6 +12500,14 @@ func (m *RetryStrategy) Size() (n int) {
 		l = m.Affinity.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	l = len(m.Expression)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.RetryOn) > 0 {
+		for _, e := range m.RetryOn {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -10299,6 +12580,14 @@ func (m *SemaphoreHolding) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if len(m.Weights) > 0 {
+		for k, v := range m.Weights {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + sovGenerated(uint64(v))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -10312,6 +12601,7 @@ func (m *SemaphoreRef) Size() (n int) {
 		l = m.ConfigMapKeyRef.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	n += 1 + sovGenerated(uint64(m.Weight))
 	return n
 }
 
@@ -10443,6 +12733,18 @@ func (m *Synchronization) Size() (n int) {
 		l = m.Mutex.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if len(m.Semaphores) > 0 {
+		for _, e := range m.Semaphores {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Mutexes) > 0 {
+		for _, e := range m.Mutexes {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -10637,6 +12939,18 @@ func (m *Template) Size() (n int) {
 	}
 	l = len(m.Timeout)
 	n += 2 + l + sovGenerated(uint64(l))
+	if m.ContainerSet != nil {
+		l = m.ContainerSet.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.HTTP != nil {
+		l = m.HTTP.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.Workflow != nil {
+		l = m.Workflow.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -10693,6 +13007,14 @@ func (m *ValueFrom) Size() (n int) {
 	}
 	l = len(m.Event)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.SecretKeyRef != nil {
+		l = m.SecretKeyRef.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.ConfigMapKeyRef != nil {
+		l = m.ConfigMapKeyRef.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -10732,6 +13054,20 @@ func (m *VolumeClaimGC) Size() (n int) {
 	return n
 }
 
+func (m *WithArtifact) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.ArtifactLocation.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.BatchSize))
+	return n
+}
+
 func (m *Workflow) Size() (n int) {
 	if m == nil {
 		return 0
@@ -10955,6 +13291,23 @@ func (m *WorkflowSpec) Size() (n int) {
 		l = m.RetryStrategy.Size()
 		n += 2 + l + sovGenerated(uint64(l))
 	}
+	if m.ArtifactGC != nil {
+		l = m.ArtifactGC.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Hooks) > 0 {
+		for k, v := range m.Hooks {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 2 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if m.TemplateDefaults != nil {
+		l = m.TemplateDefaults.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -11033,6 +13386,18 @@ func (m *WorkflowStatus) Size() (n int) {
 		l = m.ArtifactRepositoryRef.Size()
 		n += 2 + l + sovGenerated(uint64(l))
 	}
+	if m.ArtifactGCStatus != nil {
+		l = m.ArtifactGCStatus.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if len(m.ConfigMapParameterValues) > 0 {
+		for k, v := range m.ConfigMapParameterValues {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 2 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -11072,14 +13437,35 @@ func (m *WorkflowStep) Size() (n int) {
 	}
 	l = len(m.OnExit)
 	n += 1 + l + sovGenerated(uint64(l))
-	return n
-}
-
-func (m *WorkflowTemplate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
+	if len(m.Hooks) > 0 {
+		for k, v := range m.Hooks {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if m.Inline != nil {
+		l = m.Inline.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Loop != nil {
+		l = m.Loop.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.WithArtifact != nil {
+		l = m.WithArtifact.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *WorkflowTemplate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
 	_ = l
 	l = m.ObjectMeta.Size()
 	n += 1 + l + sovGenerated(uint64(l))
@@ -11190,6 +13576,26 @@ func (this *Arguments) String() string {
 	}, "")
 	return s
 }
+func (this *ArtGCStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForStrategiesProcessed := make([]string, 0, len(this.StrategiesProcessed))
+	for k := range this.StrategiesProcessed {
+		keysForStrategiesProcessed = append(keysForStrategiesProcessed, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForStrategiesProcessed)
+	mapStringForStrategiesProcessed := "map[string]bool{"
+	for _, k := range keysForStrategiesProcessed {
+		mapStringForStrategiesProcessed += fmt.Sprintf("%v: %v,", k, this.StrategiesProcessed[k])
+	}
+	mapStringForStrategiesProcessed += "}"
+	s := strings.Join([]string{`&ArtGCStatus{`,
+		`StrategiesProcessed:` + mapStringForStrategiesProcessed + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Artifact) String() string {
 	if this == nil {
 		return "nil"
@@ -11205,6 +13611,17 @@ func (this *Artifact) String() string {
 		`Optional:` + fmt.Sprintf("%v", this.Optional) + `,`,
 		`SubPath:` + fmt.Sprintf("%v", this.SubPath) + `,`,
 		`RecurseMode:` + fmt.Sprintf("%v", this.RecurseMode) + `,`,
+		`ArtifactGC:` + strings.Replace(this.ArtifactGC.String(), "ArtifactGC", "ArtifactGC", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ArtifactGC) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ArtifactGC{`,
+		`Strategy:` + fmt.Sprintf("%v", this.Strategy) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -11223,6 +13640,7 @@ func (this *ArtifactLocation) String() string {
 		`Raw:` + strings.Replace(this.Raw.String(), "RawArtifact", "RawArtifact", 1) + `,`,
 		`OSS:` + strings.Replace(this.OSS.String(), "OSSArtifact", "OSSArtifact", 1) + `,`,
 		`GCS:` + strings.Replace(this.GCS.String(), "GCSArtifact", "GCSArtifact", 1) + `,`,
+		`Azure:` + strings.Replace(this.Azure.String(), "AzureArtifact", "AzureArtifact", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -11249,6 +13667,31 @@ func (this *ArtifactoryAuth) String() string {
 	}, "")
 	return s
 }
+func (this *AzureArtifact) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AzureArtifact{`,
+		`AzureBlobContainer:` + strings.Replace(strings.Replace(this.AzureBlobContainer.String(), "AzureBlobContainer", "AzureBlobContainer", 1), `&`, ``, 1) + `,`,
+		`Blob:` + fmt.Sprintf("%v", this.Blob) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AzureBlobContainer) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AzureBlobContainer{`,
+		`Endpoint:` + fmt.Sprintf("%v", this.Endpoint) + `,`,
+		`Container:` + fmt.Sprintf("%v", this.Container) + `,`,
+		`AccountKeySecret:` + strings.Replace(fmt.Sprintf("%v", this.AccountKeySecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`SASTokenSecret:` + strings.Replace(fmt.Sprintf("%v", this.SASTokenSecret), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`UseSDKCreds:` + fmt.Sprintf("%v", this.UseSDKCreds) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Backoff) String() string {
 	if this == nil {
 		return "nil"
@@ -11267,6 +13710,18 @@ func (this *Cache) String() string {
 	}
 	s := strings.Join([]string{`&Cache{`,
 		`ConfigMap:` + strings.Replace(fmt.Sprintf("%v", this.ConfigMap), "ConfigMapKeySelector", "v1.ConfigMapKeySelector", 1) + `,`,
+		`Database:` + strings.Replace(this.Database.String(), "DatabaseCache", "DatabaseCache", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ChildWorkflow) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ChildWorkflow{`,
+		`WorkflowTemplateRef:` + strings.Replace(this.WorkflowTemplateRef.String(), "WorkflowTemplateRef", "WorkflowTemplateRef", 1) + `,`,
+		`Arguments:` + strings.Replace(strings.Replace(this.Arguments.String(), "Arguments", "Arguments", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -11310,6 +13765,38 @@ func (this *Condition) String() string {
 	}, "")
 	return s
 }
+func (this *ContainerNode) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ContainerNode{`,
+		`Container:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Container), "Container", "v1.Container", 1), `&`, ``, 1) + `,`,
+		`Dependencies:` + fmt.Sprintf("%v", this.Dependencies) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ContainerSetTemplate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForContainers := "[]ContainerNode{"
+	for _, f := range this.Containers {
+		repeatedStringForContainers += strings.Replace(strings.Replace(f.String(), "ContainerNode", "ContainerNode", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForContainers += "}"
+	repeatedStringForVolumeMounts := "[]VolumeMount{"
+	for _, f := range this.VolumeMounts {
+		repeatedStringForVolumeMounts += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForVolumeMounts += "}"
+	s := strings.Join([]string{`&ContainerSetTemplate{`,
+		`Containers:` + repeatedStringForContainers + `,`,
+		`VolumeMounts:` + repeatedStringForVolumeMounts + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ContinueOn) String() string {
 	if this == nil {
 		return "nil"
@@ -11341,6 +13828,30 @@ func (this *CreateS3BucketOptions) String() string {
 	}, "")
 	return s
 }
+func (this *CronExclusionWindow) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CronExclusionWindow{`,
+		`Start:` + fmt.Sprintf("%v", this.Start) + `,`,
+		`Duration:` + fmt.Sprintf("%v", this.Duration) + `,`,
+		`Dates:` + fmt.Sprintf("%v", this.Dates) + `,`,
+		`Timezone:` + fmt.Sprintf("%v", this.Timezone) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CronSchedule) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CronSchedule{`,
+		`Schedule:` + fmt.Sprintf("%v", this.Schedule) + `,`,
+		`Timezone:` + fmt.Sprintf("%v", this.Timezone) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *CronWorkflow) String() string {
 	if this == nil {
 		return "nil"
@@ -11373,6 +13884,16 @@ func (this *CronWorkflowSpec) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForSchedules := "[]CronSchedule{"
+	for _, f := range this.Schedules {
+		repeatedStringForSchedules += strings.Replace(strings.Replace(f.String(), "CronSchedule", "CronSchedule", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForSchedules += "}"
+	repeatedStringForExclusionWindows := "[]CronExclusionWindow{"
+	for _, f := range this.ExclusionWindows {
+		repeatedStringForExclusionWindows += strings.Replace(strings.Replace(f.String(), "CronExclusionWindow", "CronExclusionWindow", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForExclusionWindows += "}"
 	s := strings.Join([]string{`&CronWorkflowSpec{`,
 		`WorkflowSpec:` + strings.Replace(strings.Replace(this.WorkflowSpec.String(), "WorkflowSpec", "WorkflowSpec", 1), `&`, ``, 1) + `,`,
 		`Schedule:` + fmt.Sprintf("%v", this.Schedule) + `,`,
@@ -11383,6 +13904,8 @@ func (this *CronWorkflowSpec) String() string {
 		`FailedJobsHistoryLimit:` + valueToStringGenerated(this.FailedJobsHistoryLimit) + `,`,
 		`Timezone:` + fmt.Sprintf("%v", this.Timezone) + `,`,
 		`WorkflowMetadata:` + strings.Replace(fmt.Sprintf("%v", this.WorkflowMetadata), "ObjectMeta", "v11.ObjectMeta", 1) + `,`,
+		`Schedules:` + repeatedStringForSchedules + `,`,
+		`ExclusionWindows:` + repeatedStringForExclusionWindows + `,`,
 		`}`,
 	}, "")
 	return s
@@ -11418,6 +13941,16 @@ func (this *DAGTask) String() string {
 		repeatedStringForWithItems += fmt.Sprintf("%v", f) + ","
 	}
 	repeatedStringForWithItems += "}"
+	keysForHooks := make([]string, 0, len(this.Hooks))
+	for k := range this.Hooks {
+		keysForHooks = append(keysForHooks, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForHooks)
+	mapStringForHooks := "LifecycleHooks{"
+	for _, k := range keysForHooks {
+		mapStringForHooks += fmt.Sprintf("%v: %v,", k, this.Hooks[k])
+	}
+	mapStringForHooks += "}"
 	s := strings.Join([]string{`&DAGTask{`,
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`Template:` + fmt.Sprintf("%v", this.Template) + `,`,
@@ -11431,6 +13964,10 @@ func (this *DAGTask) String() string {
 		`ContinueOn:` + strings.Replace(this.ContinueOn.String(), "ContinueOn", "ContinueOn", 1) + `,`,
 		`OnExit:` + fmt.Sprintf("%v", this.OnExit) + `,`,
 		`Depends:` + fmt.Sprintf("%v", this.Depends) + `,`,
+		`Hooks:` + mapStringForHooks + `,`,
+		`Inline:` + strings.Replace(this.Inline.String(), "Template", "Template", 1) + `,`,
+		`Loop:` + strings.Replace(this.Loop.String(), "Loop", "Loop", 1) + `,`,
+		`WithArtifact:` + strings.Replace(this.WithArtifact.String(), "WithArtifact", "WithArtifact", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -11452,6 +13989,16 @@ func (this *DAGTemplate) String() string {
 	}, "")
 	return s
 }
+func (this *DatabaseCache) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DatabaseCache{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Event) String() string {
 	if this == nil {
 		return "nil"
@@ -11472,6 +14019,16 @@ func (this *ExecutorConfig) String() string {
 	}, "")
 	return s
 }
+func (this *ExportTo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ExportTo{`,
+		`ConfigMapKeyRef:` + strings.Replace(fmt.Sprintf("%v", this.ConfigMapKeyRef), "ConfigMapKeySelector", "v1.ConfigMapKeySelector", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *GCSArtifact) String() string {
 	if this == nil {
 		return "nil"
@@ -11561,6 +14118,26 @@ func (this *HDFSKrbConfig) String() string {
 	}, "")
 	return s
 }
+func (this *HTTP) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForHeaders := "[]HTTPHeader{"
+	for _, f := range this.Headers {
+		repeatedStringForHeaders += strings.Replace(strings.Replace(f.String(), "HTTPHeader", "HTTPHeader", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForHeaders += "}"
+	s := strings.Join([]string{`&HTTP{`,
+		`Method:` + fmt.Sprintf("%v", this.Method) + `,`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`Headers:` + repeatedStringForHeaders + `,`,
+		`TimeoutSeconds:` + valueToStringGenerated(this.TimeoutSeconds) + `,`,
+		`Body:` + fmt.Sprintf("%v", this.Body) + `,`,
+		`SuccessCondition:` + fmt.Sprintf("%v", this.SuccessCondition) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *HTTPArtifact) String() string {
 	if this == nil {
 		return "nil"
@@ -11577,6 +14154,28 @@ func (this *HTTPArtifact) String() string {
 	}, "")
 	return s
 }
+func (this *HTTPHeader) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HTTPHeader{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`ValueFrom:` + strings.Replace(this.ValueFrom.String(), "HTTPHeaderSource", "HTTPHeaderSource", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HTTPHeaderSource) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HTTPHeaderSource{`,
+		`SecretKeyRef:` + strings.Replace(fmt.Sprintf("%v", this.SecretKeyRef), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Header) String() string {
 	if this == nil {
 		return "nil"
@@ -11625,6 +14224,19 @@ func (this *Inputs) String() string {
 	}, "")
 	return s
 }
+func (this *LifecycleHook) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&LifecycleHook{`,
+		`Template:` + fmt.Sprintf("%v", this.Template) + `,`,
+		`Arguments:` + strings.Replace(strings.Replace(this.Arguments.String(), "Arguments", "Arguments", 1), `&`, ``, 1) + `,`,
+		`TemplateRef:` + strings.Replace(this.TemplateRef.String(), "TemplateRef", "TemplateRef", 1) + `,`,
+		`Expression:` + fmt.Sprintf("%v", this.Expression) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Link) String() string {
 	if this == nil {
 		return "nil"
@@ -11637,6 +14249,18 @@ func (this *Link) String() string {
 	}, "")
 	return s
 }
+func (this *Loop) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Loop{`,
+		`Until:` + fmt.Sprintf("%v", this.Until) + `,`,
+		`MaxIterations:` + fmt.Sprintf("%v", this.MaxIterations) + `,`,
+		`Delay:` + fmt.Sprintf("%v", this.Delay) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *MemoizationStatus) String() string {
 	if this == nil {
 		return "nil"
@@ -11645,6 +14269,8 @@ func (this *MemoizationStatus) String() string {
 		`Hit:` + fmt.Sprintf("%v", this.Hit) + `,`,
 		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
 		`CacheName:` + fmt.Sprintf("%v", this.CacheName) + `,`,
+		`CacheType:` + fmt.Sprintf("%v", this.CacheType) + `,`,
+		`MaxAge:` + fmt.Sprintf("%v", this.MaxAge) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -11774,6 +14400,16 @@ func (this *NodeStatus) String() string {
 		mapStringForResourcesDuration += fmt.Sprintf("%v: %v,", k, this.ResourcesDuration[k8s_io_api_core_v1.ResourceName(k)])
 	}
 	mapStringForResourcesDuration += "}"
+	keysForWithArtifactChildren := make([]string, 0, len(this.WithArtifactChildren))
+	for k := range this.WithArtifactChildren {
+		keysForWithArtifactChildren = append(keysForWithArtifactChildren, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForWithArtifactChildren)
+	mapStringForWithArtifactChildren := "map[string]int32{"
+	for _, k := range keysForWithArtifactChildren {
+		mapStringForWithArtifactChildren += fmt.Sprintf("%v: %v,", k, this.WithArtifactChildren[k])
+	}
+	mapStringForWithArtifactChildren += "}"
 	s := strings.Join([]string{`&NodeStatus{`,
 		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
@@ -11801,6 +14437,9 @@ func (this *NodeStatus) String() string {
 		`EstimatedDuration:` + fmt.Sprintf("%v", this.EstimatedDuration) + `,`,
 		`SynchronizationStatus:` + strings.Replace(this.SynchronizationStatus.String(), "NodeSynchronizationStatus", "NodeSynchronizationStatus", 1) + `,`,
 		`Progress:` + fmt.Sprintf("%v", this.Progress) + `,`,
+		`FailureReason:` + fmt.Sprintf("%v", this.FailureReason) + `,`,
+		`ExitCode:` + valueToStringGenerated(this.ExitCode) + `,`,
+		`WithArtifactChildren:` + mapStringForWithArtifactChildren + `,`,
 		`}`,
 	}, "")
 	return s
@@ -11897,6 +14536,10 @@ func (this *Parameter) String() string {
 		`ValueFrom:` + strings.Replace(this.ValueFrom.String(), "ValueFrom", "ValueFrom", 1) + `,`,
 		`GlobalName:` + fmt.Sprintf("%v", this.GlobalName) + `,`,
 		`Enum:` + fmt.Sprintf("%v", this.Enum) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Schema:` + strings.Replace(fmt.Sprintf("%v", this.Schema), "Item", "Item", 1) + `,`,
+		`Sensitive:` + fmt.Sprintf("%v", this.Sensitive) + `,`,
+		`ExportTo:` + strings.Replace(this.ExportTo.String(), "ExportTo", "ExportTo", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -11977,15 +14620,34 @@ func (this *RetryNodeAntiAffinity) String() string {
 	}, "")
 	return s
 }
+func (this *RetryOn) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RetryOn{`,
+		`ExitCodes:` + fmt.Sprintf("%v", this.ExitCodes) + `,`,
+		`Reasons:` + fmt.Sprintf("%v", this.Reasons) + `,`,
+		`MessagePattern:` + fmt.Sprintf("%v", this.MessagePattern) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *RetryStrategy) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForRetryOn := "[]RetryOn{"
+	for _, f := range this.RetryOn {
+		repeatedStringForRetryOn += strings.Replace(strings.Replace(f.String(), "RetryOn", "RetryOn", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForRetryOn += "}"
 	s := strings.Join([]string{`&RetryStrategy{`,
 		`Limit:` + strings.Replace(fmt.Sprintf("%v", this.Limit), "IntOrString", "intstr.IntOrString", 1) + `,`,
 		`RetryPolicy:` + fmt.Sprintf("%v", this.RetryPolicy) + `,`,
 		`Backoff:` + strings.Replace(this.Backoff.String(), "Backoff", "Backoff", 1) + `,`,
 		`Affinity:` + strings.Replace(this.Affinity.String(), "RetryAffinity", "RetryAffinity", 1) + `,`,
+		`Expression:` + fmt.Sprintf("%v", this.Expression) + `,`,
+		`RetryOn:` + repeatedStringForRetryOn + `,`,
 		`}`,
 	}, "")
 	return s
@@ -12034,9 +14696,20 @@ func (this *SemaphoreHolding) String() string {
 	if this == nil {
 		return "nil"
 	}
+	keysForWeights := make([]string, 0, len(this.Weights))
+	for k := range this.Weights {
+		keysForWeights = append(keysForWeights, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForWeights)
+	mapStringForWeights := "map[string]int32{"
+	for _, k := range keysForWeights {
+		mapStringForWeights += fmt.Sprintf("%v: %v,", k, this.Weights[k])
+	}
+	mapStringForWeights += "}"
 	s := strings.Join([]string{`&SemaphoreHolding{`,
 		`Semaphore:` + fmt.Sprintf("%v", this.Semaphore) + `,`,
 		`Holders:` + fmt.Sprintf("%v", this.Holders) + `,`,
+		`Weights:` + mapStringForWeights + `,`,
 		`}`,
 	}, "")
 	return s
@@ -12047,6 +14720,7 @@ func (this *SemaphoreRef) String() string {
 	}
 	s := strings.Join([]string{`&SemaphoreRef{`,
 		`ConfigMapKeyRef:` + strings.Replace(fmt.Sprintf("%v", this.ConfigMapKeyRef), "ConfigMapKeySelector", "v1.ConfigMapKeySelector", 1) + `,`,
+		`Weight:` + fmt.Sprintf("%v", this.Weight) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -12139,9 +14813,21 @@ func (this *Synchronization) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForSemaphores := "[]SemaphoreRef{"
+	for _, f := range this.Semaphores {
+		repeatedStringForSemaphores += strings.Replace(strings.Replace(f.String(), "SemaphoreRef", "SemaphoreRef", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForSemaphores += "}"
+	repeatedStringForMutexes := "[]Mutex{"
+	for _, f := range this.Mutexes {
+		repeatedStringForMutexes += strings.Replace(strings.Replace(f.String(), "Mutex", "Mutex", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForMutexes += "}"
 	s := strings.Join([]string{`&Synchronization{`,
 		`Semaphore:` + strings.Replace(this.Semaphore.String(), "SemaphoreRef", "SemaphoreRef", 1) + `,`,
 		`Mutex:` + strings.Replace(this.Mutex.String(), "Mutex", "Mutex", 1) + `,`,
+		`Semaphores:` + repeatedStringForSemaphores + `,`,
+		`Mutexes:` + repeatedStringForMutexes + `,`,
 		`}`,
 	}, "")
 	return s
@@ -12261,6 +14947,9 @@ func (this *Template) String() string {
 		`Synchronization:` + strings.Replace(this.Synchronization.String(), "Synchronization", "Synchronization", 1) + `,`,
 		`Memoize:` + strings.Replace(this.Memoize.String(), "Memoize", "Memoize", 1) + `,`,
 		`Timeout:` + fmt.Sprintf("%v", this.Timeout) + `,`,
+		`ContainerSet:` + strings.Replace(this.ContainerSet.String(), "ContainerSetTemplate", "ContainerSetTemplate", 1) + `,`,
+		`HTTP:` + strings.Replace(this.HTTP.String(), "HTTP", "HTTP", 1) + `,`,
+		`Workflow:` + strings.Replace(this.Workflow.String(), "ChildWorkflow", "ChildWorkflow", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -12301,6 +14990,8 @@ func (this *ValueFrom) String() string {
 		`Default:` + valueToStringGenerated(this.Default) + `,`,
 		`Supplied:` + strings.Replace(this.Supplied.String(), "SuppliedValueFrom", "SuppliedValueFrom", 1) + `,`,
 		`Event:` + fmt.Sprintf("%v", this.Event) + `,`,
+		`SecretKeyRef:` + strings.Replace(fmt.Sprintf("%v", this.SecretKeyRef), "SecretKeySelector", "v1.SecretKeySelector", 1) + `,`,
+		`ConfigMapKeyRef:` + strings.Replace(fmt.Sprintf("%v", this.ConfigMapKeyRef), "ConfigMapKeySelector", "v1.ConfigMapKeySelector", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -12332,6 +15023,18 @@ func (this *VolumeClaimGC) String() string {
 	}, "")
 	return s
 }
+func (this *WithArtifact) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WithArtifact{`,
+		`From:` + fmt.Sprintf("%v", this.From) + `,`,
+		`ArtifactLocation:` + strings.Replace(strings.Replace(this.ArtifactLocation.String(), "ArtifactLocation", "ArtifactLocation", 1), `&`, ``, 1) + `,`,
+		`BatchSize:` + fmt.Sprintf("%v", this.BatchSize) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Workflow) String() string {
 	if this == nil {
 		return "nil"
@@ -12442,6 +15145,16 @@ func (this *WorkflowSpec) String() string {
 		mapStringForNodeSelector += fmt.Sprintf("%v: %v,", k, this.NodeSelector[k])
 	}
 	mapStringForNodeSelector += "}"
+	keysForHooks := make([]string, 0, len(this.Hooks))
+	for k := range this.Hooks {
+		keysForHooks = append(keysForHooks, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForHooks)
+	mapStringForHooks := "LifecycleHooks{"
+	for _, k := range keysForHooks {
+		mapStringForHooks += fmt.Sprintf("%v: %v,", k, this.Hooks[k])
+	}
+	mapStringForHooks += "}"
 	s := strings.Join([]string{`&WorkflowSpec{`,
 		`Templates:` + repeatedStringForTemplates + `,`,
 		`Entrypoint:` + fmt.Sprintf("%v", this.Entrypoint) + `,`,
@@ -12480,6 +15193,9 @@ func (this *WorkflowSpec) String() string {
 		`Synchronization:` + strings.Replace(this.Synchronization.String(), "Synchronization", "Synchronization", 1) + `,`,
 		`VolumeClaimGC:` + strings.Replace(this.VolumeClaimGC.String(), "VolumeClaimGC", "VolumeClaimGC", 1) + `,`,
 		`RetryStrategy:` + strings.Replace(this.RetryStrategy.String(), "RetryStrategy", "RetryStrategy", 1) + `,`,
+		`ArtifactGC:` + strings.Replace(this.ArtifactGC.String(), "ArtifactGC", "ArtifactGC", 1) + `,`,
+		`Hooks:` + mapStringForHooks + `,`,
+		`TemplateDefaults:` + strings.Replace(this.TemplateDefaults.String(), "Template", "Template", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -12528,6 +15244,16 @@ func (this *WorkflowStatus) String() string {
 		mapStringForResourcesDuration += fmt.Sprintf("%v: %v,", k, this.ResourcesDuration[k8s_io_api_core_v1.ResourceName(k)])
 	}
 	mapStringForResourcesDuration += "}"
+	keysForConfigMapParameterValues := make([]string, 0, len(this.ConfigMapParameterValues))
+	for k := range this.ConfigMapParameterValues {
+		keysForConfigMapParameterValues = append(keysForConfigMapParameterValues, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForConfigMapParameterValues)
+	mapStringForConfigMapParameterValues := "map[string]string{"
+	for _, k := range keysForConfigMapParameterValues {
+		mapStringForConfigMapParameterValues += fmt.Sprintf("%v: %v,", k, this.ConfigMapParameterValues[k])
+	}
+	mapStringForConfigMapParameterValues += "}"
 	s := strings.Join([]string{`&WorkflowStatus{`,
 		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
 		`StartedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.StartedAt), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
@@ -12546,6 +15272,8 @@ func (this *WorkflowStatus) String() string {
 		`EstimatedDuration:` + fmt.Sprintf("%v", this.EstimatedDuration) + `,`,
 		`Progress:` + fmt.Sprintf("%v", this.Progress) + `,`,
 		`ArtifactRepositoryRef:` + strings.Replace(fmt.Sprintf("%v", this.ArtifactRepositoryRef), "ArtifactRepositoryRefStatus", "ArtifactRepositoryRefStatus", 1) + `,`,
+		`ArtifactGCStatus:` + strings.Replace(this.ArtifactGCStatus.String(), "ArtGCStatus", "ArtGCStatus", 1) + `,`,
+		`ConfigMapParameterValues:` + mapStringForConfigMapParameterValues + `,`,
 		`}`,
 	}, "")
 	return s
@@ -12559,6 +15287,16 @@ func (this *WorkflowStep) String() string {
 		repeatedStringForWithItems += fmt.Sprintf("%v", f) + ","
 	}
 	repeatedStringForWithItems += "}"
+	keysForHooks := make([]string, 0, len(this.Hooks))
+	for k := range this.Hooks {
+		keysForHooks = append(keysForHooks, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForHooks)
+	mapStringForHooks := "LifecycleHooks{"
+	for _, k := range keysForHooks {
+		mapStringForHooks += fmt.Sprintf("%v: %v,", k, this.Hooks[k])
+	}
+	mapStringForHooks += "}"
 	s := strings.Join([]string{`&WorkflowStep{`,
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`Template:` + fmt.Sprintf("%v", this.Template) + `,`,
@@ -12570,6 +15308,10 @@ func (this *WorkflowStep) String() string {
 		`When:` + fmt.Sprintf("%v", this.When) + `,`,
 		`ContinueOn:` + strings.Replace(this.ContinueOn.String(), "ContinueOn", "ContinueOn", 1) + `,`,
 		`OnExit:` + fmt.Sprintf("%v", this.OnExit) + `,`,
+		`Hooks:` + mapStringForHooks + `,`,
+		`Inline:` + strings.Replace(this.Inline.String(), "Template", "Template", 1) + `,`,
+		`Loop:` + strings.Replace(this.Loop.String(), "Loop", "Loop", 1) + `,`,
+		`WithArtifact:` + strings.Replace(this.WithArtifact.String(), "WithArtifact", "WithArtifact", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -13007,7 +15749,7 @@ func (m *Arguments) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Artifact) Unmarshal(dAtA []byte) error {
+func (m *ArtGCStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13030,17 +15772,17 @@ func (m *Artifact) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Artifact: wiretype end group for non-group")
+			return fmt.Errorf("proto: ArtGCStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Artifact: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ArtGCStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StrategiesProcessed", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13050,52 +15792,220 @@ func (m *Artifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			if m.StrategiesProcessed == nil {
+				m.StrategiesProcessed = make(map[string]bool)
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+			var mapkey string
+			var mapvalue bool
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapvaluetemp int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvalue = bool(mapvaluetemp != 0)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.StrategiesProcessed[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Artifact) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Artifact: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Artifact: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
 			m.Path = string(dAtA[iNdEx:postIndex])
@@ -13325,6 +16235,42 @@ func (m *Artifact) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.RecurseMode = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactGC", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ArtifactGC == nil {
+				m.ArtifactGC = &ArtifactGC{}
+			}
+			if err := m.ArtifactGC.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -13349,7 +16295,7 @@ func (m *Artifact) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
+func (m *ArtifactGC) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13372,38 +16318,17 @@ func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ArtifactLocation: wiretype end group for non-group")
+			return fmt.Errorf("proto: ArtifactGC: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArtifactLocation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ArtifactGC: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ArchiveLogs", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			b := bool(v != 0)
-			m.ArchiveLogs = &b
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field S3", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13413,33 +16338,139 @@ func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.S3 == nil {
-				m.S3 = &S3Artifact{}
-			}
-			if err := m.S3.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Strategy = ArtifactGCStrategy(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Git", wireType)
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var msglen int
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArtifactLocation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArtifactLocation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchiveLogs", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.ArchiveLogs = &b
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field S3", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.S3 == nil {
+				m.S3 = &S3Artifact{}
+			}
+			if err := m.S3.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Git", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13687,6 +16718,42 @@ func (m *ArtifactLocation) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Azure", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Azure == nil {
+				m.Azure = &AzureArtifact{}
+			}
+			if err := m.Azure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -14209,7 +17276,7 @@ func (m *ArtifactoryAuth) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Backoff) Unmarshal(dAtA []byte) error {
+func (m *AzureArtifact) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14232,47 +17299,15 @@ func (m *Backoff) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Backoff: wiretype end group for non-group")
+			return fmt.Errorf("proto: AzureArtifact: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Backoff: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AzureArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Duration = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Factor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AzureBlobContainer", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14299,16 +17334,13 @@ func (m *Backoff) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Factor == nil {
-				m.Factor = &intstr.IntOrString{}
-			}
-			if err := m.Factor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AzureBlobContainer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxDuration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Blob", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14336,7 +17368,7 @@ func (m *Backoff) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MaxDuration = string(dAtA[iNdEx:postIndex])
+			m.Blob = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14362,7 +17394,7 @@ func (m *Backoff) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Cache) Unmarshal(dAtA []byte) error {
+func (m *AzureBlobContainer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14385,17 +17417,17 @@ func (m *Cache) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Cache: wiretype end group for non-group")
+			return fmt.Errorf("proto: AzureBlobContainer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Cache: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AzureBlobContainer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14405,84 +17437,59 @@ func (m *Cache) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConfigMap == nil {
-				m.ConfigMap = &v1.ConfigMapKeySelector{}
-			}
-			if err := m.ConfigMap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Endpoint = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
 			}
-			if skippy < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ClusterWorkflowTemplate) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterWorkflowTemplate: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterWorkflowTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			m.Container = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountKeySecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14509,13 +17516,16 @@ func (m *ClusterWorkflowTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.AccountKeySecret == nil {
+				m.AccountKeySecret = &v1.SecretKeySelector{}
+			}
+			if err := m.AccountKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SASTokenSecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14542,10 +17552,33 @@ func (m *ClusterWorkflowTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.SASTokenSecret == nil {
+				m.SASTokenSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.SASTokenSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseSDKCreds", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UseSDKCreds = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -14570,7 +17603,7 @@ func (m *ClusterWorkflowTemplate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterWorkflowTemplateList) Unmarshal(dAtA []byte) error {
+func (m *Backoff) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14593,17 +17626,17 @@ func (m *ClusterWorkflowTemplateList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterWorkflowTemplateList: wiretype end group for non-group")
+			return fmt.Errorf("proto: Backoff: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterWorkflowTemplateList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Backoff: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14613,28 +17646,27 @@ func (m *ClusterWorkflowTemplateList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Duration = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Factor", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14661,11 +17693,45 @@ func (m *ClusterWorkflowTemplateList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, ClusterWorkflowTemplate{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Factor == nil {
+				m.Factor = &intstr.IntOrString{}
+			}
+			if err := m.Factor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDuration", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxDuration = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -14690,7 +17756,7 @@ func (m *ClusterWorkflowTemplateList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Condition) Unmarshal(dAtA []byte) error {
+func (m *Cache) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14713,17 +17779,17 @@ func (m *Condition) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Condition: wiretype end group for non-group")
+			return fmt.Errorf("proto: Cache: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Condition: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Cache: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMap", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14733,29 +17799,33 @@ func (m *Condition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = ConditionType(dAtA[iNdEx:postIndex])
+			if m.ConfigMap == nil {
+				m.ConfigMap = &v1.ConfigMapKeySelector{}
+			}
+			if err := m.ConfigMap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Database", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14765,55 +17835,27 @@ func (m *Condition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = k8s_io_apimachinery_pkg_apis_meta_v1.ConditionStatus(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if m.Database == nil {
+				m.Database = &DatabaseCache{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Database.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14839,7 +17881,7 @@ func (m *Condition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContinueOn) Unmarshal(dAtA []byte) error {
+func (m *ChildWorkflow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14862,17 +17904,17 @@ func (m *ContinueOn) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContinueOn: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChildWorkflow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContinueOn: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChildWorkflow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowTemplateRef", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14882,17 +17924,33 @@ func (m *ContinueOn) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Error = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowTemplateRef == nil {
+				m.WorkflowTemplateRef = &WorkflowTemplateRef{}
+			}
+			if err := m.WorkflowTemplateRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Arguments", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14902,12 +17960,25 @@ func (m *ContinueOn) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Failed = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Arguments.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -14932,7 +18003,7 @@ func (m *ContinueOn) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Counter) Unmarshal(dAtA []byte) error {
+func (m *ClusterWorkflowTemplate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14955,17 +18026,17 @@ func (m *Counter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Counter: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterWorkflowTemplate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Counter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterWorkflowTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14975,34 +18046,68 @@ func (m *Counter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) < 0 {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if (iNdEx + skippy) > l {
@@ -15017,7 +18122,7 @@ func (m *Counter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateS3BucketOptions) Unmarshal(dAtA []byte) error {
+func (m *ClusterWorkflowTemplateList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15040,17 +18145,17 @@ func (m *CreateS3BucketOptions) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateS3BucketOptions: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterWorkflowTemplateList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateS3BucketOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterWorkflowTemplateList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectLocking", wireType)
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15060,12 +18165,59 @@ func (m *CreateS3BucketOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ObjectLocking = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, ClusterWorkflowTemplate{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -15090,7 +18242,7 @@ func (m *CreateS3BucketOptions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CronWorkflow) Unmarshal(dAtA []byte) error {
+func (m *Condition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15113,17 +18265,17 @@ func (m *CronWorkflow) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CronWorkflow: wiretype end group for non-group")
+			return fmt.Errorf("proto: Condition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CronWorkflow: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Condition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15133,30 +18285,29 @@ func (m *CronWorkflow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Type = ConditionType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15166,30 +18317,29 @@ func (m *CronWorkflow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Status = k8s_io_apimachinery_pkg_apis_meta_v1.ConditionStatus(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15199,24 +18349,23 @@ func (m *CronWorkflow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -15242,7 +18391,7 @@ func (m *CronWorkflow) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CronWorkflowList) Unmarshal(dAtA []byte) error {
+func (m *ContainerNode) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15265,15 +18414,15 @@ func (m *CronWorkflowList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CronWorkflowList: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContainerNode: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CronWorkflowList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContainerNode: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Container", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15300,15 +18449,15 @@ func (m *CronWorkflowList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Container.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dependencies", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15318,25 +18467,23 @@ func (m *CronWorkflowList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, CronWorkflow{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Dependencies = append(m.Dependencies, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -15362,7 +18509,7 @@ func (m *CronWorkflowList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
+func (m *ContainerSetTemplate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15385,15 +18532,15 @@ func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CronWorkflowSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContainerSetTemplate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CronWorkflowSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContainerSetTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Containers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15420,15 +18567,16 @@ func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.WorkflowSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Containers = append(m.Containers, ContainerNode{})
+			if err := m.Containers[len(m.Containers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumeMounts", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15438,61 +18586,84 @@ func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Schedule = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConcurrencyPolicy", wireType)
+			m.VolumeMounts = append(m.VolumeMounts, v1.VolumeMount{})
+			if err := m.VolumeMounts[len(m.VolumeMounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConcurrencyPolicy = ConcurrencyPolicy(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Suspend", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContinueOn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			var v int
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContinueOn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContinueOn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15507,12 +18678,2250 @@ func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Suspend = bool(v != 0)
-		case 5:
+			m.Error = bool(v != 0)
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartingDeadlineSeconds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Failed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Counter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Counter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Counter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateS3BucketOptions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateS3BucketOptions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateS3BucketOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectLocking", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ObjectLocking = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CronExclusionWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CronExclusionWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CronExclusionWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Start = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Duration = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dates", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dates = append(m.Dates, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timezone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Timezone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CronSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CronSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CronSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schedule = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timezone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Timezone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CronWorkflow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CronWorkflow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CronWorkflow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CronWorkflowList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CronWorkflowList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CronWorkflowList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, CronWorkflow{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CronWorkflowSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CronWorkflowSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowSpec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WorkflowSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schedule = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConcurrencyPolicy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConcurrencyPolicy = ConcurrencyPolicy(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Suspend", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Suspend = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartingDeadlineSeconds", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StartingDeadlineSeconds = &v
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuccessfulJobsHistoryLimit", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SuccessfulJobsHistoryLimit = &v
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedJobsHistoryLimit", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FailedJobsHistoryLimit = &v
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timezone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Timezone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkflowMetadata == nil {
+				m.WorkflowMetadata = &v11.ObjectMeta{}
+			}
+			if err := m.WorkflowMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schedules = append(m.Schedules, CronSchedule{})
+			if err := m.Schedules[len(m.Schedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExclusionWindows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExclusionWindows = append(m.ExclusionWindows, CronExclusionWindow{})
+			if err := m.ExclusionWindows[len(m.ExclusionWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CronWorkflowStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CronWorkflowStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CronWorkflowStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Active = append(m.Active, v1.ObjectReference{})
+			if err := m.Active[len(m.Active)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastScheduledTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastScheduledTime == nil {
+				m.LastScheduledTime = &v11.Time{}
+			}
+			if err := m.LastScheduledTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Conditions = append(m.Conditions, Condition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DAGTask) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DAGTask: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DAGTask: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Template = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Arguments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Arguments.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TemplateRef == nil {
+				m.TemplateRef = &TemplateRef{}
+			}
+			if err := m.TemplateRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dependencies", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dependencies = append(m.Dependencies, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithItems", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithItems = append(m.WithItems, Item{})
+			if err := m.WithItems[len(m.WithItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithParam", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithParam = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithSequence", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WithSequence == nil {
+				m.WithSequence = &Sequence{}
+			}
+			if err := m.WithSequence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field When", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.When = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContinueOn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ContinueOn == nil {
+				m.ContinueOn = &ContinueOn{}
+			}
+			if err := m.ContinueOn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnExit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OnExit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depends", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depends = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hooks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Hooks == nil {
+				m.Hooks = make(LifecycleHooks)
+			}
+			var mapkey string
+			mapvalue := &LifecycleHook{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &LifecycleHook{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Hooks[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Inline == nil {
+				m.Inline = &Template{}
+			}
+			if err := m.Inline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Loop", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Loop == nil {
+				m.Loop = &Loop{}
+			}
+			if err := m.Loop.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithArtifact", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WithArtifact == nil {
+				m.WithArtifact = &WithArtifact{}
+			}
+			if err := m.WithArtifact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DAGTemplate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DAGTemplate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DAGTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Target = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tasks = append(m.Tasks, DAGTask{})
+			if err := m.Tasks[len(m.Tasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailFast", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.FailFast = &b
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DatabaseCache) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DatabaseCache: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DatabaseCache: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Event) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Event: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Event: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
 			}
-			var v int64
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15522,55 +20931,80 @@ func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.StartingDeadlineSeconds = &v
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SuccessfulJobsHistoryLimit", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.SuccessfulJobsHistoryLimit = &v
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailedJobsHistoryLimit", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int32
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Selector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.FailedJobsHistoryLimit = &v
-		case 8:
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecutorConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecutorConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecutorConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timezone", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15598,11 +21032,64 @@ func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Timezone = string(dAtA[iNdEx:postIndex])
+			m.ServiceAccountName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExportTo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExportTo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExportTo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowMetadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapKeyRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15629,10 +21116,10 @@ func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkflowMetadata == nil {
-				m.WorkflowMetadata = &v11.ObjectMeta{}
+			if m.ConfigMapKeyRef == nil {
+				m.ConfigMapKeyRef = &v1.ConfigMapKeySelector{}
 			}
-			if err := m.WorkflowMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ConfigMapKeyRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -15660,7 +21147,7 @@ func (m *CronWorkflowSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CronWorkflowStatus) Unmarshal(dAtA []byte) error {
+func (m *GCSArtifact) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15683,15 +21170,15 @@ func (m *CronWorkflowStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CronWorkflowStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: GCSArtifact: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CronWorkflowStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GCSArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GCSBucket", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15718,16 +21205,100 @@ func (m *CronWorkflowStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Active = append(m.Active, v1.ObjectReference{})
-			if err := m.Active[len(m.Active)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GCSBucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastScheduledTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GCSBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GCSBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GCSBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15737,31 +21308,27 @@ func (m *CronWorkflowStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastScheduledTime == nil {
-				m.LastScheduledTime = &v11.Time{}
-			}
-			if err := m.LastScheduledTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Bucket = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountKeySecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15788,8 +21355,7 @@ func (m *CronWorkflowStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Conditions = append(m.Conditions, Condition{})
-			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ServiceAccountKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -15817,7 +21383,7 @@ func (m *CronWorkflowStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DAGTask) Unmarshal(dAtA []byte) error {
+func (m *Gauge) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15840,15 +21406,15 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DAGTask: wiretype end group for non-group")
+			return fmt.Errorf("proto: Gauge: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DAGTask: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Gauge: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15876,13 +21442,13 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Realtime", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15892,62 +21458,71 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			b := bool(v != 0)
+			m.Realtime = &b
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Template = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Arguments", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GitArtifact) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Arguments.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 4:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GitArtifact: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GitArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TemplateRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15957,31 +21532,27 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TemplateRef == nil {
-				m.TemplateRef = &TemplateRef{}
-			}
-			if err := m.TemplateRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Repo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dependencies", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16009,13 +21580,13 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Dependencies = append(m.Dependencies, string(dAtA[iNdEx:postIndex]))
+			m.Revision = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithItems", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
 			}
-			var msglen int
+			var v uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16025,29 +21596,15 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.WithItems = append(m.WithItems, Item{})
-			if err := m.WithItems[len(m.WithItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
+			m.Depth = &v
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithParam", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fetch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16075,11 +21632,11 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WithParam = string(dAtA[iNdEx:postIndex])
+			m.Fetch = append(m.Fetch, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 8:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithSequence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UsernameSecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16106,48 +21663,16 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WithSequence == nil {
-				m.WithSequence = &Sequence{}
+			if m.UsernameSecret == nil {
+				m.UsernameSecret = &v1.SecretKeySelector{}
 			}
-			if err := m.WithSequence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.UsernameSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field When", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.When = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContinueOn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PasswordSecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16174,18 +21699,18 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ContinueOn == nil {
-				m.ContinueOn = &ContinueOn{}
+			if m.PasswordSecret == nil {
+				m.PasswordSecret = &v1.SecretKeySelector{}
 			}
-			if err := m.ContinueOn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PasswordSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OnExit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SSHPrivateKeySecret", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16195,29 +21720,33 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OnExit = string(dAtA[iNdEx:postIndex])
+			if m.SSHPrivateKeySecret == nil {
+				m.SSHPrivateKeySecret = &v1.SecretKeySelector{}
+			}
+			if err := m.SSHPrivateKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depends", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsecureIgnoreHostKey", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16227,24 +21756,12 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Depends = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.InsecureIgnoreHostKey = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -16269,7 +21786,7 @@ func (m *DAGTask) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DAGTemplate) Unmarshal(dAtA []byte) error {
+func (m *HDFSArtifact) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16292,17 +21809,17 @@ func (m *DAGTemplate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DAGTemplate: wiretype end group for non-group")
+			return fmt.Errorf("proto: HDFSArtifact: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DAGTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HDFSArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HDFSConfig", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16312,29 +21829,30 @@ func (m *DAGTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Target = string(dAtA[iNdEx:postIndex])
+			if err := m.HDFSConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16344,29 +21862,27 @@ func (m *DAGTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tasks = append(m.Tasks, DAGTask{})
-			if err := m.Tasks[len(m.Tasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailFast", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -16383,8 +21899,7 @@ func (m *DAGTemplate) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.FailFast = &b
+			m.Force = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -16409,7 +21924,7 @@ func (m *DAGTemplate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Event) Unmarshal(dAtA []byte) error {
+func (m *HDFSConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16432,17 +21947,17 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Event: wiretype end group for non-group")
+			return fmt.Errorf("proto: HDFSConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Event: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HDFSConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HDFSKrbConfig", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16452,80 +21967,60 @@ func (m *Event) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Selector = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.HDFSKrbConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
 			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ExecutorConfig) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ExecutorConfig: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExecutorConfig: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HDFSUser", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16553,7 +22048,7 @@ func (m *ExecutorConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServiceAccountName = string(dAtA[iNdEx:postIndex])
+			m.HDFSUser = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16579,7 +22074,7 @@ func (m *ExecutorConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GCSArtifact) Unmarshal(dAtA []byte) error {
+func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16602,15 +22097,15 @@ func (m *GCSArtifact) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GCSArtifact: wiretype end group for non-group")
+			return fmt.Errorf("proto: HDFSKrbConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GCSArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HDFSKrbConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GCSBucket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KrbCCacheSecret", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16637,15 +22132,18 @@ func (m *GCSArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GCSBucket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.KrbCCacheSecret == nil {
+				m.KrbCCacheSecret = &v1.SecretKeySelector{}
+			}
+			if err := m.KrbCCacheSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KrbKeytabSecret", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16655,80 +22153,31 @@ func (m *GCSArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GCSBucket) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.KrbKeytabSecret == nil {
+				m.KrbKeytabSecret = &v1.SecretKeySelector{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GCSBucket: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GCSBucket: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.KrbKeytabSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KrbUsername", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16756,13 +22205,13 @@ func (m *GCSBucket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bucket = string(dAtA[iNdEx:postIndex])
+			m.KrbUsername = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountKeySecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KrbRealm", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16772,83 +22221,29 @@ func (m *GCSBucket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ServiceAccountKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.KrbRealm = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Gauge) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Gauge: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Gauge: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KrbConfigConfigMap", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16858,29 +22253,33 @@ func (m *Gauge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			if m.KrbConfigConfigMap == nil {
+				m.KrbConfigConfigMap = &v1.ConfigMapKeySelector{}
+			}
+			if err := m.KrbConfigConfigMap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Realtime", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KrbServicePrincipalName", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16890,13 +22289,24 @@ func (m *Gauge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.Realtime = &b
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KrbServicePrincipalName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -16921,7 +22331,7 @@ func (m *Gauge) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GitArtifact) Unmarshal(dAtA []byte) error {
+func (m *HTTP) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16944,15 +22354,15 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GitArtifact: wiretype end group for non-group")
+			return fmt.Errorf("proto: HTTP: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GitArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HTTP: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16980,11 +22390,11 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Repo = string(dAtA[iNdEx:postIndex])
+			m.Method = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17012,33 +22422,13 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revision = string(dAtA[iNdEx:postIndex])
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
-			}
-			var v uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Depth = &v
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fetch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17048,29 +22438,31 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fetch = append(m.Fetch, string(dAtA[iNdEx:postIndex]))
+			m.Headers = append(m.Headers, HTTPHeader{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsernameSecret", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutSeconds", wireType)
 			}
-			var msglen int
+			var v int64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17080,33 +22472,17 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.UsernameSecret == nil {
-				m.UsernameSecret = &v1.SecretKeySelector{}
-			}
-			if err := m.UsernameSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
+			m.TimeoutSeconds = &v
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PasswordSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17116,33 +22492,29 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PasswordSecret == nil {
-				m.PasswordSecret = &v1.SecretKeySelector{}
-			}
-			if err := m.PasswordSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Body = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SSHPrivateKeySecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SuccessCondition", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17152,48 +22524,24 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SSHPrivateKeySecret == nil {
-				m.SSHPrivateKeySecret = &v1.SecretKeySelector{}
-			}
-			if err := m.SSHPrivateKeySecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SuccessCondition = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InsecureIgnoreHostKey", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.InsecureIgnoreHostKey = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -17218,7 +22566,7 @@ func (m *GitArtifact) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HDFSArtifact) Unmarshal(dAtA []byte) error {
+func (m *HTTPArtifact) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17241,17 +22589,17 @@ func (m *HDFSArtifact) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HDFSArtifact: wiretype end group for non-group")
+			return fmt.Errorf("proto: HTTPArtifact: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HDFSArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HTTPArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HDFSConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17261,30 +22609,29 @@ func (m *HDFSArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.HDFSConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17294,44 +22641,26 @@ func (m *HDFSArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Headers = append(m.Headers, Header{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Force = bool(v != 0)
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -17356,7 +22685,7 @@ func (m *HDFSArtifact) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HDFSConfig) Unmarshal(dAtA []byte) error {
+func (m *HTTPHeader) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17379,17 +22708,17 @@ func (m *HDFSConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HDFSConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: HTTPHeader: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HDFSConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HTTPHeader: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HDFSKrbConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17399,28 +22728,27 @@ func (m *HDFSConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.HDFSKrbConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17448,13 +22776,13 @@ func (m *HDFSConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HDFSUser", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueFrom", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17464,23 +22792,27 @@ func (m *HDFSConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HDFSUser = string(dAtA[iNdEx:postIndex])
+			if m.ValueFrom == nil {
+				m.ValueFrom = &HTTPHeaderSource{}
+			}
+			if err := m.ValueFrom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -17506,7 +22838,7 @@ func (m *HDFSConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
+func (m *HTTPHeaderSource) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17529,15 +22861,15 @@ func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HDFSKrbConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: HTTPHeaderSource: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HDFSKrbConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HTTPHeaderSource: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KrbCCacheSecret", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretKeyRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17564,52 +22896,69 @@ func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.KrbCCacheSecret == nil {
-				m.KrbCCacheSecret = &v1.SecretKeySelector{}
+			if m.SecretKeyRef == nil {
+				m.SecretKeyRef = &v1.SecretKeySelector{}
 			}
-			if err := m.KrbCCacheSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SecretKeyRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KrbKeytabSecret", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.KrbKeytabSecret == nil {
-				m.KrbKeytabSecret = &v1.SecretKeySelector{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Header) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if err := m.KrbKeytabSecret.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Header: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Header: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KrbUsername", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17637,11 +22986,11 @@ func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KrbUsername = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KrbRealm", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17669,13 +23018,66 @@ func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KrbRealm = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Histogram) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Histogram: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Histogram: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KrbConfigConfigMap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17685,33 +23087,29 @@ func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.KrbConfigConfigMap == nil {
-				m.KrbConfigConfigMap = &v1.ConfigMapKeySelector{}
-			}
-			if err := m.KrbConfigConfigMap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KrbServicePrincipalName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17721,23 +23119,25 @@ func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KrbServicePrincipalName = string(dAtA[iNdEx:postIndex])
+			m.Buckets = append(m.Buckets, Amount{})
+			if err := m.Buckets[len(m.Buckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -17763,7 +23163,7 @@ func (m *HDFSKrbConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HTTPArtifact) Unmarshal(dAtA []byte) error {
+func (m *Inputs) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17786,17 +23186,17 @@ func (m *HTTPArtifact) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HTTPArtifact: wiretype end group for non-group")
+			return fmt.Errorf("proto: Inputs: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HTTPArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Inputs: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17806,27 +23206,29 @@ func (m *HTTPArtifact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			m.Parameters = append(m.Parameters, Parameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Artifacts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17853,8 +23255,8 @@ func (m *HTTPArtifact) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Headers = append(m.Headers, Header{})
-			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Artifacts = append(m.Artifacts, Artifact{})
+			if err := m.Artifacts[len(m.Artifacts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -17882,7 +23284,7 @@ func (m *HTTPArtifact) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Header) Unmarshal(dAtA []byte) error {
+func (m *Item) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17905,49 +23307,17 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Header: wiretype end group for non-group")
+			return fmt.Errorf("proto: Item: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Header: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Item: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17957,23 +23327,25 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -17999,7 +23371,7 @@ func (m *Header) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Histogram) Unmarshal(dAtA []byte) error {
+func (m *LifecycleHook) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18022,15 +23394,15 @@ func (m *Histogram) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Histogram: wiretype end group for non-group")
+			return fmt.Errorf("proto: LifecycleHook: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Histogram: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LifecycleHook: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 3:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18058,11 +23430,11 @@ func (m *Histogram) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Template = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Arguments", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18089,11 +23461,78 @@ func (m *Histogram) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Buckets = append(m.Buckets, Amount{})
-			if err := m.Buckets[len(m.Buckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Arguments.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TemplateRef == nil {
+				m.TemplateRef = &TemplateRef{}
+			}
+			if err := m.TemplateRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Expression = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -18118,7 +23557,7 @@ func (m *Histogram) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Inputs) Unmarshal(dAtA []byte) error {
+func (m *Link) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18141,17 +23580,17 @@ func (m *Inputs) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Inputs: wiretype end group for non-group")
+			return fmt.Errorf("proto: Link: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Inputs: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Link: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18161,31 +23600,29 @@ func (m *Inputs) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Parameters = append(m.Parameters, Parameter{})
-			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Artifacts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18195,84 +23632,29 @@ func (m *Inputs) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Artifacts = append(m.Artifacts, Artifact{})
-			if err := m.Artifacts[len(m.Artifacts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Item) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Item: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Item: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scope = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18282,25 +23664,23 @@ func (m *Item) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
-			}
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -18326,7 +23706,7 @@ func (m *Item) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Link) Unmarshal(dAtA []byte) error {
+func (m *Loop) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18349,15 +23729,15 @@ func (m *Link) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Link: wiretype end group for non-group")
+			return fmt.Errorf("proto: Loop: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Link: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Loop: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Until", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18385,13 +23765,13 @@ func (m *Link) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Until = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxIterations", wireType)
 			}
-			var stringLen uint64
+			m.MaxIterations = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18401,27 +23781,14 @@ func (m *Link) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxIterations |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Scope = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delay", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18449,7 +23816,7 @@ func (m *Link) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			m.Delay = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -18588,6 +23955,70 @@ func (m *MemoizationStatus) Unmarshal(dAtA []byte) error {
 			}
 			m.CacheName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CacheType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CacheType = CacheType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAge", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxAge = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -20435,28 +25866,147 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			if msglen < 0 {
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MemoizationStatus == nil {
+				m.MemoizationStatus = &MemoizationStatus{}
+			}
+			if err := m.MemoizationStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedDuration", wireType)
+			}
+			m.EstimatedDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EstimatedDuration |= EstimatedDuration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SynchronizationStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SynchronizationStatus == nil {
+				m.SynchronizationStatus = &NodeSynchronizationStatus{}
+			}
+			if err := m.SynchronizationStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Progress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Progress = Progress(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MemoizationStatus == nil {
-				m.MemoizationStatus = &MemoizationStatus{}
-			}
-			if err := m.MemoizationStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FailureReason = FailureReason(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 24:
+		case 28:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedDuration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitCode", wireType)
 			}
-			m.EstimatedDuration = 0
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20466,14 +26016,15 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EstimatedDuration |= EstimatedDuration(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 25:
+			m.ExitCode = &v
+		case 29:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SynchronizationStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WithArtifactChildren", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20500,44 +26051,89 @@ func (m *NodeStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SynchronizationStatus == nil {
-				m.SynchronizationStatus = &NodeSynchronizationStatus{}
-			}
-			if err := m.SynchronizationStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 26:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Progress", wireType)
+			if m.WithArtifactChildren == nil {
+				m.WithArtifactChildren = make(map[string]int32)
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Progress = Progress(dAtA[iNdEx:postIndex])
+			m.WithArtifactChildren[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -21307,7 +26903,141 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Default", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := AnyString(dAtA[iNdEx:postIndex])
+			m.Default = &s
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := AnyString(dAtA[iNdEx:postIndex])
+			m.Value = &s
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueFrom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ValueFrom == nil {
+				m.ValueFrom = &ValueFrom{}
+			}
+			if err := m.ValueFrom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21335,11 +27065,11 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.GlobalName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Default", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Enum", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21367,12 +27097,11 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := AnyString(dAtA[iNdEx:postIndex])
-			m.Default = &s
+			m.Enum = append(m.Enum, AnyString(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -21400,12 +27129,11 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := AnyString(dAtA[iNdEx:postIndex])
-			m.Value = &s
+			m.Type = ParameterType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueFrom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -21432,18 +27160,18 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ValueFrom == nil {
-				m.ValueFrom = &ValueFrom{}
+			if m.Schema == nil {
+				m.Schema = &Item{}
 			}
-			if err := m.ValueFrom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Schema.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalName", wireType)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sensitive", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21453,29 +27181,17 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.GlobalName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+			m.Sensitive = bool(v != 0)
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExportTo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -21485,23 +27201,27 @@ func (m *Parameter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Enum = append(m.Enum, AnyString(dAtA[iNdEx:postIndex]))
+			if m.ExportTo == nil {
+				m.ExportTo = &ExportTo{}
+			}
+			if err := m.ExportTo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -22296,81 +28016,274 @@ func (m *RetryAffinity) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NodeAntiAffinity == nil {
+				m.NodeAntiAffinity = &RetryNodeAntiAffinity{}
+			}
+			if err := m.NodeAntiAffinity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RetryNodeAntiAffinity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RetryNodeAntiAffinity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RetryNodeAntiAffinity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RetryOn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RetryOn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RetryOn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ExitCodes = append(m.ExitCodes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenerated
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenerated
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ExitCodes) == 0 {
+					m.ExitCodes = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ExitCodes = append(m.ExitCodes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitCodes", wireType)
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reasons", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NodeAntiAffinity == nil {
-				m.NodeAntiAffinity = &RetryNodeAntiAffinity{}
-			}
-			if err := m.NodeAntiAffinity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reasons = append(m.Reasons, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessagePattern", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthGenerated
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RetryNodeAntiAffinity) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RetryNodeAntiAffinity: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RetryNodeAntiAffinity: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.MessagePattern = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -22564,6 +28477,72 @@ func (m *RetryStrategy) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Expression = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryOn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetryOn = append(m.RetryOn, RetryOn{})
+			if err := m.RetryOn[len(m.RetryOn)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -23228,18 +29207,131 @@ func (m *SemaphoreHolding) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Holders = append(m.Holders, string(dAtA[iNdEx:postIndex]))
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holders = append(m.Holders, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Weights == nil {
+				m.Weights = make(map[string]int32)
+			}
+			var mapkey string
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Weights[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -23330,6 +29422,25 @@ func (m *SemaphoreRef) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -24415,6 +30526,74 @@ func (m *Synchronization) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Semaphores", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Semaphores = append(m.Semaphores, SemaphoreRef{})
+			if err := m.Semaphores[len(m.Semaphores)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mutexes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mutexes = append(m.Mutexes, Mutex{})
+			if err := m.Mutexes[len(m.Mutexes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -26088,6 +32267,114 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 			}
 			m.Timeout = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 39:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ContainerSet == nil {
+				m.ContainerSet = &ContainerSetTemplate{}
+			}
+			if err := m.ContainerSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 40:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HTTP", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HTTP == nil {
+				m.HTTP = &HTTP{}
+			}
+			if err := m.HTTP.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 41:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Workflow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Workflow == nil {
+				m.Workflow = &ChildWorkflow{}
+			}
+			if err := m.Workflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -26604,9 +32891,77 @@ func (m *ValueFrom) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Event = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretKeyRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SecretKeyRef == nil {
+				m.SecretKeyRef = &v1.SecretKeySelector{}
+			}
+			if err := m.SecretKeyRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapKeyRef", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -26616,23 +32971,27 @@ func (m *ValueFrom) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Event = string(dAtA[iNdEx:postIndex])
+			if m.ConfigMapKeyRef == nil {
+				m.ConfigMapKeyRef = &v1.ConfigMapKeySelector{}
+			}
+			if err := m.ConfigMapKeyRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -27052,6 +33411,143 @@ func (m *VolumeClaimGC) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *WithArtifact) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WithArtifact: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WithArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactLocation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ArtifactLocation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchSize", wireType)
+			}
+			m.BatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Workflow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -28887,16 +35383,124 @@ func (m *WorkflowSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Synchronization == nil {
-				m.Synchronization = &Synchronization{}
+			if m.Synchronization == nil {
+				m.Synchronization = &Synchronization{}
+			}
+			if err := m.Synchronization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 36:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VolumeClaimGC", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VolumeClaimGC == nil {
+				m.VolumeClaimGC = &VolumeClaimGC{}
+			}
+			if err := m.VolumeClaimGC.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 37:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryStrategy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetryStrategy == nil {
+				m.RetryStrategy = &RetryStrategy{}
+			}
+			if err := m.RetryStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 38:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactGC", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ArtifactGC == nil {
+				m.ArtifactGC = &ArtifactGC{}
 			}
-			if err := m.Synchronization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ArtifactGC.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 36:
+		case 39:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VolumeClaimGC", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hooks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28923,16 +35527,109 @@ func (m *WorkflowSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VolumeClaimGC == nil {
-				m.VolumeClaimGC = &VolumeClaimGC{}
+			if m.Hooks == nil {
+				m.Hooks = make(LifecycleHooks)
 			}
-			if err := m.VolumeClaimGC.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var mapkey string
+			mapvalue := &LifecycleHook{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &LifecycleHook{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Hooks[mapkey] = *mapvalue
 			iNdEx = postIndex
-		case 37:
+		case 40:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RetryStrategy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateDefaults", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -28959,10 +35656,10 @@ func (m *WorkflowSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RetryStrategy == nil {
-				m.RetryStrategy = &RetryStrategy{}
+			if m.TemplateDefaults == nil {
+				m.TemplateDefaults = &Template{}
 			}
-			if err := m.RetryStrategy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TemplateDefaults.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -29830,22 +36527,185 @@ func (m *WorkflowStatus) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ArtifactRepositoryRef == nil {
-				m.ArtifactRepositoryRef = &ArtifactRepositoryRefStatus{}
-			}
-			if err := m.ArtifactRepositoryRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ArtifactRepositoryRef == nil {
+				m.ArtifactRepositoryRef = &ArtifactRepositoryRefStatus{}
+			}
+			if err := m.ArtifactRepositoryRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArtifactGCStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ArtifactGCStatus == nil {
+				m.ArtifactGCStatus = &ArtGCStatus{}
+			}
+			if err := m.ArtifactGCStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapParameterValues", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConfigMapParameterValues == nil {
+				m.ConfigMapParameterValues = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ConfigMapParameterValues[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -30235,6 +37095,243 @@ func (m *WorkflowStep) Unmarshal(dAtA []byte) error {
 			}
 			m.OnExit = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hooks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Hooks == nil {
+				m.Hooks = make(LifecycleHooks)
+			}
+			var mapkey string
+			mapvalue := &LifecycleHook{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &LifecycleHook{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Hooks[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Inline == nil {
+				m.Inline = &Template{}
+			}
+			if err := m.Inline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Loop", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Loop == nil {
+				m.Loop = &Loop{}
+			}
+			if err := m.Loop.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithArtifact", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WithArtifact == nil {
+				m.WithArtifact = &WithArtifact{}
+			}
+			if err := m.WithArtifact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
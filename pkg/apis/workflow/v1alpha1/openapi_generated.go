@@ -16,28 +16,39 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Amount":                      schema_pkg_apis_workflow_v1alpha1_Amount(ref),
 		"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.ArchiveStrategy":             schema_pkg_apis_workflow_v1alpha1_ArchiveStrategy(ref),
 		"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Arguments":                   schema_pkg_apis_workflow_v1alpha1_Arguments(ref),
+		"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.ArtGCStatus":                 schema_pkg_apis_workflow_v1alpha1_ArtGCStatus(ref),
 		"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Artifact":                    schema_pkg_apis_workflow_v1alpha1_Artifact(ref),
+		"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.ArtifactGC":                  schema_pkg_apis_workflow_v1alpha1_ArtifactGC(ref),
 		"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.ArtifactLocation":            schema_pkg_apis_workflow_v1alpha1_ArtifactLocation(ref),
 		"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.ArtifactRepositoryRef":       schema_pkg_apis_workflow_v1alpha1_ArtifactRepositoryRef(ref),
 		"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.ArtifactRepositoryRefStatus": schema_pkg_apis_workflow_v1alpha1_ArtifactRepositoryRefStatus(ref),
 		"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.ArtifactoryArtifact":         schema_pkg_apis_workflow_v1alpha1_ArtifactoryArtifact(ref),
 		"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.ArtifactoryAuth":             schema_pkg_apis_workflow_v1alpha1_ArtifactoryAuth(ref),
+		"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.AzureArtifact":               schema_pkg_apis_workflow_v1alpha1_AzureArtifact(ref),
+		"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.AzureBlobContainer":          schema_pkg_apis_workflow_v1alpha1_AzureBlobContainer(ref),
 		"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Backoff":                     schema_pkg_apis_workflow_v1alpha1_Backoff(ref),
 		"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Cache":                       schema_pkg_apis_workflow_v1alpha1_Cache(ref),
+		"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.ChildWorkflow":               schema_pkg_apis_workflow_v1alpha1_ChildWorkflow(ref),
 		"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.ClusterWorkflowTemplate":     schema_pkg_apis_workflow_v1alpha1_ClusterWorkflowTemplate(ref),
 		"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.ClusterWorkflowTemplateList": schema_pkg_apis_workflow_v1alpha1_ClusterWorkflowTemplateList(ref),
 		"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Condition":                   schema_pkg_apis_workflow_v1alpha1_Condition(ref),
+		"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.ContainerNode":               schema_pkg_apis_workflow_v1alpha1_ContainerNode(ref),
+		"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.ContainerSetTemplate":        schema_pkg_apis_workflow_v1alpha1_ContainerSetTemplate(ref),
 		"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.ContinueOn":                  schema_pkg_apis_workflow_v1alpha1_ContinueOn(ref),
 		"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Counter":                     schema_pkg_apis_workflow_v1alpha1_Counter(ref),
 		"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.CreateS3BucketOptions":       schema_pkg_apis_workflow_v1alpha1_CreateS3BucketOptions(ref),
+		"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.CronExclusionWindow":         schema_pkg_apis_workflow_v1alpha1_CronExclusionWindow(ref),
+		"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.CronSchedule":                schema_pkg_apis_workflow_v1alpha1_CronSchedule(ref),
 		"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.CronWorkflow":                schema_pkg_apis_workflow_v1alpha1_CronWorkflow(ref),
 		"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.CronWorkflowList":            schema_pkg_apis_workflow_v1alpha1_CronWorkflowList(ref),
 		"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.CronWorkflowSpec":            schema_pkg_apis_workflow_v1alpha1_CronWorkflowSpec(ref),
 		"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.CronWorkflowStatus":          schema_pkg_apis_workflow_v1alpha1_CronWorkflowStatus(ref),
 		"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.DAGTask":                     schema_pkg_apis_workflow_v1alpha1_DAGTask(ref),
 		"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.DAGTemplate":                 schema_pkg_apis_workflow_v1alpha1_DAGTemplate(ref),
+		"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.DatabaseCache":               schema_pkg_apis_workflow_v1alpha1_DatabaseCache(ref),
 		"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Event":                       schema_pkg_apis_workflow_v1alpha1_Event(ref),
 		"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.ExecutorConfig":              schema_pkg_apis_workflow_v1alpha1_ExecutorConfig(ref),
+		"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.ExportTo":                    schema_pkg_apis_workflow_v1alpha1_ExportTo(ref),
 		"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.GCSArtifact":                 schema_pkg_apis_workflow_v1alpha1_GCSArtifact(ref),
 		"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.GCSBucket":                   schema_pkg_apis_workflow_v1alpha1_GCSBucket(ref),
 		"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Gauge":                       schema_pkg_apis_workflow_v1alpha1_Gauge(ref),
@@ -45,12 +56,17 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.HDFSArtifact":                schema_pkg_apis_workflow_v1alpha1_HDFSArtifact(ref),
 		"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.HDFSConfig":                  schema_pkg_apis_workflow_v1alpha1_HDFSConfig(ref),
 		"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.HDFSKrbConfig":               schema_pkg_apis_workflow_v1alpha1_HDFSKrbConfig(ref),
+		"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.HTTP":                        schema_pkg_apis_workflow_v1alpha1_HTTP(ref),
 		"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.HTTPArtifact":                schema_pkg_apis_workflow_v1alpha1_HTTPArtifact(ref),
+		"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.HTTPHeader":                  schema_pkg_apis_workflow_v1alpha1_HTTPHeader(ref),
+		"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.HTTPHeaderSource":            schema_pkg_apis_workflow_v1alpha1_HTTPHeaderSource(ref),
 		"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Header":                      schema_pkg_apis_workflow_v1alpha1_Header(ref),
 		"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Histogram":                   schema_pkg_apis_workflow_v1alpha1_Histogram(ref),
 		"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Inputs":                      schema_pkg_apis_workflow_v1alpha1_Inputs(ref),
 		"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Item":                        schema_pkg_apis_workflow_v1alpha1_Item(ref),
+		"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.LifecycleHook":               schema_pkg_apis_workflow_v1alpha1_LifecycleHook(ref),
 		"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Link":                        schema_pkg_apis_workflow_v1alpha1_Link(ref),
+		"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Loop":                        schema_pkg_apis_workflow_v1alpha1_Loop(ref),
 		"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.MemoizationStatus":           schema_pkg_apis_workflow_v1alpha1_MemoizationStatus(ref),
 		"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Memoize":                     schema_pkg_apis_workflow_v1alpha1_Memoize(ref),
 		"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Metadata":                    schema_pkg_apis_workflow_v1alpha1_Metadata(ref),
@@ -73,6 +89,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.ResourceTemplate":            schema_pkg_apis_workflow_v1alpha1_ResourceTemplate(ref),
 		"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.RetryAffinity":               schema_pkg_apis_workflow_v1alpha1_RetryAffinity(ref),
 		"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.RetryNodeAntiAffinity":       schema_pkg_apis_workflow_v1alpha1_RetryNodeAntiAffinity(ref),
+		"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.RetryOn":                     schema_pkg_apis_workflow_v1alpha1_RetryOn(ref),
 		"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.RetryStrategy":               schema_pkg_apis_workflow_v1alpha1_RetryStrategy(ref),
 		"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.S3Artifact":                  schema_pkg_apis_workflow_v1alpha1_S3Artifact(ref),
 		"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.S3Bucket":                    schema_pkg_apis_workflow_v1alpha1_S3Bucket(ref),
@@ -95,6 +112,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.ValueFrom":                   schema_pkg_apis_workflow_v1alpha1_ValueFrom(ref),
 		"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Version":                     schema_pkg_apis_workflow_v1alpha1_Version(ref),
 		"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.VolumeClaimGC":               schema_pkg_apis_workflow_v1alpha1_VolumeClaimGC(ref),
+		"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.WithArtifact":                schema_pkg_apis_workflow_v1alpha1_WithArtifact(ref),
 		"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Workflow":                    schema_pkg_apis_workflow_v1alpha1_Workflow(ref),
 		"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.WorkflowEventBinding":        schema_pkg_apis_workflow_v1alpha1_WorkflowEventBinding(ref),
 		"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.WorkflowEventBindingList":    schema_pkg_apis_workflow_v1alpha1_WorkflowEventBindingList(ref),
@@ -208,6 +226,35 @@ func schema_pkg_apis_workflow_v1alpha1_Arguments(ref common.ReferenceCallback) c
 	}
 }
 
+func schema_pkg_apis_workflow_v1alpha1_ArtGCStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ArtGCStatus maintains state related to ArtifactGC",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"strategiesProcessed": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StrategiesProcessed records which strategies have been processed, so that each is only done once",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: false,
+										Type:    []string{"boolean"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_workflow_v1alpha1_Artifact(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -299,6 +346,12 @@ func schema_pkg_apis_workflow_v1alpha1_Artifact(ref common.ReferenceCallback) co
 							Ref:         ref("github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.GCSArtifact"),
 						},
 					},
+					"azure": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Azure contains Azure Blob Storage artifact location details",
+							Ref:         ref("github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.AzureArtifact"),
+						},
+					},
 					"globalName": {
 						SchemaProps: spec.SchemaProps{
 							Description: "GlobalName exports an output artifact to the global scope, making it available as '{{workflow.outputs.artifacts.XXXX}} and in workflow.status.outputs.artifacts",
@@ -333,12 +386,38 @@ func schema_pkg_apis_workflow_v1alpha1_Artifact(ref common.ReferenceCallback) co
 							Format:      "",
 						},
 					},
+					"artifactGC": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ArtifactGC describes the strategy to use when deleting this output artifact, overriding the workflow's strategy",
+							Ref:         ref("github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.ArtifactGC"),
+						},
+					},
 				},
 				Required: []string{"name"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.ArchiveStrategy", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.ArtifactoryArtifact", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.GCSArtifact", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.GitArtifact", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.HDFSArtifact", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.HTTPArtifact", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.OSSArtifact", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.RawArtifact", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.S3Artifact"},
+			"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.ArchiveStrategy", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.ArtifactGC", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.ArtifactoryArtifact", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.AzureArtifact", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.GCSArtifact", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.GitArtifact", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.HDFSArtifact", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.HTTPArtifact", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.OSSArtifact", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.RawArtifact", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.S3Artifact"},
+	}
+}
+
+func schema_pkg_apis_workflow_v1alpha1_ArtifactGC(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ArtifactGC describes how to delete artifacts from completed or deleted Workflows",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"strategy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Strategy is the strategy to use. One of \"OnWorkflowCompletion\", \"OnWorkflowDeletion\", \"Never\"",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -404,11 +483,17 @@ func schema_pkg_apis_workflow_v1alpha1_ArtifactLocation(ref common.ReferenceCall
 							Ref:         ref("github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.GCSArtifact"),
 						},
 					},
+					"azure": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Azure contains Azure Blob Storage artifact location details",
+							Ref:         ref("github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.AzureArtifact"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.ArtifactoryArtifact", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.GCSArtifact", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.GitArtifact", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.HDFSArtifact", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.HTTPArtifact", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.OSSArtifact", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.RawArtifact", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.S3Artifact"},
+			"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.ArtifactoryArtifact", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.AzureArtifact", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.GCSArtifact", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.GitArtifact", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.HDFSArtifact", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.HTTPArtifact", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.OSSArtifact", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.RawArtifact", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.S3Artifact"},
 	}
 }
 
@@ -541,6 +626,116 @@ func schema_pkg_apis_workflow_v1alpha1_ArtifactoryAuth(ref common.ReferenceCallb
 	}
 }
 
+func schema_pkg_apis_workflow_v1alpha1_AzureArtifact(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AzureArtifact is the location of an Azure Blob Storage artifact",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"endpoint": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Endpoint is the service url associated with an account. It is most likely \"https://<ACCOUNT_NAME>.blob.core.windows.net\"",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"container": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Container is the container where resources will be stored",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"accountKeySecret": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AccountKeySecret is the secret selector to the Azure Blob Storage account access key",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+					"sasTokenSecret": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SASTokenSecret is the secret selector to a shared access signature (SAS) token for the container",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+					"useSDKCreds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UseSDKCreds tells the driver to authenticate using the pod's managed identity",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"blob": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Blob is the blob name (i.e., path) in the container where the artifact resides",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"endpoint", "container", "blob"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.SecretKeySelector"},
+	}
+}
+
+func schema_pkg_apis_workflow_v1alpha1_AzureBlobContainer(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AzureBlobContainer contains the access information for interfacing with an Azure Blob Storage container",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"endpoint": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Endpoint is the service url associated with an account. It is most likely \"https://<ACCOUNT_NAME>.blob.core.windows.net\"",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"container": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Container is the container where resources will be stored",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"accountKeySecret": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AccountKeySecret is the secret selector to the Azure Blob Storage account access key",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+					"sasTokenSecret": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SASTokenSecret is the secret selector to a shared access signature (SAS) token for the container",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+					"useSDKCreds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UseSDKCreds tells the driver to authenticate using the pod's managed identity",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"endpoint", "container"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.SecretKeySelector"},
+	}
+}
+
 func schema_pkg_apis_workflow_v1alpha1_Backoff(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -589,12 +784,46 @@ func schema_pkg_apis_workflow_v1alpha1_Cache(ref common.ReferenceCallback) commo
 							Ref:         ref("k8s.io/api/core/v1.ConfigMapKeySelector"),
 						},
 					},
+					"database": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Database sets a cache stored in the controller's persistence database, which must be configured",
+							Ref:         ref("github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.DatabaseCache"),
+						},
+					},
 				},
-				Required: []string{"configMap"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.ConfigMapKeySelector"},
+			"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.DatabaseCache", "k8s.io/api/core/v1.ConfigMapKeySelector"},
+	}
+}
+
+func schema_pkg_apis_workflow_v1alpha1_ChildWorkflow(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ChildWorkflow is a template subtype to run a child workflow from a workflow template. The child is named after its node, is owned by the parent workflow, and its outputs are the outputs of the node.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"workflowTemplateRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WorkflowTemplateRef is a reference to the workflow template the child workflow is created from",
+							Ref:         ref("github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.WorkflowTemplateRef"),
+						},
+					},
+					"arguments": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Arguments are the arguments of the child workflow",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Arguments"),
+						},
+					},
+				},
+				Required: []string{"workflowTemplateRef"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Arguments", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.WorkflowTemplateRef"},
 	}
 }
 
@@ -673,52 +902,369 @@ func schema_pkg_apis_workflow_v1alpha1_ClusterWorkflowTemplateList(ref common.Re
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.ClusterWorkflowTemplate"),
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.ClusterWorkflowTemplate"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"metadata", "items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.ClusterWorkflowTemplate", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_pkg_apis_workflow_v1alpha1_Condition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the type of condition",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status is the status of the condition",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message is the condition message",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_workflow_v1alpha1_ContainerNode(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ContainerNode is a container of a container set",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the container specified as a DNS_LABEL. Each container in a pod must have a unique name (DNS_LABEL). Cannot be updated.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"image": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Docker image name. More info: https://kubernetes.io/docs/concepts/containers/images This field is optional to allow higher level config management to default or override container images in workload controllers like Deployments and StatefulSets.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"command": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Entrypoint array. Not executed within a shell. The docker image's ENTRYPOINT is used if this is not provided. Variable references $(VAR_NAME) are expanded using the container's environment. If a variable cannot be resolved, the reference in the input string will be unchanged. The $(VAR_NAME) syntax can be escaped with a double $$, ie: $$(VAR_NAME). Escaped references will never be expanded, regardless of whether the variable exists or not. Cannot be updated. More info: https://kubernetes.io/docs/tasks/inject-data-application/define-command-argument-container/#running-a-command-in-a-shell",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"args": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Arguments to the entrypoint. The docker image's CMD is used if this is not provided. Variable references $(VAR_NAME) are expanded using the container's environment. If a variable cannot be resolved, the reference in the input string will be unchanged. The $(VAR_NAME) syntax can be escaped with a double $$, ie: $$(VAR_NAME). Escaped references will never be expanded, regardless of whether the variable exists or not. Cannot be updated. More info: https://kubernetes.io/docs/tasks/inject-data-application/define-command-argument-container/#running-a-command-in-a-shell",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"workingDir": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Container's working directory. If not specified, the container runtime's default will be used, which might be configured in the container image. Cannot be updated.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ports": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"containerPort",
+									"protocol",
+								},
+								"x-kubernetes-list-type":       "map",
+								"x-kubernetes-patch-merge-key": "containerPort",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "List of ports to expose from the container. Exposing a port here gives the system additional information about the network connections a container uses, but is primarily informational. Not specifying a port here DOES NOT prevent that port from being exposed. Any port which is listening on the default \"0.0.0.0\" address inside a container will be accessible from the network. Cannot be updated.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.ContainerPort"),
+									},
+								},
+							},
+						},
+					},
+					"envFrom": {
+						SchemaProps: spec.SchemaProps{
+							Description: "List of sources to populate environment variables in the container. The keys defined within a source must be a C_IDENTIFIER. All invalid keys will be reported as an event when the container is starting. When a key exists in multiple sources, the value associated with the last source will take precedence. Values defined by an Env with a duplicate key will take precedence. Cannot be updated.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.EnvFromSource"),
+									},
+								},
+							},
+						},
+					},
+					"env": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "name",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "List of environment variables to set in the container. Cannot be updated.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.EnvVar"),
+									},
+								},
+							},
+						},
+					},
+					"resources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Compute Resources required by this container. Cannot be updated. More info: https://kubernetes.io/docs/concepts/configuration/manage-compute-resources-container/",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/api/core/v1.ResourceRequirements"),
+						},
+					},
+					"volumeMounts": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "mountPath",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Pod volumes to mount into the container's filesystem. Cannot be updated.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.VolumeMount"),
+									},
+								},
+							},
+						},
+					},
+					"volumeDevices": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "devicePath",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "volumeDevices is the list of block devices to be used by the container.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.VolumeDevice"),
+									},
+								},
+							},
+						},
+					},
+					"livenessProbe": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Periodic probe of container liveness. Container will be restarted if the probe fails. Cannot be updated. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes",
+							Ref:         ref("k8s.io/api/core/v1.Probe"),
+						},
+					},
+					"readinessProbe": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Periodic probe of container service readiness. Container will be removed from service endpoints if the probe fails. Cannot be updated. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes",
+							Ref:         ref("k8s.io/api/core/v1.Probe"),
+						},
+					},
+					"startupProbe": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StartupProbe indicates that the Pod has successfully initialized. If specified, no other probes are executed until this completes successfully. If this probe fails, the Pod will be restarted, just as if the livenessProbe failed. This can be used to provide different probe parameters at the beginning of a Pod's lifecycle, when it might take a long time to load data or warm a cache, than during steady-state operation. This cannot be updated. This is a beta feature enabled by the StartupProbe feature flag. More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes",
+							Ref:         ref("k8s.io/api/core/v1.Probe"),
+						},
+					},
+					"lifecycle": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Actions that the management system should take in response to container lifecycle events. Cannot be updated.",
+							Ref:         ref("k8s.io/api/core/v1.Lifecycle"),
+						},
+					},
+					"terminationMessagePath": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Optional: Path at which the file to which the container's termination message will be written is mounted into the container's filesystem. Message written is intended to be brief final status, such as an assertion failure message. Will be truncated by the node if greater than 4096 bytes. The total message length across all containers will be limited to 12kb. Defaults to /dev/termination-log. Cannot be updated.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"terminationMessagePolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Indicate how the termination message should be populated. File will use the contents of terminationMessagePath to populate the container status message on both success and failure. FallbackToLogsOnError will use the last chunk of container log output if the termination message file is empty and the container exited with an error. The log output is limited to 2048 bytes or 80 lines, whichever is smaller. Defaults to File. Cannot be updated.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"imagePullPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Image pull policy. One of Always, Never, IfNotPresent. Defaults to Always if :latest tag is specified, or IfNotPresent otherwise. Cannot be updated. More info: https://kubernetes.io/docs/concepts/containers/images#updating-images",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"securityContext": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Security options the pod should run with. More info: https://kubernetes.io/docs/concepts/policy/security-context/ More info: https://kubernetes.io/docs/tasks/configure-pod-container/security-context/",
+							Ref:         ref("k8s.io/api/core/v1.SecurityContext"),
+						},
+					},
+					"stdin": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Whether this container should allocate a buffer for stdin in the container runtime. If this is not set, reads from stdin in the container will always result in EOF. Default is false.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"stdinOnce": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Whether the container runtime should close the stdin channel after it has been opened by a single attach. When stdin is true the stdin stream will remain open across multiple attach sessions. If stdinOnce is set to true, stdin is opened on container start, is empty until the first client attaches to stdin, and then remains open and accepts data until the client disconnects, at which time stdin is closed and remains closed until the container is restarted. If this flag is false, a container processes that reads from stdin will never receive an EOF. Default is false",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"tty": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Whether this container should allocate a TTY for itself, also requires 'stdin' to be true. Default is false.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"dependencies": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Dependencies are the names of the containers of the set that must complete successfully before this one starts",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"metadata", "items"},
+				Required: []string{"name"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.ClusterWorkflowTemplate", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"k8s.io/api/core/v1.ContainerPort", "k8s.io/api/core/v1.EnvFromSource", "k8s.io/api/core/v1.EnvVar", "k8s.io/api/core/v1.Lifecycle", "k8s.io/api/core/v1.Probe", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.SecurityContext", "k8s.io/api/core/v1.VolumeDevice", "k8s.io/api/core/v1.VolumeMount"},
 	}
 }
 
-func schema_pkg_apis_workflow_v1alpha1_Condition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_workflow_v1alpha1_ContainerSetTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "ContainerSetTemplate is a template subtype to run several containers in a single pod. The containers start in the order of their dependencies, and each is reported as a child node of the pod's node.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"type": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Type is the type of condition",
-							Type:        []string{"string"},
-							Format:      "",
+					"containers": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "name",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
 						},
-					},
-					"status": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Status is the status of the condition",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Containers are the containers to run. A container named \"main\" is required if the template has outputs, as the outputs are collected from it.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.ContainerNode"),
+									},
+								},
+							},
 						},
 					},
-					"message": {
+					"volumeMounts": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "mountPath",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
 						SchemaProps: spec.SchemaProps{
-							Description: "Message is the condition message",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "VolumeMounts are mounted into every container of the set",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.VolumeMount"),
+									},
+								},
+							},
 						},
 					},
 				},
+				Required: []string{"containers"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.ContainerNode", "k8s.io/api/core/v1.VolumeMount"},
 	}
 }
 
@@ -789,6 +1335,84 @@ func schema_pkg_apis_workflow_v1alpha1_CreateS3BucketOptions(ref common.Referenc
 	}
 }
 
+func schema_pkg_apis_workflow_v1alpha1_CronExclusionWindow(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CronExclusionWindow is a period of time during which scheduled runs are skipped. It is either a recurring window, given by a start schedule and a duration, or a list of dates, or both.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"start": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Start is when each occurrence of the window starts, in Cron format, e.g. \"0 18 * * 5\" for Fridays at 18:00",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"duration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Duration is how long each occurrence of the window lasts, e.g. \"60h\". Required with Start.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"dates": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Dates are whole days that are excluded in the format YYYY-MM-DD, e.g. a holiday calendar",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"timezone": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timezone is the timezone against which the window will be calculated, e.g. \"Asia/Tokyo\". Defaults to the CronWorkflow's timezone.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_workflow_v1alpha1_CronSchedule(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CronSchedule is a schedule to run a Workflow at",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"schedule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schedule is the schedule in Cron format",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"timezone": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timezone is the timezone against which the schedule will be calculated, e.g. \"Asia/Tokyo\". Defaults to the CronWorkflow's timezone.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"schedule"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_workflow_v1alpha1_CronWorkflow(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -903,7 +1527,6 @@ func schema_pkg_apis_workflow_v1alpha1_CronWorkflowSpec(ref common.ReferenceCall
 					"schedule": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Schedule is a schedule to run the Workflow in Cron format",
-							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -956,12 +1579,40 @@ func schema_pkg_apis_workflow_v1alpha1_CronWorkflowSpec(ref common.ReferenceCall
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
+					"schedules": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schedules are additional schedules to run the Workflow at, in Cron format",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.CronSchedule"),
+									},
+								},
+							},
+						},
+					},
+					"exclusionWindows": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExclusionWindows are periods of time during which scheduled runs are skipped",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.CronExclusionWindow"),
+									},
+								},
+							},
+						},
+					},
 				},
-				Required: []string{"workflowSpec", "schedule"},
+				Required: []string{"workflowSpec"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.WorkflowSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.CronExclusionWindow", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.CronSchedule", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.WorkflowSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
@@ -1119,12 +1770,45 @@ func schema_pkg_apis_workflow_v1alpha1_DAGTask(ref common.ReferenceCallback) com
 							Format:      "",
 						},
 					},
+					"hooks": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Hooks are templates, keyed by name, that are each run once when their expression first becomes true while the task runs, e.g. `tasks.deploy.status == \"Failed\"`",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.LifecycleHook"),
+									},
+								},
+							},
+						},
+					},
+					"inline": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Inline is the template to execute, defined in place rather than referred to by name",
+							Ref:         ref("github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Template"),
+						},
+					},
+					"loop": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Loop repeats the task, one iteration after the other, until its condition is met",
+							Ref:         ref("github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Loop"),
+						},
+					},
+					"withArtifact": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WithArtifact expands a task into multiple parallel tasks from the JSON list stored in an artifact, which may be too large to be held by a parameter",
+							Ref:         ref("github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.WithArtifact"),
+						},
+					},
 				},
 				Required: []string{"name"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Arguments", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.ContinueOn", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Item", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Sequence", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.TemplateRef"},
+			"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Arguments", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.ContinueOn", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Item", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.LifecycleHook", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Loop", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Sequence", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Template", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.TemplateRef", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.WithArtifact"},
 	}
 }
 
@@ -1178,6 +1862,28 @@ func schema_pkg_apis_workflow_v1alpha1_DAGTemplate(ref common.ReferenceCallback)
 	}
 }
 
+func schema_pkg_apis_workflow_v1alpha1_DatabaseCache(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatabaseCache is a memoization cache stored in the controller's persistence database",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the cache",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_workflow_v1alpha1_Event(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1219,6 +1925,27 @@ func schema_pkg_apis_workflow_v1alpha1_ExecutorConfig(ref common.ReferenceCallba
 	}
 }
 
+func schema_pkg_apis_workflow_v1alpha1_ExportTo(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ExportTo is where the value of an output parameter is exported to",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"configMapKeyRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConfigMapKeyRef is a key of a config map, in the namespace of the workflow, that the value is written to by the controller. The config map is created if it does not exist.",
+							Ref:         ref("k8s.io/api/core/v1.ConfigMapKeySelector"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.ConfigMapKeySelector"},
+	}
+}
+
 func schema_pkg_apis_workflow_v1alpha1_GCSArtifact(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1569,53 +2296,119 @@ func schema_pkg_apis_workflow_v1alpha1_HDFSKrbConfig(ref common.ReferenceCallbac
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "HDFSKrbConfig is auth configurations for Kerberos",
+				Description: "HDFSKrbConfig is auth configurations for Kerberos",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"krbCCacheSecret": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KrbCCacheSecret is the secret selector for Kerberos ccache Either ccache or keytab can be set to use Kerberos.",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+					"krbKeytabSecret": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KrbKeytabSecret is the secret selector for Kerberos keytab Either ccache or keytab can be set to use Kerberos.",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+					"krbUsername": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KrbUsername is the Kerberos username used with Kerberos keytab It must be set if keytab is used.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"krbRealm": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KrbRealm is the Kerberos realm used with Kerberos keytab It must be set if keytab is used.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"krbConfigConfigMap": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KrbConfig is the configmap selector for Kerberos config as string It must be set if either ccache or keytab is used.",
+							Ref:         ref("k8s.io/api/core/v1.ConfigMapKeySelector"),
+						},
+					},
+					"krbServicePrincipalName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KrbServicePrincipalName is the principal name of Kerberos service It must be set if either ccache or keytab is used.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.ConfigMapKeySelector", "k8s.io/api/core/v1.SecretKeySelector"},
+	}
+}
+
+func schema_pkg_apis_workflow_v1alpha1_HTTP(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HTTP is a template subtype to make a HTTP request. The request is made by the controller, so it does not have the overhead of a pod, and the response body is the template's result.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"krbCCacheSecret": {
+					"method": {
 						SchemaProps: spec.SchemaProps{
-							Description: "KrbCCacheSecret is the secret selector for Kerberos ccache Either ccache or keytab can be set to use Kerberos.",
-							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+							Description: "Method is the HTTP method of the request, it defaults to GET",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"krbKeytabSecret": {
+					"url": {
 						SchemaProps: spec.SchemaProps{
-							Description: "KrbKeytabSecret is the secret selector for Kerberos keytab Either ccache or keytab can be set to use Kerberos.",
-							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+							Description: "URL is the URL of the request",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"krbUsername": {
+					"headers": {
 						SchemaProps: spec.SchemaProps{
-							Description: "KrbUsername is the Kerberos username used with Kerberos keytab It must be set if keytab is used.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Headers are the headers of the request",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.HTTPHeader"),
+									},
+								},
+							},
 						},
 					},
-					"krbRealm": {
+					"timeoutSeconds": {
 						SchemaProps: spec.SchemaProps{
-							Description: "KrbRealm is the Kerberos realm used with Kerberos keytab It must be set if keytab is used.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "TimeoutSeconds is the number of seconds to wait for the response, it defaults to 30 and may be at most 60",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"krbConfigConfigMap": {
+					"body": {
 						SchemaProps: spec.SchemaProps{
-							Description: "KrbConfig is the configmap selector for Kerberos config as string It must be set if either ccache or keytab is used.",
-							Ref:         ref("k8s.io/api/core/v1.ConfigMapKeySelector"),
+							Description: "Body is the body of the request",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"krbServicePrincipalName": {
+					"successCondition": {
 						SchemaProps: spec.SchemaProps{
-							Description: "KrbServicePrincipalName is the principal name of Kerberos service It must be set if either ccache or keytab is used.",
+							Description: "SuccessCondition is an expression (https://github.com/antonmedv/expr) over the response that must be true for the request to be successful, e.g. `response.statusCode == 201 && response.body contains \"ok\"`. It defaults to the status code being 2xx.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
+				Required: []string{"url"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.ConfigMapKeySelector", "k8s.io/api/core/v1.SecretKeySelector"},
+			"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.HTTPHeader"},
 	}
 }
 
@@ -1657,6 +2450,64 @@ func schema_pkg_apis_workflow_v1alpha1_HTTPArtifact(ref common.ReferenceCallback
 	}
 }
 
+func schema_pkg_apis_workflow_v1alpha1_HTTPHeader(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HTTPHeader is a header of a HTTP request",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the header",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Value is the value of the header",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"valueFrom": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ValueFrom is the source of the value of the header, for values that must not be in the workflow",
+							Ref:         ref("github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.HTTPHeaderSource"),
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.HTTPHeaderSource"},
+	}
+}
+
+func schema_pkg_apis_workflow_v1alpha1_HTTPHeaderSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HTTPHeaderSource is the source of the value of a HTTP header",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"secretKeyRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretKeyRef is a key of a secret in the workflow's namespace",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.SecretKeySelector"},
+	}
+}
+
 func schema_pkg_apis_workflow_v1alpha1_Header(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1792,6 +2643,50 @@ func schema_pkg_apis_workflow_v1alpha1_Item(ref common.ReferenceCallback) common
 	}
 }
 
+func schema_pkg_apis_workflow_v1alpha1_LifecycleHook(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LifecycleHook is a template that is run, at most once, when its expression first evaluates to true. Unlike an exit handler, the success or failure of a hook does not affect the phase of what it hooks.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"template": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Template is the name of the template to execute by the hook",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"arguments": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Arguments hold arguments to the template",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Arguments"),
+						},
+					},
+					"templateRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TemplateRef is the reference to the template resource to execute by the hook",
+							Ref:         ref("github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.TemplateRef"),
+						},
+					},
+					"expression": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Expression is evaluated on each reconciliation, and runs the hook the first time it is true. The status of the workflow, step or task is available as `workflow.status`, `steps.<name>.status` or `tasks.<name>.status`.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"expression"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Arguments", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.TemplateRef"},
+	}
+}
+
 func schema_pkg_apis_workflow_v1alpha1_Link(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1836,6 +2731,42 @@ func schema_pkg_apis_workflow_v1alpha1_Link(ref common.ReferenceCallback) common
 	}
 }
 
+func schema_pkg_apis_workflow_v1alpha1_Loop(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Loop repeats a step or task until its condition is met. Each iteration is a child node of the loop node, and the arguments of an iteration can refer to `loop.index` and to the outputs of the previous iteration, e.g. `loop.outputs.parameters.<name>` and `loop.outputs.result`.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"until": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Until is an expression that is evaluated after each successful iteration, and ends the loop when it is true. It can refer to the outputs of the iteration, e.g. `outputs.parameters.<name>`, `outputs.result` and `outputs.exitCode`, and to `loop.index`. If it is empty, the loop runs maxIterations times.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maxIterations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxIterations is the maximum number of iterations. The loop fails if its condition is still not met after them.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"delay": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Delay is the amount of time to wait between iterations. Default unit is seconds, but could also be a duration (e.g. \"2m\", \"1h\")",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"maxIterations"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_workflow_v1alpha1_MemoizationStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1867,6 +2798,20 @@ func schema_pkg_apis_workflow_v1alpha1_MemoizationStatus(ref common.ReferenceCal
 							Format:      "",
 						},
 					},
+					"cacheType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CacheType is the type of the cache that was used, \"ConfigMap\" if empty",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maxAge": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxAge is the maximum age of the cache entry, after which it may be evicted from caches that support eviction",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"hit", "key", "cacheName"},
 			},
@@ -2324,6 +3269,36 @@ func schema_pkg_apis_workflow_v1alpha1_NodeStatus(ref common.ReferenceCallback)
 							Ref:         ref("github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.NodeSynchronizationStatus"),
 						},
 					},
+					"failureReason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailureReason is the cause of the node failing, if it is known. One of: OOMKilled, Evicted, DeadlineExceeded, ImagePull, NodeLost, ExitCode, Preempted or ArtifactError",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"exitCode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExitCode is the exit code of the main container of the pod of a failed node, if it exited",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"withArtifactChildren": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WithArtifactChildren are the numbers of children that the withArtifact of the steps of this step group, or of the tasks of this DAG, were expanded into, by the name of the step or task. Once all of them exist, the children are rebuilt from their nodes, rather than from the items of the artifact.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: 0,
+										Type:    []string{"integer"},
+										Format:  "int32",
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"id", "name", "type"},
 			},
@@ -2342,7 +3317,7 @@ func schema_pkg_apis_workflow_v1alpha1_NodeSynchronizationStatus(ref common.Refe
 				Properties: map[string]spec.Schema{
 					"waiting": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Waiting is the name of the lock that this node is waiting for",
+							Description: "Waiting is the name of the lock that this node is waiting for, or the first of them if it waits for several",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -2601,12 +3576,38 @@ func schema_pkg_apis_workflow_v1alpha1_Parameter(ref common.ReferenceCallback) c
 							},
 						},
 					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the type of the value of the parameter, which is checked when the workflow is created or submitted",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"schema": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schema is a JSON schema that the value of the parameter, parsed as JSON, must be valid against, e.g. `{\"type\": \"integer\", \"minimum\": 1}`. It is checked when the workflow is created or submitted.",
+							Ref:         ref("github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Item"),
+						},
+					},
+					"sensitive": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Sensitive marks the value of the parameter as sensitive, so that it is redacted from the status of the workflow, the templates stored on its pods, the archive and API responses. The value is only made available to container and script templates, via an environment variable that is resolved when the pod runs.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"exportTo": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExportTo is where the value of an output parameter is exported to when its node succeeds, so that other workflows can use it",
+							Ref:         ref("github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.ExportTo"),
+						},
+					},
 				},
 				Required: []string{"name"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.ValueFrom"},
+			"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.ExportTo", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Item", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.ValueFrom"},
 	}
 }
 
@@ -2809,19 +3810,69 @@ func schema_pkg_apis_workflow_v1alpha1_RetryAffinity(ref common.ReferenceCallbac
 				},
 			},
 		},
-		Dependencies: []string{
-			"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.RetryNodeAntiAffinity"},
-	}
-}
-
-func schema_pkg_apis_workflow_v1alpha1_RetryNodeAntiAffinity(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "RetryNodeAntiAffinity is a placeholder for future expansion, only empty nodeAntiAffinity is allowed. In order to prevent running steps on the same host, it uses \"kubernetes.io/hostname\".",
-				Type:        []string{"object"},
-			},
-		},
+		Dependencies: []string{
+			"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.RetryNodeAntiAffinity"},
+	}
+}
+
+func schema_pkg_apis_workflow_v1alpha1_RetryNodeAntiAffinity(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RetryNodeAntiAffinity is a placeholder for future expansion, only empty nodeAntiAffinity is allowed. In order to prevent running steps on the same host, it uses \"kubernetes.io/hostname\".",
+				Type:        []string{"object"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_workflow_v1alpha1_RetryOn(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RetryOn is a rule for the failures that are retried. A failure matches the rule if it matches all of the rule's conditions.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"exitCodes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExitCodes are the exit codes of the main container that are retried, e.g. 137 and 143",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: 0,
+										Type:    []string{"integer"},
+										Format:  "int32",
+									},
+								},
+							},
+						},
+					},
+					"reasons": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reasons are the reasons of the pod, or of one of its containers, that are retried, e.g. `Evicted`, `OOMKilled` or `ImagePullBackOff`",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"messagePattern": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MessagePattern is a regular expression that the message of the failed node must match to be retried",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -2857,11 +3908,32 @@ func schema_pkg_apis_workflow_v1alpha1_RetryStrategy(ref common.ReferenceCallbac
 							Ref:         ref("github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.RetryAffinity"),
 						},
 					},
+					"expression": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Expression is a condition expression for when a node will be retried. If it evaluates to false, the node will not be retried and the retry strategy will be ignored. It can refer to `retries`, `lastRetry.exitCode`, `lastRetry.status`, `lastRetry.duration` and `lastRetry.message`.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"retryOn": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RetryOn are rules for the failures that are retried, e.g. exit code 137 or an evicted pod. If there are any, a failure that the retry policy allows to be retried is only retried if it matches one of them.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.RetryOn"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Backoff", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.RetryAffinity", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
+			"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Backoff", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.RetryAffinity", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.RetryOn", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
 	}
 }
 
@@ -3320,6 +4392,22 @@ func schema_pkg_apis_workflow_v1alpha1_SemaphoreHolding(ref common.ReferenceCall
 							},
 						},
 					},
+					"weights": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Weights stores the number of units held by the holders that acquired more than one unit of the semaphore.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: 0,
+										Type:    []string{"integer"},
+										Format:  "int32",
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
@@ -3339,6 +4427,13 @@ func schema_pkg_apis_workflow_v1alpha1_SemaphoreRef(ref common.ReferenceCallback
 							Ref:         ref("k8s.io/api/core/v1.ConfigMapKeySelector"),
 						},
 					},
+					"weight": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Weight is the number of units of the semaphore to acquire. Defaults to 1.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 				},
 			},
 		},
@@ -3606,6 +4701,44 @@ func schema_pkg_apis_workflow_v1alpha1_Synchronization(ref common.ReferenceCallb
 							Ref:         ref("github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Mutex"),
 						},
 					},
+					"semaphores": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Semaphores holds the configuration of further semaphores. All the semaphores and mutexes are acquired together, or not at all, so that templates waiting for several locks do not deadlock.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.SemaphoreRef"),
+									},
+								},
+							},
+						},
+					},
+					"mutexes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Mutexes holds the details of further mutex locks",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Mutex"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
@@ -3704,8 +4837,7 @@ func schema_pkg_apis_workflow_v1alpha1_Template(ref common.ReferenceCallback) co
 				Properties: map[string]spec.Schema{
 					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the template",
-							Default:     "",
+							Description: "Name is the name of the template. It is required, except for inline templates and the template defaults.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -3812,6 +4944,24 @@ func schema_pkg_apis_workflow_v1alpha1_Template(ref common.ReferenceCallback) co
 							Ref:         ref("github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.ResourceTemplate"),
 						},
 					},
+					"containerSet": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ContainerSet runs several containers, which may depend on each other, in a single pod",
+							Ref:         ref("github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.ContainerSetTemplate"),
+						},
+					},
+					"http": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HTTP makes a HTTP request, which is made by the controller rather than in a pod",
+							Ref:         ref("github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.HTTP"),
+						},
+					},
+					"workflow": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Workflow runs a child workflow from a workflow template, and waits for it to complete",
+							Ref:         ref("github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.ChildWorkflow"),
+						},
+					},
 					"dag": {
 						SchemaProps: spec.SchemaProps{
 							Description: "DAG template subtype which runs a DAG",
@@ -4029,11 +5179,10 @@ func schema_pkg_apis_workflow_v1alpha1_Template(ref common.ReferenceCallback) co
 						},
 					},
 				},
-				Required: []string{"name"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Arguments", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.ArtifactLocation", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.DAGTemplate", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.ExecutorConfig", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Inputs", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Memoize", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Metadata", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Metrics", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Outputs", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.ParallelSteps", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.ResourceTemplate", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.RetryStrategy", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.ScriptTemplate", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.SuspendTemplate", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Synchronization", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.TemplateRef", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.UserContainer", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.Container", "k8s.io/api/core/v1.HostAlias", "k8s.io/api/core/v1.PodSecurityContext", "k8s.io/api/core/v1.Toleration", "k8s.io/api/core/v1.Volume", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
+			"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Arguments", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.ArtifactLocation", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.ChildWorkflow", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.ContainerSetTemplate", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.DAGTemplate", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.ExecutorConfig", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.HTTP", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Inputs", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Memoize", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Metadata", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Metrics", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Outputs", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.ParallelSteps", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.ResourceTemplate", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.RetryStrategy", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.ScriptTemplate", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.SuspendTemplate", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Synchronization", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.TemplateRef", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.UserContainer", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.Container", "k8s.io/api/core/v1.HostAlias", "k8s.io/api/core/v1.PodSecurityContext", "k8s.io/api/core/v1.Toleration", "k8s.io/api/core/v1.Volume", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
 	}
 }
 
@@ -4386,11 +5535,23 @@ func schema_pkg_apis_workflow_v1alpha1_ValueFrom(ref common.ReferenceCallback) c
 							Format:      "",
 						},
 					},
+					"secretKeyRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretKeyRef is a key of a secret to use as the value of an argument or input parameter. It is resolved when the pod runs, and the parameter is sensitive.",
+							Ref:         ref("k8s.io/api/core/v1.SecretKeySelector"),
+						},
+					},
+					"configMapKeyRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConfigMapKeyRef is a key of a config map, in the namespace of the workflow, to use as the value of a workflow, argument or input parameter. It is resolved by the controller.",
+							Ref:         ref("k8s.io/api/core/v1.ConfigMapKeySelector"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.SuppliedValueFrom"},
+			"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.SuppliedValueFrom", "k8s.io/api/core/v1.ConfigMapKeySelector", "k8s.io/api/core/v1.SecretKeySelector"},
 	}
 }
 
@@ -4483,6 +5644,96 @@ func schema_pkg_apis_workflow_v1alpha1_VolumeClaimGC(ref common.ReferenceCallbac
 	}
 }
 
+func schema_pkg_apis_workflow_v1alpha1_WithArtifact(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WithArtifact expands a step or task over the items of a JSON list stored in an artifact. The list is read from the artifact repository as the step or task is expanded, rather than being held by the workflow.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"from": {
+						SchemaProps: spec.SchemaProps{
+							Description: "From is the artifact that holds the list, usually an output artifact of a previous step or task, e.g. `{{steps.generate.outputs.artifacts.items}}`",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"archiveLogs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ArchiveLogs indicates if the container logs should be archived",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"s3": {
+						SchemaProps: spec.SchemaProps{
+							Description: "S3 contains S3 artifact location details",
+							Ref:         ref("github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.S3Artifact"),
+						},
+					},
+					"git": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Git contains git artifact location details",
+							Ref:         ref("github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.GitArtifact"),
+						},
+					},
+					"http": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HTTP contains HTTP artifact location details",
+							Ref:         ref("github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.HTTPArtifact"),
+						},
+					},
+					"artifactory": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Artifactory contains artifactory artifact location details",
+							Ref:         ref("github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.ArtifactoryArtifact"),
+						},
+					},
+					"hdfs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HDFS contains HDFS artifact location details",
+							Ref:         ref("github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.HDFSArtifact"),
+						},
+					},
+					"raw": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Raw contains raw artifact location details",
+							Ref:         ref("github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.RawArtifact"),
+						},
+					},
+					"oss": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OSS contains OSS artifact location details",
+							Ref:         ref("github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.OSSArtifact"),
+						},
+					},
+					"gcs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GCS contains GCS artifact location details",
+							Ref:         ref("github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.GCSArtifact"),
+						},
+					},
+					"azure": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Azure contains Azure Blob Storage artifact location details",
+							Ref:         ref("github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.AzureArtifact"),
+						},
+					},
+					"batchSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BatchSize is the number of items each expanded step or task is given (default: 1). If it is greater than one, `{{item}}` is a JSON list of the items of the batch.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.ArtifactoryArtifact", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.AzureArtifact", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.GCSArtifact", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.GitArtifact", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.HDFSArtifact", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.HTTPArtifact", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.OSSArtifact", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.RawArtifact", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.S3Artifact"},
+	}
+}
+
 func schema_pkg_apis_workflow_v1alpha1_Workflow(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -5038,11 +6289,38 @@ func schema_pkg_apis_workflow_v1alpha1_WorkflowSpec(ref common.ReferenceCallback
 							Ref:         ref("github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.RetryStrategy"),
 						},
 					},
+					"artifactGC": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ArtifactGC describes the strategy to use when deleting output artifacts from completed or deleted workflows. It can be overridden by individual artifacts.",
+							Ref:         ref("github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.ArtifactGC"),
+						},
+					},
+					"hooks": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Hooks are templates, keyed by name, that are each run once when their expression first becomes true while the workflow runs, e.g. `workflow.status == \"Running\"`",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.LifecycleHook"),
+									},
+								},
+							},
+						},
+					},
+					"templateDefaults": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TemplateDefaults holds the default fields of the templates of the workflow, e.g. `retryStrategy` or `podSpecPatch`, which are strategically merged into each template. The fields of a template take precedence.",
+							Ref:         ref("github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Template"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Arguments", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.ArtifactRepositoryRef", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.ExecutorConfig", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Metrics", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.PodGC", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.RetryStrategy", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Synchronization", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.TTLStrategy", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Template", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.VolumeClaimGC", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.WorkflowTemplateRef", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.HostAlias", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/api/core/v1.PersistentVolumeClaim", "k8s.io/api/core/v1.PodDNSConfig", "k8s.io/api/core/v1.PodSecurityContext", "k8s.io/api/core/v1.Toleration", "k8s.io/api/core/v1.Volume", "k8s.io/api/policy/v1beta1.PodDisruptionBudgetSpec"},
+			"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Arguments", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.ArtifactGC", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.ArtifactRepositoryRef", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.ExecutorConfig", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.LifecycleHook", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Metrics", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.PodGC", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.RetryStrategy", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Synchronization", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.TTLStrategy", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Template", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.VolumeClaimGC", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.WorkflowTemplateRef", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.HostAlias", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/api/core/v1.PersistentVolumeClaim", "k8s.io/api/core/v1.PodDNSConfig", "k8s.io/api/core/v1.PodSecurityContext", "k8s.io/api/core/v1.Toleration", "k8s.io/api/core/v1.Volume", "k8s.io/api/policy/v1beta1.PodDisruptionBudgetSpec"},
 	}
 }
 
@@ -5207,11 +6485,33 @@ func schema_pkg_apis_workflow_v1alpha1_WorkflowStatus(ref common.ReferenceCallba
 							Ref:         ref("github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.ArtifactRepositoryRefStatus"),
 						},
 					},
+					"artifactGCStatus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ArtifactGCStatus maintains the status of artifact garbage collection",
+							Ref:         ref("github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.ArtGCStatus"),
+						},
+					},
+					"configMapParameterValues": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConfigMapParameterValues are the values of the workflow parameters that are from config maps, by the name of the parameter. They are resolved once, as the workflow starts, so that the config maps are not read again.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.ArtifactRepositoryRefStatus", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Condition", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.NodeStatus", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Outputs", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.SynchronizationStatus", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Template", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.WorkflowSpec", "k8s.io/api/core/v1.Volume", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.ArtGCStatus", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.ArtifactRepositoryRefStatus", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Condition", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.NodeStatus", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Outputs", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.SynchronizationStatus", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Template", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.WorkflowSpec", "k8s.io/api/core/v1.Volume", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -5296,11 +6596,44 @@ func schema_pkg_apis_workflow_v1alpha1_WorkflowStep(ref common.ReferenceCallback
 							Format:      "",
 						},
 					},
+					"hooks": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Hooks are templates, keyed by name, that are each run once when their expression first becomes true while the step runs, e.g. `steps.deploy.status == \"Failed\"`",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.LifecycleHook"),
+									},
+								},
+							},
+						},
+					},
+					"inline": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Inline is the template to execute as the step, defined in place rather than referred to by name",
+							Ref:         ref("github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Template"),
+						},
+					},
+					"loop": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Loop repeats the step, one iteration after the other, until its condition is met",
+							Ref:         ref("github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Loop"),
+						},
+					},
+					"withArtifact": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WithArtifact expands a step into multiple parallel steps from the JSON list stored in an artifact, which may be too large to be held by a parameter",
+							Ref:         ref("github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.WithArtifact"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Arguments", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.ContinueOn", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Item", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Sequence", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.TemplateRef"},
+			"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Arguments", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.ContinueOn", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Item", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.LifecycleHook", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Loop", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Sequence", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Template", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.TemplateRef", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.WithArtifact"},
 	}
 }
 
@@ -5761,6 +7094,33 @@ func schema_pkg_apis_workflow_v1alpha1_WorkflowTemplateSpec(ref common.Reference
 							Ref:         ref("github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.RetryStrategy"),
 						},
 					},
+					"artifactGC": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ArtifactGC describes the strategy to use when deleting output artifacts from completed or deleted workflows. It can be overridden by individual artifacts.",
+							Ref:         ref("github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.ArtifactGC"),
+						},
+					},
+					"hooks": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Hooks are templates, keyed by name, that are each run once when their expression first becomes true while the workflow runs, e.g. `workflow.status == \"Running\"`",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.LifecycleHook"),
+									},
+								},
+							},
+						},
+					},
+					"templateDefaults": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TemplateDefaults holds the default fields of the templates of the workflow, e.g. `retryStrategy` or `podSpecPatch`, which are strategically merged into each template. The fields of a template take precedence.",
+							Ref:         ref("github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Template"),
+						},
+					},
 					"workflowMetadata": {
 						SchemaProps: spec.SchemaProps{
 							Description: "WorkflowMetadata contains some metadata of the workflow to be refer",
@@ -5771,7 +7131,7 @@ func schema_pkg_apis_workflow_v1alpha1_WorkflowTemplateSpec(ref common.Reference
 			},
 		},
 		Dependencies: []string{
-			"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Arguments", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.ArtifactRepositoryRef", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.ExecutorConfig", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Metrics", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.PodGC", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.RetryStrategy", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Synchronization", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.TTLStrategy", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Template", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.VolumeClaimGC", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.WorkflowTemplateRef", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.HostAlias", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/api/core/v1.PersistentVolumeClaim", "k8s.io/api/core/v1.PodDNSConfig", "k8s.io/api/core/v1.PodSecurityContext", "k8s.io/api/core/v1.Toleration", "k8s.io/api/core/v1.Volume", "k8s.io/api/policy/v1beta1.PodDisruptionBudgetSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Arguments", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.ArtifactGC", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.ArtifactRepositoryRef", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.ExecutorConfig", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.LifecycleHook", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Metrics", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.PodGC", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.RetryStrategy", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Synchronization", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.TTLStrategy", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.Template", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.VolumeClaimGC", "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1.WorkflowTemplateRef", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.HostAlias", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/api/core/v1.PersistentVolumeClaim", "k8s.io/api/core/v1.PodDNSConfig", "k8s.io/api/core/v1.PodSecurityContext", "k8s.io/api/core/v1.Toleration", "k8s.io/api/core/v1.Volume", "k8s.io/api/policy/v1beta1.PodDisruptionBudgetSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
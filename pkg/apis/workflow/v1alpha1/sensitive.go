@@ -0,0 +1,69 @@
+package v1alpha1
+
+// IsSensitive returns whether the value of the parameter is sensitive, either because it is marked as such, or because
+// it is from a secret
+func (p Parameter) IsSensitive() bool {
+	return p.Sensitive || (p.ValueFrom != nil && p.ValueFrom.SecretKeyRef != nil)
+}
+
+// Redacted returns a copy of the parameters in which the values of the sensitive parameters are redacted
+func Redacted(params []Parameter) []Parameter {
+	if params == nil {
+		return nil
+	}
+	result := make([]Parameter, len(params))
+	for i, param := range params {
+		if param.IsSensitive() {
+			if param.Value != nil {
+				param.Value = AnyStringPtr(RedactedValue)
+			}
+			if param.Default != nil {
+				param.Default = AnyStringPtr(RedactedValue)
+			}
+		}
+		result[i] = param
+	}
+	return result
+}
+
+// Redact redacts the values of the sensitive parameters in the inputs of the template
+func (tmpl *Template) Redact() {
+	tmpl.Inputs.Parameters = Redacted(tmpl.Inputs.Parameters)
+}
+
+// Redact redacts the values of the sensitive parameters in the arguments and templates of the spec
+func (wfs *WorkflowSpec) Redact() {
+	wfs.Arguments.Parameters = Redacted(wfs.Arguments.Parameters)
+	for i := range wfs.Templates {
+		wfs.Templates[i].Redact()
+	}
+}
+
+// Redact redacts the values of the workflow's sensitive parameters, in its spec, the inputs of its nodes, its stored
+// templates and the values resolved from config maps, so that the workflow can be archived or returned by the API
+// without them
+func (wf *Workflow) Redact() {
+	for name := range wf.Status.ConfigMapParameterValues {
+		param := wf.Spec.Arguments.GetParameterByName(name)
+		if wf.Status.StoredWorkflowSpec != nil && (param == nil || !param.IsSensitive()) {
+			param = wf.Status.StoredWorkflowSpec.Arguments.GetParameterByName(name)
+		}
+		if param != nil && param.IsSensitive() {
+			wf.Status.ConfigMapParameterValues[name] = RedactedValue
+		}
+	}
+	wf.Spec.Redact()
+	if wf.Status.StoredWorkflowSpec != nil {
+		wf.Status.StoredWorkflowSpec.Redact()
+	}
+	for id, tmpl := range wf.Status.StoredTemplates {
+		tmpl.Redact()
+		wf.Status.StoredTemplates[id] = tmpl
+	}
+	for id, node := range wf.Status.Nodes {
+		if node.Inputs != nil {
+			node.Inputs.Parameters = Redacted(node.Inputs.Parameters)
+			wf.Status.Nodes[id] = node
+		}
+	}
+}
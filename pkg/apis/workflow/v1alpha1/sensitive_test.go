@@ -0,0 +1,52 @@
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestParameter_IsSensitive(t *testing.T) {
+	assert.False(t, Parameter{Name: "message"}.IsSensitive())
+	assert.True(t, Parameter{Name: "token", Sensitive: true}.IsSensitive())
+	assert.True(t, Parameter{Name: "token", ValueFrom: &ValueFrom{SecretKeyRef: &corev1.SecretKeySelector{Key: "token"}}}.IsSensitive())
+}
+
+func TestRedacted(t *testing.T) {
+	params := []Parameter{
+		{Name: "message", Value: AnyStringPtr("hello")},
+		{Name: "token", Value: AnyStringPtr("my-token"), Default: AnyStringPtr("my-default"), Sensitive: true},
+		{Name: "password", ValueFrom: &ValueFrom{SecretKeyRef: &corev1.SecretKeySelector{Key: "password"}}},
+	}
+	redacted := Redacted(params)
+	assert.Equal(t, "hello", redacted[0].Value.String())
+	assert.Equal(t, RedactedValue, redacted[1].Value.String())
+	assert.Equal(t, RedactedValue, redacted[1].Default.String())
+	assert.Nil(t, redacted[2].Value)
+	assert.Equal(t, "my-token", params[1].Value.String(), "the parameters are not modified")
+	assert.Nil(t, Redacted(nil))
+}
+
+func TestWorkflow_Redact(t *testing.T) {
+	token := Parameter{Name: "token", Value: AnyStringPtr("my-token"), Sensitive: true}
+	wf := &Workflow{
+		Spec: WorkflowSpec{
+			Arguments: Arguments{Parameters: []Parameter{token}},
+			Templates: []Template{{Name: "main", Inputs: Inputs{Parameters: []Parameter{token}}}},
+		},
+		Status: WorkflowStatus{
+			StoredTemplates:          map[string]Template{"main": {Name: "main", Inputs: Inputs{Parameters: []Parameter{token}}}},
+			StoredWorkflowSpec:       &WorkflowSpec{Arguments: Arguments{Parameters: []Parameter{token}}},
+			Nodes:                    Nodes{"my-node": {Inputs: &Inputs{Parameters: []Parameter{token}}}},
+			ConfigMapParameterValues: map[string]string{"token": "my-token", "region": "eu-west-1"},
+		},
+	}
+	wf.Redact()
+	assert.Equal(t, RedactedValue, wf.Spec.Arguments.Parameters[0].Value.String())
+	assert.Equal(t, RedactedValue, wf.Spec.Templates[0].Inputs.Parameters[0].Value.String())
+	assert.Equal(t, RedactedValue, wf.Status.StoredTemplates["main"].Inputs.Parameters[0].Value.String())
+	assert.Equal(t, RedactedValue, wf.Status.StoredWorkflowSpec.Arguments.Parameters[0].Value.String())
+	assert.Equal(t, RedactedValue, wf.Status.Nodes["my-node"].Inputs.Parameters[0].Value.String())
+	assert.Equal(t, map[string]string{"token": RedactedValue, "region": "eu-west-1"}, wf.Status.ConfigMapParameterValues)
+}
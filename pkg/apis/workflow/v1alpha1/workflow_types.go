@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"hash/fnv"
+	"net/http"
 	"reflect"
 	"sort"
 	"strings"
@@ -23,13 +24,16 @@ type TemplateType string
 
 // Possible template types
 const (
-	TemplateTypeContainer TemplateType = "Container"
-	TemplateTypeSteps     TemplateType = "Steps"
-	TemplateTypeScript    TemplateType = "Script"
-	TemplateTypeResource  TemplateType = "Resource"
-	TemplateTypeDAG       TemplateType = "DAG"
-	TemplateTypeSuspend   TemplateType = "Suspend"
-	TemplateTypeUnknown   TemplateType = "Unknown"
+	TemplateTypeContainer    TemplateType = "Container"
+	TemplateTypeSteps        TemplateType = "Steps"
+	TemplateTypeScript       TemplateType = "Script"
+	TemplateTypeResource     TemplateType = "Resource"
+	TemplateTypeDAG          TemplateType = "DAG"
+	TemplateTypeSuspend      TemplateType = "Suspend"
+	TemplateTypeContainerSet TemplateType = "ContainerSet"
+	TemplateTypeHTTP         TemplateType = "HTTP"
+	TemplateTypeWorkflow     TemplateType = "Workflow"
+	TemplateTypeUnknown      TemplateType = "Unknown"
 )
 
 // NodePhase is a label for the condition of a node at the current time.
@@ -53,6 +57,29 @@ const (
 	NodeOmitted NodePhase = "Omitted"
 )
 
+// FailureReason is the cause of a node failing, which unlike its message can be grouped by
+type FailureReason string
+
+// Failure reasons of nodes
+const (
+	// A container ran out of memory
+	FailureReasonOOMKilled FailureReason = "OOMKilled"
+	// The pod was evicted, e.g. because its node was low on resources
+	FailureReasonEvicted FailureReason = "Evicted"
+	// The pod or the step exceeded its deadline
+	FailureReasonDeadlineExceeded FailureReason = "DeadlineExceeded"
+	// The image of a container could not be pulled
+	FailureReasonImagePull FailureReason = "ImagePull"
+	// The node of the pod was lost, e.g. because it stopped responding
+	FailureReasonNodeLost FailureReason = "NodeLost"
+	// The main container exited with a non-zero exit code
+	FailureReasonExitCode FailureReason = "ExitCode"
+	// The pod was preempted by a pod with a higher priority
+	FailureReasonPreempted FailureReason = "Preempted"
+	// The input artifacts could not be loaded, or the outputs could not be saved
+	FailureReasonArtifactError FailureReason = "ArtifactError"
+)
+
 // NodeType is the type of a node
 type NodeType string
 
@@ -66,6 +93,10 @@ const (
 	NodeTypeRetry     NodeType = "Retry"
 	NodeTypeSkipped   NodeType = "Skipped"
 	NodeTypeSuspend   NodeType = "Suspend"
+	NodeTypeContainer NodeType = "Container"
+	NodeTypeHTTP      NodeType = "HTTP"
+	NodeTypeLoop      NodeType = "Loop"
+	NodeTypeWorkflow  NodeType = "Workflow"
 )
 
 // PodGCStrategy is the strategy when to delete completed pods for GC.
@@ -87,6 +118,15 @@ const (
 	VolumeClaimGCOnSuccess    VolumeClaimGCStrategy = "OnWorkflowSuccess"
 )
 
+// ArtifactGCStrategy is the strategy to use when deleting artifacts from completed or deleted workflows
+type ArtifactGCStrategy string
+
+const (
+	ArtifactGCOnWorkflowCompletion ArtifactGCStrategy = "OnWorkflowCompletion"
+	ArtifactGCOnWorkflowDeletion   ArtifactGCStrategy = "OnWorkflowDeletion"
+	ArtifactGCNever                ArtifactGCStrategy = "Never"
+)
+
 // Workflow is the definition of a workflow resource
 // +genclient
 // +genclient:noStatus
@@ -349,6 +389,18 @@ type WorkflowSpec struct {
 
 	// RetryStrategy for all templates in the workflow.
 	RetryStrategy *RetryStrategy `json:"retryStrategy,omitempty" protobuf:"bytes,37,opt,name=retryStrategy"`
+
+	// ArtifactGC describes the strategy to use when deleting output artifacts from completed or deleted workflows.
+	// It can be overridden by individual artifacts.
+	ArtifactGC *ArtifactGC `json:"artifactGC,omitempty" protobuf:"bytes,38,opt,name=artifactGC"`
+
+	// Hooks are templates, keyed by name, that are each run once when their expression first becomes true while the
+	// workflow runs, e.g. `workflow.status == "Running"`
+	Hooks LifecycleHooks `json:"hooks,omitempty" protobuf:"bytes,39,rep,name=hooks"`
+
+	// TemplateDefaults holds the default fields of the templates of the workflow, e.g. `retryStrategy` or `podSpecPatch`,
+	// which are strategically merged into each template. The fields of a template take precedence.
+	TemplateDefaults *Template `json:"templateDefaults,omitempty" protobuf:"bytes,40,opt,name=templateDefaults"`
 }
 
 // GetVolumeClaimGC returns the VolumeClaimGC that was defined in the workflow spec.  If none was provided, a default value is returned.
@@ -362,6 +414,14 @@ func (wfs WorkflowSpec) GetVolumeClaimGC() *VolumeClaimGC {
 	return wfs.VolumeClaimGC
 }
 
+// GetArtifactGC returns the ArtifactGC that was defined in the workflow spec, or nil if none was provided
+func (wfs *WorkflowSpec) GetArtifactGC() *ArtifactGC {
+	if wfs == nil {
+		return nil
+	}
+	return wfs.ArtifactGC
+}
+
 func (wfs WorkflowSpec) GetTTLStrategy() *TTLStrategy {
 	if wfs.TTLSecondsAfterFinished != nil {
 		if wfs.TTLStrategy == nil {
@@ -380,32 +440,26 @@ func (wfs WorkflowSpec) GetTTLStrategy() *TTLStrategy {
 func (wf *Workflow) GetSemaphoreKeys() []string {
 	keyMap := make(map[string]bool)
 	namespace := wf.Namespace
+	addKeys := func(s *Synchronization) {
+		if s == nil {
+			return
+		}
+		for _, configMapRef := range s.getSemaphoreConfigMapRefs() {
+			key := fmt.Sprintf("%s/%s", namespace, configMapRef.Name)
+			keyMap[key] = true
+		}
+	}
 	var templates []Template
 	if wf.Spec.WorkflowTemplateRef == nil {
 		templates = wf.Spec.Templates
-		if wf.Spec.Synchronization != nil {
-			if configMapRef := wf.Spec.Synchronization.getSemaphoreConfigMapRef(); configMapRef != nil {
-				key := fmt.Sprintf("%s/%s", namespace, configMapRef.Name)
-				keyMap[key] = true
-			}
-		}
+		addKeys(wf.Spec.Synchronization)
 	} else if wf.Status.StoredWorkflowSpec != nil {
 		templates = wf.Status.StoredWorkflowSpec.Templates
-		if wf.Status.StoredWorkflowSpec.Synchronization != nil {
-			if configMapRef := wf.Status.StoredWorkflowSpec.Synchronization.getSemaphoreConfigMapRef(); configMapRef != nil {
-				key := fmt.Sprintf("%s/%s", namespace, configMapRef.Name)
-				keyMap[key] = true
-			}
-		}
+		addKeys(wf.Status.StoredWorkflowSpec.Synchronization)
 	}
 
 	for _, tmpl := range templates {
-		if tmpl.Synchronization != nil {
-			if configMapRef := tmpl.Synchronization.getSemaphoreConfigMapRef(); configMapRef != nil {
-				key := fmt.Sprintf("%s/%s", namespace, configMapRef.Name)
-				keyMap[key] = true
-			}
-		}
+		addKeys(tmpl.Synchronization)
 	}
 	var semaphoreKeys []string
 	for key := range keyMap {
@@ -490,8 +544,8 @@ func (wfs *WorkflowSpec) HasPodSpecPatch() bool {
 
 // Template is a reusable and composable unit of execution in a workflow
 type Template struct {
-	// Name is the name of the template
-	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Name is the name of the template. It is required, except for inline templates and the template defaults.
+	Name string `json:"name,omitempty" protobuf:"bytes,1,opt,name=name"`
 
 	// Template is the name of the template which is used as the base of this template.
 	// DEPRECATED: This field is not used.
@@ -537,6 +591,15 @@ type Template struct {
 	// Resource template subtype which can run k8s resources
 	Resource *ResourceTemplate `json:"resource,omitempty" protobuf:"bytes,14,opt,name=resource"`
 
+	// ContainerSet runs several containers, which may depend on each other, in a single pod
+	ContainerSet *ContainerSetTemplate `json:"containerSet,omitempty" protobuf:"bytes,39,opt,name=containerSet"`
+
+	// HTTP makes a HTTP request, which is made by the controller rather than in a pod
+	HTTP *HTTP `json:"http,omitempty" protobuf:"bytes,40,opt,name=http"`
+
+	// Workflow runs a child workflow from a workflow template, and waits for it to complete
+	Workflow *ChildWorkflow `json:"workflow,omitempty" protobuf:"bytes,41,opt,name=workflow"`
+
 	// DAG template subtype which runs a DAG
 	DAG *DAGTemplate `json:"dag,omitempty" protobuf:"bytes,15,opt,name=dag"`
 
@@ -650,6 +713,11 @@ func (tmpl *Template) GetTemplateRef() *TemplateRef {
 	return tmpl.TemplateRef
 }
 
+// DEPRECATED: Templates should not be used as TemplateReferenceHolder
+func (tmpl *Template) GetTemplate() *Template {
+	return nil
+}
+
 // GetBaseTemplate returns a base template content.
 func (tmpl *Template) GetBaseTemplate() *Template {
 	baseTemplate := tmpl.DeepCopy()
@@ -716,8 +784,45 @@ type Parameter struct {
 
 	// Enum holds a list of string values to choose from, for the actual value of the parameter
 	Enum []AnyString `json:"enum,omitempty" protobuf:"bytes,6,rep,name=enum"`
+
+	// Type is the type of the value of the parameter, which is checked when the workflow is created or submitted
+	Type ParameterType `json:"type,omitempty" protobuf:"bytes,7,opt,name=type,casttype=ParameterType"`
+
+	// Schema is a JSON schema that the value of the parameter, parsed as JSON, must be valid against, e.g.
+	// `{"type": "integer", "minimum": 1}`. It is checked when the workflow is created or submitted.
+	Schema *Item `json:"schema,omitempty" protobuf:"bytes,8,opt,name=schema"`
+
+	// Sensitive marks the value of the parameter as sensitive, so that it is redacted from the status of the workflow,
+	// the templates stored on its pods, the archive and API responses. The value is only made available to container
+	// and script templates, via an environment variable that is resolved when the pod runs.
+	Sensitive bool `json:"sensitive,omitempty" protobuf:"varint,9,opt,name=sensitive"`
+
+	// ExportTo is where the value of an output parameter is exported to when its node succeeds, so that other workflows
+	// can use it
+	ExportTo *ExportTo `json:"exportTo,omitempty" protobuf:"bytes,10,opt,name=exportTo"`
+}
+
+// ExportTo is where the value of an output parameter is exported to
+type ExportTo struct {
+	// ConfigMapKeyRef is a key of a config map, in the namespace of the workflow, that the value is written to by the
+	// controller. The config map is created if it does not exist.
+	ConfigMapKeyRef *apiv1.ConfigMapKeySelector `json:"configMapKeyRef,omitempty" protobuf:"bytes,1,opt,name=configMapKeyRef"`
 }
 
+// RedactedValue replaces the value of a sensitive parameter
+const RedactedValue = "******"
+
+// ParameterType is the type of the value of a parameter
+type ParameterType string
+
+const (
+	ParameterTypeString ParameterType = "string"
+	ParameterTypeInt    ParameterType = "int"
+	ParameterTypeBool   ParameterType = "bool"
+	ParameterTypeNumber ParameterType = "number"
+	ParameterTypeJSON   ParameterType = "json"
+)
+
 // ValueFrom describes a location in which to obtain the value to a parameter
 type ValueFrom struct {
 	// Path in the container to retrieve an output parameter value from in container templates
@@ -741,6 +846,14 @@ type ValueFrom struct {
 
 	// Default specifies a value to be used if retrieving the value from the specified source fails
 	Default *AnyString `json:"default,omitempty" protobuf:"bytes,5,opt,name=default"`
+
+	// SecretKeyRef is a key of a secret to use as the value of an argument or input parameter. It is resolved when the
+	// pod runs, and the parameter is sensitive.
+	SecretKeyRef *apiv1.SecretKeySelector `json:"secretKeyRef,omitempty" protobuf:"bytes,8,opt,name=secretKeyRef"`
+
+	// ConfigMapKeyRef is a key of a config map, in the namespace of the workflow, to use as the value of a workflow,
+	// argument or input parameter. It is resolved by the controller.
+	ConfigMapKeyRef *apiv1.ConfigMapKeySelector `json:"configMapKeyRef,omitempty" protobuf:"bytes,9,opt,name=configMapKeyRef"`
 }
 
 // SuppliedValueFrom is a placeholder for a value to be filled in directly, either through the CLI, API, etc.
@@ -780,6 +893,18 @@ type Artifact struct {
 
 	// If mode is set, apply the permission recursively into the artifact if it is a folder
 	RecurseMode bool `json:"recurseMode,omitempty" protobuf:"varint,10,opt,name=recurseMode"`
+
+	// ArtifactGC describes the strategy to use when deleting this output artifact, overriding the workflow's strategy
+	ArtifactGC *ArtifactGC `json:"artifactGC,omitempty" protobuf:"bytes,11,opt,name=artifactGC"`
+}
+
+// GetArtifactGCStrategy returns the ArtifactGCStrategy to use for this artifact, falling back to the
+// workflow's strategy when the artifact does not define one
+func (a *Artifact) GetArtifactGCStrategy(wfs *WorkflowSpec) ArtifactGCStrategy {
+	if a.ArtifactGC != nil && a.ArtifactGC.Strategy != "" {
+		return a.ArtifactGC.Strategy
+	}
+	return wfs.GetArtifactGC().GetStrategy()
 }
 
 // PodGC describes how to delete completed pods as they complete
@@ -803,6 +928,42 @@ func (vgc VolumeClaimGC) GetStrategy() VolumeClaimGCStrategy {
 	return vgc.Strategy
 }
 
+// ArtifactGC describes how to delete artifacts from completed or deleted Workflows
+type ArtifactGC struct {
+	// Strategy is the strategy to use. One of "OnWorkflowCompletion", "OnWorkflowDeletion", "Never"
+	Strategy ArtifactGCStrategy `json:"strategy,omitempty" protobuf:"bytes,1,opt,name=strategy,casttype=ArtifactGCStrategy"`
+}
+
+// GetStrategy returns the ArtifactGCStrategy to use
+func (agc *ArtifactGC) GetStrategy() ArtifactGCStrategy {
+	if agc == nil || agc.Strategy == "" {
+		return ArtifactGCNever
+	}
+	return agc.Strategy
+}
+
+// ArtGCStatus maintains state related to ArtifactGC
+type ArtGCStatus struct {
+	// StrategiesProcessed records which strategies have been processed, so that each is only done once
+	StrategiesProcessed map[string]bool `json:"strategiesProcessed,omitempty" protobuf:"bytes,1,rep,name=strategiesProcessed"`
+}
+
+// IsStrategyProcessed returns whether artifacts for the given strategy have already been garbage collected
+func (s *ArtGCStatus) IsStrategyProcessed(strategy ArtifactGCStrategy) bool {
+	if s == nil {
+		return false
+	}
+	return s.StrategiesProcessed[string(strategy)]
+}
+
+// SetStrategyProcessed records that artifacts for the given strategy have been garbage collected
+func (s *ArtGCStatus) SetStrategyProcessed(strategy ArtifactGCStrategy) {
+	if s.StrategiesProcessed == nil {
+		s.StrategiesProcessed = make(map[string]bool)
+	}
+	s.StrategiesProcessed[string(strategy)] = true
+}
+
 // ArchiveStrategy describes how to archive files/directory when saving artifacts
 type ArchiveStrategy struct {
 	Tar  *TarStrategy  `json:"tar,omitempty" protobuf:"bytes,1,opt,name=tar"`
@@ -838,6 +999,7 @@ const (
 	ArtifactLocationRaw         ArtifactLocationType = "Raw"
 	ArtifactLocationOSS         ArtifactLocationType = "OSS"
 	ArtifactLocationGCS         ArtifactLocationType = "GCS"
+	ArtifactLocationAzure       ArtifactLocationType = "Azure"
 	ArtifactLocationUnknown     ArtifactLocationType = ""
 )
 
@@ -872,6 +1034,9 @@ type ArtifactLocation struct {
 
 	// GCS contains GCS artifact location details
 	GCS *GCSArtifact `json:"gcs,omitempty" protobuf:"bytes,9,opt,name=gcs"`
+
+	// Azure contains Azure Blob Storage artifact location details
+	Azure *AzureArtifact `json:"azure,omitempty" protobuf:"bytes,10,opt,name=azure"`
 }
 
 // HasLocation whether or not an artifact has a location defined
@@ -883,7 +1048,8 @@ func (a *ArtifactLocation) HasLocation() bool {
 		a.Raw.HasLocation() ||
 		a.HDFS.HasLocation() ||
 		a.OSS.HasLocation() ||
-		a.GCS.HasLocation()
+		a.GCS.HasLocation() ||
+		a.Azure.HasLocation()
 }
 
 func (a *ArtifactLocation) GetType() ArtifactLocationType {
@@ -920,6 +1086,10 @@ func (a *ArtifactLocation) GetType() ArtifactLocationType {
 		return ArtifactLocationGCS
 	}
 
+	if a.Azure != nil {
+		return ArtifactLocationAzure
+	}
+
 	return ArtifactLocationUnknown
 
 }
@@ -937,9 +1107,30 @@ func (a *ArtifactLocation) GetKey() string {
 		return a.GCS.Key
 	}
 
+	if a.Azure != nil {
+		return a.Azure.Blob
+	}
+
 	return ""
 }
 
+// SetKey sets the key of the artifact, for artifact locations that have one
+func (a *ArtifactLocation) SetKey(key string) error {
+	switch {
+	case a.S3 != nil:
+		a.S3.Key = key
+	case a.OSS != nil:
+		a.OSS.Key = key
+	case a.GCS != nil:
+		a.GCS.Key = key
+	case a.Azure != nil:
+		a.Azure.Blob = key
+	default:
+		return fmt.Errorf("artifact location type %q does not have a key", a.GetType())
+	}
+	return nil
+}
+
 // +protobuf.options.(gogoproto.goproto_stringer)=false
 type ArtifactRepositoryRef struct {
 	// The name of the config map. Defaults to "artifact-repositories".
@@ -1044,6 +1235,20 @@ type WorkflowStep struct {
 	// template, irrespective of the success, failure, or error of the
 	// primary template.
 	OnExit string `json:"onExit,omitempty" protobuf:"bytes,11,opt,name=onExit"`
+
+	// Hooks are templates, keyed by name, that are each run once when their expression first becomes true while the
+	// step runs, e.g. `steps.deploy.status == "Failed"`
+	Hooks LifecycleHooks `json:"hooks,omitempty" protobuf:"bytes,12,rep,name=hooks"`
+
+	// Inline is the template to execute as the step, defined in place rather than referred to by name
+	Inline *Template `json:"inline,omitempty" protobuf:"bytes,13,opt,name=inline"`
+
+	// Loop repeats the step, one iteration after the other, until its condition is met
+	Loop *Loop `json:"loop,omitempty" protobuf:"bytes,14,opt,name=loop"`
+
+	// WithArtifact expands a step into multiple parallel steps from the JSON list stored in an artifact, which may be
+	// too large to be held by a parameter
+	WithArtifact *WithArtifact `json:"withArtifact,omitempty" protobuf:"bytes,15,opt,name=withArtifact"`
 }
 
 var _ TemplateReferenceHolder = &WorkflowStep{}
@@ -1056,8 +1261,72 @@ func (step *WorkflowStep) GetTemplateRef() *TemplateRef {
 	return step.TemplateRef
 }
 
+func (step *WorkflowStep) GetTemplate() *Template {
+	return step.Inline
+}
+
 func (step *WorkflowStep) ShouldExpand() bool {
-	return len(step.WithItems) != 0 || step.WithParam != "" || step.WithSequence != nil
+	return len(step.WithItems) != 0 || step.WithParam != "" || step.WithSequence != nil || step.WithArtifact != nil
+}
+
+// LifecycleHooks are lifecycle hooks keyed by their names
+type LifecycleHooks map[string]LifecycleHook
+
+// LifecycleHook is a template that is run, at most once, when its expression first evaluates to true. Unlike an exit
+// handler, the success or failure of a hook does not affect the phase of what it hooks.
+type LifecycleHook struct {
+	// Template is the name of the template to execute by the hook
+	Template string `json:"template,omitempty" protobuf:"bytes,1,opt,name=template"`
+
+	// Arguments hold arguments to the template
+	Arguments Arguments `json:"arguments,omitempty" protobuf:"bytes,2,opt,name=arguments"`
+
+	// TemplateRef is the reference to the template resource to execute by the hook
+	TemplateRef *TemplateRef `json:"templateRef,omitempty" protobuf:"bytes,3,opt,name=templateRef"`
+
+	// Expression is evaluated on each reconciliation, and runs the hook the first time it is true. The status of the
+	// workflow, step or task is available as `workflow.status`, `steps.<name>.status` or `tasks.<name>.status`.
+	Expression string `json:"expression" protobuf:"bytes,4,opt,name=expression"`
+}
+
+// Loop repeats a step or task until its condition is met. Each iteration is a child node of the loop node, and the
+// arguments of an iteration can refer to `loop.index` and to the outputs of the previous iteration, e.g.
+// `loop.outputs.parameters.<name>` and `loop.outputs.result`.
+type Loop struct {
+	// Until is an expression that is evaluated after each successful iteration, and ends the loop when it is true. It
+	// can refer to the outputs of the iteration, e.g. `outputs.parameters.<name>`, `outputs.result` and
+	// `outputs.exitCode`, and to `loop.index`. If it is empty, the loop runs maxIterations times.
+	Until string `json:"until,omitempty" protobuf:"bytes,1,opt,name=until"`
+
+	// MaxIterations is the maximum number of iterations. The loop fails if its condition is still not met after them.
+	MaxIterations int32 `json:"maxIterations" protobuf:"varint,2,opt,name=maxIterations"`
+
+	// Delay is the amount of time to wait between iterations. Default unit is seconds, but could also be a duration
+	// (e.g. "2m", "1h")
+	Delay string `json:"delay,omitempty" protobuf:"bytes,3,opt,name=delay"`
+}
+
+// WithArtifact expands a step or task over the items of a JSON list stored in an artifact. The list is read from the
+// artifact repository as the step or task is expanded, rather than being held by the workflow.
+type WithArtifact struct {
+	// From is the artifact that holds the list, usually an output artifact of a previous step or task, e.g.
+	// `{{steps.generate.outputs.artifacts.items}}`
+	From string `json:"from,omitempty" protobuf:"bytes,1,opt,name=from"`
+
+	// ArtifactLocation is the location of the artifact, if it is not from a step or task
+	ArtifactLocation `json:",inline" protobuf:"bytes,2,opt,name=artifactLocation"`
+
+	// BatchSize is the number of items each expanded step or task is given (default: 1). If it is greater than one,
+	// `{{item}}` is a JSON list of the items of the batch.
+	BatchSize int32 `json:"batchSize,omitempty" protobuf:"varint,3,opt,name=batchSize"`
+}
+
+// GetBatchSize returns the number of items in each batch
+func (w *WithArtifact) GetBatchSize() int {
+	if w.BatchSize > 1 {
+		return int(w.BatchSize)
+	}
+	return 1
 }
 
 // Sequence expands a workflow step into numeric range
@@ -1095,13 +1364,41 @@ type Synchronization struct {
 	Semaphore *SemaphoreRef `json:"semaphore,omitempty" protobuf:"bytes,1,opt,name=semaphore"`
 	// Mutex holds the Mutex lock details
 	Mutex *Mutex `json:"mutex,omitempty" protobuf:"bytes,2,opt,name=mutex"`
+	// Semaphores holds the configuration of further semaphores. All the semaphores and mutexes are acquired together,
+	// or not at all, so that templates waiting for several locks do not deadlock.
+	// +listType=atomic
+	Semaphores []SemaphoreRef `json:"semaphores,omitempty" protobuf:"bytes,3,rep,name=semaphores"`
+	// Mutexes holds the details of further mutex locks
+	// +listType=atomic
+	Mutexes []Mutex `json:"mutexes,omitempty" protobuf:"bytes,4,rep,name=mutexes"`
 }
 
-func (s *Synchronization) getSemaphoreConfigMapRef() *apiv1.ConfigMapKeySelector {
-	if s.Semaphore != nil && s.Semaphore.ConfigMapKeyRef != nil {
-		return s.Semaphore.ConfigMapKeyRef
+// GetSemaphores returns all the semaphores to acquire
+func (s *Synchronization) GetSemaphores() []SemaphoreRef {
+	var semaphores []SemaphoreRef
+	if s.Semaphore != nil {
+		semaphores = append(semaphores, *s.Semaphore)
 	}
-	return nil
+	return append(semaphores, s.Semaphores...)
+}
+
+// GetMutexes returns all the mutexes to acquire
+func (s *Synchronization) GetMutexes() []Mutex {
+	var mutexes []Mutex
+	if s.Mutex != nil {
+		mutexes = append(mutexes, *s.Mutex)
+	}
+	return append(mutexes, s.Mutexes...)
+}
+
+func (s *Synchronization) getSemaphoreConfigMapRefs() []*apiv1.ConfigMapKeySelector {
+	var refs []*apiv1.ConfigMapKeySelector
+	for _, semaphore := range s.GetSemaphores() {
+		if semaphore.ConfigMapKeyRef != nil {
+			refs = append(refs, semaphore.ConfigMapKeyRef)
+		}
+	}
+	return refs
 }
 
 type SynchronizationType string
@@ -1113,9 +1410,9 @@ const (
 )
 
 func (s *Synchronization) GetType() SynchronizationType {
-	if s.Semaphore != nil {
+	if len(s.GetSemaphores()) > 0 {
 		return SynchronizationTypeSemaphore
-	} else if s.Mutex != nil {
+	} else if len(s.GetMutexes()) > 0 {
 		return SynchronizationTypeMutex
 	}
 	return SynchronizationTypeUnknown
@@ -1125,6 +1422,16 @@ func (s *Synchronization) GetType() SynchronizationType {
 type SemaphoreRef struct {
 	// ConfigMapKeyRef is configmap selector for Semaphore configuration
 	ConfigMapKeyRef *apiv1.ConfigMapKeySelector `json:"configMapKeyRef,omitempty" protobuf:"bytes,1,opt,name=configMapKeyRef"`
+	// Weight is the number of units of the semaphore to acquire. Defaults to 1.
+	Weight int32 `json:"weight,omitempty" protobuf:"varint,2,opt,name=weight"`
+}
+
+// GetWeight returns the number of units of the semaphore to acquire
+func (s SemaphoreRef) GetWeight() int32 {
+	if s.Weight > 0 {
+		return s.Weight
+	}
+	return 1
 }
 
 // Mutex holds Mutex configuration
@@ -1304,6 +1611,13 @@ type WorkflowStatus struct {
 
 	// ArtifactRepositoryRef is used to cache the repository to use so we do not need to determine it everytime we reconcile.
 	ArtifactRepositoryRef *ArtifactRepositoryRefStatus `json:"artifactRepositoryRef,omitempty" protobuf:"bytes,18,opt,name=artifactRepositoryRef"`
+
+	// ArtifactGCStatus maintains the status of artifact garbage collection
+	ArtifactGCStatus *ArtGCStatus `json:"artifactGCStatus,omitempty" protobuf:"bytes,19,opt,name=artifactGCStatus"`
+
+	// ConfigMapParameterValues are the values of the workflow parameters that are from config maps, by the name of the
+	// parameter. They are resolved once, as the workflow starts, so that the config maps are not read again.
+	ConfigMapParameterValues map[string]string `json:"configMapParameterValues,omitempty" protobuf:"bytes,20,rep,name=configMapParameterValues"`
 }
 
 func (ws *WorkflowStatus) IsOffloadNodeStatus() bool {
@@ -1359,6 +1673,29 @@ type RetryStrategy struct {
 
 	// Affinity prevents running workflow's step on the same host
 	Affinity *RetryAffinity `json:"affinity,omitempty" protobuf:"bytes,4,opt,name=affinity"`
+
+	// Expression is a condition expression for when a node will be retried. If it evaluates to false, the node will not
+	// be retried and the retry strategy will be ignored. It can refer to `retries`, `lastRetry.exitCode`,
+	// `lastRetry.status`, `lastRetry.duration` and `lastRetry.message`.
+	Expression string `json:"expression,omitempty" protobuf:"bytes,5,opt,name=expression"`
+
+	// RetryOn are rules for the failures that are retried, e.g. exit code 137 or an evicted pod. If there are any, a
+	// failure that the retry policy allows to be retried is only retried if it matches one of them.
+	RetryOn []RetryOn `json:"retryOn,omitempty" protobuf:"bytes,6,rep,name=retryOn"`
+}
+
+// RetryOn is a rule for the failures that are retried. A failure matches the rule if it matches all of the rule's
+// conditions.
+type RetryOn struct {
+	// ExitCodes are the exit codes of the main container that are retried, e.g. 137 and 143
+	ExitCodes []int32 `json:"exitCodes,omitempty" protobuf:"varint,1,rep,name=exitCodes"`
+
+	// Reasons are the reasons of the pod, or of one of its containers, that are retried, e.g. `Evicted`, `OOMKilled` or
+	// `ImagePullBackOff`
+	Reasons []string `json:"reasons,omitempty" protobuf:"bytes,2,rep,name=reasons"`
+
+	// MessagePattern is a regular expression that the message of the failed node must match to be retried
+	MessagePattern string `json:"messagePattern,omitempty" protobuf:"bytes,3,opt,name=messagePattern"`
 }
 
 // The amount of requested resource * the duration that request was used.
@@ -1592,6 +1929,18 @@ type NodeStatus struct {
 
 	// SynchronizationStatus is the synchronization status of the node
 	SynchronizationStatus *NodeSynchronizationStatus `json:"synchronizationStatus,omitempty" protobuf:"bytes,25,opt,name=synchronizationStatus"`
+
+	// FailureReason is the cause of the node failing, if it is known. One of: OOMKilled, Evicted, DeadlineExceeded,
+	// ImagePull, NodeLost, ExitCode, Preempted or ArtifactError
+	FailureReason FailureReason `json:"failureReason,omitempty" protobuf:"bytes,27,opt,name=failureReason,casttype=FailureReason"`
+
+	// ExitCode is the exit code of the main container of the pod of a failed node, if it exited
+	ExitCode *int32 `json:"exitCode,omitempty" protobuf:"varint,28,opt,name=exitCode"`
+
+	// WithArtifactChildren are the numbers of children that the withArtifact of the steps of this step group, or of the
+	// tasks of this DAG, were expanded into, by the name of the step or task. Once all of them exist, the children are
+	// rebuilt from their nodes, rather than from the items of the artifact.
+	WithArtifactChildren map[string]int32 `json:"withArtifactChildren,omitempty" protobuf:"bytes,29,rep,name=withArtifactChildren"`
 }
 
 // Fulfilled returns whether a phase is fulfilled, i.e. it completed execution or was skipped or omitted
@@ -1715,6 +2064,10 @@ func (n *NodeStatus) GetTemplateRef() *TemplateRef {
 	return n.TemplateRef
 }
 
+func (n *NodeStatus) GetTemplate() *Template {
+	return nil
+}
+
 // IsActiveSuspendNode returns whether this node is an active suspend node
 func (n *NodeStatus) IsActiveSuspendNode() bool {
 	return n.Type == NodeTypeSuspend && n.Phase == NodeRunning
@@ -1946,6 +2299,36 @@ func (g *GCSArtifact) HasLocation() bool {
 	return g != nil && g.Bucket != "" && g.Key != ""
 }
 
+// AzureBlobContainer contains the access information for interfacing with an Azure Blob Storage container
+type AzureBlobContainer struct {
+	// Endpoint is the service url associated with an account. It is most likely "https://<ACCOUNT_NAME>.blob.core.windows.net"
+	Endpoint string `json:"endpoint" protobuf:"bytes,1,opt,name=endpoint"`
+
+	// Container is the container where resources will be stored
+	Container string `json:"container" protobuf:"bytes,2,opt,name=container"`
+
+	// AccountKeySecret is the secret selector to the Azure Blob Storage account access key
+	AccountKeySecret *apiv1.SecretKeySelector `json:"accountKeySecret,omitempty" protobuf:"bytes,3,opt,name=accountKeySecret"`
+
+	// SASTokenSecret is the secret selector to a shared access signature (SAS) token for the container
+	SASTokenSecret *apiv1.SecretKeySelector `json:"sasTokenSecret,omitempty" protobuf:"bytes,4,opt,name=sasTokenSecret"`
+
+	// UseSDKCreds tells the driver to authenticate using the pod's managed identity
+	UseSDKCreds bool `json:"useSDKCreds,omitempty" protobuf:"varint,5,opt,name=useSDKCreds"`
+}
+
+// AzureArtifact is the location of an Azure Blob Storage artifact
+type AzureArtifact struct {
+	AzureBlobContainer `json:",inline" protobuf:"bytes,1,opt,name=azureBlobContainer"`
+
+	// Blob is the blob name (i.e., path) in the container where the artifact resides
+	Blob string `json:"blob" protobuf:"bytes,2,opt,name=blob"`
+}
+
+func (a *AzureArtifact) HasLocation() bool {
+	return a != nil && a.Endpoint != "" && a.Container != "" && a.Blob != ""
+}
+
 // OSSBucket contains the access information required for interfacing with an Alibaba Cloud OSS bucket
 type OSSBucket struct {
 	// Endpoint is the hostname of the bucket endpoint
@@ -1987,6 +2370,108 @@ type ScriptTemplate struct {
 	Source string `json:"source" protobuf:"bytes,2,opt,name=source"`
 }
 
+// ContainerSetTemplate is a template subtype to run several containers in a single pod. The containers start in the
+// order of their dependencies, and each is reported as a child node of the pod's node.
+type ContainerSetTemplate struct {
+	// Containers are the containers to run. A container named "main" is required if the template has outputs, as the
+	// outputs are collected from it.
+	// +patchStrategy=merge
+	// +patchMergeKey=name
+	Containers []ContainerNode `json:"containers" patchStrategy:"merge" patchMergeKey:"name" protobuf:"bytes,1,rep,name=containers"`
+
+	// VolumeMounts are mounted into every container of the set
+	// +patchStrategy=merge
+	// +patchMergeKey=mountPath
+	VolumeMounts []apiv1.VolumeMount `json:"volumeMounts,omitempty" patchStrategy:"merge" patchMergeKey:"mountPath" protobuf:"bytes,2,rep,name=volumeMounts"`
+}
+
+// GetContainerNames returns the names of the containers of the set
+func (tmpl *ContainerSetTemplate) GetContainerNames() []string {
+	var names []string
+	for _, c := range tmpl.Containers {
+		names = append(names, c.Name)
+	}
+	return names
+}
+
+// HasContainerNamed returns whether the set has a container with the name
+func (tmpl *ContainerSetTemplate) HasContainerNamed(name string) bool {
+	for _, c := range tmpl.Containers {
+		if c.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// ContainerNode is a container of a container set
+type ContainerNode struct {
+	apiv1.Container `json:",inline" protobuf:"bytes,1,opt,name=container"`
+
+	// Dependencies are the names of the containers of the set that must complete successfully before this one starts
+	Dependencies []string `json:"dependencies,omitempty" protobuf:"bytes,2,rep,name=dependencies"`
+}
+
+// HTTP is a template subtype to make a HTTP request. The request is made by the controller, so it does not have the
+// overhead of a pod, and the response body is the template's result.
+type HTTP struct {
+	// Method is the HTTP method of the request, it defaults to GET
+	Method string `json:"method,omitempty" protobuf:"bytes,1,opt,name=method"`
+
+	// URL is the URL of the request
+	URL string `json:"url" protobuf:"bytes,2,opt,name=url"`
+
+	// Headers are the headers of the request
+	Headers []HTTPHeader `json:"headers,omitempty" protobuf:"bytes,3,rep,name=headers"`
+
+	// TimeoutSeconds is the number of seconds to wait for the response, it defaults to 30 and may be at most 60
+	TimeoutSeconds *int64 `json:"timeoutSeconds,omitempty" protobuf:"varint,4,opt,name=timeoutSeconds"`
+
+	// Body is the body of the request
+	Body string `json:"body,omitempty" protobuf:"bytes,5,opt,name=body"`
+
+	// SuccessCondition is an expression (https://github.com/antonmedv/expr) over the response that must be true for the
+	// request to be successful, e.g. `response.statusCode == 201 && response.body contains "ok"`. It defaults to
+	// the status code being 2xx.
+	SuccessCondition string `json:"successCondition,omitempty" protobuf:"bytes,6,opt,name=successCondition"`
+}
+
+// GetMethod returns the HTTP method of the request
+func (h *HTTP) GetMethod() string {
+	if h.Method == "" {
+		return http.MethodGet
+	}
+	return h.Method
+}
+
+// HTTPHeader is a header of a HTTP request
+type HTTPHeader struct {
+	// Name is the name of the header
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+
+	// Value is the value of the header
+	Value string `json:"value,omitempty" protobuf:"bytes,2,opt,name=value"`
+
+	// ValueFrom is the source of the value of the header, for values that must not be in the workflow
+	ValueFrom *HTTPHeaderSource `json:"valueFrom,omitempty" protobuf:"bytes,3,opt,name=valueFrom"`
+}
+
+// HTTPHeaderSource is the source of the value of a HTTP header
+type HTTPHeaderSource struct {
+	// SecretKeyRef is a key of a secret in the workflow's namespace
+	SecretKeyRef *apiv1.SecretKeySelector `json:"secretKeyRef,omitempty" protobuf:"bytes,1,opt,name=secretKeyRef"`
+}
+
+// ChildWorkflow is a template subtype to run a child workflow from a workflow template. The child is named after its
+// node, is owned by the parent workflow, and its outputs are the outputs of the node.
+type ChildWorkflow struct {
+	// WorkflowTemplateRef is a reference to the workflow template the child workflow is created from
+	WorkflowTemplateRef *WorkflowTemplateRef `json:"workflowTemplateRef" protobuf:"bytes,1,opt,name=workflowTemplateRef"`
+
+	// Arguments are the arguments of the child workflow
+	Arguments Arguments `json:"arguments,omitempty" protobuf:"bytes,2,opt,name=arguments"`
+}
+
 // ResourceTemplate is a template subtype to manipulate kubernetes resources
 type ResourceTemplate struct {
 	// Action is the action to perform to the resource.
@@ -2039,13 +2524,22 @@ func (tmpl *Template) GetType() TemplateType {
 	if tmpl.Suspend != nil {
 		return TemplateTypeSuspend
 	}
+	if tmpl.ContainerSet != nil {
+		return TemplateTypeContainerSet
+	}
+	if tmpl.HTTP != nil {
+		return TemplateTypeHTTP
+	}
+	if tmpl.Workflow != nil {
+		return TemplateTypeWorkflow
+	}
 	return TemplateTypeUnknown
 }
 
 // IsPodType returns whether or not the template is a pod type
 func (tmpl *Template) IsPodType() bool {
 	switch tmpl.GetType() {
-	case TemplateTypeContainer, TemplateTypeScript, TemplateTypeResource:
+	case TemplateTypeContainer, TemplateTypeScript, TemplateTypeResource, TemplateTypeContainerSet:
 		return true
 	}
 	return false
@@ -2054,7 +2548,7 @@ func (tmpl *Template) IsPodType() bool {
 // IsLeaf returns whether or not the template is a leaf
 func (tmpl *Template) IsLeaf() bool {
 	switch tmpl.GetType() {
-	case TemplateTypeContainer, TemplateTypeScript, TemplateTypeResource:
+	case TemplateTypeContainer, TemplateTypeScript, TemplateTypeResource, TemplateTypeContainerSet:
 		return true
 	}
 	return false
@@ -2120,6 +2614,20 @@ type DAGTask struct {
 
 	// Depends are name of other targets which this depends on
 	Depends string `json:"depends,omitempty" protobuf:"bytes,12,opt,name=depends"`
+
+	// Hooks are templates, keyed by name, that are each run once when their expression first becomes true while the
+	// task runs, e.g. `tasks.deploy.status == "Failed"`
+	Hooks LifecycleHooks `json:"hooks,omitempty" protobuf:"bytes,13,rep,name=hooks"`
+
+	// Inline is the template to execute, defined in place rather than referred to by name
+	Inline *Template `json:"inline,omitempty" protobuf:"bytes,14,opt,name=inline"`
+
+	// Loop repeats the task, one iteration after the other, until its condition is met
+	Loop *Loop `json:"loop,omitempty" protobuf:"bytes,15,opt,name=loop"`
+
+	// WithArtifact expands a task into multiple parallel tasks from the JSON list stored in an artifact, which may be
+	// too large to be held by a parameter
+	WithArtifact *WithArtifact `json:"withArtifact,omitempty" protobuf:"bytes,16,opt,name=withArtifact"`
 }
 
 var _ TemplateReferenceHolder = &DAGTask{}
@@ -2132,8 +2640,12 @@ func (t *DAGTask) GetTemplateRef() *TemplateRef {
 	return t.TemplateRef
 }
 
+func (t *DAGTask) GetTemplate() *Template {
+	return t.Inline
+}
+
 func (t *DAGTask) ShouldExpand() bool {
-	return len(t.WithItems) != 0 || t.WithParam != "" || t.WithSequence != nil
+	return len(t.WithItems) != 0 || t.WithParam != "" || t.WithSequence != nil || t.WithArtifact != nil
 }
 
 // SuspendTemplate is a template subtype to suspend a workflow at a predetermined point in time
@@ -2192,6 +2704,19 @@ func (out *Outputs) GetArtifactByName(name string) *Artifact {
 	return out.Artifacts.GetArtifactByName(name)
 }
 
+// GetParameterByName returns an output parameter by its name
+func (out *Outputs) GetParameterByName(name string) *Parameter {
+	if out == nil {
+		return nil
+	}
+	for _, param := range out.Parameters {
+		if param.Name == name {
+			return &param
+		}
+	}
+	return nil
+}
+
 // GetArtifactByName retrieves an artifact by its name
 func (args *Arguments) GetArtifactByName(name string) *Artifact {
 	return args.Artifacts.GetArtifactByName(name)
@@ -2293,6 +2818,10 @@ func (wf *Workflow) SetStoredTemplate(scope ResourceScope, resourceName string,
 // resolveTemplateReference resolves the stored template name of a given template holder on the template scope and determines
 // if it should be stored
 func resolveTemplateReference(callerScope ResourceScope, resourceName string, caller TemplateReferenceHolder) (string, bool) {
+	if caller.GetTemplate() != nil {
+		// An inline template is part of the template that calls it, which is stored if needed
+		return "", false
+	}
 	tmplRef := caller.GetTemplateRef()
 	if tmplRef != nil {
 		// We are calling an external WorkflowTemplate or ClusterWorkflowTemplate. Template storage is needed
@@ -2508,12 +3037,59 @@ type MemoizationStatus struct {
 	Key string `json:"key" protobuf:"bytes,2,opt,name=key"`
 	// Cache is the name of the cache that was used
 	CacheName string `json:"cacheName" protobuf:"bytes,3,opt,name=cacheName"`
+	// CacheType is the type of the cache that was used, "ConfigMap" if empty
+	CacheType CacheType `json:"cacheType,omitempty" protobuf:"bytes,4,opt,name=cacheType,casttype=CacheType"`
+	// MaxAge is the maximum age of the cache entry, after which it may be evicted from caches that support eviction
+	MaxAge string `json:"maxAge,omitempty" protobuf:"bytes,5,opt,name=maxAge"`
+}
+
+// GetCacheType returns the type of the cache that was used
+func (m *MemoizationStatus) GetCacheType() CacheType {
+	if m.CacheType == "" {
+		return CacheTypeConfigMap
+	}
+	return m.CacheType
 }
 
+// CacheType is the type of a memoization cache
+type CacheType string
+
+const (
+	CacheTypeConfigMap CacheType = "ConfigMap"
+	CacheTypeDatabase  CacheType = "Database"
+)
+
 // Cache is the configuration for the type of cache to be used
 type Cache struct {
 	// ConfigMap sets a ConfigMap-based cache
-	ConfigMap *apiv1.ConfigMapKeySelector `json:"configMap" protobuf:"bytes,1,opt,name=configMap"`
+	ConfigMap *apiv1.ConfigMapKeySelector `json:"configMap,omitempty" protobuf:"bytes,1,opt,name=configMap"`
+	// Database sets a cache stored in the controller's persistence database, which must be configured
+	Database *DatabaseCache `json:"database,omitempty" protobuf:"bytes,2,opt,name=database"`
+}
+
+// GetType returns the type of the cache
+func (c *Cache) GetType() CacheType {
+	if c.Database != nil {
+		return CacheTypeDatabase
+	}
+	return CacheTypeConfigMap
+}
+
+// GetName returns the name of the cache
+func (c *Cache) GetName() string {
+	switch {
+	case c.Database != nil:
+		return c.Database.Name
+	case c.ConfigMap != nil:
+		return c.ConfigMap.Name
+	}
+	return ""
+}
+
+// DatabaseCache is a memoization cache stored in the controller's persistence database
+type DatabaseCache struct {
+	// Name is the name of the cache
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
 }
 
 type SynchronizationAction interface {
@@ -2528,6 +3104,16 @@ type SemaphoreHolding struct {
 	// Holders stores the list of current holder names in the workflow.
 	// +listType=atomic
 	Holders []string `json:"holders,omitempty" protobuf:"bytes,2,opt,name=holders"`
+	// Weights stores the number of units held by the holders that acquired more than one unit of the semaphore.
+	Weights map[string]int32 `json:"weights,omitempty" protobuf:"bytes,3,rep,name=weights"`
+}
+
+// GetWeight returns the number of units of the semaphore held by the holder
+func (sh SemaphoreHolding) GetWeight(holder string) int32 {
+	if weight, ok := sh.Weights[holder]; ok {
+		return weight
+	}
+	return 1
 }
 
 type SemaphoreStatus struct {
@@ -2595,12 +3181,33 @@ func (ss *SemaphoreStatus) LockReleased(holderKey, lockKey string) bool {
 	holdingName := items[len(items)-1]
 	if i >= 0 {
 		semaphoreHolding.Holders = slice.RemoveString(semaphoreHolding.Holders, holdingName)
+		delete(semaphoreHolding.Weights, holdingName)
 		ss.Holding[i] = semaphoreHolding
 		return true
 	}
 	return false
 }
 
+// LockWeighted records the number of units of the semaphore acquired by the holder
+func (ss *SemaphoreStatus) LockWeighted(holderKey, lockKey string, weight int32) bool {
+	i, semaphoreHolding := ss.GetHolding(lockKey)
+	items := strings.Split(holderKey, "/")
+	holdingName := items[len(items)-1]
+	if i < 0 || semaphoreHolding.GetWeight(holdingName) == weight {
+		return false
+	}
+	if weight == 1 {
+		delete(semaphoreHolding.Weights, holdingName)
+	} else {
+		if semaphoreHolding.Weights == nil {
+			semaphoreHolding.Weights = map[string]int32{}
+		}
+		semaphoreHolding.Weights[holdingName] = weight
+	}
+	ss.Holding[i] = semaphoreHolding
+	return true
+}
+
 // MutexHolding describes the mutex and the object which is holding it.
 type MutexHolding struct {
 	// Reference for the mutex
@@ -2716,6 +3323,6 @@ func (ss *SynchronizationStatus) GetStatus(syncType SynchronizationType) Synchro
 
 // NodeSynchronizationStatus stores the status of a node
 type NodeSynchronizationStatus struct {
-	// Waiting is the name of the lock that this node is waiting for
+	// Waiting is the name of the lock that this node is waiting for, or the first of them if it waits for several
 	Waiting string `json:"waiting,omitempty" protobuf:"bytes,1,opt,name=waiting"`
 }
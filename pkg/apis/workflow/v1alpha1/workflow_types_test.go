@@ -84,6 +84,7 @@ func TestArtifactLocation_HasLocation(t *testing.T) {
 	assert.True(t, (&ArtifactLocation{HDFS: &HDFSArtifact{HDFSConfig: HDFSConfig{Addresses: []string{"my-address"}}}}).HasLocation())
 	assert.True(t, (&ArtifactLocation{OSS: &OSSArtifact{Key: "my-key", OSSBucket: OSSBucket{Endpoint: "my-endpoint", Bucket: "my-bucket"}}}).HasLocation())
 	assert.True(t, (&ArtifactLocation{GCS: &GCSArtifact{Key: "my-key", GCSBucket: GCSBucket{Bucket: "my-bucket"}}}).HasLocation())
+	assert.True(t, (&ArtifactLocation{Azure: &AzureArtifact{Blob: "my-blob", AzureBlobContainer: AzureBlobContainer{Endpoint: "my-endpoint", Container: "my-container"}}}).HasLocation())
 }
 
 func TestArtifactLocation_GetType(t *testing.T) {
@@ -96,6 +97,15 @@ func TestArtifactLocation_GetType(t *testing.T) {
 	assert.Equal(t, ArtifactLocationHDFS, (&ArtifactLocation{HDFS: &HDFSArtifact{HDFSConfig: HDFSConfig{Addresses: []string{"my-address"}}}}).GetType())
 	assert.Equal(t, ArtifactLocationOSS, (&ArtifactLocation{OSS: &OSSArtifact{Key: "my-key", OSSBucket: OSSBucket{Endpoint: "my-endpoint", Bucket: "my-bucket"}}}).GetType())
 	assert.Equal(t, ArtifactLocationGCS, (&ArtifactLocation{GCS: &GCSArtifact{Key: "my-key", GCSBucket: GCSBucket{Bucket: "my-bucket"}}}).GetType())
+	assert.Equal(t, ArtifactLocationAzure, (&ArtifactLocation{Azure: &AzureArtifact{Blob: "my-blob", AzureBlobContainer: AzureBlobContainer{Endpoint: "my-endpoint", Container: "my-container"}}}).GetType())
+}
+
+func TestArtifactLocation_SetKey(t *testing.T) {
+	l := &ArtifactLocation{S3: &S3Artifact{}}
+	if assert.NoError(t, l.SetKey("my-key")) {
+		assert.Equal(t, "my-key", l.GetKey())
+	}
+	assert.Error(t, (&ArtifactLocation{Git: &GitArtifact{Repo: "my-repo"}}).SetKey("my-key"))
 }
 
 func TestArtifact_GetArchive(t *testing.T) {
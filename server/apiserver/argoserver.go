@@ -21,6 +21,7 @@ import (
 	"github.com/argoproj/argo"
 	"github.com/simster7/argo/v2/config"
 	"github.com/simster7/argo/v2/persist/sqldb"
+	cachepkg "github.com/simster7/argo/v2/pkg/apiclient/cache"
 	clusterwftemplatepkg "github.com/simster7/argo/v2/pkg/apiclient/clusterworkflowtemplate"
 	cronworkflowpkg "github.com/simster7/argo/v2/pkg/apiclient/cronworkflow"
 	eventpkg "github.com/simster7/argo/v2/pkg/apiclient/event"
@@ -35,6 +36,7 @@ import (
 	"github.com/simster7/argo/v2/server/auth"
 	"github.com/simster7/argo/v2/server/auth/sso"
 	"github.com/simster7/argo/v2/server/auth/webhook"
+	"github.com/simster7/argo/v2/server/cache"
 	"github.com/simster7/argo/v2/server/clusterworkflowtemplate"
 	"github.com/simster7/argo/v2/server/cronworkflow"
 	"github.com/simster7/argo/v2/server/event"
@@ -146,6 +148,7 @@ func (as *argoServer) Run(ctx context.Context, port int, browserOpenFunc func(st
 	instanceIDService := instanceid.NewService(config.InstanceID)
 	var offloadRepo = sqldb.ExplosiveOffloadNodeStatusRepo
 	var wfArchive = sqldb.NullWorkflowArchive
+	var memoizationCacheRepo = sqldb.NullMemoizationCacheRepo
 	persistence := config.Persistence
 	if persistence != nil {
 		session, tableName, err := sqldb.CreateDBSession(as.clients.Kubernetes, as.namespace, persistence)
@@ -161,12 +164,13 @@ func (as *argoServer) Run(ctx context.Context, port int, browserOpenFunc func(st
 		// we always enable the archive for the Argo Server, as the Argo Server does not write records, so you can
 		// disable the archiving - and still read old records
 		wfArchive = sqldb.NewWorkflowArchive(session, persistence.GetClusterName(), as.managedNamespace, instanceIDService)
+		memoizationCacheRepo = sqldb.NewMemoizationCacheRepo(session, persistence.GetClusterName(), persistence.MemoizationCache.GetMaxEntries())
 	}
 	eventRecorderManager := events.NewEventRecorderManager(as.clients.Kubernetes)
 	artifactRepositories := artifactrepositories.New(as.clients.Kubernetes, as.managedNamespace, &config.ArtifactRepository)
 	artifactServer := artifacts.NewArtifactServer(as.gatekeeper, hydrator.New(offloadRepo), wfArchive, instanceIDService, artifactRepositories)
 	eventServer := event.NewController(instanceIDService, eventRecorderManager, as.eventQueueSize, as.eventWorkerCount)
-	grpcServer := as.newGRPCServer(instanceIDService, offloadRepo, wfArchive, eventServer, config.Links)
+	grpcServer := as.newGRPCServer(instanceIDService, offloadRepo, wfArchive, memoizationCacheRepo, eventServer, config.Links)
 	httpServer := as.newHTTPServer(ctx, port, artifactServer)
 
 	// Start listener
@@ -210,7 +214,7 @@ func (as *argoServer) Run(ctx context.Context, port int, browserOpenFunc func(st
 	<-as.stopCh
 }
 
-func (as *argoServer) newGRPCServer(instanceIDService instanceid.Service, offloadNodeStatusRepo sqldb.OffloadNodeStatusRepo, wfArchive sqldb.WorkflowArchive, eventServer *event.Controller, links []*v1alpha1.Link) *grpc.Server {
+func (as *argoServer) newGRPCServer(instanceIDService instanceid.Service, offloadNodeStatusRepo sqldb.OffloadNodeStatusRepo, wfArchive sqldb.WorkflowArchive, memoizationCacheRepo sqldb.MemoizationCacheRepo, eventServer *event.Controller, links []*v1alpha1.Link) *grpc.Server {
 	serverLog := log.NewEntry(log.StandardLogger())
 	sOpts := []grpc.ServerOption{
 		// Set both the send and receive the bytes limit to be 100MB
@@ -244,6 +248,7 @@ func (as *argoServer) newGRPCServer(instanceIDService instanceid.Service, offloa
 	cronworkflowpkg.RegisterCronWorkflowServiceServer(grpcServer, cronworkflow.NewCronWorkflowServer(instanceIDService))
 	workflowarchivepkg.RegisterArchivedWorkflowServiceServer(grpcServer, workflowarchive.NewWorkflowArchiveServer(wfArchive))
 	clusterwftemplatepkg.RegisterClusterWorkflowTemplateServiceServer(grpcServer, clusterworkflowtemplate.NewClusterWorkflowTemplateServer(instanceIDService))
+	cachepkg.RegisterCacheServiceServer(grpcServer, cache.NewCacheServer(memoizationCacheRepo))
 	return grpcServer
 }
 
@@ -290,6 +295,7 @@ func (as *argoServer) newHTTPServer(ctx context.Context, port int, artifactServe
 	mustRegisterGWHandler(cronworkflowpkg.RegisterCronWorkflowServiceHandlerFromEndpoint, ctx, gwmux, endpoint, dialOpts)
 	mustRegisterGWHandler(workflowarchivepkg.RegisterArchivedWorkflowServiceHandlerFromEndpoint, ctx, gwmux, endpoint, dialOpts)
 	mustRegisterGWHandler(clusterwftemplatepkg.RegisterClusterWorkflowTemplateServiceHandlerFromEndpoint, ctx, gwmux, endpoint, dialOpts)
+	mustRegisterGWHandler(cachepkg.RegisterCacheServiceHandlerFromEndpoint, ctx, gwmux, endpoint, dialOpts)
 
 	mux.HandleFunc("/api/", func(w http.ResponseWriter, r *http.Request) { webhookInterceptor(w, r, gwmux) })
 	mux.HandleFunc("/artifacts/", artifactServer.GetArtifact)
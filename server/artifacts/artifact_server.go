@@ -3,13 +3,9 @@ package artifacts
 import (
 	"context"
 	"fmt"
-	"io/ioutil"
 	"net/http"
-	"os"
 	"path"
-	"strconv"
 	"strings"
-	"time"
 
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
@@ -17,6 +13,7 @@ import (
 	"google.golang.org/grpc/status"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	argoerrs "github.com/simster7/argo/v2/errors"
 	"github.com/simster7/argo/v2/persist/sqldb"
 	wfv1 "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1"
 	"github.com/simster7/argo/v2/server/auth"
@@ -83,7 +80,7 @@ func (a *ArtifactServer) GetArtifactByUID(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	path := strings.SplitN(r.URL.Path, "/", 6)
+	path := strings.SplitN(r.URL.Path, "/", 5)
 
 	uid := path[2]
 	nodeId := path[3]
@@ -126,53 +123,47 @@ func (a *ArtifactServer) serverInternalError(err error, w http.ResponseWriter) {
 	_, _ = w.Write([]byte(err.Error()))
 }
 
-func (a *ArtifactServer) returnArtifact(ctx context.Context, w http.ResponseWriter, r *http.Request, wf *wfv1.Workflow, nodeId, artifactName string) error {
+func (a *ArtifactServer) returnArtifact(ctx context.Context, w http.ResponseWriter, r *http.Request, wf *wfv1.Workflow, nodeId, artifactPath string) error {
 	kubeClient := auth.GetKubeClient(ctx)
 
+	// the artifact name may be followed by a path to a file or directory within the artifact
+	parts := strings.SplitN(artifactPath, "/", 2)
+	artifactName := parts[0]
 	art := wf.Status.Nodes[nodeId].Outputs.GetArtifactByName(artifactName)
 	if art == nil {
 		return fmt.Errorf("artifact not found")
 	}
+	art = art.DeepCopy()
 
 	driver, err := a.artDriverFactory(ctx, art, resources{kubeClient, wf.Namespace})
 	if err != nil {
 		return err
 	}
-	tmp, err := ioutil.TempFile("/tmp", "artifact")
-	if err != nil {
-		return err
-	}
-	tmpPath := tmp.Name()
-	defer func() { _ = os.Remove(tmpPath) }()
 
-	err = driver.Load(art, tmpPath)
-	if err != nil {
+	if len(parts) == 1 {
+		err = streamArtifact(w, r, driver, art)
+		if argoerrs.IsCode(argoerrs.CodeNotFound, err) {
+			// this may be a directory artifact, so see if there is anything in it to list
+			keys, listErr := driver.ListObjects(art)
+			if listErr == nil && len(keys) > 0 {
+				http.Redirect(w, r, r.URL.Path+"/", http.StatusFound)
+				return nil
+			}
+		}
 		return err
 	}
 
-	file, err := os.Open(tmpPath)
-
-	if err != nil {
-		return err
+	subPath := strings.Trim(parts[1], "/")
+	if subPath != "" {
+		err = art.SetKey(path.Join(art.GetKey(), subPath))
+		if err != nil {
+			return err
+		}
 	}
-
-	defer file.Close()
-
-	stats, err := file.Stat()
-
-	if err != nil {
-		return err
+	if strings.HasSuffix(parts[1], "/") || subPath == "" {
+		return listArtifact(w, driver, art)
 	}
-
-	contentLength := strconv.FormatInt(stats.Size(), 10)
-	log.WithFields(log.Fields{"size": contentLength}).Debug("Artifact file size")
-
-	w.Header().Add("Content-Disposition", fmt.Sprintf(`filename="%s"`, path.Base(art.GetKey())))
-	w.WriteHeader(200)
-
-	http.ServeContent(w, r, "", time.Time{}, file)
-
-	return nil
+	return streamArtifact(w, r, driver, art)
 }
 
 func (a *ArtifactServer) getWorkflowAndValidate(ctx context.Context, namespace string, workflowName string) (*wfv1.Workflow, error) {
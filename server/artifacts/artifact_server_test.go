@@ -1,14 +1,20 @@
 package artifacts
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
+	"strings"
 	"testing"
 
+	argoerrs "github.com/simster7/argo/v2/errors"
 	artifact "github.com/simster7/argo/v2/workflow/artifacts"
+	artifactscommon "github.com/simster7/argo/v2/workflow/artifacts/common"
 	"github.com/simster7/argo/v2/workflow/artifacts/resource"
 
 	"github.com/stretchr/testify/assert"
@@ -42,10 +48,37 @@ type fakeArtifactDriver struct {
 	data []byte
 }
 
+// the objects stored within the directory artifact
+var fakeDirectoryObjects = map[string]string{
+	"my-wf/my-node/my-dir/a.txt":     "a",
+	"my-wf/my-node/my-dir/sub/b.txt": "b",
+}
+
 func (a *fakeArtifactDriver) Load(_ *wfv1.Artifact, path string) error {
 	return ioutil.WriteFile(path, a.data, 0666)
 }
 
+func (a *fakeArtifactDriver) OpenStream(art *wfv1.Artifact) (io.ReadCloser, error) {
+	key := art.GetKey()
+	if data, ok := fakeDirectoryObjects[key]; ok {
+		return artifactscommon.NewSizedReadCloser(ioutil.NopCloser(strings.NewReader(data)), int64(len(data))), nil
+	}
+	if key == "my-wf/my-node/my-dir" {
+		return nil, argoerrs.New(argoerrs.CodeNotFound, "not found")
+	}
+	return artifactscommon.NewSizedReadCloser(ioutil.NopCloser(bytes.NewReader(a.data)), int64(len(a.data))), nil
+}
+
+func (a *fakeArtifactDriver) ListObjects(art *wfv1.Artifact) ([]string, error) {
+	var keys []string
+	for key := range fakeDirectoryObjects {
+		if artifactscommon.IsKeyInArtifact(art.GetKey(), key) {
+			keys = append(keys, key)
+		}
+	}
+	return keys, nil
+}
+
 func (a *fakeArtifactDriver) Save(_ string, _ *wfv1.Artifact) error {
 	return fmt.Errorf("not implemented")
 }
@@ -79,6 +112,14 @@ func newServer() *ArtifactServer {
 									},
 								},
 							},
+							{
+								Name: "my-dir-artifact",
+								ArtifactLocation: wfv1.ArtifactLocation{
+									S3: &wfv1.S3Artifact{
+										Key: "my-wf/my-node/my-dir",
+									},
+								},
+							},
 							{
 								Name: "my-oss-artifact",
 								ArtifactLocation: wfv1.ArtifactLocation{
@@ -140,6 +181,62 @@ func TestArtifactServer_GetArtifact(t *testing.T) {
 	}
 }
 
+func TestArtifactServer_GetArtifactRange(t *testing.T) {
+	s := newServer()
+	r := httptest.NewRequest("GET", "/artifacts/my-ns/my-wf/my-node/my-s3-artifact", nil)
+	r.Header.Set("Range", "bytes=3-")
+	w := httptest.NewRecorder()
+	s.GetArtifact(w, r)
+	assert.Equal(t, 206, w.Code)
+	assert.Equal(t, "4", w.Header().Get("Content-Length"))
+	assert.Equal(t, "bytes 3-6/7", w.Header().Get("Content-Range"))
+	assert.Equal(t, "data", w.Body.String())
+}
+
+func TestArtifactServer_GetArtifactDirectory(t *testing.T) {
+	s := newServer()
+	t.Run("Redirect", func(t *testing.T) {
+		r := httptest.NewRequest("GET", "/artifacts/my-ns/my-wf/my-node/my-dir-artifact", nil)
+		w := httptest.NewRecorder()
+		s.GetArtifact(w, r)
+		assert.Equal(t, 302, w.Code)
+		assert.Equal(t, "/artifacts/my-ns/my-wf/my-node/my-dir-artifact/", w.Header().Get("Location"))
+	})
+	t.Run("Listing", func(t *testing.T) {
+		r := httptest.NewRequest("GET", "/artifacts/my-ns/my-wf/my-node/my-dir-artifact/", nil)
+		w := httptest.NewRecorder()
+		s.GetArtifact(w, r)
+		assert.Equal(t, 200, w.Code)
+		assert.Equal(t, "text/html; charset=utf-8", w.Header().Get("Content-Type"))
+		assert.Contains(t, w.Body.String(), `<a href="a.txt">a.txt</a>`)
+		assert.Contains(t, w.Body.String(), `<a href="sub/">sub/</a>`)
+		assert.NotContains(t, w.Body.String(), "b.txt")
+	})
+	t.Run("SubDirectoryListing", func(t *testing.T) {
+		r := httptest.NewRequest("GET", "/artifacts/my-ns/my-wf/my-node/my-dir-artifact/sub/", nil)
+		w := httptest.NewRecorder()
+		s.GetArtifact(w, r)
+		assert.Equal(t, 200, w.Code)
+		assert.Contains(t, w.Body.String(), `<a href="b.txt">b.txt</a>`)
+	})
+	t.Run("File", func(t *testing.T) {
+		r := httptest.NewRequest("GET", "/artifacts/my-ns/my-wf/my-node/my-dir-artifact/a.txt", nil)
+		w := httptest.NewRecorder()
+		s.GetArtifact(w, r)
+		assert.Equal(t, 200, w.Code)
+		assert.Equal(t, "text/plain; charset=utf-8", w.Header().Get("Content-Type"))
+		assert.Equal(t, "1", w.Header().Get("Content-Length"))
+		assert.Equal(t, `filename="a.txt"`, w.Header().Get("Content-Disposition"))
+		assert.Equal(t, "a", w.Body.String())
+	})
+	t.Run("NotFound", func(t *testing.T) {
+		r := httptest.NewRequest("GET", "/artifacts/my-ns/my-wf/my-node/my-dir-artifact/missing/", nil)
+		w := httptest.NewRecorder()
+		s.GetArtifact(w, r)
+		assert.Equal(t, 500, w.Code)
+	})
+}
+
 func TestArtifactServer_GetArtifactWithoutInstanceID(t *testing.T) {
 	s := newServer()
 	r := &http.Request{}
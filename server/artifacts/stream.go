@@ -0,0 +1,167 @@
+package artifacts
+
+import (
+	"bufio"
+	"fmt"
+	"html/template"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	wfv1 "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1"
+	artifact "github.com/simster7/argo/v2/workflow/artifacts"
+)
+
+// sizer is implemented by streams that know their size up-front, e.g. GCS readers
+type sizer interface {
+	Size() int64
+}
+
+// forwardSeeker lets http.ServeContent serve Range requests from a stream of known size that cannot seek,
+// by discarding bytes up to the requested offset. It can only seek forwards from the last byte read.
+type forwardSeeker struct {
+	r    io.Reader
+	size int64
+	read int64 // number of bytes read from r
+	pos  int64 // the offset the next read will be from
+}
+
+func (s *forwardSeeker) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+		s.pos = offset
+	case io.SeekCurrent:
+		s.pos += offset
+	case io.SeekEnd:
+		s.pos = s.size + offset
+	default:
+		return 0, fmt.Errorf("invalid whence %d", whence)
+	}
+	return s.pos, nil
+}
+
+func (s *forwardSeeker) Read(p []byte) (int, error) {
+	if s.pos < s.read {
+		return 0, fmt.Errorf("cannot seek backwards to %d after reading %d bytes", s.pos, s.read)
+	}
+	if s.pos > s.read {
+		n, err := io.CopyN(ioutil.Discard, s.r, s.pos-s.read)
+		s.read += n
+		if err != nil {
+			return 0, err
+		}
+	}
+	n, err := s.r.Read(p)
+	s.read += int64(n)
+	s.pos = s.read
+	return n, err
+}
+
+// streamArtifact writes a single artifact to the response without buffering it. Range requests are supported
+// if the size of the artifact is known.
+func streamArtifact(w http.ResponseWriter, r *http.Request, driver artifact.ArtifactDriver, art *wfv1.Artifact) error {
+	stream, err := driver.OpenStream(art)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = stream.Close() }()
+
+	name := path.Base(art.GetKey())
+	if name == "." || name == "/" {
+		name = art.Name
+	}
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`filename="%s"`, name))
+
+	// we must set the content type ourselves, otherwise http.ServeContent sniffs it by reading and then
+	// seeking back to the start, which a forwardSeeker cannot do
+	content := bufio.NewReader(stream)
+	contentType := mime.TypeByExtension(path.Ext(name))
+	if contentType == "" {
+		head, _ := content.Peek(512)
+		contentType = http.DetectContentType(head)
+	}
+	w.Header().Set("Content-Type", contentType)
+
+	var seeker io.ReadSeeker
+	if s, ok := stream.(io.ReadSeeker); ok {
+		// peeking may have read from the stream, so we rewind it and serve it directly
+		if _, err := s.Seek(0, io.SeekStart); err == nil {
+			seeker = s
+		}
+	}
+	if seeker == nil {
+		if s, ok := stream.(sizer); ok {
+			seeker = &forwardSeeker{r: content, size: s.Size()}
+			// each range of a multi-part request is served in turn, which may require seeking backwards
+			if strings.Contains(r.Header.Get("Range"), ",") {
+				r.Header.Del("Range")
+			}
+		}
+	}
+	if seeker != nil {
+		http.ServeContent(w, r, "", time.Time{}, seeker)
+		return nil
+	}
+
+	// the size is unknown, so we can only stream the whole artifact using chunked encoding
+	w.Header().Set("Accept-Ranges", "none")
+	w.WriteHeader(http.StatusOK)
+	n, err := io.Copy(w, content)
+	if err != nil {
+		// the status has already been written, so all we can do is log the error
+		log.WithError(err).WithField("bytesWritten", n).Error("failed to stream artifact")
+	}
+	return nil
+}
+
+var listingTemplate = template.Must(template.New("listing").Parse(`<html>
+<head><title>{{.Name}}</title></head>
+<body>
+<pre>
+{{range .Entries}}<a href="{{.}}">{{.}}</a>
+{{end}}</pre>
+</body>
+</html>
+`))
+
+// listArtifact writes an HTML listing of the files and sub-directories directly within a directory artifact
+func listArtifact(w http.ResponseWriter, driver artifact.ArtifactDriver, art *wfv1.Artifact) error {
+	keys, err := driver.ListObjects(art)
+	if err != nil {
+		return err
+	}
+	prefix := strings.TrimSuffix(art.GetKey(), "/") + "/"
+	seen := make(map[string]bool)
+	var entries []string
+	for _, key := range keys {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		entry := strings.TrimPrefix(key, prefix)
+		if i := strings.Index(entry, "/"); i >= 0 {
+			entry = entry[:i+1]
+		}
+		if entry != "" && !seen[entry] {
+			seen[entry] = true
+			entries = append(entries, entry)
+		}
+	}
+	if len(entries) == 0 {
+		return fmt.Errorf("artifact directory not found")
+	}
+	sort.Strings(entries)
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	err = listingTemplate.Execute(w, map[string]interface{}{"Name": art.Name, "Entries": entries})
+	if err != nil {
+		log.WithError(err).Error("failed to write artifact listing")
+	}
+	return nil
+}
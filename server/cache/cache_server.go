@@ -0,0 +1,141 @@
+package cache
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/simster7/argo/v2/persist/sqldb"
+	cachepkg "github.com/simster7/argo/v2/pkg/apiclient/cache"
+	"github.com/simster7/argo/v2/pkg/apis/workflow"
+	wfv1 "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1"
+	"github.com/simster7/argo/v2/server/auth"
+	controllercache "github.com/simster7/argo/v2/workflow/controller/cache"
+)
+
+type cacheServer struct {
+	repo sqldb.MemoizationCacheRepo
+}
+
+// NewCacheServer returns a new cacheServer
+func NewCacheServer(repo sqldb.MemoizationCacheRepo) cachepkg.CacheServiceServer {
+	return &cacheServer{repo: repo}
+}
+
+// getCache checks the user may read, or if write is true, modify the cache, and returns it.
+// Entries decide whether the namespace's workflows run their steps, so reading needs permission to list workflows, and
+// modifying needs permission to delete them. Config map caches are read and written as the user, so Kubernetes also
+// enforces RBAC on the config map.
+func (s *cacheServer) getCache(ctx context.Context, namespace, cacheName, cacheType string, write bool) (controllercache.MemoizationCache, error) {
+	if namespace == "" || cacheName == "" {
+		return nil, status.Error(codes.InvalidArgument, "namespace and cache name are required")
+	}
+	verb := "list"
+	if write {
+		verb = "delete"
+	}
+	allowed, err := auth.CanI(ctx, verb, workflow.WorkflowPlural, namespace, "")
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return nil, status.Error(codes.PermissionDenied, "permission denied")
+	}
+	switch wfv1.CacheType(cacheType) {
+	case "", wfv1.CacheTypeConfigMap:
+		return controllercache.NewConfigMapCache(namespace, auth.GetKubeClient(ctx), cacheName), nil
+	case wfv1.CacheTypeDatabase:
+		if !s.repo.IsEnabled() {
+			return nil, status.Error(codes.FailedPrecondition, sqldb.MemoizationCacheNotSupportedError.Error())
+		}
+		return controllercache.NewDatabaseCache(namespace, s.repo, cacheName), nil
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown cache type %q", cacheType)
+	}
+}
+
+func newCacheEntry(key string, entry *controllercache.Entry) *cachepkg.CacheEntry {
+	return &cachepkg.CacheEntry{
+		Key:               key,
+		NodeID:            entry.NodeID,
+		CreationTimestamp: entry.CreationTimestamp.DeepCopy(),
+		HitCount:          entry.HitCount,
+		Outputs:           entry.Outputs,
+	}
+}
+
+func (s *cacheServer) ListCacheEntries(ctx context.Context, req *cachepkg.ListCacheEntriesRequest) (*cachepkg.CacheEntryList, error) {
+	c, err := s.getCache(ctx, req.Namespace, req.CacheName, req.CacheType, false)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := c.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*cachepkg.CacheEntry, 0, len(entries))
+	for key, entry := range entries {
+		items = append(items, newCacheEntry(key, entry))
+	}
+	// newest first
+	sort.Slice(items, func(i, j int) bool {
+		if !items[i].CreationTimestamp.Equal(items[j].CreationTimestamp) {
+			return items[j].CreationTimestamp.Before(items[i].CreationTimestamp)
+		}
+		return items[i].Key < items[j].Key
+	})
+	return &cachepkg.CacheEntryList{Items: items}, nil
+}
+
+func (s *cacheServer) GetCacheEntry(ctx context.Context, req *cachepkg.GetCacheEntryRequest) (*cachepkg.CacheEntry, error) {
+	c, err := s.getCache(ctx, req.Namespace, req.CacheName, req.CacheType, false)
+	if err != nil {
+		return nil, err
+	}
+	entry, err := c.Load(ctx, req.Key)
+	if err != nil {
+		return nil, err
+	}
+	if !entry.Hit() {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+	return newCacheEntry(req.Key, entry), nil
+}
+
+func (s *cacheServer) DeleteCacheEntry(ctx context.Context, req *cachepkg.DeleteCacheEntryRequest) (*cachepkg.CacheEntryDeletedResponse, error) {
+	c, err := s.getCache(ctx, req.Namespace, req.CacheName, req.CacheType, true)
+	if err != nil {
+		return nil, err
+	}
+	err = c.Delete(ctx, req.Key)
+	if err == controllercache.ErrEntryNotFound {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &cachepkg.CacheEntryDeletedResponse{}, nil
+}
+
+func (s *cacheServer) PruneCache(ctx context.Context, req *cachepkg.PruneCacheRequest) (*cachepkg.PruneCacheResponse, error) {
+	var olderThan time.Duration
+	if req.OlderThan != "" {
+		var err error
+		olderThan, err = time.ParseDuration(req.OlderThan)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid olderThan: %v", err)
+		}
+	}
+	c, err := s.getCache(ctx, req.Namespace, req.CacheName, req.CacheType, true)
+	if err != nil {
+		return nil, err
+	}
+	deleted, err := c.Prune(ctx, time.Now().Add(-olderThan))
+	if err != nil {
+		return nil, err
+	}
+	return &cachepkg.PruneCacheResponse{Deleted: int32(deleted)}, nil
+}
@@ -0,0 +1,110 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/simster7/argo/v2/persist/sqldb"
+	"github.com/simster7/argo/v2/persist/sqldb/mocks"
+	cachepkg "github.com/simster7/argo/v2/pkg/apiclient/cache"
+	wfv1 "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1"
+	"github.com/simster7/argo/v2/server/auth"
+)
+
+func Test_cacheServer(t *testing.T) {
+	kubeClient := kubefake.NewSimpleClientset(&apiv1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-cache", Namespace: "my-ns"},
+		Data: map[string]string{
+			"old-key": `{"nodeID":"old-node","outputs":{},"creationTimestamp":"2020-09-21T18:12:56Z","hitCount":3}`,
+			"new-key": `{"nodeID":"new-node","outputs":{},"creationTimestamp":"` + time.Now().Format(time.RFC3339) + `"}`,
+		},
+	})
+	var reviews []authorizationv1.ResourceAttributes
+	allowed := true
+	kubeClient.PrependReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (handled bool, ret runtime.Object, err error) {
+		review := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectAccessReview)
+		reviews = append(reviews, *review.Spec.ResourceAttributes)
+		return true, &authorizationv1.SelfSubjectAccessReview{
+			Status: authorizationv1.SubjectAccessReviewStatus{Allowed: allowed},
+		}, nil
+	})
+	repo := &mocks.MemoizationCacheRepo{}
+	repo.On("IsEnabled").Return(true)
+	repo.On("List", "my-ns", "my-db-cache").Return([]*sqldb.MemoizationCacheEntry{{Key: "my-key", NodeID: "my-node", Outputs: &wfv1.Outputs{}, CreatedAt: time.Now(), HitCount: 1}}, nil)
+	repo.On("Delete", "my-ns", "my-db-cache", "my-key").Return(true, nil)
+	s := NewCacheServer(repo)
+	ctx := context.WithValue(context.TODO(), auth.KubeKey, kubeClient)
+
+	t.Run("ListCacheEntries", func(t *testing.T) {
+		list, err := s.ListCacheEntries(ctx, &cachepkg.ListCacheEntriesRequest{Namespace: "my-ns", CacheName: "my-cache"})
+		if assert.NoError(t, err) && assert.Len(t, list.Items, 2) {
+			assert.Equal(t, "new-key", list.Items[0].Key)
+			assert.Equal(t, "old-key", list.Items[1].Key)
+			assert.Equal(t, "old-node", list.Items[1].NodeID)
+			assert.Equal(t, int64(3), list.Items[1].HitCount)
+		}
+		list, err = s.ListCacheEntries(ctx, &cachepkg.ListCacheEntriesRequest{Namespace: "my-ns", CacheName: "my-db-cache", CacheType: "Database"})
+		if assert.NoError(t, err) && assert.Len(t, list.Items, 1) {
+			assert.Equal(t, "my-key", list.Items[0].Key)
+			assert.Equal(t, int64(1), list.Items[0].HitCount)
+		}
+		_, err = s.ListCacheEntries(ctx, &cachepkg.ListCacheEntriesRequest{Namespace: "my-ns", CacheName: "my-cache", CacheType: "Unknown"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("GetCacheEntry", func(t *testing.T) {
+		allowed = false
+		_, err := s.GetCacheEntry(ctx, &cachepkg.GetCacheEntryRequest{Namespace: "my-ns", CacheName: "my-cache", Key: "old-key"})
+		assert.Equal(t, status.Error(codes.PermissionDenied, "permission denied"), err)
+		allowed = true
+		entry, err := s.GetCacheEntry(ctx, &cachepkg.GetCacheEntryRequest{Namespace: "my-ns", CacheName: "my-cache", Key: "old-key"})
+		if assert.NoError(t, err) {
+			assert.Equal(t, "old-node", entry.NodeID)
+		}
+		_, err = s.GetCacheEntry(ctx, &cachepkg.GetCacheEntryRequest{Namespace: "my-ns", CacheName: "my-cache", Key: "missing"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+	t.Run("DeleteCacheEntry", func(t *testing.T) {
+		allowed = false
+		reviews = nil
+		_, err := s.DeleteCacheEntry(ctx, &cachepkg.DeleteCacheEntryRequest{Namespace: "my-ns", CacheName: "my-cache", Key: "old-key"})
+		assert.Equal(t, status.Error(codes.PermissionDenied, "permission denied"), err)
+		_, err = s.DeleteCacheEntry(ctx, &cachepkg.DeleteCacheEntryRequest{Namespace: "my-ns", CacheName: "my-db-cache", Key: "my-key", CacheType: "Database"})
+		assert.Equal(t, status.Error(codes.PermissionDenied, "permission denied"), err)
+		expected := authorizationv1.ResourceAttributes{Namespace: "my-ns", Verb: "delete", Group: "argoproj.io", Resource: "workflows"}
+		assert.Equal(t, []authorizationv1.ResourceAttributes{expected, expected}, reviews)
+		allowed = true
+		_, err = s.DeleteCacheEntry(ctx, &cachepkg.DeleteCacheEntryRequest{Namespace: "my-ns", CacheName: "my-cache", Key: "old-key"})
+		assert.NoError(t, err)
+		_, err = s.DeleteCacheEntry(ctx, &cachepkg.DeleteCacheEntryRequest{Namespace: "my-ns", CacheName: "my-cache", Key: "old-key"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		_, err = s.DeleteCacheEntry(ctx, &cachepkg.DeleteCacheEntryRequest{Namespace: "my-ns", CacheName: "my-db-cache", Key: "my-key", CacheType: "Database"})
+		assert.NoError(t, err)
+	})
+	t.Run("PruneCache", func(t *testing.T) {
+		_, err := s.PruneCache(ctx, &cachepkg.PruneCacheRequest{Namespace: "my-ns", CacheName: "my-cache", OlderThan: "invalid"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		resp, err := s.PruneCache(ctx, &cachepkg.PruneCacheRequest{Namespace: "my-ns", CacheName: "my-cache", OlderThan: "1h"})
+		if assert.NoError(t, err) {
+			assert.Equal(t, int32(0), resp.Deleted)
+		}
+		resp, err = s.PruneCache(ctx, &cachepkg.PruneCacheRequest{Namespace: "my-ns", CacheName: "my-cache"})
+		if assert.NoError(t, err) {
+			assert.Equal(t, int32(1), resp.Deleted)
+		}
+	})
+	t.Run("DatabaseNotConfigured", func(t *testing.T) {
+		_, err := NewCacheServer(sqldb.NullMemoizationCacheRepo).ListCacheEntries(ctx, &cachepkg.ListCacheEntriesRequest{Namespace: "my-ns", CacheName: "my-db-cache", CacheType: "Database"})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+}
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 
@@ -13,6 +15,7 @@ import (
 	"github.com/simster7/argo/v2/server/auth"
 	"github.com/simster7/argo/v2/util/instanceid"
 	"github.com/simster7/argo/v2/workflow/creator"
+	"github.com/simster7/argo/v2/workflow/cron"
 	"github.com/simster7/argo/v2/workflow/templateresolution"
 	"github.com/simster7/argo/v2/workflow/validate"
 )
@@ -106,6 +109,21 @@ func (c *cronWorkflowServiceServer) SuspendCronWorkflow(ctx context.Context, req
 	return setCronWorkflowSuspend(ctx, true, req.Namespace, req.Name)
 }
 
+func (c *cronWorkflowServiceServer) BackfillCronWorkflow(ctx context.Context, req *cronworkflowpkg.BackfillCronWorkflowRequest) (*cronworkflowpkg.BackfillCronWorkflowResponse, error) {
+	if req.Start == nil || req.End == nil {
+		return nil, status.Error(codes.InvalidArgument, "start and end must be specified")
+	}
+	cronWf, err := c.getCronWorkflowAndValidate(ctx, req.Namespace, req.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	workflows, err := cron.Backfill(ctx, auth.GetWfClient(ctx), cronWf, cron.BackfillOpts{Start: req.Start.Time, End: req.End.Time, Parallelism: int(req.Parallelism)})
+	if err != nil {
+		return nil, err
+	}
+	return &cronworkflowpkg.BackfillCronWorkflowResponse{Items: workflows}, nil
+}
+
 func setCronWorkflowSuspend(ctx context.Context, setTo bool, namespace, name string) (*v1alpha1.CronWorkflow, error) {
 	data, err := json.Marshal(map[string]interface{}{"spec": map[string]interface{}{"suspend": setTo}})
 	if err != nil {
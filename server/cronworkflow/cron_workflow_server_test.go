@@ -3,9 +3,11 @@ package cronworkflow
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"gopkg.in/square/go-jose.v2/jwt"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	cronworkflowpkg "github.com/simster7/argo/v2/pkg/apiclient/cronworkflow"
 	wfv1 "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1"
@@ -107,6 +109,25 @@ metadata:
 			assert.Error(t, err)
 		})
 	})
+	t.Run("BackfillCronWorkflow", func(t *testing.T) {
+		start := metav1.NewTime(time.Date(2020, 10, 1, 0, 0, 0, 0, time.UTC))
+		end := metav1.NewTime(start.Add(2 * time.Minute))
+		t.Run("Labelled", func(t *testing.T) {
+			resp, err := server.BackfillCronWorkflow(ctx, &cronworkflowpkg.BackfillCronWorkflowRequest{Name: "my-name", Namespace: "my-ns", Start: &start, End: &end, Parallelism: 2})
+			if assert.NoError(t, err) && assert.Len(t, resp.Items, 3) {
+				assert.Contains(t, resp.Items[0].Labels, common.LabelKeyCronWorkflowBackfill)
+				assert.Contains(t, resp.Items[0].Labels, common.LabelKeyControllerInstanceID)
+			}
+		})
+		t.Run("NoEnd", func(t *testing.T) {
+			_, err := server.BackfillCronWorkflow(ctx, &cronworkflowpkg.BackfillCronWorkflowRequest{Name: "my-name", Namespace: "my-ns", Start: &start})
+			assert.Error(t, err)
+		})
+		t.Run("Unlabelled", func(t *testing.T) {
+			_, err := server.BackfillCronWorkflow(ctx, &cronworkflowpkg.BackfillCronWorkflowRequest{Name: "unlabelled", Namespace: "my-ns", Start: &start, End: &end})
+			assert.Error(t, err)
+		})
+	})
 	t.Run("DeleteCronWorkflow", func(t *testing.T) {
 		t.Run("Labelled", func(t *testing.T) {
 			_, err := server.DeleteCronWorkflow(ctx, &cronworkflowpkg.DeleteCronWorkflowRequest{Name: "my-name", Namespace: "my-ns"})
@@ -69,10 +69,10 @@ func (s *workflowServer) CreateWorkflow(ctx context.Context, req *workflowpkg.Wo
 
 	// if we are doing a normal dryRun, just return the workflow un-altered
 	if req.CreateOptions != nil && len(req.CreateOptions.DryRun) > 0 {
-		return req.Workflow, nil
+		return redacted(req.Workflow, nil)
 	}
 	if req.ServerDryRun {
-		return util.CreateServerDryRun(ctx, req.Workflow, wfClient)
+		return redacted(util.CreateServerDryRun(ctx, req.Workflow, wfClient))
 	}
 
 	wf, err := wfClient.ArgoprojV1alpha1().Workflows(req.Namespace).Create(ctx, req.Workflow, metav1.CreateOptions{})
@@ -86,7 +86,7 @@ func (s *workflowServer) CreateWorkflow(ctx context.Context, req *workflowpkg.Wo
 		return nil, err
 	}
 
-	return wf, nil
+	return redacted(wf, nil)
 }
 
 func (s *workflowServer) GetWorkflow(ctx context.Context, req *workflowpkg.WorkflowGetRequest) (*wfv1.Workflow, error) {
@@ -107,6 +107,7 @@ func (s *workflowServer) GetWorkflow(ctx context.Context, req *workflowpkg.Workf
 	if err != nil {
 		return nil, err
 	}
+	wf.Redact()
 	if req.Fields != "" {
 		wfBytes, err := json.Marshal(wf)
 		if err != nil {
@@ -156,6 +157,9 @@ func (s *workflowServer) ListWorkflows(ctx context.Context, req *workflowpkg.Wor
 
 	// we make no promises about the overall list sorting, we just sort each page
 	sort.Sort(wfList.Items)
+	for i := range wfList.Items {
+		wfList.Items[i].Redact()
+	}
 
 	res := &wfv1.WorkflowList{ListMeta: metav1.ListMeta{Continue: wfList.Continue, ResourceVersion: wfList.ResourceVersion}, Items: wfList.Items}
 	if req.Fields != "" {
@@ -224,6 +228,7 @@ func (s *workflowServer) WatchWorkflows(req *workflowpkg.WatchWorkflowsRequest,
 			if err != nil {
 				return err
 			}
+			wf.Redact()
 			logCtx.Debug("Sending workflow event")
 			err = ws.Send(&workflowpkg.WorkflowWatchEvent{Type: string(event.Type), Object: wf})
 			if err != nil {
@@ -309,7 +314,7 @@ func (s *workflowServer) RetryWorkflow(ctx context.Context, req *workflowpkg.Wor
 	if err != nil {
 		return nil, err
 	}
-	return wf, nil
+	return redacted(wf, nil)
 }
 
 func (s *workflowServer) ResubmitWorkflow(ctx context.Context, req *workflowpkg.WorkflowResubmitRequest) (*wfv1.Workflow, error) {
@@ -333,7 +338,7 @@ func (s *workflowServer) ResubmitWorkflow(ctx context.Context, req *workflowpkg.
 	if err != nil {
 		return nil, err
 	}
-	return created, nil
+	return redacted(created, nil)
 }
 
 func (s *workflowServer) ResumeWorkflow(ctx context.Context, req *workflowpkg.WorkflowResumeRequest) (*wfv1.Workflow, error) {
@@ -359,7 +364,7 @@ func (s *workflowServer) ResumeWorkflow(ctx context.Context, req *workflowpkg.Wo
 		return nil, err
 	}
 
-	return wf, nil
+	return redacted(wf, nil)
 }
 
 func (s *workflowServer) SuspendWorkflow(ctx context.Context, req *workflowpkg.WorkflowSuspendRequest) (*wfv1.Workflow, error) {
@@ -385,7 +390,7 @@ func (s *workflowServer) SuspendWorkflow(ctx context.Context, req *workflowpkg.W
 		return nil, err
 	}
 
-	return wf, nil
+	return redacted(wf, nil)
 }
 
 func (s *workflowServer) TerminateWorkflow(ctx context.Context, req *workflowpkg.WorkflowTerminateRequest) (*wfv1.Workflow, error) {
@@ -410,7 +415,7 @@ func (s *workflowServer) TerminateWorkflow(ctx context.Context, req *workflowpkg
 	if err != nil {
 		return nil, err
 	}
-	return wf, nil
+	return redacted(wf, nil)
 }
 
 func (s *workflowServer) StopWorkflow(ctx context.Context, req *workflowpkg.WorkflowStopRequest) (*wfv1.Workflow, error) {
@@ -432,7 +437,7 @@ func (s *workflowServer) StopWorkflow(ctx context.Context, req *workflowpkg.Work
 	if err != nil {
 		return nil, err
 	}
-	return wf, nil
+	return redacted(wf, nil)
 }
 
 func (s *workflowServer) SetWorkflow(ctx context.Context, req *workflowpkg.WorkflowSetRequest) (*wfv1.Workflow, error) {
@@ -477,7 +482,7 @@ func (s *workflowServer) SetWorkflow(ctx context.Context, req *workflowpkg.Workf
 	if err != nil {
 		return nil, err
 	}
-	return wf, nil
+	return redacted(wf, nil)
 }
 
 func (s *workflowServer) LintWorkflow(ctx context.Context, req *workflowpkg.WorkflowLintRequest) (*wfv1.Workflow, error) {
@@ -493,7 +498,7 @@ func (s *workflowServer) LintWorkflow(ctx context.Context, req *workflowpkg.Work
 		return nil, err
 	}
 
-	return req.Workflow, nil
+	return redacted(req.Workflow, nil)
 }
 
 func (s *workflowServer) PodLogs(req *workflowpkg.WorkflowLogRequest, ws workflowpkg.WorkflowService_PodLogsServer) error {
@@ -593,6 +598,15 @@ func (s *workflowServer) SubmitWorkflow(ctx context.Context, req *workflowpkg.Wo
 	if err != nil {
 		return nil, err
 	}
-	return wfClient.ArgoprojV1alpha1().Workflows(req.Namespace).Create(ctx, wf, metav1.CreateOptions{})
+	return redacted(wfClient.ArgoprojV1alpha1().Workflows(req.Namespace).Create(ctx, wf, metav1.CreateOptions{}))
 
 }
+
+// redacted redacts the values of the sensitive parameters of the workflow, as must be done to every workflow that the
+// server returns
+func redacted(wf *wfv1.Workflow, err error) (*wfv1.Workflow, error) {
+	if wf != nil {
+		wf.Redact()
+	}
+	return wf, err
+}
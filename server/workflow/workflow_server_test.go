@@ -446,7 +446,11 @@ const workflowtmpl = `
       "parameters": [
         {
           "name": "message",
-          "value": "hello world"
+          "value": "hello world",
+          "type": "string",
+          "schema": {
+            "maxLength": 20
+          }
         }
       ]
     },
@@ -620,6 +624,21 @@ func TestCreateWorkflow(t *testing.T) {
 	}
 }
 
+func TestCreateWorkflowRedacted(t *testing.T) {
+	server, ctx := getWorkflowServer()
+	var req workflowpkg.WorkflowCreateRequest
+	testutil.MustUnmarshallJSON(workflow1, &req)
+	req.Workflow.Spec.Arguments.Parameters = []v1alpha1.Parameter{{Name: "token", Value: v1alpha1.AnyStringPtr("my-token"), Sensitive: true}}
+	wf, err := server.CreateWorkflow(ctx, &req)
+	if assert.NoError(t, err) {
+		assert.Equal(t, v1alpha1.RedactedValue, wf.Spec.Arguments.GetParameterByName("token").Value.String())
+	}
+	linted, err := server.LintWorkflow(ctx, &workflowpkg.WorkflowLintRequest{Workflow: req.Workflow})
+	if assert.NoError(t, err) {
+		assert.Equal(t, v1alpha1.RedactedValue, linted.Spec.Arguments.GetParameterByName("token").Value.String())
+	}
+}
+
 type testWatchWorkflowServer struct {
 	testServerStream
 }
@@ -892,6 +911,17 @@ func TestSubmitWorkflowFromResource(t *testing.T) {
 			assert.Contains(t, wf.Annotations, "annotationTest")
 		}
 	})
+	t.Run("SubmitFromWorkflowTemplateWithInvalidParameter", func(t *testing.T) {
+		_, err := server.SubmitWorkflow(ctx, &workflowpkg.WorkflowSubmitRequest{
+			Namespace:     "workflows",
+			ResourceKind:  "workflowtemplate",
+			ResourceName:  "workflow-template-whalesay-template",
+			SubmitOptions: &v1alpha1.SubmitOpts{Parameters: []string{"message=hello world, this is a long message"}},
+		})
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), "spec.arguments.parameters.message.value: parameter 'message' is not valid against the schema")
+		}
+	})
 	t.Run("SubmitFromCronWorkflow", func(t *testing.T) {
 		wf, err := server.SubmitWorkflow(ctx, &workflowpkg.WorkflowSubmitRequest{
 			Namespace:    "workflows",
@@ -126,6 +126,8 @@ func (w *archivedWorkflowServer) GetArchivedWorkflow(ctx context.Context, req *w
 	if !allowed {
 		return nil, status.Error(codes.PermissionDenied, "permission denied")
 	}
+	// workflows archived before their sensitive parameters were redacted may still have their values
+	wf.Redact()
 	return wf, err
 }
 
@@ -211,6 +211,11 @@ export class WorkflowDag extends React.Component<WorkflowDagProps, WorkflowDagRe
         // Traverse the workflow from the root node
         traverse(workflowRoot);
 
+        // Traverse the trees of the workflow's lifecycle hooks, which have no parent
+        Object.values(allNodes)
+            .filter(node => node.name.startsWith(`${this.props.workflowName}.hooks.`))
+            .forEach(hookNode => traverse({nodeName: hookNode.id, parent: '', children: getChildren(hookNode.id)}));
+
         const onExitHandlerNodeId = Object.values(allNodes).find(nodeId => nodeId.name === `${this.props.workflowName}.onExit`);
         if (onExitHandlerNodeId) {
             this.getOutboundNodes(this.props.workflowName).forEach(v => {
@@ -15,6 +15,8 @@ export interface CronWorkflowSpec {
     workflowSpec: WorkflowSpec;
     workflowMetadata?: kubernetes.ObjectMeta;
     schedule: string;
+    schedules?: CronSchedule[];
+    exclusionWindows?: CronExclusionWindow[];
     concurrencyPolicy?: ConcurrencyPolicy;
     suspend?: boolean;
     startingDeadlineSeconds?: number;
@@ -23,6 +25,18 @@ export interface CronWorkflowSpec {
     timezone?: string;
 }
 
+export interface CronSchedule {
+    schedule: string;
+    timezone?: string;
+}
+
+export interface CronExclusionWindow {
+    start?: string;
+    duration?: string;
+    dates?: string[];
+    timezone?: string;
+}
+
 export interface CronWorkflowStatus {
     active: kubernetes.ObjectReference[];
     lastScheduledTime: kubernetes.Time;
@@ -104,8 +104,36 @@ export interface Parameter {
      * Enum holds a list of string values to choose from, for the actual value of the parameter
      */
     enum?: Array<string>;
+    /**
+     * Type is the type of the value of the parameter, which is checked when the workflow is created or submitted
+     */
+    type?: ParameterType;
+    /**
+     * Schema is a JSON schema that the value of the parameter, parsed as JSON, must be valid against
+     */
+    schema?: any;
+    /**
+     * Sensitive marks the value of the parameter as sensitive, so that it is redacted
+     */
+    sensitive?: boolean;
+    /**
+     * ExportTo is where the value of an output parameter is exported to when its node succeeds
+     */
+    exportTo?: ExportTo;
 }
 
+/**
+ * ExportTo is where the value of an output parameter is exported to
+ */
+export interface ExportTo {
+    /**
+     * ConfigMapKeyRef is a key of a config map, in the namespace of the workflow, that the value is written to
+     */
+    configMapKeyRef?: kubernetes.ConfigMapKeySelector;
+}
+
+export type ParameterType = 'string' | 'int' | 'bool' | 'number' | 'json';
+
 /**
  * ResourceTemplate is a template subtype to manipulate kubernetes resources
  */
@@ -136,6 +164,37 @@ export interface RetryStrategy {
      * Limit is the maximum number of attempts when retrying a container
      */
     limit?: number;
+    /**
+     * RetryOn are rules for the failures that are retried
+     */
+    retryOn?: RetryOn[];
+}
+
+/**
+ * RetryOn is a rule for the failures that are retried
+ */
+export interface RetryOn {
+    exitCodes?: number[];
+    reasons?: string[];
+    messagePattern?: string;
+}
+
+/**
+ * Loop repeats a step or task until its condition is met
+ */
+export interface Loop {
+    /**
+     * Until is an expression that is evaluated after each successful iteration, and ends the loop when it is true
+     */
+    until?: string;
+    /**
+     * MaxIterations is the maximum number of iterations
+     */
+    maxIterations: number;
+    /**
+     * Delay is the amount of time to wait between iterations
+     */
+    delay?: string;
 }
 
 /**
@@ -310,10 +369,22 @@ export interface Template {
      * Container is the main container image to run in the pod
      */
     container?: kubernetes.Container;
+    /**
+     * ContainerSet runs several containers in a single pod
+     */
+    containerSet?: ContainerSetTemplate;
     /**
      * Deamon will allow a workflow to proceed to the next step so long as the container reaches readiness
      */
     daemon?: boolean;
+    /**
+     * HTTP makes a HTTP request from the controller
+     */
+    http?: HTTP;
+    /**
+     * Workflow runs a child workflow from a workflow template
+     */
+    workflow?: ChildWorkflow;
     /**
      * Inputs describe what inputs parameters and artifacts are supplied to this template
      */
@@ -392,6 +463,14 @@ export interface ValueFrom {
      * Path in the container to retrieve an output parameter value from in container templates
      */
     path?: string;
+    /**
+     * SecretKeyRef is a key of a secret to use as the value of an argument or input parameter
+     */
+    secretKeyRef?: kubernetes.SecretKeySelector;
+    /**
+     * ConfigMapKeyRef is a key of a config map, in the namespace of the workflow, to use as the value of a parameter
+     */
+    configMapKeyRef?: kubernetes.ConfigMapKeySelector;
 }
 
 /**
@@ -415,7 +494,7 @@ export interface Workflow {
 
 export const execSpec = (w: Workflow) => Object.assign({}, w.status.storedWorkflowTemplateSpec, w.spec);
 
-export type NodeType = 'Pod' | 'Steps' | 'StepGroup' | 'DAG' | 'Retry' | 'Skipped' | 'TaskGroup' | 'Suspend';
+export type NodeType = 'Pod' | 'Container' | 'HTTP' | 'Steps' | 'StepGroup' | 'DAG' | 'Retry' | 'Skipped' | 'TaskGroup' | 'Suspend' | 'Loop' | 'Workflow';
 
 export interface NodeStatus {
     /**
@@ -455,6 +534,21 @@ export interface NodeStatus {
      */
     message: string;
 
+    /**
+     * FailureReason is the cause of the node failing, if it is known.
+     */
+    failureReason?: FailureReason;
+
+    /**
+     * ExitCode is the exit code of the main container of the pod of a failed node, if it exited.
+     */
+    exitCode?: number;
+
+    /**
+     * WithArtifactChildren are the numbers of children that the withArtifact of the steps of this step group, or of the tasks of this DAG, were expanded into.
+     */
+    withArtifactChildren?: {[name: string]: number};
+
     /**
      * Time at which this node started.
      */
@@ -621,6 +715,11 @@ export interface WorkflowStatus {
      * StoredWorkflowTemplateSpec is a Workflow Spec of top level WorkflowTemplate.
      */
     storedWorkflowTemplateSpec?: WorkflowSpec;
+
+    /**
+     * ConfigMapParameterValues are the values of the workflow parameters that are from config maps, by the name of the parameter.
+     */
+    configMapParameterValues?: {[name: string]: string};
 }
 
 export interface Condition {
@@ -709,6 +808,14 @@ export interface WorkflowSpec {
      * OnExit is a template reference which is invoked at the end of the workflow, irrespective of the success, failure, or error of the primary workflow.
      */
     onExit?: string;
+    /**
+     * Hooks are templates, keyed by name, that are each run once when their expression first becomes true while the workflow runs.
+     */
+    hooks?: LifecycleHooks;
+    /**
+     * TemplateDefaults holds the default fields of the templates of the workflow, which are strategically merged into each template.
+     */
+    templateDefaults?: Template;
     /**
      * ServiceAccountName is the name of the ServiceAccount to run all pods of the workflow as.
      */
@@ -750,6 +857,47 @@ export interface WorkflowTemplateRef {
     clusterScope?: boolean;
 }
 
+export interface HTTP {
+    method?: string;
+    url: string;
+    headers?: HTTPHeader[];
+    timeoutSeconds?: number;
+    body?: string;
+    successCondition?: string;
+}
+
+export interface ChildWorkflow {
+    /**
+     * WorkflowTemplateRef is a reference to the workflow template the child workflow is created from
+     */
+    workflowTemplateRef: WorkflowTemplateRef;
+    arguments?: Arguments;
+}
+
+export interface HTTPHeader {
+    name: string;
+    value?: string;
+    valueFrom?: {secretKeyRef?: {name: string; key: string}};
+}
+
+export interface ContainerSetTemplate {
+    /**
+     * Containers are the containers to run in the pod
+     */
+    containers: ContainerNode[];
+    /**
+     * VolumeMounts are mounted into each of the containers
+     */
+    volumeMounts?: kubernetes.VolumeMount[];
+}
+
+export interface ContainerNode extends kubernetes.Container {
+    /**
+     * Dependencies are the names of the containers that must succeed before this container runs its command
+     */
+    dependencies?: string[];
+}
+
 export interface DAGTemplate {
     /**
      * Target are one or more names of targets to execute in a DAG
@@ -762,12 +910,43 @@ export interface DAGTemplate {
     tasks: DAGTask[];
 }
 
+/**
+ * WithArtifact expands a step or task over a JSON list stored in an artifact
+ */
+export interface WithArtifact {
+    /**
+     * From is a reference to the artifact that holds the list
+     */
+    from?: string;
+    /**
+     * BatchSize is the number of items passed to each child as a JSON list, default 1
+     */
+    batchSize?: number;
+}
+
 export interface Sequence {
     start?: number;
     end?: number;
     count?: number;
 }
 
+/**
+ * LifecycleHook is a template that is run, at most once, when its expression first evaluates to true.
+ */
+export interface LifecycleHook {
+    template?: string;
+    templateRef?: TemplateRef;
+    arguments?: Arguments;
+    /**
+     * Expression is evaluated on each reconciliation, and runs the hook the first time it is true, e.g. `workflow.status == "Running"`
+     */
+    expression: string;
+}
+
+export interface LifecycleHooks {
+    [name: string]: LifecycleHook;
+}
+
 export interface DAGTask {
     name: string;
 
@@ -791,9 +970,22 @@ export interface DAGTask {
      */
     dependencies?: string[];
     onExit?: string;
+    hooks?: LifecycleHooks;
+    /**
+     * Inline is the template to execute, defined in place rather than referred to by name
+     */
+    inline?: Template;
+    /**
+     * Loop repeats the task, one iteration after the other, until its condition is met
+     */
+    loop?: Loop;
     withItems?: any[];
     withParam?: string;
     withSequence?: Sequence;
+    /**
+     * WithArtifact expands the task over the JSON list stored in an artifact
+     */
+    withArtifact?: WithArtifact;
 }
 
 /**
@@ -817,12 +1009,25 @@ export interface WorkflowStep {
      */
     when?: string;
     onExit?: string;
+    hooks?: LifecycleHooks;
+    /**
+     * Inline is the template to execute as the step, defined in place rather than referred to by name
+     */
+    inline?: Template;
+    /**
+     * Loop repeats the step, one iteration after the other, until its condition is met
+     */
+    loop?: Loop;
     /**
      * WithParam expands a step into from the value in the parameter
      */
     withParam?: string;
     withItems?: any[];
     withSequence?: Sequence;
+    /**
+     * WithArtifact expands the step over the JSON list stored in an artifact
+     */
+    withArtifact?: WithArtifact;
     /**
      * TemplateRef is the reference to the template resource which is used as the base of this template.
      */
@@ -850,6 +1055,8 @@ export interface MemoizationStatus {
 
 export type NodePhase = '' | 'Pending' | 'Running' | 'Succeeded' | 'Skipped' | 'Failed' | 'Error' | 'Omitted';
 
+export type FailureReason = 'OOMKilled' | 'Evicted' | 'DeadlineExceeded' | 'ImagePull' | 'NodeLost' | 'ExitCode' | 'Preempted' | 'ArtifactError';
+
 export const WorkflowPhases: NodePhase[] = ['Pending', 'Running', 'Succeeded', 'Failed', 'Error'];
 
 export const NODE_PHASE = {
@@ -0,0 +1,209 @@
+package expr
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/antonmedv/expr"
+	"github.com/antonmedv/expr/ast"
+	"github.com/antonmedv/expr/parser"
+)
+
+// functions are available to all expressions, as parameters are strings unless they are known to be numbers. A
+// function panics rather than returning an error, which the evaluation of the expression recovers as its error.
+var functions = map[string]interface{}{
+	"asInt": func(s string) int {
+		i, err := strconv.Atoi(strings.TrimSpace(s))
+		if err != nil {
+			panic(err)
+		}
+		return i
+	},
+	"asFloat": func(s string) float64 {
+		f, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+		if err != nil {
+			panic(err)
+		}
+		return f
+	},
+}
+
+// EnvMap returns the parameters, whose names are dot-separated paths such as `workflow.status`, as nested maps, so that
+// an expression can refer to them as a template would, e.g. `workflow.status == "Running"`. A name that contains a
+// '-' can be referred to using an index, e.g. `steps["my-step"].status`. Where a parameter's name is the prefix of
+// another's, such as `inputs.parameters` and `inputs.parameters.message`, the nested map takes precedence.
+//
+// Parameters are strings, apart from exit codes, `retries` and `loop.index`, which are integers, and `lastRetry.duration`, which is
+// a number of seconds.
+func EnvMap(params map[string]string) map[string]interface{} {
+	env := make(map[string]interface{})
+	for name, value := range params {
+		keys := strings.Split(name, ".")
+		m := env
+		for _, key := range keys[:len(keys)-1] {
+			child, ok := m[key].(map[string]interface{})
+			if !ok {
+				child = make(map[string]interface{})
+				m[key] = child
+			}
+			m = child
+		}
+		key := keys[len(keys)-1]
+		if _, ok := m[key].(map[string]interface{}); !ok {
+			m[key] = typedValue(name, value)
+		}
+	}
+	return env
+}
+
+func typedValue(name, value string) interface{} {
+	switch {
+	case name == "retries" || name == "loop.index" || name == "exitCode" || strings.HasSuffix(name, ".exitCode"):
+		if i, err := strconv.Atoi(value); err == nil {
+			return i
+		}
+	case name == "lastRetry.duration":
+		if f, err := strconv.ParseFloat(value, 64); err == nil {
+			return f
+		}
+	}
+	return value
+}
+
+// HasVariable returns whether the environment has the variable, e.g. `steps.my-step.status`
+func HasVariable(env map[string]interface{}, name string) bool {
+	var value interface{} = env
+	for _, key := range strings.Split(name, ".") {
+		m, ok := value.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		value, ok = m[key]
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// Eval evaluates the expression with the environment, and the functions available to all expressions
+func Eval(expression string, env map[string]interface{}) (interface{}, error) {
+	return eval(expression, env)
+}
+
+// EvalBoolWithFloats evaluates the expression, which must result in a boolean, with its integer literals as floats, as
+// govaluate would, so that e.g. `1/2 == 0.5` holds. Indexes and slice bounds remain integers.
+func EvalBoolWithFloats(expression string, env map[string]interface{}) (bool, error) {
+	return evalBool(expression, env, expr.Patch(&floatsVisitor{indexes: make(map[ast.Node]bool)}))
+}
+
+func eval(expression string, env map[string]interface{}, ops ...expr.Option) (interface{}, error) {
+	fullEnv := make(map[string]interface{}, len(env)+len(functions))
+	for k, v := range env {
+		fullEnv[k] = v
+	}
+	for k, v := range functions {
+		fullEnv[k] = v
+	}
+	program, err := expr.Compile(expression, ops...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to evaluate expression '%s': %s", expression, err)
+	}
+	result, err := expr.Run(program, fullEnv)
+	if err != nil {
+		return nil, fmt.Errorf("unable to evaluate expression '%s': %s", expression, err)
+	}
+	return result, nil
+}
+
+// EvalBool evaluates the expression, which must result in a boolean
+func EvalBool(expression string, env map[string]interface{}) (bool, error) {
+	return evalBool(expression, env)
+}
+
+func evalBool(expression string, env map[string]interface{}, ops ...expr.Option) (bool, error) {
+	result, err := eval(expression, env, ops...)
+	if err != nil {
+		return false, err
+	}
+	b, ok := result.(bool)
+	if !ok {
+		return false, fmt.Errorf("expression '%s' evaluated to %v, rather than a boolean", expression, result)
+	}
+	return b, nil
+}
+
+// Variables returns the sorted names of the variables the expression refers to, such as `steps.my-step.status` for
+// `steps["my-step"].status`. A variable's prefixes, e.g. `steps` and `steps.my-step`, are included.
+func Variables(expression string) ([]string, error) {
+	tree, err := parser.Parse(expression)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse expression '%s': %s", expression, err)
+	}
+	v := &variablesVisitor{names: make(map[string]bool)}
+	ast.Walk(&tree.Node, v)
+	var names []string
+	for name := range v.names {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// floatsVisitor replaces the integer literals of an expression, other than its indexes and slice bounds, with floats
+type floatsVisitor struct {
+	indexes map[ast.Node]bool
+}
+
+func (v *floatsVisitor) Enter(node *ast.Node) {
+	switch n := (*node).(type) {
+	case *ast.IndexNode:
+		v.indexes[n.Index] = true
+	case *ast.SliceNode:
+		v.indexes[n.From] = true
+		v.indexes[n.To] = true
+	}
+}
+
+func (v *floatsVisitor) Exit(node *ast.Node) {
+	if n, ok := (*node).(*ast.IntegerNode); ok && !v.indexes[n] {
+		*node = &ast.FloatNode{Value: float64(n.Value)}
+	}
+}
+
+type variablesVisitor struct {
+	names map[string]bool
+}
+
+func (v *variablesVisitor) Enter(*ast.Node) {}
+
+func (v *variablesVisitor) Exit(node *ast.Node) {
+	if name, ok := variableName(*node); ok {
+		v.names[name] = true
+	}
+}
+
+// variableName returns the name of the variable the node refers to, if it is an identifier, or a property or constant
+// index of one, e.g. `workflow.status` or `steps["my-step"]`
+func variableName(node ast.Node) (string, bool) {
+	switch n := node.(type) {
+	case *ast.IdentifierNode:
+		if _, ok := functions[n.Value]; ok {
+			return "", false
+		}
+		return n.Value, true
+	case *ast.PropertyNode:
+		if name, ok := variableName(n.Node); ok {
+			return name + "." + n.Property, true
+		}
+	case *ast.IndexNode:
+		if index, ok := n.Index.(*ast.StringNode); ok {
+			if name, ok := variableName(n.Node); ok {
+				return name + "." + index.Value, true
+			}
+		}
+	}
+	return "", false
+}
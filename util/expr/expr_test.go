@@ -0,0 +1,109 @@
+package expr
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnvMap(t *testing.T) {
+	assert.Equal(t, map[string]interface{}{
+		"workflow":  map[string]interface{}{"name": "my-wf", "status": "Running"},
+		"inputs":    map[string]interface{}{"parameters": map[string]interface{}{"message": "hello"}},
+		"steps":     map[string]interface{}{"my-step": map[string]interface{}{"exitCode": 1, "outputs": map[string]interface{}{"result": "2"}}},
+		"retries":   3,
+		"lastRetry": map[string]interface{}{"duration": 1.5, "exitCode": "-"},
+		"loop":      map[string]interface{}{"index": 2},
+	}, EnvMap(map[string]string{
+		"workflow.name":                "my-wf",
+		"workflow.status":              "Running",
+		"inputs.parameters":            `[{"name": "message"}]`,
+		"inputs.parameters.message":    "hello",
+		"steps.my-step.exitCode":       "1",
+		"steps.my-step.outputs.result": "2",
+		"retries":                      "3",
+		"lastRetry.duration":           "1.5",
+		"lastRetry.exitCode":           "-",
+		"loop.index":                   "2",
+	}))
+}
+
+func TestHasVariable(t *testing.T) {
+	env := EnvMap(map[string]string{"steps.my-step.status": "Failed"})
+	assert.True(t, HasVariable(env, "steps"))
+	assert.True(t, HasVariable(env, "steps.my-step.status"))
+	assert.False(t, HasVariable(env, "steps.my-step.status.foo"))
+	assert.False(t, HasVariable(env, "steps.other"))
+}
+
+func TestEval(t *testing.T) {
+	env := EnvMap(map[string]string{"steps.my-step.outputs.result": "2", "steps.my-step.exitCode": "1"})
+	for expression, expected := range map[string]interface{}{
+		`asInt(steps["my-step"].outputs.result) + 1`:   3,
+		`asFloat(steps["my-step"].outputs.result) / 4`: 0.5,
+		`steps["my-step"].exitCode > 0`:                true,
+		`steps["my-step"].outputs.result + "0"`:        "20",
+	} {
+		result, err := Eval(expression, env)
+		if assert.NoError(t, err, expression) {
+			assert.Equal(t, expected, result, expression)
+		}
+	}
+	_, err := Eval(`steps["my-step"].outputs.result > 1`, env)
+	assert.Error(t, err)
+	_, err = Eval(`asInt("one")`, env)
+	assert.Error(t, err)
+}
+
+func TestEvalBool(t *testing.T) {
+	env := EnvMap(map[string]string{"workflow.status": "Running", "steps.my-step.status": "Failed"})
+	for expression, expected := range map[string]bool{
+		`workflow.status == "Running"`:        true,
+		`workflow.status == "Failed"`:         false,
+		`steps["my-step"].status == "Failed"`: true,
+		`steps.other == nil`:                  true,
+	} {
+		result, err := EvalBool(expression, env)
+		if assert.NoError(t, err, expression) {
+			assert.Equal(t, expected, result, expression)
+		}
+	}
+	_, err := EvalBool("workflow.status", env)
+	assert.EqualError(t, err, "expression 'workflow.status' evaluated to Running, rather than a boolean")
+	_, err = EvalBool("workflow.status ==", env)
+	assert.Error(t, err)
+}
+
+func TestEvalBoolWithFloats(t *testing.T) {
+	env := EnvMap(map[string]string{"steps.my-step.exitCode": "1"})
+	for expression, expected := range map[string]bool{
+		`1/2 == 0.5`:                        true,
+		`steps["my-step"].exitCode / 2 > 0`: true,
+		`[1, 2, 3][1] == 2`:                 true,
+		`len([1, 2, 3][1:2]) == 1`:          true,
+		`asInt("3") / 2 == 1.5`:             true,
+	} {
+		result, err := EvalBoolWithFloats(expression, env)
+		if assert.NoError(t, err, expression) {
+			assert.Equal(t, expected, result, expression)
+		}
+	}
+}
+
+func TestVariables(t *testing.T) {
+	names, err := Variables(`asInt(steps["my-step"].outputs.result) > retries && all(inputs.parameters.list, {# > 1})`)
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{
+			"inputs",
+			"inputs.parameters",
+			"inputs.parameters.list",
+			"retries",
+			"steps",
+			"steps.my-step",
+			"steps.my-step.outputs",
+			"steps.my-step.outputs.result",
+		}, names)
+	}
+	_, err = Variables("retries >")
+	assert.Error(t, err)
+}
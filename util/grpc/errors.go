@@ -4,6 +4,8 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
+
+	argoerrs "github.com/simster7/argo/v2/errors"
 )
 
 // translate a K8S errors into gRPC error - assume that we want to surface this - which we may not
@@ -31,6 +33,9 @@ func TranslateError(err error) error {
 		return status.Error(codes.DeadlineExceeded, err.Error())
 	case apierr.IsInternalError(err):
 		return status.Error(codes.Internal, err.Error())
+	case argoerrs.IsCode(argoerrs.CodeBadRequest, err):
+		// e.g. a workflow that is not valid
+		return status.Error(codes.InvalidArgument, err.Error())
 	}
 	return err
 }
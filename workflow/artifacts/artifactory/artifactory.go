@@ -7,6 +7,7 @@ import (
 
 	"github.com/simster7/argo/v2/errors"
 	wfv1 "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1"
+	"github.com/simster7/argo/v2/workflow/artifacts/common"
 )
 
 type ArtifactoryArtifactDriver struct {
@@ -73,3 +74,56 @@ func (a *ArtifactoryArtifactDriver) Save(path string, artifact *wfv1.Artifact) e
 	}
 	return nil
 }
+
+// OpenStream opens the artifact at the artifactory URL for reading
+func (a *ArtifactoryArtifactDriver) OpenStream(artifact *wfv1.Artifact) (io.ReadCloser, error) {
+	req, err := http.NewRequest(http.MethodGet, artifact.Artifactory.URL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.SetBasicAuth(a.Username, a.Password)
+	res, err := (&http.Client{}).Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode == 404 {
+		_ = res.Body.Close()
+		return nil, errors.New(errors.CodeNotFound, res.Status)
+	}
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		_ = res.Body.Close()
+		return nil, errors.InternalErrorf("loading file from artifactory failed with reason:%s", res.Status)
+	}
+	if res.ContentLength >= 0 {
+		return common.NewSizedReadCloser(res.Body, res.ContentLength), nil
+	}
+	return res.Body, nil
+}
+
+// ListObjects returns the artifactory URL, as an artifactory artifact is always a single file
+func (a *ArtifactoryArtifactDriver) ListObjects(artifact *wfv1.Artifact) ([]string, error) {
+	return []string{artifact.Artifactory.URL}, nil
+}
+
+// Delete artifact at the artifactory URL
+func (a *ArtifactoryArtifactDriver) Delete(artifact *wfv1.Artifact) error {
+	req, err := http.NewRequest(http.MethodDelete, artifact.Artifactory.URL, nil)
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(a.Username, a.Password)
+	res, err := (&http.Client{}).Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+	if res.StatusCode == 404 {
+		return nil
+	}
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return errors.InternalErrorf("deleting file from artifactory failed with reason:%s", res.Status)
+	}
+	return nil
+}
@@ -3,12 +3,14 @@ package executor
 import (
 	"context"
 	"fmt"
+	"io"
 
 	"github.com/simster7/argo/v2/workflow/artifacts/gcs"
 	"github.com/simster7/argo/v2/workflow/artifacts/oss"
 
 	wfv1 "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1"
 	"github.com/simster7/argo/v2/workflow/artifacts/artifactory"
+	"github.com/simster7/argo/v2/workflow/artifacts/azure"
 	"github.com/simster7/argo/v2/workflow/artifacts/git"
 	"github.com/simster7/argo/v2/workflow/artifacts/hdfs"
 	"github.com/simster7/argo/v2/workflow/artifacts/http"
@@ -24,6 +26,15 @@ type ArtifactDriver interface {
 
 	// Save uploads the path to artifact destination
 	Save(path string, outputArtifact *wfv1.Artifact) error
+
+	// OpenStream opens the artifact for reading. The caller is responsible for closing the returned reader.
+	OpenStream(artifact *wfv1.Artifact) (io.ReadCloser, error)
+
+	// ListObjects returns the keys of all objects stored at, or below, the artifact's location
+	ListObjects(artifact *wfv1.Artifact) ([]string, error)
+
+	// Delete removes the artifact, and any objects below it, from its location
+	Delete(artifact *wfv1.Artifact) error
 }
 
 var ErrUnsupportedDriver = fmt.Errorf("unsupported artifact driver")
@@ -153,5 +164,27 @@ func NewDriver(ctx context.Context, art *wfv1.Artifact, ri resource.Interface) (
 		return &driver, nil
 	}
 
+	if art.Azure != nil {
+		driver := azure.ArtifactDriver{
+			Endpoint:    art.Azure.Endpoint,
+			UseSDKCreds: art.Azure.UseSDKCreds,
+		}
+		if art.Azure.AccountKeySecret != nil && art.Azure.AccountKeySecret.Name != "" {
+			accountKey, err := ri.GetSecret(ctx, art.Azure.AccountKeySecret.Name, art.Azure.AccountKeySecret.Key)
+			if err != nil {
+				return nil, err
+			}
+			driver.AccountKey = accountKey
+		}
+		if art.Azure.SASTokenSecret != nil && art.Azure.SASTokenSecret.Name != "" {
+			sasToken, err := ri.GetSecret(ctx, art.Azure.SASTokenSecret.Name, art.Azure.SASTokenSecret.Key)
+			if err != nil {
+				return nil, err
+			}
+			driver.SASToken = sasToken
+		}
+		return &driver, nil
+	}
+
 	return nil, ErrUnsupportedDriver
 }
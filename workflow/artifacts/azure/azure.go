@@ -0,0 +1,327 @@
+package azure
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-storage-blob-go/azblob"
+	"github.com/Azure/go-autorest/autorest/adal"
+	"github.com/argoproj/pkg/file"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/simster7/argo/v2/errors"
+	wfv1 "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1"
+	"github.com/simster7/argo/v2/workflow/artifacts/common"
+)
+
+// storageResource is the resource managed identity tokens are requested for
+const storageResource = "https://storage.azure.com/"
+
+// ArtifactDriver is a driver for Azure Blob Storage
+type ArtifactDriver struct {
+	Endpoint    string
+	AccountKey  string
+	SASToken    string
+	UseSDKCreds bool
+}
+
+// accountName returns the name of the storage account from the endpoint. For Azure this is the first label of the
+// host, e.g. "https://myaccount.blob.core.windows.net", but emulators such as Azurite put it in the path instead,
+// e.g. "http://127.0.0.1:10000/devstoreaccount1".
+func accountName(u *url.URL) (string, error) {
+	if p := strings.Trim(u.Path, "/"); p != "" {
+		return strings.Split(p, "/")[0], nil
+	}
+	host := u.Hostname()
+	if host == "" || net.ParseIP(host) != nil || host == "localhost" {
+		return "", fmt.Errorf("cannot determine the storage account name from endpoint %q", u.String())
+	}
+	return strings.Split(host, ".")[0], nil
+}
+
+func (a *ArtifactDriver) newContainerURL(container string) (azblob.ContainerURL, error) {
+	u, err := url.Parse(a.Endpoint)
+	if err != nil {
+		return azblob.ContainerURL{}, fmt.Errorf("invalid Azure Blob Storage endpoint %q: %v", a.Endpoint, err)
+	}
+	var credential azblob.Credential
+	switch {
+	case a.AccountKey != "":
+		account, err := accountName(u)
+		if err != nil {
+			return azblob.ContainerURL{}, err
+		}
+		credential, err = azblob.NewSharedKeyCredential(account, a.AccountKey)
+		if err != nil {
+			return azblob.ContainerURL{}, fmt.Errorf("Azure Blob Storage shared key credential: %v", err)
+		}
+	case a.SASToken != "":
+		u.RawQuery = strings.TrimPrefix(a.SASToken, "?")
+		credential = azblob.NewAnonymousCredential()
+	case a.UseSDKCreds:
+		credential, err = newManagedIdentityCredential()
+		if err != nil {
+			return azblob.ContainerURL{}, err
+		}
+	default:
+		// no credentials, assume the container allows public access
+		credential = azblob.NewAnonymousCredential()
+	}
+	u.Path = path.Join("/", u.Path, container)
+	return azblob.NewContainerURL(*u, azblob.NewPipeline(credential, azblob.PipelineOptions{})), nil
+}
+
+// newManagedIdentityCredential gets a token for the pod's managed identity, which is refreshed before it expires
+func newManagedIdentityCredential() (azblob.TokenCredential, error) {
+	endpoint, err := adal.GetMSIVMEndpoint()
+	if err != nil {
+		return nil, fmt.Errorf("Azure managed identity endpoint: %v", err)
+	}
+	spt, err := adal.NewServicePrincipalTokenFromMSI(endpoint, storageResource)
+	if err != nil {
+		return nil, fmt.Errorf("Azure managed identity token: %v", err)
+	}
+	if err := spt.Refresh(); err != nil {
+		return nil, fmt.Errorf("Azure managed identity token refresh: %v", err)
+	}
+	return azblob.NewTokenCredential(spt.Token().AccessToken, func(credential azblob.TokenCredential) time.Duration {
+		if err := spt.Refresh(); err != nil {
+			log.Warnf("Failed to refresh Azure managed identity token: %v", err)
+			return time.Minute
+		}
+		token := spt.Token()
+		credential.SetToken(token.AccessToken)
+		return time.Until(token.Expires().Add(-2 * time.Minute))
+	}), nil
+}
+
+func isNotFound(err error) bool {
+	if serr, ok := err.(azblob.StorageError); ok {
+		return serr.ServiceCode() == azblob.ServiceCodeBlobNotFound || serr.ServiceCode() == azblob.ServiceCodeContainerNotFound
+	}
+	return false
+}
+
+// Load function downloads a blob, or all the blobs below it, from Azure Blob Storage
+func (a *ArtifactDriver) Load(inputArtifact *wfv1.Artifact, path string) error {
+	return wait.ExponentialBackoff(wait.Backoff{Duration: time.Second * 2, Factor: 2.0, Steps: 5, Jitter: 0.1},
+		func() (bool, error) {
+			log.Infof("Azure Load path: %s, container: %s, blob: %s", path, inputArtifact.Azure.Container, inputArtifact.Azure.Blob)
+			containerURL, err := a.newContainerURL(inputArtifact.Azure.Container)
+			if err != nil {
+				return false, err
+			}
+			err = downloadBlobs(containerURL, inputArtifact.Azure.Blob, path)
+			if err != nil {
+				if errors.IsCode(errors.CodeNotFound, err) {
+					return false, err
+				}
+				log.Warnf("Failed to download blobs from Azure Blob Storage: %v", err)
+				return false, nil
+			}
+			return true, nil
+		})
+}
+
+// download the blob to path, or if it is a directory, all the blobs below it into path
+func downloadBlobs(containerURL azblob.ContainerURL, blob, path string) error {
+	blobNames, err := listArtifactBlobs(containerURL, blob)
+	if err != nil {
+		return err
+	}
+	if len(blobNames) == 0 {
+		return errors.Errorf(errors.CodeNotFound, "blob %s not found in container %s", blob, containerURL.String())
+	}
+	prefix := strings.TrimSuffix(blob, "/")
+	for _, blobName := range blobNames {
+		localPath := filepath.Join(path, filepath.FromSlash(strings.TrimPrefix(blobName, prefix)))
+		err = downloadBlob(containerURL, blobName, localPath)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func downloadBlob(containerURL azblob.ContainerURL, blobName, localPath string) error {
+	if dir := filepath.Dir(localPath); dir != "" {
+		if err := os.MkdirAll(dir, 0700); err != nil {
+			return fmt.Errorf("mkdir %s: %v", dir, err)
+		}
+	}
+	f, err := os.Create(localPath)
+	if err != nil {
+		return fmt.Errorf("os create %s: %v", localPath, err)
+	}
+	defer f.Close()
+	err = azblob.DownloadBlobToFile(context.Background(), containerURL.NewBlobURL(blobName), 0, azblob.CountToEnd, f, azblob.DownloadFromBlobOptions{})
+	if err != nil {
+		if isNotFound(err) {
+			return errors.New(errors.CodeNotFound, err.Error())
+		}
+		return fmt.Errorf("download %s: %v", blobName, err)
+	}
+	return nil
+}
+
+// list the names of all blobs with the prefix in the container
+func listByPrefix(containerURL azblob.ContainerURL, prefix string) ([]string, error) {
+	ctx := context.Background()
+	var results []string
+	for marker := (azblob.Marker{}); marker.NotDone(); {
+		resp, err := containerURL.ListBlobsFlatSegment(ctx, marker, azblob.ListBlobsSegmentOptions{Prefix: prefix})
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range resp.Segment.BlobItems {
+			results = append(results, item.Name)
+		}
+		marker = resp.NextMarker
+	}
+	return results, nil
+}
+
+// list the names of the blob, or the blobs below it, excluding other blobs that merely share its prefix
+func listArtifactBlobs(containerURL azblob.ContainerURL, blob string) ([]string, error) {
+	blobNames, err := listByPrefix(containerURL, blob)
+	if err != nil {
+		if isNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var keys []string
+	for _, blobName := range blobNames {
+		if common.IsKeyInArtifact(blob, blobName) {
+			keys = append(keys, blobName)
+		}
+	}
+	return keys, nil
+}
+
+// Save uploads a local file, or all the files in a local directory, to Azure Blob Storage
+func (a *ArtifactDriver) Save(path string, outputArtifact *wfv1.Artifact) error {
+	return wait.ExponentialBackoff(wait.Backoff{Duration: time.Second * 2, Factor: 2.0, Steps: 5, Jitter: 0.1},
+		func() (bool, error) {
+			log.Infof("Azure Save path: %s, container: %s, blob: %s", path, outputArtifact.Azure.Container, outputArtifact.Azure.Blob)
+			containerURL, err := a.newContainerURL(outputArtifact.Azure.Container)
+			if err != nil {
+				return false, err
+			}
+			err = uploadBlobs(containerURL, outputArtifact.Azure.Blob, path)
+			if err != nil {
+				log.Warnf("Failed to upload to Azure Blob Storage: %v", err)
+				return false, nil
+			}
+			return true, nil
+		})
+}
+
+// upload a local file or dir to the container
+func uploadBlobs(containerURL azblob.ContainerURL, blob, path string) error {
+	isDir, err := file.IsDirectory(path)
+	if err != nil {
+		return fmt.Errorf("test if %s is a dir: %v", path, err)
+	}
+	if !isDir {
+		return uploadBlob(containerURL, strings.TrimSuffix(blob, "/"), path)
+	}
+	prefix := strings.TrimSuffix(blob, "/") + "/"
+	return filepath.Walk(path, func(localPath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		relPath, err := filepath.Rel(path, localPath)
+		if err != nil {
+			return err
+		}
+		return uploadBlob(containerURL, prefix+filepath.ToSlash(relPath), localPath)
+	})
+}
+
+func uploadBlob(containerURL azblob.ContainerURL, blobName, localPath string) error {
+	f, err := os.Open(localPath)
+	if err != nil {
+		return fmt.Errorf("os open: %v", err)
+	}
+	defer f.Close()
+	_, err = azblob.UploadFileToBlockBlob(context.Background(), f, containerURL.NewBlockBlobURL(blobName), azblob.UploadToBlockBlobOptions{})
+	if err != nil {
+		return fmt.Errorf("upload %s: %v", localPath, err)
+	}
+	return nil
+}
+
+// OpenStream opens a single blob for reading
+func (a *ArtifactDriver) OpenStream(artifact *wfv1.Artifact) (io.ReadCloser, error) {
+	log.Infof("Azure OpenStream container: %s, blob: %s", artifact.Azure.Container, artifact.Azure.Blob)
+	containerURL, err := a.newContainerURL(artifact.Azure.Container)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := containerURL.NewBlobURL(artifact.Azure.Blob).Download(context.Background(), 0, azblob.CountToEnd, azblob.BlobAccessConditions{}, false, azblob.ClientProvidedKeyOptions{})
+	if err != nil {
+		if isNotFound(err) {
+			return nil, errors.New(errors.CodeNotFound, err.Error())
+		}
+		return nil, fmt.Errorf("download %s: %v", artifact.Azure.Blob, err)
+	}
+	return common.NewSizedReadCloser(resp.Body(azblob.RetryReaderOptions{MaxRetryRequests: 3}), resp.ContentLength()), nil
+}
+
+// ListObjects lists the names of the blob, or of all blobs below it
+func (a *ArtifactDriver) ListObjects(artifact *wfv1.Artifact) ([]string, error) {
+	var keys []string
+	err := wait.ExponentialBackoff(wait.Backoff{Duration: time.Second * 2, Factor: 2.0, Steps: 5, Jitter: 0.1},
+		func() (bool, error) {
+			log.Infof("Azure ListObjects container: %s, blob: %s", artifact.Azure.Container, artifact.Azure.Blob)
+			containerURL, err := a.newContainerURL(artifact.Azure.Container)
+			if err != nil {
+				return false, err
+			}
+			keys, err = listArtifactBlobs(containerURL, artifact.Azure.Blob)
+			if err != nil {
+				log.Warnf("Failed to list blobs: %v", err)
+				return false, nil
+			}
+			return true, nil
+		})
+	return keys, err
+}
+
+// Delete deletes the blob, or all blobs below it
+func (a *ArtifactDriver) Delete(artifact *wfv1.Artifact) error {
+	return wait.ExponentialBackoff(wait.Backoff{Duration: time.Second * 2, Factor: 2.0, Steps: 5, Jitter: 0.1},
+		func() (bool, error) {
+			log.Infof("Azure Delete container: %s, blob: %s", artifact.Azure.Container, artifact.Azure.Blob)
+			containerURL, err := a.newContainerURL(artifact.Azure.Container)
+			if err != nil {
+				return false, err
+			}
+			blobNames, err := listArtifactBlobs(containerURL, artifact.Azure.Blob)
+			if err != nil {
+				log.Warnf("Failed to list blobs: %v", err)
+				return false, nil
+			}
+			for _, blobName := range blobNames {
+				_, err = containerURL.NewBlobURL(blobName).Delete(context.Background(), azblob.DeleteSnapshotsOptionInclude, azblob.BlobAccessConditions{})
+				if err != nil && !isNotFound(err) {
+					log.Warnf("Failed to delete blob %s: %v", blobName, err)
+					return false, nil
+				}
+			}
+			return true, nil
+		})
+}
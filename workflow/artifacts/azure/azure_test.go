@@ -0,0 +1,116 @@
+package azure
+
+import (
+	"context"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Azure/azure-storage-blob-go/azblob"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/simster7/argo/v2/errors"
+	wfv1 "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1"
+)
+
+// azuriteAccountKey is the well-known account key of the Azurite emulator's "devstoreaccount1" account
+const azuriteAccountKey = "Eby8vdM02xNOcqFlqUwJPLlmEtlCDXJ1OUzFT50uSRZ6IFsuFq2UVErCz4I6tq/K1SZFPTOtr/KBHBeksoGMGw=="
+
+func TestAccountName(t *testing.T) {
+	for endpoint, expected := range map[string]string{
+		"https://myaccount.blob.core.windows.net":  "myaccount",
+		"https://myaccount.blob.core.windows.net/": "myaccount",
+		"http://127.0.0.1:10000/devstoreaccount1":  "devstoreaccount1",
+		"http://azurite:10000/devstoreaccount1/":   "devstoreaccount1",
+	} {
+		u, err := url.Parse(endpoint)
+		assert.NoError(t, err)
+		name, err := accountName(u)
+		if assert.NoError(t, err, endpoint) {
+			assert.Equal(t, expected, name, endpoint)
+		}
+	}
+	u, err := url.Parse("http://127.0.0.1:10000")
+	assert.NoError(t, err)
+	_, err = accountName(u)
+	assert.Error(t, err)
+}
+
+func TestNewContainerURL(t *testing.T) {
+	t.Run("AccountKey", func(t *testing.T) {
+		driver := &ArtifactDriver{Endpoint: "http://127.0.0.1:10000/devstoreaccount1", AccountKey: azuriteAccountKey}
+		containerURL, err := driver.newContainerURL("my-container")
+		if assert.NoError(t, err) {
+			assert.Equal(t, "http://127.0.0.1:10000/devstoreaccount1/my-container", containerURL.String())
+		}
+	})
+	t.Run("SASToken", func(t *testing.T) {
+		driver := &ArtifactDriver{Endpoint: "https://myaccount.blob.core.windows.net", SASToken: "?sv=2019-12-12&sig=my-sig"}
+		containerURL, err := driver.newContainerURL("my-container")
+		if assert.NoError(t, err) {
+			assert.Equal(t, "https://myaccount.blob.core.windows.net/my-container?sv=2019-12-12&sig=my-sig", containerURL.String())
+		}
+	})
+}
+
+// TestArtifactDriver runs against Azurite, e.g. `docker run -p 10000:10000 mcr.microsoft.com/azure-storage/azurite azurite-blob --blobHost 0.0.0.0`
+func TestArtifactDriver(t *testing.T) {
+	endpoint := os.Getenv("AZURITE_ENDPOINT")
+	if endpoint == "" {
+		t.Skip("not running as AZURITE_ENDPOINT not set")
+	}
+	driver := &ArtifactDriver{Endpoint: endpoint, AccountKey: azuriteAccountKey}
+	containerURL, err := driver.newContainerURL("argo-test")
+	assert.NoError(t, err)
+	_, err = containerURL.Create(context.Background(), azblob.Metadata{}, azblob.PublicAccessNone)
+	if serr, ok := err.(azblob.StorageError); !ok || serr.ServiceCode() != azblob.ServiceCodeContainerAlreadyExists {
+		assert.NoError(t, err)
+	}
+
+	tmp, err := ioutil.TempDir("", "azure")
+	assert.NoError(t, err)
+	defer func() { _ = os.RemoveAll(tmp) }()
+	src := filepath.Join(tmp, "src")
+	assert.NoError(t, os.MkdirAll(filepath.Join(src, "sub"), 0700))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(src, "a.txt"), []byte("a"), 0600))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(src, "sub", "b.txt"), []byte("b"), 0600))
+
+	art := &wfv1.Artifact{Name: "my-art", ArtifactLocation: wfv1.ArtifactLocation{Azure: &wfv1.AzureArtifact{
+		AzureBlobContainer: wfv1.AzureBlobContainer{Endpoint: endpoint, Container: "argo-test"},
+		Blob:               "my-dir",
+	}}}
+	err = driver.Save(src, art)
+	assert.NoError(t, err)
+
+	keys, err := driver.ListObjects(art)
+	if assert.NoError(t, err) {
+		assert.ElementsMatch(t, []string{"my-dir/a.txt", "my-dir/sub/b.txt"}, keys)
+	}
+
+	dst := filepath.Join(tmp, "dst")
+	err = driver.Load(art, dst)
+	if assert.NoError(t, err) {
+		data, err := ioutil.ReadFile(filepath.Join(dst, "sub", "b.txt"))
+		assert.NoError(t, err)
+		assert.Equal(t, "b", string(data))
+	}
+
+	fileArt := art.DeepCopy()
+	fileArt.Azure.Blob = "my-dir/a.txt"
+	stream, err := driver.OpenStream(fileArt)
+	if assert.NoError(t, err) {
+		data, err := ioutil.ReadAll(stream)
+		assert.NoError(t, err)
+		assert.Equal(t, "a", string(data))
+		assert.NoError(t, stream.Close())
+	}
+
+	err = driver.Delete(art)
+	assert.NoError(t, err)
+	_, err = driver.OpenStream(fileArt)
+	assert.True(t, errors.IsCode(errors.CodeNotFound, err))
+	err = driver.Load(art, filepath.Join(tmp, "missing"))
+	assert.True(t, errors.IsCode(errors.CodeNotFound, err))
+}
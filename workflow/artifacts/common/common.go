@@ -0,0 +1,41 @@
+package common
+
+import (
+	"io"
+	"strings"
+
+	"github.com/simster7/argo/v2/errors"
+)
+
+// ErrNotSupported returns an error indicating that the artifact driver does not support an operation
+func ErrNotSupported(driver, operation string) error {
+	return errors.Errorf(errors.CodeNotImplemented, "%s artifacts do not support %s", driver, operation)
+}
+
+// IsNotSupported returns whether or not the error was returned because a driver does not support an operation
+func IsNotSupported(err error) bool {
+	return errors.IsCode(errors.CodeNotImplemented, err)
+}
+
+// IsKeyInArtifact returns whether an object key is either the artifact key itself, or a key stored
+// below it when the artifact is a directory. Unlike a plain prefix match, "my-key" does not match "my-key-2".
+func IsKeyInArtifact(key, objKey string) bool {
+	key = strings.TrimSuffix(key, "/")
+	return objKey == key || strings.HasPrefix(objKey, key+"/")
+}
+
+// SizedReadCloser is a stream that knows its size up-front, allowing it to be served with a Content-Length
+type SizedReadCloser struct {
+	io.ReadCloser
+	size int64
+}
+
+// NewSizedReadCloser returns a stream of the given size
+func NewSizedReadCloser(rc io.ReadCloser, size int64) *SizedReadCloser {
+	return &SizedReadCloser{ReadCloser: rc, size: size}
+}
+
+// Size returns the size of the stream in bytes
+func (r *SizedReadCloser) Size() int64 {
+	return r.size
+}
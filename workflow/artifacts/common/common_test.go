@@ -0,0 +1,34 @@
+package common
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrNotSupported(t *testing.T) {
+	err := ErrNotSupported("HTTP", "delete")
+	assert.EqualError(t, err, "HTTP artifacts do not support delete")
+	assert.True(t, IsNotSupported(err))
+	assert.False(t, IsNotSupported(fmt.Errorf("HTTP artifacts do not support delete")))
+}
+
+func TestIsKeyInArtifact(t *testing.T) {
+	assert.True(t, IsKeyInArtifact("my-key", "my-key"))
+	assert.True(t, IsKeyInArtifact("my-key", "my-key/foo"))
+	assert.True(t, IsKeyInArtifact("my-key/", "my-key/foo/bar"))
+	assert.False(t, IsKeyInArtifact("my-key", "my-key-2"))
+	assert.False(t, IsKeyInArtifact("my-key", "other"))
+}
+
+func TestSizedReadCloser(t *testing.T) {
+	rc := NewSizedReadCloser(ioutil.NopCloser(strings.NewReader("my-data")), 7)
+	assert.Equal(t, int64(7), rc.Size())
+	data, err := ioutil.ReadAll(rc)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "my-data", string(data))
+	}
+}
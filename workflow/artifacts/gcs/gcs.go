@@ -21,6 +21,7 @@ import (
 
 	"github.com/simster7/argo/v2/errors"
 	wfv1 "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1"
+	"github.com/simster7/argo/v2/workflow/artifacts/common"
 )
 
 // ArtifactDriver is a driver for GCS
@@ -249,3 +250,91 @@ func uploadObject(client *storage.Client, bucket, key, localPath string) error {
 	}
 	return nil
 }
+
+// gcsReader closes the client along with the object reader
+type gcsReader struct {
+	*storage.Reader
+	client *storage.Client
+}
+
+func (r *gcsReader) Close() error {
+	defer func() { _ = r.client.Close() }()
+	return r.Reader.Close()
+}
+
+// OpenStream opens a single GCS object for reading
+func (g *ArtifactDriver) OpenStream(artifact *wfv1.Artifact) (io.ReadCloser, error) {
+	log.Infof("GCS OpenStream bucket: %s, key: %s", artifact.GCS.Bucket, artifact.GCS.Key)
+	client, err := g.newGCSClient()
+	if err != nil {
+		return nil, err
+	}
+	rc, err := client.Bucket(artifact.GCS.Bucket).Object(artifact.GCS.Key).NewReader(context.Background())
+	if err != nil {
+		_ = client.Close()
+		if err == storage.ErrObjectNotExist {
+			return nil, errors.New(errors.CodeNotFound, err.Error())
+		}
+		return nil, fmt.Errorf("new bucket reader: %v", err)
+	}
+	return &gcsReader{Reader: rc, client: client}, nil
+}
+
+// ListObjects lists the names of the GCS object, or of all objects below the key
+func (g *ArtifactDriver) ListObjects(artifact *wfv1.Artifact) ([]string, error) {
+	var keys []string
+	err := wait.ExponentialBackoff(wait.Backoff{Duration: time.Second * 2, Factor: 2.0, Steps: 5, Jitter: 0.1},
+		func() (bool, error) {
+			log.Infof("GCS ListObjects bucket: %s, key: %s", artifact.GCS.Bucket, artifact.GCS.Key)
+			client, err := g.newGCSClient()
+			if err != nil {
+				return false, err
+			}
+			defer client.Close()
+			keys, err = listArtifactObjects(client, artifact.GCS.Bucket, artifact.GCS.Key)
+			if err != nil {
+				return false, err
+			}
+			return true, nil
+		})
+	return keys, err
+}
+
+// list the object names of the key, excluding other objects that merely share its prefix
+func listArtifactObjects(client *storage.Client, bucket, key string) ([]string, error) {
+	objNames, err := listByPrefix(client, bucket, key, "")
+	if err != nil {
+		return nil, err
+	}
+	var keys []string
+	for _, objName := range objNames {
+		if common.IsKeyInArtifact(key, objName) {
+			keys = append(keys, objName)
+		}
+	}
+	return keys, nil
+}
+
+// Delete deletes the GCS object, or all objects below the key
+func (g *ArtifactDriver) Delete(artifact *wfv1.Artifact) error {
+	return wait.ExponentialBackoff(wait.Backoff{Duration: time.Second * 2, Factor: 2.0, Steps: 5, Jitter: 0.1},
+		func() (bool, error) {
+			log.Infof("GCS Delete bucket: %s, key: %s", artifact.GCS.Bucket, artifact.GCS.Key)
+			client, err := g.newGCSClient()
+			if err != nil {
+				return false, err
+			}
+			defer client.Close()
+			keys, err := listArtifactObjects(client, artifact.GCS.Bucket, artifact.GCS.Key)
+			if err != nil {
+				return false, err
+			}
+			for _, key := range keys {
+				err = client.Bucket(artifact.GCS.Bucket).Object(key).Delete(context.Background())
+				if err != nil && err != storage.ErrObjectNotExist {
+					return false, fmt.Errorf("delete %s: %v", key, err)
+				}
+			}
+			return true, nil
+		})
+}
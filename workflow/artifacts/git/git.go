@@ -2,6 +2,7 @@ package git
 
 import (
 	"errors"
+	"io"
 	"io/ioutil"
 	"os"
 	"os/exec"
@@ -17,6 +18,7 @@ import (
 	ssh2 "gopkg.in/src-d/go-git.v4/plumbing/transport/ssh"
 
 	wfv1 "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1"
+	"github.com/simster7/argo/v2/workflow/artifacts/common"
 )
 
 // GitArtifactDriver is the artifact driver for a git repo
@@ -95,6 +97,21 @@ func (g *GitArtifactDriver) Save(string, *wfv1.Artifact) error {
 	return errors.New("git output artifacts unsupported")
 }
 
+// OpenStream is unsupported for git artifacts, as a repository can only be cloned
+func (g *GitArtifactDriver) OpenStream(*wfv1.Artifact) (io.ReadCloser, error) {
+	return nil, common.ErrNotSupported("Git", "streaming")
+}
+
+// ListObjects is unsupported for git artifacts
+func (g *GitArtifactDriver) ListObjects(*wfv1.Artifact) ([]string, error) {
+	return nil, common.ErrNotSupported("Git", "listing")
+}
+
+// Delete is unsupported for git artifacts
+func (g *GitArtifactDriver) Delete(*wfv1.Artifact) error {
+	return common.ErrNotSupported("Git", "deletion")
+}
+
 func (g *GitArtifactDriver) Load(inputArtifact *wfv1.Artifact, path string) error {
 	closer, auth, env, err := g.auth()
 	if err != nil {
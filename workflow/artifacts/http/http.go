@@ -2,6 +2,8 @@ package http
 
 import (
 	"fmt"
+	"io"
+	"net/http"
 	"os/exec"
 	"strings"
 
@@ -9,6 +11,7 @@ import (
 
 	"github.com/simster7/argo/v2/errors"
 	wfv1 "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1"
+	"github.com/simster7/argo/v2/workflow/artifacts/common"
 )
 
 // HTTPArtifactDriver is the artifact driver for a HTTP URL
@@ -44,3 +47,38 @@ func (h *HTTPArtifactDriver) Load(inputArtifact *wfv1.Artifact, path string) err
 func (h *HTTPArtifactDriver) Save(string, *wfv1.Artifact) error {
 	return errors.Errorf(errors.CodeBadRequest, "HTTP output artifacts unsupported")
 }
+
+// OpenStream opens the HTTP URL for reading
+func (h *HTTPArtifactDriver) OpenStream(artifact *wfv1.Artifact) (io.ReadCloser, error) {
+	req, err := http.NewRequest(http.MethodGet, artifact.HTTP.URL, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range artifact.HTTP.Headers {
+		req.Header.Add(v.Name, v.Value)
+	}
+	res, err := (&http.Client{}).Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode == http.StatusNotFound {
+		_ = res.Body.Close()
+		return nil, errors.New(errors.CodeNotFound, res.Status)
+	}
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		_ = res.Body.Close()
+		return nil, errors.InternalErrorf("loading %s failed with reason:%s", artifact.HTTP.URL, res.Status)
+	}
+	if res.ContentLength >= 0 {
+		return common.NewSizedReadCloser(res.Body, res.ContentLength), nil
+	}
+	return res.Body, nil
+}
+
+func (h *HTTPArtifactDriver) ListObjects(*wfv1.Artifact) ([]string, error) {
+	return nil, common.ErrNotSupported("HTTP", "listing")
+}
+
+func (h *HTTPArtifactDriver) Delete(*wfv1.Artifact) error {
+	return common.ErrNotSupported("HTTP", "deletion")
+}
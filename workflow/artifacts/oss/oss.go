@@ -1,6 +1,7 @@
 package oss
 
 import (
+	"io"
 	"time"
 
 	log "github.com/sirupsen/logrus"
@@ -8,7 +9,9 @@ import (
 
 	"github.com/aliyun/aliyun-oss-go-sdk/oss"
 
+	"github.com/simster7/argo/v2/errors"
 	wfv1 "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1"
+	"github.com/simster7/argo/v2/workflow/artifacts/common"
 )
 
 // OSSArtifactDriver is a driver for OSS
@@ -75,3 +78,98 @@ func (ossDriver *OSSArtifactDriver) Save(path string, outputArtifact *wfv1.Artif
 		})
 	return err
 }
+
+// OpenStream opens a single OSS object for reading
+func (ossDriver *OSSArtifactDriver) OpenStream(artifact *wfv1.Artifact) (io.ReadCloser, error) {
+	log.Infof("OSS OpenStream bucket: %s, key: %s", artifact.OSS.Bucket, artifact.OSS.Key)
+	osscli, err := ossDriver.newOSSClient()
+	if err != nil {
+		return nil, err
+	}
+	bucket, err := osscli.Bucket(artifact.OSS.Bucket)
+	if err != nil {
+		return nil, err
+	}
+	rc, err := bucket.GetObject(artifact.OSS.Key)
+	if err != nil {
+		if isNoSuchKeyErr(err) {
+			return nil, errors.New(errors.CodeNotFound, err.Error())
+		}
+		return nil, err
+	}
+	return rc, nil
+}
+
+func isNoSuchKeyErr(err error) bool {
+	serviceErr, ok := err.(oss.ServiceError)
+	return ok && serviceErr.Code == "NoSuchKey"
+}
+
+// ListObjects lists the keys of the OSS object, or of all objects below the key
+func (ossDriver *OSSArtifactDriver) ListObjects(artifact *wfv1.Artifact) ([]string, error) {
+	var keys []string
+	err := wait.ExponentialBackoff(wait.Backoff{Duration: time.Second * 2, Factor: 2.0, Steps: 5, Jitter: 0.1},
+		func() (bool, error) {
+			log.Infof("OSS ListObjects bucket: %s, key: %s", artifact.OSS.Bucket, artifact.OSS.Key)
+			osscli, err := ossDriver.newOSSClient()
+			if err != nil {
+				return false, err
+			}
+			bucket, err := osscli.Bucket(artifact.OSS.Bucket)
+			if err != nil {
+				return false, err
+			}
+			keys, err = listObjects(bucket, artifact.OSS.Key)
+			if err != nil {
+				return false, err
+			}
+			return true, nil
+		})
+	return keys, err
+}
+
+func listObjects(bucket *oss.Bucket, key string) ([]string, error) {
+	var keys []string
+	marker := oss.Marker("")
+	for {
+		result, err := bucket.ListObjects(oss.Prefix(key), marker)
+		if err != nil {
+			return nil, err
+		}
+		for _, obj := range result.Objects {
+			if common.IsKeyInArtifact(key, obj.Key) {
+				keys = append(keys, obj.Key)
+			}
+		}
+		if !result.IsTruncated {
+			return keys, nil
+		}
+		marker = oss.Marker(result.NextMarker)
+	}
+}
+
+// Delete deletes the OSS object, or all objects below the key
+func (ossDriver *OSSArtifactDriver) Delete(artifact *wfv1.Artifact) error {
+	return wait.ExponentialBackoff(wait.Backoff{Duration: time.Second * 2, Factor: 2.0, Steps: 5, Jitter: 0.1},
+		func() (bool, error) {
+			log.Infof("OSS Delete bucket: %s, key: %s", artifact.OSS.Bucket, artifact.OSS.Key)
+			osscli, err := ossDriver.newOSSClient()
+			if err != nil {
+				return false, err
+			}
+			bucket, err := osscli.Bucket(artifact.OSS.Bucket)
+			if err != nil {
+				return false, err
+			}
+			keys, err := listObjects(bucket, artifact.OSS.Key)
+			if err != nil {
+				return false, err
+			}
+			for _, key := range keys {
+				if err := bucket.DeleteObject(key); err != nil {
+					return false, err
+				}
+			}
+			return true, nil
+		})
+}
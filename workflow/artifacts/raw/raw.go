@@ -1,10 +1,14 @@
 package raw
 
 import (
+	"io"
+	"io/ioutil"
 	"os"
+	"strings"
 
 	"github.com/simster7/argo/v2/errors"
 	wfv1 "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1"
+	"github.com/simster7/argo/v2/workflow/artifacts/common"
 )
 
 type RawArtifactDriver struct {
@@ -28,3 +32,18 @@ func (a *RawArtifactDriver) Load(artifact *wfv1.Artifact, path string) error {
 func (g *RawArtifactDriver) Save(string, *wfv1.Artifact) error {
 	return errors.Errorf(errors.CodeBadRequest, "Raw output artifacts unsupported")
 }
+
+// OpenStream returns a reader of the raw content
+func (a *RawArtifactDriver) OpenStream(artifact *wfv1.Artifact) (io.ReadCloser, error) {
+	return common.NewSizedReadCloser(ioutil.NopCloser(strings.NewReader(artifact.Raw.Data)), int64(len(artifact.Raw.Data))), nil
+}
+
+// ListObjects is unsupported for raw artifacts
+func (a *RawArtifactDriver) ListObjects(*wfv1.Artifact) ([]string, error) {
+	return nil, common.ErrNotSupported("Raw", "listing")
+}
+
+// Delete is unsupported for raw artifacts
+func (a *RawArtifactDriver) Delete(*wfv1.Artifact) error {
+	return common.ErrNotSupported("Raw", "deletion")
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	wfv1 "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1"
+	"github.com/simster7/argo/v2/workflow/artifacts/common"
 	"github.com/simster7/argo/v2/workflow/artifacts/raw"
 )
 
@@ -37,3 +38,25 @@ func TestLoad(t *testing.T) {
 	assert.Equal(t, content, string(dat))
 
 }
+
+func TestOpenStream(t *testing.T) {
+	art := &wfv1.Artifact{}
+	art.Raw = &wfv1.RawArtifact{
+		Data: "my-data",
+	}
+	driver := &raw.RawArtifactDriver{}
+	rc, err := driver.OpenStream(art)
+	assert.NoError(t, err)
+	defer rc.Close()
+	dat, err := ioutil.ReadAll(rc)
+	assert.NoError(t, err)
+	assert.Equal(t, "my-data", string(dat))
+}
+
+func TestUnsupported(t *testing.T) {
+	driver := &raw.RawArtifactDriver{}
+	_, err := driver.ListObjects(&wfv1.Artifact{})
+	assert.True(t, common.IsNotSupported(err))
+	err = driver.Delete(&wfv1.Artifact{})
+	assert.True(t, common.IsNotSupported(err))
+}
@@ -2,6 +2,7 @@ package s3
 
 import (
 	"context"
+	"io"
 	"os"
 	"time"
 
@@ -15,6 +16,7 @@ import (
 
 	"github.com/simster7/argo/v2/errors"
 	wfv1 "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1"
+	artifactscommon "github.com/simster7/argo/v2/workflow/artifacts/common"
 	"github.com/simster7/argo/v2/workflow/common"
 )
 
@@ -30,9 +32,8 @@ type S3ArtifactDriver struct {
 	Context     context.Context
 }
 
-// newMinioClient instantiates a new minio client object.
-func (s3Driver *S3ArtifactDriver) newS3Client(ctx context.Context) (argos3.S3Client, error) {
-	opts := argos3.S3ClientOpts{
+func (s3Driver *S3ArtifactDriver) clientOpts() argos3.S3ClientOpts {
+	return argos3.S3ClientOpts{
 		Endpoint:    s3Driver.Endpoint,
 		Region:      s3Driver.Region,
 		Secure:      s3Driver.Secure,
@@ -42,7 +43,29 @@ func (s3Driver *S3ArtifactDriver) newS3Client(ctx context.Context) (argos3.S3Cli
 		Trace:       os.Getenv(common.EnvVarArgoTrace) == "1",
 		UseSDKCreds: s3Driver.UseSDKCreds,
 	}
-	return argos3.NewS3Client(ctx, opts)
+}
+
+// newS3Client instantiates a new S3 client object.
+func (s3Driver *S3ArtifactDriver) newS3Client(ctx context.Context) (argos3.S3Client, error) {
+	return argos3.NewS3Client(ctx, s3Driver.clientOpts())
+}
+
+// newMinioClient instantiates a new minio client object. This is used for the operations (listing, streaming and
+// deleting) which argos3.S3Client does not expose.
+func (s3Driver *S3ArtifactDriver) newMinioClient() (*minio.Client, error) {
+	opts := s3Driver.clientOpts()
+	credentials, err := argos3.GetCredentials(opts)
+	if err != nil {
+		return nil, err
+	}
+	minioClient, err := minio.New(opts.Endpoint, &minio.Options{Creds: credentials, Secure: opts.Secure, Region: opts.Region})
+	if err != nil {
+		return nil, err
+	}
+	if opts.Trace {
+		minioClient.TraceOn(log.StandardLogger().Out)
+	}
+	return minioClient, nil
 }
 
 // Load downloads artifacts from S3 compliant storage
@@ -133,3 +156,87 @@ func (s3Driver *S3ArtifactDriver) Save(path string, outputArtifact *wfv1.Artifac
 		})
 	return err
 }
+
+// OpenStream opens a single S3 object for reading
+func (s3Driver *S3ArtifactDriver) OpenStream(artifact *wfv1.Artifact) (io.ReadCloser, error) {
+	log.Infof("S3 OpenStream bucket: %s, key: %s", artifact.S3.Bucket, artifact.S3.Key)
+	minioClient, err := s3Driver.newMinioClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx := context.Background()
+	obj, err := minioClient.GetObject(ctx, artifact.S3.Bucket, artifact.S3.Key, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, err
+	}
+	// GetObject is lazy, so we stat the object to find out whether or not it actually exists
+	if _, err := obj.Stat(); err != nil {
+		_ = obj.Close()
+		if argos3.IsS3ErrCode(err, "NoSuchKey") {
+			return nil, errors.New(errors.CodeNotFound, err.Error())
+		}
+		return nil, err
+	}
+	return obj, nil
+}
+
+// ListObjects lists the keys of the S3 object, or of all objects in the S3 "directory"
+func (s3Driver *S3ArtifactDriver) ListObjects(artifact *wfv1.Artifact) ([]string, error) {
+	var keys []string
+	err := wait.ExponentialBackoff(wait.Backoff{Duration: time.Second * 2, Factor: 2.0, Steps: 5, Jitter: 0.1},
+		func() (bool, error) {
+			log.Infof("S3 ListObjects bucket: %s, key: %s", artifact.S3.Bucket, artifact.S3.Key)
+			minioClient, err := s3Driver.newMinioClient()
+			if err != nil {
+				log.Warnf("Failed to create new S3 client: %v", err)
+				return false, nil
+			}
+			keys, err = listObjects(minioClient, artifact.S3.Bucket, artifact.S3.Key)
+			if err != nil {
+				log.Warnf("Failed to list objects: %v", err)
+				return false, nil
+			}
+			return true, nil
+		})
+	return keys, err
+}
+
+func listObjects(minioClient *minio.Client, bucket, key string) ([]string, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var keys []string
+	for obj := range minioClient.ListObjects(ctx, bucket, minio.ListObjectsOptions{Prefix: key, Recursive: true}) {
+		if obj.Err != nil {
+			return nil, obj.Err
+		}
+		if artifactscommon.IsKeyInArtifact(key, obj.Key) {
+			keys = append(keys, obj.Key)
+		}
+	}
+	return keys, nil
+}
+
+// Delete deletes the S3 object, or all objects in the S3 "directory"
+func (s3Driver *S3ArtifactDriver) Delete(artifact *wfv1.Artifact) error {
+	return wait.ExponentialBackoff(wait.Backoff{Duration: time.Second * 2, Factor: 2.0, Steps: 5, Jitter: 0.1},
+		func() (bool, error) {
+			log.Infof("S3 Delete bucket: %s, key: %s", artifact.S3.Bucket, artifact.S3.Key)
+			minioClient, err := s3Driver.newMinioClient()
+			if err != nil {
+				log.Warnf("Failed to create new S3 client: %v", err)
+				return false, nil
+			}
+			keys, err := listObjects(minioClient, artifact.S3.Bucket, artifact.S3.Key)
+			if err != nil {
+				log.Warnf("Failed to list objects: %v", err)
+				return false, nil
+			}
+			for _, key := range keys {
+				if err := minioClient.RemoveObject(context.Background(), artifact.S3.Bucket, key, minio.RemoveObjectOptions{}); err != nil {
+					log.Warnf("Failed to delete object %s: %v", key, err)
+					return false, nil
+				}
+			}
+			return true, nil
+		})
+}
@@ -46,6 +46,12 @@ const (
 	AnnotationKeyTemplate = workflow.WorkflowFullName + "/template"
 	// AnnotationKeyOutputs is the pod metadata annotation key containing the container outputs
 	AnnotationKeyOutputs = workflow.WorkflowFullName + "/outputs"
+	// AnnotationKeyCronWorkflowScheduledTime is the time a backfilled Workflow was scheduled to run at
+	AnnotationKeyCronWorkflowScheduledTime = workflow.WorkflowFullName + "/scheduled-time"
+	// AnnotationKeyBackfillParallelism is the number of Workflows of a backfill that may run at the same time
+	AnnotationKeyBackfillParallelism = workflow.WorkflowFullName + "/backfill-parallelism"
+	// AnnotationKeyBackfillPending is applied to backfilled Workflows that are suspended until they may run
+	AnnotationKeyBackfillPending = workflow.WorkflowFullName + "/backfill-pending"
 	// AnnotationKeyExecutionControl is the pod metadata annotation key containing execution control parameters
 	// set by the controller and obeyed by the executor. For example, the controller will use this annotation to
 	// signal the executors of daemoned containers that it should terminate.
@@ -75,15 +81,28 @@ const (
 	LabelKeyPreviousWorkflowName = workflow.WorkflowFullName + "/resubmitted-from-workflow"
 	// LabelKeyCronWorkflow is a label applied to Workflows that are started by a CronWorkflow
 	LabelKeyCronWorkflow = workflow.WorkflowFullName + "/cron-workflow"
+	// LabelKeyCronWorkflowBackfill is a label applied to Workflows that backfill the runs of a CronWorkflow, its value
+	// identifies the backfill
+	LabelKeyCronWorkflowBackfill = workflow.WorkflowFullName + "/cron-workflow-backfill"
 	// LabelKeyWorkflowTemplate is a label applied to Workflows that are submitted from Workflowtemplate
 	LabelKeyWorkflowTemplate = workflow.WorkflowFullName + "/workflow-template"
 	// LabelKeyWorkflowEventBinding is a label applied to Workflows that are submitted from a WorkflowEventBinding
 	LabelKeyWorkflowEventBinding = workflow.WorkflowFullName + "/workflow-event-binding"
 	// LabelKeyWorkflowTemplate is a label applied to Workflows that are submitted from ClusterWorkflowtemplate
 	LabelKeyClusterWorkflowTemplate = workflow.WorkflowFullName + "/cluster-workflow-template"
+	// LabelKeyParentWorkflow is a label applied to the child workflows of workflow templates, its value is the name of
+	// the parent workflow
+	LabelKeyParentWorkflow = workflow.WorkflowFullName + "/parent-workflow"
+	// LabelKeyCreatedByWorkflowExport is a label applied to the config maps that the controller creates to export output
+	// parameters to, it only updates the config maps that have it
+	LabelKeyCreatedByWorkflowExport = workflow.WorkflowFullName + "/created-by-workflow-export"
 	// LabelKeyOnExit is a label applied to Pods that are run from onExit nodes, so that they are not shut down when stopping a Workflow
 	LabelKeyOnExit = workflow.WorkflowFullName + "/on-exit"
 
+	// FinalizerArtifactGC is the finalizer added to workflows whose output artifacts need to be garbage collected.
+	// It is removed once all artifacts have been deleted (or we have given up trying).
+	FinalizerArtifactGC = workflow.WorkflowFullName + "/artifact-gc"
+
 	// ExecutorArtifactBaseDir is the base directory in the init container in which artifacts will be copied to.
 	// Each artifact will be named according to its input name (e.g: /argo/inputs/artifacts/CODE)
 	ExecutorArtifactBaseDir = "/argo/inputs/artifacts"
@@ -100,6 +119,11 @@ const (
 	ExecutorScriptSourcePath = "/argo/staging/script"
 	// ExecutorResourceManifestPath is the path which init will write the a manifest file to for resource templates
 	ExecutorResourceManifestPath = "/tmp/manifest.yaml"
+	// ExecutorVarRunArgoDir is the path of the emptydir shared by the init container and the containers of a container
+	// set. Init copies argoexec into it, and each container runs its command through it and records its exit code in it.
+	ExecutorVarRunArgoDir = "/var/run/argo"
+	// VarRunArgoVolumeName is the name of the volume mounted at ExecutorVarRunArgoDir
+	VarRunArgoVolumeName = "var-run-argo"
 
 	// Various environment variables containing pod information exposed to the executor container(s)
 
@@ -115,6 +139,13 @@ const (
 	EnvVarKubeletInsecure = "ARGO_KUBELET_INSECURE"
 	// EnvVarArgoTrace is used enable tracing statements in Argo components
 	EnvVarArgoTrace = "ARGO_TRACE"
+	// EnvVarContainerName is the name of the container of a container set that `argoexec run` runs the command of
+	EnvVarContainerName = "ARGO_CONTAINER_NAME"
+	// EnvVarDependencies is the comma-separated names of the containers of the set that must succeed before `argoexec run`
+	// runs the command
+	EnvVarDependencies = "ARGO_DEPENDENCIES"
+	// EnvVarTemplate is the template of the pod, which the emissary executor's `argoexec run` saves the outputs of
+	EnvVarTemplate = "ARGO_TEMPLATE"
 
 	// ContainerRuntimeExecutorDocker to use docker as container runtime executor
 	ContainerRuntimeExecutorDocker = "docker"
@@ -128,6 +159,10 @@ const (
 	// ContainerRuntimeExecutorPNS indicates to use process namespace sharing as the container runtime executor
 	ContainerRuntimeExecutorPNS = "pns"
 
+	// ContainerRuntimeExecutorEmissary indicates to run the commands of the containers through argoexec, which records
+	// their outputs in a shared volume, as the container runtime executor
+	ContainerRuntimeExecutorEmissary = "emissary"
+
 	// Variables that are added to the scope during template execution and can be referenced using {{}} syntax
 
 	// GlobalVarWorkflowName is a global workflow variable referencing the workflow's metadata.name field
@@ -162,6 +197,21 @@ const (
 	LocalVarResourcesDuration = "resourcesDuration"
 	// LocalVarExitCode is a step level variable (currently only available in metric emission) that tracks the step's exit code
 	LocalVarExitCode = "exitCode"
+	// LocalVarFailureReason is a step level variable (currently only available in metric emission) that tracks the cause of the step failing
+	LocalVarFailureReason = "failureReason"
+	// LocalVarRetriesLastExitCode is a variable of a retry strategy's expression that references the exit code of the last retry
+	LocalVarRetriesLastExitCode = "lastRetry.exitCode"
+	// LocalVarRetriesLastStatus is a variable of a retry strategy's expression that references the phase of the last retry
+	LocalVarRetriesLastStatus = "lastRetry.status"
+	// LocalVarRetriesLastDuration is a variable of a retry strategy's expression that references the duration of the last retry in seconds
+	LocalVarRetriesLastDuration = "lastRetry.duration"
+	// LocalVarRetriesLastMessage is a variable of a retry strategy's expression that references the message of the last retry
+	LocalVarRetriesLastMessage = "lastRetry.message"
+	// LocalVarLoopIndex is a variable of a looped step or task that references the index of the current iteration
+	LocalVarLoopIndex = "loop.index"
+	// LocalVarLoopOutputsPrefix is the prefix of the variables of a looped step or task that reference the outputs of
+	// the previous iteration, e.g. `loop.outputs.result`
+	LocalVarLoopOutputsPrefix = "loop.outputs"
 
 	KubeConfigDefaultMountPath    = "/kube/config"
 	KubeConfigDefaultVolumeName   = "kubeconfig"
@@ -171,7 +221,7 @@ const (
 )
 
 // GlobalVarWorkflowRootTags is a list of root tags in workflow which could be used for variable reference
-var GlobalVarValidWorkflowVariablePrefix = []string{"item.", "steps.", "inputs.", "outputs.", "pod.", "workflow.", "tasks."}
+var GlobalVarValidWorkflowVariablePrefix = []string{"item.", "steps.", "inputs.", "outputs.", "pod.", "workflow.", "tasks.", "loop."}
 
 // ExecutionControl contains execution control parameters for executor to decide how to execute the container
 type ExecutionControl struct {
@@ -0,0 +1,41 @@
+package common
+
+import (
+	wfv1 "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1"
+)
+
+// ConfigMapValueFunc returns the value of the key of the config map that the value of the parameter is from
+type ConfigMapValueFunc func(param wfv1.Parameter) (string, error)
+
+// hasConfigMapKeyRef returns whether the value of the parameter is from a config map, and has not been resolved yet
+func hasConfigMapKeyRef(param *wfv1.Parameter) bool {
+	return param != nil && param.Value == nil && param.ValueFrom != nil && param.ValueFrom.ConfigMapKeyRef != nil
+}
+
+// SetConfigMapParameterValues returns copies of the template and the arguments, in which the parameters whose values are
+// from config maps have them. The value of an input parameter is its default, which is only resolved if the arguments do
+// not supply its value.
+func SetConfigMapParameterValues(tmpl *wfv1.Template, args wfv1.Arguments, getValue ConfigMapValueFunc) (*wfv1.Template, wfv1.Arguments, error) {
+	newTmpl := tmpl.DeepCopy()
+	newArgs := *args.DeepCopy()
+	for i, param := range newArgs.Parameters {
+		if hasConfigMapKeyRef(&param) {
+			value, err := getValue(param)
+			if err != nil {
+				return nil, newArgs, err
+			}
+			newArgs.Parameters[i].Value = wfv1.AnyStringPtr(value)
+		}
+	}
+	for i, param := range newTmpl.Inputs.Parameters {
+		argParam := newArgs.GetParameterByName(param.Name)
+		if hasConfigMapKeyRef(&param) && (argParam == nil || argParam.Value == nil) {
+			value, err := getValue(param)
+			if err != nil {
+				return nil, newArgs, err
+			}
+			newTmpl.Inputs.Parameters[i].Default = wfv1.AnyStringPtr(value)
+		}
+	}
+	return newTmpl, newArgs, nil
+}
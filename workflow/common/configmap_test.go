@@ -0,0 +1,46 @@
+package common
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+
+	wfv1 "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1"
+)
+
+func TestSetConfigMapParameterValues(t *testing.T) {
+	fromConfigMap := func(key string) *wfv1.ValueFrom {
+		return &wfv1.ValueFrom{ConfigMapKeyRef: &corev1.ConfigMapKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "settings"}, Key: key}}
+	}
+	tmpl := &wfv1.Template{
+		Name: "main",
+		Inputs: wfv1.Inputs{Parameters: []wfv1.Parameter{
+			{Name: "region", ValueFrom: fromConfigMap("region")},
+			{Name: "tier", ValueFrom: fromConfigMap("missing")},
+		}},
+	}
+	args := wfv1.Arguments{Parameters: []wfv1.Parameter{
+		{Name: "tier", Value: wfv1.AnyStringPtr("premium")},
+		{Name: "replicas", ValueFrom: fromConfigMap("replicas")},
+	}}
+	data := map[string]string{"region": "eu-west-1", "replicas": "3"}
+	getValue := func(param wfv1.Parameter) (string, error) {
+		if value, ok := data[param.ValueFrom.ConfigMapKeyRef.Key]; ok {
+			return value, nil
+		}
+		return "", fmt.Errorf("key '%s' not found", param.ValueFrom.ConfigMapKeyRef.Key)
+	}
+	newTmpl, newArgs, err := SetConfigMapParameterValues(tmpl, args, getValue)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "eu-west-1", newTmpl.Inputs.Parameters[0].Default.String())
+		assert.Nil(t, newTmpl.Inputs.Parameters[1].Default, "the argument supplies the value")
+		assert.Equal(t, "3", newArgs.Parameters[1].Value.String())
+		assert.Nil(t, tmpl.Inputs.Parameters[0].Default, "the template is not modified")
+		assert.Nil(t, args.Parameters[1].Value, "the arguments are not modified")
+	}
+	args.Parameters = args.Parameters[1:]
+	_, _, err = SetConfigMapParameterValues(tmpl, args, getValue)
+	assert.EqualError(t, err, "key 'missing' not found")
+}
@@ -0,0 +1,34 @@
+package common
+
+import (
+	wfv1 "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1"
+)
+
+// DetachInlineTemplates returns a copy of the template without the inline templates of its steps and tasks, so that it
+// can be substituted without them, and a function that attaches them to the substituted template. Like a template that is
+// referred to by name, an inline template is only substituted with its own inputs, when it is executed.
+func DetachInlineTemplates(tmpl *wfv1.Template) (*wfv1.Template, func(*wfv1.Template)) {
+	newTmpl := tmpl.DeepCopy()
+	for i := range newTmpl.Steps {
+		for j := range newTmpl.Steps[i].Steps {
+			newTmpl.Steps[i].Steps[j].Inline = nil
+		}
+	}
+	if newTmpl.DAG != nil {
+		for i := range newTmpl.DAG.Tasks {
+			newTmpl.DAG.Tasks[i].Inline = nil
+		}
+	}
+	return newTmpl, func(substitutedTmpl *wfv1.Template) {
+		for i := range tmpl.Steps {
+			for j := range tmpl.Steps[i].Steps {
+				substitutedTmpl.Steps[i].Steps[j].Inline = tmpl.Steps[i].Steps[j].Inline.DeepCopy()
+			}
+		}
+		if tmpl.DAG != nil {
+			for i := range tmpl.DAG.Tasks {
+				substitutedTmpl.DAG.Tasks[i].Inline = tmpl.DAG.Tasks[i].Inline.DeepCopy()
+			}
+		}
+	}
+}
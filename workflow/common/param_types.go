@@ -0,0 +1,95 @@
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/xeipuuv/gojsonschema"
+
+	wfv1 "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1"
+)
+
+var parameterTypes = []wfv1.ParameterType{
+	wfv1.ParameterTypeString,
+	wfv1.ParameterTypeInt,
+	wfv1.ParameterTypeBool,
+	wfv1.ParameterTypeNumber,
+	wfv1.ParameterTypeJSON,
+}
+
+// ValidateParameterDeclaration validates the type and the schema of the parameter
+func ValidateParameterDeclaration(param wfv1.Parameter) error {
+	if param.Type != "" {
+		valid := false
+		var types []string
+		for _, t := range parameterTypes {
+			valid = valid || param.Type == t
+			types = append(types, string(t))
+		}
+		if !valid {
+			return fmt.Errorf("type '%s' is invalid, it must be one of: %s", param.Type, strings.Join(types, ", "))
+		}
+	}
+	if param.Schema != nil {
+		if _, err := gojsonschema.NewSchema(gojsonschema.NewBytesLoader(param.Schema.Value)); err != nil {
+			return fmt.Errorf("schema is invalid: %v", err)
+		}
+	}
+	return nil
+}
+
+// ValidateParameterValue validates the value against the type and the schema of the parameter. A value that refers to
+// a variable, or that is a placeholder, is not validated, as it is not known until runtime. An error names the
+// parameter rather than echoing the value, which may be sensitive.
+func ValidateParameterValue(param wfv1.Parameter, value string) error {
+	if strings.Contains(value, "{{") || strings.HasPrefix(value, placeholderPrefix) {
+		return nil
+	}
+	valid := true
+	switch param.Type {
+	case wfv1.ParameterTypeInt:
+		_, err := strconv.ParseInt(value, 10, 64)
+		valid = err == nil
+	case wfv1.ParameterTypeBool:
+		valid = value == "true" || value == "false"
+	case wfv1.ParameterTypeNumber:
+		_, err := strconv.ParseFloat(value, 64)
+		valid = err == nil
+	case wfv1.ParameterTypeJSON:
+		valid = json.Valid([]byte(value))
+	}
+	if !valid {
+		return fmt.Errorf("parameter '%s' is not a valid %s", param.Name, param.Type)
+	}
+	if param.Schema == nil {
+		return nil
+	}
+	schema, err := gojsonschema.NewSchema(gojsonschema.NewBytesLoader(param.Schema.Value))
+	if err != nil {
+		return fmt.Errorf("schema is invalid: %v", err)
+	}
+	result, err := schema.Validate(gojsonschema.NewBytesLoader(parameterDocument(param.Type, value)))
+	if err != nil {
+		return fmt.Errorf("parameter '%s' could not be validated against the schema: %v", param.Name, err)
+	}
+	if !result.Valid() {
+		var descriptions []string
+		for _, resultErr := range result.Errors() {
+			descriptions = append(descriptions, resultErr.String())
+		}
+		return fmt.Errorf("parameter '%s' is not valid against the schema: %s", param.Name, strings.Join(descriptions, "; "))
+	}
+	return nil
+}
+
+// parameterDocument returns the value as the JSON document that is validated against the schema. A string parameter,
+// or a value that is not valid JSON, is a JSON string.
+func parameterDocument(paramType wfv1.ParameterType, value string) []byte {
+	if paramType != wfv1.ParameterTypeString && json.Valid([]byte(value)) {
+		return []byte(value)
+	}
+	data, _ := json.Marshal(value)
+	return data
+}
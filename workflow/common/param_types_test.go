@@ -0,0 +1,58 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	wfv1 "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1"
+)
+
+func schema(s string) *wfv1.Item {
+	item, err := wfv1.ParseItem(s)
+	if err != nil {
+		panic(err)
+	}
+	return &item
+}
+
+func TestValidateParameterDeclaration(t *testing.T) {
+	assert.NoError(t, ValidateParameterDeclaration(wfv1.Parameter{Name: "x", Type: wfv1.ParameterTypeInt, Schema: schema(`{"minimum": 1}`)}))
+	assert.EqualError(t, ValidateParameterDeclaration(wfv1.Parameter{Name: "x", Type: "float"}), "type 'float' is invalid, it must be one of: string, int, bool, number, json")
+	err := ValidateParameterDeclaration(wfv1.Parameter{Name: "x", Schema: schema(`{"type": "unknown"}`)})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "schema is invalid")
+	}
+}
+
+func TestValidateParameterValue(t *testing.T) {
+	for _, tt := range []struct {
+		param wfv1.Parameter
+		value string
+		err   string
+	}{
+		{wfv1.Parameter{Name: "p", Type: wfv1.ParameterTypeString}, "1", ""},
+		{wfv1.Parameter{Name: "p", Type: wfv1.ParameterTypeInt}, "-1", ""},
+		{wfv1.Parameter{Name: "p", Type: wfv1.ParameterTypeInt}, "1.5", "parameter 'p' is not a valid int"},
+		{wfv1.Parameter{Name: "p", Type: wfv1.ParameterTypeInt}, "{{workflow.parameters.x}}", ""},
+		{wfv1.Parameter{Name: "p", Type: wfv1.ParameterTypeInt}, "placeholder-1", ""},
+		{wfv1.Parameter{Name: "p", Type: wfv1.ParameterTypeBool}, "true", ""},
+		{wfv1.Parameter{Name: "p", Type: wfv1.ParameterTypeBool}, "yes", "parameter 'p' is not a valid bool"},
+		{wfv1.Parameter{Name: "p", Type: wfv1.ParameterTypeNumber}, "1.5", ""},
+		{wfv1.Parameter{Name: "p", Type: wfv1.ParameterTypeNumber}, "one", "parameter 'p' is not a valid number"},
+		{wfv1.Parameter{Name: "p", Type: wfv1.ParameterTypeJSON}, `{"a": 1}`, ""},
+		{wfv1.Parameter{Name: "p", Type: wfv1.ParameterTypeJSON}, `{"a": 1`, "parameter 'p' is not a valid json"},
+		{wfv1.Parameter{Name: "p", Type: wfv1.ParameterTypeInt, Schema: schema(`{"minimum": 1}`)}, "1", ""},
+		{wfv1.Parameter{Name: "p", Type: wfv1.ParameterTypeInt, Schema: schema(`{"minimum": 1}`)}, "0", "parameter 'p' is not valid against the schema: (root): Must be greater than or equal to 1"},
+		{wfv1.Parameter{Name: "p", Type: wfv1.ParameterTypeString, Schema: schema(`{"type": "string"}`)}, "1", ""},
+		{wfv1.Parameter{Name: "p", Schema: schema(`{"enum": ["a", "b"]}`)}, "a", ""},
+		{wfv1.Parameter{Name: "p", Schema: schema(`{"type": "object", "required": ["a"]}`)}, `{"b": 1}`, "parameter 'p' is not valid against the schema: (root): a is required"},
+	} {
+		err := ValidateParameterValue(tt.param, tt.value)
+		if tt.err == "" {
+			assert.NoError(t, err, tt.value)
+		} else {
+			assert.EqualError(t, err, tt.err, tt.value)
+		}
+	}
+}
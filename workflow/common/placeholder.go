@@ -5,6 +5,9 @@ import (
 	"strings"
 )
 
+// placeholderPrefix is the prefix of all placeholders
+const placeholderPrefix = "placeholder-"
+
 // placeholderGenerator is to generate dynamically-generated placeholder strings.
 type placeholderGenerator struct {
 	index int
@@ -17,11 +20,11 @@ func NewPlaceholderGenerator() *placeholderGenerator {
 
 // NextPlaceholder returns an arbitrary string to perform mock substitution of variables
 func (p *placeholderGenerator) NextPlaceholder() string {
-	s := fmt.Sprintf("placeholder-%d", p.index)
+	s := fmt.Sprintf("%s%d", placeholderPrefix, p.index)
 	p.index = p.index + 1
 	return s
 }
 
 func (p *placeholderGenerator) IsPlaceholder(s string) bool {
-	return strings.HasPrefix(s, "placeholder-")
+	return strings.HasPrefix(s, placeholderPrefix)
 }
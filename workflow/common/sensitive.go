@@ -0,0 +1,93 @@
+package common
+
+import (
+	"regexp"
+	"strings"
+
+	apiv1 "k8s.io/api/core/v1"
+
+	wfv1 "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1"
+)
+
+const (
+	// envVarInputParameterPrefix prefixes the environment variables of the sensitive input parameters of a pod
+	envVarInputParameterPrefix = "ARGO_PARAMETER_"
+	// envVarWorkflowParameterPrefix prefixes the environment variables of the sensitive workflow parameters
+	envVarWorkflowParameterPrefix = "ARGO_WORKFLOW_PARAMETER_"
+)
+
+var (
+	envVarNameReplacer   = regexp.MustCompile(`[^A-Z0-9_]`)
+	sensitiveReferenceRE = regexp.MustCompile(`\$\(((` + envVarInputParameterPrefix + `|` + envVarWorkflowParameterPrefix + `)[A-Z0-9_]+)\)`)
+)
+
+func envVarName(prefix, name string) string {
+	return prefix + envVarNameReplacer.ReplaceAllString(strings.ToUpper(name), "_")
+}
+
+// envVarReference returns a reference to the environment variable, which Kubernetes expands in the command, args and
+// environment of a container, e.g. `$(ARGO_PARAMETER_TOKEN)`
+func envVarReference(name string) string {
+	return "$(" + name + ")"
+}
+
+// InputParameterValue returns the value that `{{inputs.parameters.XXX}}` is substituted with. The value of a sensitive
+// parameter is never substituted, rather it is a reference to the environment variable of the pod that has its value.
+func InputParameterValue(param wfv1.Parameter) string {
+	if param.IsSensitive() {
+		return envVarReference(envVarName(envVarInputParameterPrefix, param.Name))
+	}
+	return param.Value.String()
+}
+
+// WorkflowParameterValue returns the value that `{{workflow.parameters.XXX}}` is substituted with, which for a sensitive
+// parameter is a reference to the environment variable of the pod that has its value
+func WorkflowParameterValue(param wfv1.Parameter) string {
+	if param.IsSensitive() {
+		return envVarReference(envVarName(envVarWorkflowParameterPrefix, param.Name))
+	}
+	return param.Value.String()
+}
+
+// SensitiveParameterEnvVars returns the environment variables of the sensitive parameters that the template uses: its
+// sensitive inputs, and the sensitive workflow parameters that it refers to. The workflow parameters come first, so
+// that the value of an input can refer to them.
+func SensitiveParameterEnvVars(tmpl *wfv1.Template, tmplJSON string, workflowParams []wfv1.Parameter) []apiv1.EnvVar {
+	var env []apiv1.EnvVar
+	for _, param := range workflowParams {
+		if !param.IsSensitive() || !strings.Contains(tmplJSON, WorkflowParameterValue(param)) {
+			continue
+		}
+		env = append(env, parameterEnvVar(envVarName(envVarWorkflowParameterPrefix, param.Name), param))
+	}
+	for _, param := range tmpl.Inputs.Parameters {
+		if param.IsSensitive() {
+			env = append(env, parameterEnvVar(envVarName(envVarInputParameterPrefix, param.Name), param))
+		}
+	}
+	return env
+}
+
+// parameterEnvVar returns the environment variable of the parameter. Its value takes precedence over its secret. A
+// literal value is in plain text in the pod spec, as it is in the workflow, so only a parameter from a secret is
+// protected.
+func parameterEnvVar(name string, param wfv1.Parameter) apiv1.EnvVar {
+	if param.Value != nil {
+		return apiv1.EnvVar{Name: name, Value: param.Value.String()}
+	}
+	if param.ValueFrom != nil && param.ValueFrom.SecretKeyRef != nil {
+		return apiv1.EnvVar{Name: name, ValueFrom: &apiv1.EnvVarSource{SecretKeyRef: param.ValueFrom.SecretKeyRef}}
+	}
+	return apiv1.EnvVar{Name: name}
+}
+
+// ExpandSensitiveParameters expands the references to the environment variables of sensitive parameters, as Kubernetes
+// does not expand them in files such as the source of a script. Other references are left as they are.
+func ExpandSensitiveParameters(s string, lookupEnv func(string) (string, bool)) string {
+	return sensitiveReferenceRE.ReplaceAllStringFunc(s, func(ref string) string {
+		if value, ok := lookupEnv(sensitiveReferenceRE.FindStringSubmatch(ref)[1]); ok {
+			return value
+		}
+		return ref
+	})
+}
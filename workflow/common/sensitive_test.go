@@ -0,0 +1,68 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+
+	wfv1 "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1"
+)
+
+func TestInputParameterValue(t *testing.T) {
+	assert.Equal(t, "hello", InputParameterValue(wfv1.Parameter{Name: "message", Value: wfv1.AnyStringPtr("hello")}))
+	assert.Equal(t, "$(ARGO_PARAMETER_API_TOKEN)", InputParameterValue(wfv1.Parameter{Name: "api-token", Value: wfv1.AnyStringPtr("my-token"), Sensitive: true}))
+	assert.Equal(t, "$(ARGO_WORKFLOW_PARAMETER_API_TOKEN)", WorkflowParameterValue(wfv1.Parameter{Name: "api-token", Value: wfv1.AnyStringPtr("my-token"), Sensitive: true}))
+}
+
+func TestSensitiveParameterEnvVars(t *testing.T) {
+	secret := &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "my-secret"}, Key: "password"}
+	tmpl := &wfv1.Template{
+		Inputs: wfv1.Inputs{Parameters: []wfv1.Parameter{
+			{Name: "message", Value: wfv1.AnyStringPtr("hello")},
+			{Name: "token", Value: wfv1.AnyStringPtr("my-token"), Sensitive: true},
+			{Name: "password", ValueFrom: &wfv1.ValueFrom{SecretKeyRef: secret}},
+		}},
+	}
+	workflowParams := []wfv1.Parameter{
+		{Name: "used", ValueFrom: &wfv1.ValueFrom{SecretKeyRef: secret}},
+		{Name: "unused", Value: wfv1.AnyStringPtr("my-unused"), Sensitive: true},
+	}
+	env := SensitiveParameterEnvVars(tmpl, `{"args":["$(ARGO_WORKFLOW_PARAMETER_USED)"]}`, workflowParams)
+	assert.Equal(t, []corev1.EnvVar{
+		{Name: "ARGO_WORKFLOW_PARAMETER_USED", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: secret}},
+		{Name: "ARGO_PARAMETER_TOKEN", Value: "my-token"},
+		{Name: "ARGO_PARAMETER_PASSWORD", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: secret}},
+	}, env)
+}
+
+func TestExpandSensitiveParameters(t *testing.T) {
+	env := map[string]string{"ARGO_PARAMETER_TOKEN": "my-token", "ARGO_WORKFLOW_PARAMETER_PASSWORD": "my-password", "HOME": "/root"}
+	lookupEnv := func(name string) (string, bool) {
+		value, ok := env[name]
+		return value, ok
+	}
+	assert.Equal(t, "login my-token my-password $(HOME) $(ARGO_PARAMETER_MISSING)",
+		ExpandSensitiveParameters("login $(ARGO_PARAMETER_TOKEN) $(ARGO_WORKFLOW_PARAMETER_PASSWORD) $(HOME) $(ARGO_PARAMETER_MISSING)", lookupEnv))
+}
+
+func TestProcessArgsSensitiveParameter(t *testing.T) {
+	tmpl := &wfv1.Template{
+		Name:      "main",
+		Inputs:    wfv1.Inputs{Parameters: []wfv1.Parameter{{Name: "token"}, {Name: "message"}}},
+		Container: &corev1.Container{Image: "alpine:3.7", Args: []string{"{{inputs.parameters.token}}", "{{inputs.parameters.message}}", "{{inputs.parameters}}"}},
+	}
+	args := &wfv1.Arguments{Parameters: []wfv1.Parameter{
+		{Name: "token", ValueFrom: &wfv1.ValueFrom{SecretKeyRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "my-secret"}, Key: "token"}}},
+		{Name: "message", Value: wfv1.AnyStringPtr("my-message"), Sensitive: true},
+	}}
+	newTmpl, err := ProcessArgs(tmpl, args, Parameters{}, Parameters{}, false)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "$(ARGO_PARAMETER_TOKEN)", newTmpl.Container.Args[0])
+		assert.Equal(t, "$(ARGO_PARAMETER_MESSAGE)", newTmpl.Container.Args[1])
+		assert.NotContains(t, newTmpl.Container.Args[2], "my-message")
+		assert.True(t, newTmpl.Inputs.Parameters[0].IsSensitive())
+		assert.True(t, newTmpl.Inputs.Parameters[1].IsSensitive())
+		assert.Equal(t, "my-message", newTmpl.Inputs.Parameters[1].Value.String())
+	}
+}
@@ -31,20 +31,14 @@ import (
 	"github.com/simster7/argo/v2/pkg/apis/workflow"
 	wfv1 "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1"
 	"github.com/simster7/argo/v2/util"
+	argoexpr "github.com/simster7/argo/v2/util/expr"
 )
 
 // FindOverlappingVolume looks an artifact path, checks if it overlaps with any
 // user specified volumeMounts in the template, and returns the deepest volumeMount
 // (if any). A return value of nil indicates the path is not under any volumeMount.
 func FindOverlappingVolume(tmpl *wfv1.Template, path string) *apiv1.VolumeMount {
-	var volMounts []apiv1.VolumeMount
-	if tmpl.Container != nil {
-		volMounts = tmpl.Container.VolumeMounts
-	} else if tmpl.Script != nil {
-		volMounts = tmpl.Script.VolumeMounts
-	} else {
-		return nil
-	}
+	volMounts := GetMainContainerVolumeMounts(tmpl)
 	var volMnt *apiv1.VolumeMount
 	deepestLen := 0
 	for _, mnt := range volMounts {
@@ -59,6 +53,34 @@ func FindOverlappingVolume(tmpl *wfv1.Template, path string) *apiv1.VolumeMount
 	return volMnt
 }
 
+// GetMainContainerVolumeMounts returns the volume mounts of the main container of the template. For a container set,
+// these are the volume mounts shared by the set, and those of its container named main.
+func GetMainContainerVolumeMounts(tmpl *wfv1.Template) []apiv1.VolumeMount {
+	switch {
+	case tmpl.Container != nil:
+		return tmpl.Container.VolumeMounts
+	case tmpl.Script != nil:
+		return tmpl.Script.VolumeMounts
+	case tmpl.ContainerSet != nil:
+		volMounts := append([]apiv1.VolumeMount{}, tmpl.ContainerSet.VolumeMounts...)
+		for _, ctr := range tmpl.ContainerSet.Containers {
+			if ctr.Name == MainContainerName {
+				volMounts = append(volMounts, ctr.VolumeMounts...)
+			}
+		}
+		return volMounts
+	}
+	return nil
+}
+
+// GetMainContainerNames returns the names of the containers that run the work of the template, rather than sidecars
+func GetMainContainerNames(tmpl *wfv1.Template) []string {
+	if tmpl.ContainerSet != nil {
+		return tmpl.ContainerSet.GetContainerNames()
+	}
+	return []string{MainContainerName}
+}
+
 // KillPodContainer is a convenience function to issue a kill signal to a container in a pod
 // It gives a 15 second grace period before issuing SIGKILL
 // NOTE: this only works with containers that have sh
@@ -261,11 +283,29 @@ func ProcessArgs(tmpl *wfv1.Template, args wfv1.ArgumentsProvider, globalParams,
 		}
 		// overwrite value from argument (if supplied)
 		argParam := args.GetParameterByName(inParam.Name)
-		if argParam != nil && argParam.Value != nil {
-			inParam.Value = argParam.Value
+		if argParam != nil {
+			if argParam.Value != nil {
+				inParam.Value = argParam.Value
+			} else if argParam.ValueFrom != nil && argParam.ValueFrom.SecretKeyRef != nil {
+				inParam.Value = nil
+				inParam.ValueFrom = &wfv1.ValueFrom{SecretKeyRef: argParam.ValueFrom.SecretKeyRef}
+			} else if hasConfigMapKeyRef(argParam) && validateOnly {
+				inParam.Value = wfv1.AnyStringPtr(NewPlaceholderGenerator().NextPlaceholder())
+			}
+			// a parameter is sensitive if either the argument or the input is
+			inParam.Sensitive = inParam.Sensitive || argParam.IsSensitive()
+		}
+		if hasConfigMapKeyRef(&inParam) && validateOnly {
+			// the value of a parameter from a config map is resolved by the controller
+			inParam.Value = wfv1.AnyStringPtr(NewPlaceholderGenerator().NextPlaceholder())
 		}
 		if inParam.Value == nil {
-			return nil, errors.Errorf(errors.CodeBadRequest, "inputs.parameters.%s was not supplied", inParam.Name)
+			// the value of a parameter from a secret is resolved when the pod runs
+			if inParam.ValueFrom == nil || inParam.ValueFrom.SecretKeyRef == nil {
+				return nil, errors.Errorf(errors.CodeBadRequest, "inputs.parameters.%s was not supplied", inParam.Name)
+			}
+		} else if err := ValidateParameterValue(inParam, inParam.Value.String()); err != nil {
+			return nil, errors.Errorf(errors.CodeBadRequest, "inputs.parameters.%s.value: %v", inParam.Name, err)
 		}
 		newTmpl.Inputs.Parameters[i] = inParam
 	}
@@ -304,6 +344,7 @@ func ProcessArgs(tmpl *wfv1.Template, args wfv1.ArgumentsProvider, globalParams,
 
 // SubstituteParams returns a new copy of the template with global, pod, and input parameters substituted
 func SubstituteParams(tmpl *wfv1.Template, globalParams, localParams Parameters) (*wfv1.Template, error) {
+	tmpl, attachInlineTemplates := DetachInlineTemplates(tmpl)
 	tmplBytes, err := json.Marshal(tmpl)
 	if err != nil {
 		return nil, errors.InternalWrapError(err)
@@ -326,13 +367,13 @@ func SubstituteParams(tmpl *wfv1.Template, globalParams, localParams Parameters)
 	// Now replace the rest of substitutions (the ones that can be made) in the template
 	replaceMap = make(map[string]string)
 	for _, inParam := range globalReplacedTmpl.Inputs.Parameters {
-		if inParam.Value == nil {
+		if inParam.Value == nil && !inParam.IsSensitive() {
 			return nil, errors.InternalErrorf("inputs.parameters.%s had no value", inParam.Name)
 		}
-		replaceMap["inputs.parameters."+inParam.Name] = inParam.Value.String()
+		replaceMap["inputs.parameters."+inParam.Name] = InputParameterValue(inParam)
 	}
 	//allow {{inputs.parameters}} to fetch the entire input parameters list as JSON
-	jsonInputParametersBytes, err := json.Marshal(globalReplacedTmpl.Inputs.Parameters)
+	jsonInputParametersBytes, err := json.Marshal(wfv1.Redacted(globalReplacedTmpl.Inputs.Parameters))
 	if err != nil {
 		return nil, errors.InternalWrapError(err)
 	}
@@ -366,15 +407,38 @@ func SubstituteParams(tmpl *wfv1.Template, globalParams, localParams Parameters)
 	if err != nil {
 		return nil, errors.InternalWrapError(err)
 	}
+	attachInlineTemplates(&newTmpl)
 	return &newTmpl, nil
 }
 
 // Replace executes basic string substitution of a template with replacement values.
 // allowUnresolved indicates whether or not it is acceptable to have unresolved variables
-// remaining in the substituted template.
+// remaining in the substituted template. An expression tag, e.g. `{{= asInt(inputs.parameters.x) + 1}}`,
+// is replaced with the result of the expression once all the variables it refers to can be resolved.
 func Replace(fstTmpl *fasttemplate.Template, replaceMap map[string]string, allowUnresolved bool) (string, error) {
 	var unresolvedErr error
+	var env map[string]interface{}
 	replacedTmpl := fstTmpl.ExecuteFuncString(func(w io.Writer, tag string) (int, error) {
+		if expression, ok := ExpressionTag(tag); ok {
+			if env == nil {
+				env = expressionEnv(replaceMap)
+			}
+			replacement, resolved, err := evalExpressionTag(expression, env)
+			if err != nil {
+				unresolvedErr = errors.Errorf(errors.CodeBadRequest, "%v", err)
+				return 0, nil
+			}
+			if !resolved {
+				if allowUnresolved {
+					return w.Write([]byte(fmt.Sprintf("{{%s}}", tag)))
+				}
+				unresolvedErr = errors.Errorf(errors.CodeBadRequest, "failed to resolve {{%s}}", tag)
+				return 0, nil
+			}
+			replacement = strconv.Quote(replacement)
+			replacement = replacement[1 : len(replacement)-1]
+			return w.Write([]byte(replacement))
+		}
 		replacement, ok := replaceMap[strings.TrimSpace(tag)]
 		if !ok {
 			// Attempt to resolve nested tags, if possible
@@ -406,6 +470,59 @@ func Replace(fstTmpl *fasttemplate.Template, replaceMap map[string]string, allow
 	return replacedTmpl, nil
 }
 
+// ExpressionTag returns the expression of an expression tag, i.e. one that starts with '=', such as
+// `{{= steps.flip.outputs.result == "heads"}}`. As templates are substituted as JSON, the expression is unescaped.
+func ExpressionTag(tag string) (string, bool) {
+	tag = strings.TrimSpace(tag)
+	if !strings.HasPrefix(tag, "=") {
+		return "", false
+	}
+	expression := strings.TrimSpace(strings.TrimPrefix(tag, "="))
+	var unescaped string
+	if strings.Contains(expression, `\`) && json.Unmarshal([]byte(`"`+expression+`"`), &unescaped) == nil {
+		expression = unescaped
+	}
+	return expression, true
+}
+
+// expressionEnv returns the environment expression tags are evaluated with. Placeholders, which validation substitutes
+// for values that are only known at runtime, are left out, so that the expressions that refer to them are not resolved.
+func expressionEnv(replaceMap map[string]string) map[string]interface{} {
+	params := make(map[string]string, len(replaceMap))
+	for name, value := range replaceMap {
+		if !strings.HasPrefix(value, placeholderPrefix) {
+			params[name] = value
+		}
+	}
+	return argoexpr.EnvMap(params)
+}
+
+// evalExpressionTag returns the result of the expression as a string, unless one of the variables it refers to is not
+// in the environment yet
+func evalExpressionTag(expression string, env map[string]interface{}) (string, bool, error) {
+	names, err := argoexpr.Variables(expression)
+	if err != nil {
+		return "", false, err
+	}
+	for _, name := range names {
+		if !argoexpr.HasVariable(env, name) {
+			return "", false, nil
+		}
+	}
+	result, err := argoexpr.Eval(expression, env)
+	if err != nil {
+		return "", false, err
+	}
+	if s, ok := result.(string); ok {
+		return s, true, nil
+	}
+	data, err := json.Marshal(result)
+	if err != nil {
+		return "", false, fmt.Errorf("unable to marshal result of expression '%s': %w", expression, err)
+	}
+	return string(data), true, nil
+}
+
 // RunCommand is a convenience function to run/log a command and log the stderr upon failure
 func RunCommand(name string, arg ...string) ([]byte, error) {
 	cmd := exec.Command(name, arg...)
@@ -702,7 +819,9 @@ func GetTemplateGetterString(getter wfv1.TemplateHolder) string {
 func GetTemplateHolderString(tmplHolder wfv1.TemplateReferenceHolder) string {
 	tmplName := tmplHolder.GetTemplateName()
 	tmplRef := tmplHolder.GetTemplateRef()
-	if tmplRef != nil {
+	if tmplHolder.GetTemplate() != nil {
+		return fmt.Sprintf("%T (inline)", tmplHolder)
+	} else if tmplRef != nil {
 		return fmt.Sprintf("%T (%s/%s)", tmplHolder, tmplRef.Name, tmplRef.Template)
 	} else {
 		return fmt.Sprintf("%T (%s)", tmplHolder, tmplName)
@@ -740,3 +859,8 @@ func SplitClusterWorkflowTemplateYAMLFile(body []byte, strict bool) ([]wfv1.Clus
 func GenerateOnExitNodeName(parentDisplayName string) string {
 	return fmt.Sprintf("%s.onExit", parentDisplayName)
 }
+
+// GenerateLifecycleHookNodeName returns the name of the node of a lifecycle hook of the hooked node
+func GenerateLifecycleHookNodeName(hookedNodeName string, hookName string) string {
+	return fmt.Sprintf("%s.hooks.%s", hookedNodeName, hookName)
+}
@@ -174,3 +174,47 @@ func TestReplaceStringWithWhiteSpace(t *testing.T) {
 		}
 	}
 }
+
+func TestReplaceExpression(t *testing.T) {
+	replaceMap := map[string]string{"inputs.parameters.count": "2", "steps.flip.outputs.result": "heads"}
+	for test, expected := range map[string]string{
+		`{"value": "{{= asInt(inputs.parameters.count) * 2}}"}`:                    `{"value": "4"}`,
+		`{"when": "{{= steps.flip.outputs.result == \"heads\"}}"}`:                 `{"when": "true"}`,
+		`{"value": "{{=steps.flip.outputs.result + \"\\n\"}}"}`:                    `{"value": "heads\n"}`,
+		`{"value": "{{= steps.coin.outputs.result}} {{inputs.parameters.count}}"}`: `{"value": "{{= steps.coin.outputs.result}} 2"}`,
+	} {
+		fstTmpl, err := fasttemplate.NewTemplate(test, "{{", "}}")
+		if assert.NoError(t, err) {
+			replacement, err := Replace(fstTmpl, replaceMap, true)
+			if assert.NoError(t, err, test) {
+				assert.Equal(t, expected, replacement, test)
+			}
+		}
+	}
+	fstTmpl, err := fasttemplate.NewTemplate(`{{= steps.coin.outputs.result}}`, "{{", "}}")
+	if assert.NoError(t, err) {
+		_, err = Replace(fstTmpl, replaceMap, false)
+		assert.EqualError(t, err, "failed to resolve {{= steps.coin.outputs.result}}")
+	}
+	fstTmpl, err = fasttemplate.NewTemplate(`{{= steps.flip.outputs.result > 1}}`, "{{", "}}")
+	if assert.NoError(t, err) {
+		_, err = Replace(fstTmpl, replaceMap, true)
+		assert.Error(t, err)
+	}
+}
+
+func TestProcessArgsTypedParameter(t *testing.T) {
+	tmpl := &wfv1.Template{
+		Name:      "main",
+		Inputs:    wfv1.Inputs{Parameters: []wfv1.Parameter{{Name: "replicas", Type: wfv1.ParameterTypeInt}}},
+		Container: &corev1.Container{Image: "alpine:3.7", Args: []string{"{{inputs.parameters.replicas}}"}},
+	}
+	args := &wfv1.Arguments{Parameters: []wfv1.Parameter{{Name: "replicas", Value: wfv1.AnyStringPtr("2")}}}
+	newTmpl, err := ProcessArgs(tmpl, args, Parameters{}, Parameters{}, false)
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{"2"}, newTmpl.Container.Args)
+	}
+	args.Parameters[0].Value = wfv1.AnyStringPtr("two")
+	_, err = ProcessArgs(tmpl, args, Parameters{}, Parameters{}, false)
+	assert.EqualError(t, err, "inputs.parameters.replicas.value: parameter 'replicas' is not a valid int")
+}
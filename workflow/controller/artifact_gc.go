@@ -0,0 +1,261 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+
+	log "github.com/sirupsen/logrus"
+	apiv1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+
+	wfv1 "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1"
+	"github.com/simster7/argo/v2/util/slice"
+	artifactscommon "github.com/simster7/argo/v2/workflow/artifacts/common"
+	"github.com/simster7/argo/v2/workflow/common"
+	"github.com/simster7/argo/v2/workflow/util"
+)
+
+// artifactGCMaxRetries is the number of times we try to delete a workflow's artifacts before giving up
+var artifactGCMaxRetries = func() int {
+	if v, err := strconv.Atoi(os.Getenv("ARTIFACT_GC_MAX_RETRIES")); err == nil {
+		return v
+	}
+	return 10
+}()
+
+// artifactGCResources provides artifact drivers with access to secrets and config maps in the workflow's namespace
+type artifactGCResources struct {
+	kubeClient kubernetes.Interface
+	namespace  string
+}
+
+func (r artifactGCResources) GetSecret(ctx context.Context, name, key string) (string, error) {
+	secret, err := r.kubeClient.CoreV1().Secrets(r.namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	return string(secret.Data[key]), nil
+}
+
+func (r artifactGCResources) GetConfigMapKey(ctx context.Context, name, key string) (string, error) {
+	configMap, err := r.kubeClient.CoreV1().ConfigMaps(r.namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	return configMap.Data[key], nil
+}
+
+// execWorkflowSpec returns the spec the workflow was actually run with, which may have come from a workflow template
+func execWorkflowSpec(wf *wfv1.Workflow) *wfv1.WorkflowSpec {
+	if wf.Status.StoredWorkflowSpec != nil {
+		return wf.Status.StoredWorkflowSpec
+	}
+	return &wf.Spec
+}
+
+// artifactGCStrategies returns the set of strategies (other than "Never") used by the workflow's templates
+func artifactGCStrategies(wf *wfv1.Workflow, spec *wfv1.WorkflowSpec) map[wfv1.ArtifactGCStrategy]bool {
+	strategies := make(map[wfv1.ArtifactGCStrategy]bool)
+	if strategy := spec.GetArtifactGC().GetStrategy(); strategy != wfv1.ArtifactGCNever {
+		strategies[strategy] = true
+	}
+	addTemplate := func(tmpl wfv1.Template) {
+		for _, art := range tmpl.Outputs.Artifacts {
+			if strategy := art.GetArtifactGCStrategy(spec); strategy != wfv1.ArtifactGCNever {
+				strategies[strategy] = true
+			}
+		}
+	}
+	for _, tmpl := range spec.Templates {
+		addTemplate(tmpl)
+	}
+	for _, tmpl := range wf.Status.StoredTemplates {
+		addTemplate(tmpl)
+	}
+	return strategies
+}
+
+// needsArtifactGC returns whether any of the workflow's output artifacts need to be garbage collected
+func needsArtifactGC(wf *wfv1.Workflow, spec *wfv1.WorkflowSpec) bool {
+	return len(artifactGCStrategies(wf, spec)) > 0
+}
+
+// addArtifactGCFinalizer adds the artifact GC finalizer, preventing the workflow from being deleted before its
+// artifacts have been garbage collected
+func (woc *wfOperationCtx) addArtifactGCFinalizer() {
+	if woc.wf.Status.Fulfilled() || slice.ContainsString(woc.wf.Finalizers, common.FinalizerArtifactGC) {
+		return
+	}
+	if !needsArtifactGC(woc.wf, &woc.execWf.Spec) {
+		return
+	}
+	woc.log.Info("adding artifact GC finalizer")
+	woc.wf.Finalizers = append(woc.wf.Finalizers, common.FinalizerArtifactGC)
+	woc.updated = true
+}
+
+func (wfc *WorkflowController) queueWorkflowForArtifactGC(obj interface{}) {
+	key, err := cache.MetaNamespaceKeyFunc(obj)
+	if err != nil {
+		return
+	}
+	wfc.artifactGCQueue.Add(key)
+}
+
+func (wfc *WorkflowController) runArtifactGC(ctx context.Context) {
+	for wfc.processNextArtifactGCItem(ctx) {
+	}
+}
+
+// processNextArtifactGCItem deletes the output artifacts of a completed or deleted workflow, and then removes
+// its finalizer
+func (wfc *WorkflowController) processNextArtifactGCItem(ctx context.Context) bool {
+	key, quit := wfc.artifactGCQueue.Get()
+	if quit {
+		return false
+	}
+	defer wfc.artifactGCQueue.Done(key)
+
+	logCtx := log.WithField("key", key)
+	obj, exists, err := wfc.wfInformer.GetIndexer().GetByKey(key.(string))
+	if err != nil {
+		logCtx.WithError(err).Error("failed to get workflow from informer")
+		return true
+	}
+	if !exists {
+		wfc.artifactGCQueue.Forget(key)
+		return true
+	}
+
+	wfc.workflowKeyLock.Lock(key.(string))
+	defer wfc.workflowKeyLock.Unlock(key.(string))
+
+	err = wfc.garbageCollectArtifacts(ctx, obj, wfc.artifactGCQueue.NumRequeues(key) >= artifactGCMaxRetries)
+	if err != nil {
+		logCtx.WithError(err).Warn("failed to garbage collect artifacts, will retry")
+		wfc.artifactGCQueue.AddRateLimited(key)
+		return true
+	}
+	wfc.artifactGCQueue.Forget(key)
+	return true
+}
+
+// garbageCollectArtifacts deletes the artifacts for every strategy that is now due. If giveUp is true, any
+// failures are recorded as a warning event rather than returned, so that the finalizer can be removed.
+func (wfc *WorkflowController) garbageCollectArtifacts(ctx context.Context, obj interface{}, giveUp bool) error {
+	un, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return nil
+	}
+	if !slice.ContainsString(un.GetFinalizers(), common.FinalizerArtifactGC) {
+		return nil
+	}
+	wf, err := util.FromUnstructured(un)
+	if err != nil {
+		return fmt.Errorf("failed to convert to workflow from unstructured: %w", err)
+	}
+	err = wfc.hydrator.Hydrate(wf)
+	if err != nil {
+		return fmt.Errorf("failed to hydrate workflow: %w", err)
+	}
+	logCtx := log.WithFields(log.Fields{"namespace": wf.Namespace, "workflow": wf.Name})
+
+	deleting := wf.DeletionTimestamp != nil
+	var due []wfv1.ArtifactGCStrategy
+	if wf.Status.Fulfilled() || deleting {
+		due = append(due, wfv1.ArtifactGCOnWorkflowCompletion)
+	}
+	if deleting {
+		due = append(due, wfv1.ArtifactGCOnWorkflowDeletion)
+	}
+
+	spec := execWorkflowSpec(wf)
+	status := wf.Status.ArtifactGCStatus.DeepCopy()
+	if status == nil {
+		status = &wfv1.ArtGCStatus{}
+	}
+	changed := false
+	for _, strategy := range due {
+		if status.IsStrategyProcessed(strategy) {
+			continue
+		}
+		logCtx.WithField("strategy", strategy).Info("garbage collecting artifacts")
+		err := wfc.deleteArtifacts(ctx, wf, spec, strategy)
+		if err != nil {
+			if !giveUp {
+				return err
+			}
+			logCtx.WithError(err).Warn("giving up garbage collecting artifacts")
+			wfc.eventRecorderManager.Get(wf.Namespace).Event(wf, apiv1.EventTypeWarning, "ArtifactGCFailed", fmt.Sprintf("Giving up deleting %s artifacts: %v", strategy, err))
+		}
+		status.SetStrategyProcessed(strategy)
+		changed = true
+	}
+
+	finalizers := wf.Finalizers
+	done := true
+	for strategy := range artifactGCStrategies(wf, spec) {
+		done = done && status.IsStrategyProcessed(strategy)
+	}
+	if done || deleting {
+		logCtx.Info("removing artifact GC finalizer")
+		finalizers = slice.RemoveString(finalizers, common.FinalizerArtifactGC)
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+	return wfc.patchArtifactGC(ctx, wf, finalizers, status)
+}
+
+// deleteArtifacts deletes every node output artifact whose strategy is the one given
+func (wfc *WorkflowController) deleteArtifacts(ctx context.Context, wf *wfv1.Workflow, spec *wfv1.WorkflowSpec, strategy wfv1.ArtifactGCStrategy) error {
+	for _, node := range wf.Status.Nodes {
+		if node.Outputs == nil {
+			continue
+		}
+		for _, art := range node.Outputs.Artifacts {
+			if !art.HasLocation() || art.GetArtifactGCStrategy(spec) != strategy {
+				continue
+			}
+			art := art
+			driver, err := wfc.artDriverFactory(ctx, &art, artifactGCResources{wfc.kubeclientset, wf.Namespace})
+			if err != nil {
+				return fmt.Errorf("failed to create driver for artifact %q of node %q: %w", art.Name, node.ID, err)
+			}
+			err = driver.Delete(&art)
+			if err != nil && !artifactscommon.IsNotSupported(err) {
+				return fmt.Errorf("failed to delete artifact %q of node %q: %w", art.Name, node.ID, err)
+			}
+		}
+	}
+	return nil
+}
+
+func (wfc *WorkflowController) patchArtifactGC(ctx context.Context, wf *wfv1.Workflow, finalizers []string, status *wfv1.ArtGCStatus) error {
+	data, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"resourceVersion": wf.ResourceVersion,
+			"finalizers":      finalizers,
+		},
+		"status": map[string]interface{}{
+			"artifactGCStatus": status,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal patch: %w", err)
+	}
+	_, err = wfc.wfclientset.ArgoprojV1alpha1().Workflows(wf.Namespace).Patch(ctx, wf.Name, types.MergePatchType, data, metav1.PatchOptions{})
+	if err != nil && !apierr.IsNotFound(err) {
+		return fmt.Errorf("failed to patch workflow: %w", err)
+	}
+	return nil
+}
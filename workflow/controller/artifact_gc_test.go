@@ -0,0 +1,175 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1"
+	artifact "github.com/simster7/argo/v2/workflow/artifacts"
+	"github.com/simster7/argo/v2/workflow/artifacts/resource"
+	"github.com/simster7/argo/v2/workflow/common"
+	"github.com/simster7/argo/v2/workflow/util"
+)
+
+type fakeArtifactDriver struct {
+	artifact.ArtifactDriver
+	deleted []string
+	err     error
+}
+
+func (d *fakeArtifactDriver) Delete(art *wfv1.Artifact) error {
+	if d.err != nil {
+		return d.err
+	}
+	d.deleted = append(d.deleted, art.S3.Key)
+	return nil
+}
+
+func withFakeArtifactDriver(driver *fakeArtifactDriver) func(*WorkflowController) {
+	return func(wfc *WorkflowController) {
+		wfc.artDriverFactory = func(context.Context, *wfv1.Artifact, resource.Interface) (artifact.ArtifactDriver, error) {
+			return driver, nil
+		}
+	}
+}
+
+var artifactGCWf = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: artifact-gc
+spec:
+  entrypoint: main
+  artifactGC:
+    strategy: OnWorkflowCompletion
+  templates:
+  - name: main
+    container:
+      image: docker/whalesay
+    outputs:
+      artifacts:
+      - name: keep
+        path: /tmp/keep
+        artifactGC:
+          strategy: Never
+      - name: delete
+        path: /tmp/delete
+`
+
+func TestAddArtifactGCFinalizer(t *testing.T) {
+	t.Run("Needed", func(t *testing.T) {
+		wf := unmarshalWF(artifactGCWf)
+		cancel, controller := newController(wf)
+		defer cancel()
+		ctx := context.Background()
+		woc := newWorkflowOperationCtx(wf, controller)
+		woc.operate(ctx)
+		wf, err := controller.wfclientset.ArgoprojV1alpha1().Workflows("").Get(ctx, wf.Name, metav1.GetOptions{})
+		if assert.NoError(t, err) {
+			assert.Contains(t, wf.Finalizers, common.FinalizerArtifactGC)
+		}
+	})
+	t.Run("NotNeeded", func(t *testing.T) {
+		wf := unmarshalWF(helloWorldWf)
+		cancel, controller := newController(wf)
+		defer cancel()
+		ctx := context.Background()
+		woc := newWorkflowOperationCtx(wf, controller)
+		woc.operate(ctx)
+		wf, err := controller.wfclientset.ArgoprojV1alpha1().Workflows("").Get(ctx, wf.Name, metav1.GetOptions{})
+		if assert.NoError(t, err) {
+			assert.NotContains(t, wf.Finalizers, common.FinalizerArtifactGC)
+		}
+	})
+}
+
+func completedArtifactGCWf() *wfv1.Workflow {
+	wf := unmarshalWF(artifactGCWf)
+	wf.Finalizers = []string{common.FinalizerArtifactGC}
+	wf.Status.Phase = wfv1.NodeSucceeded
+	artifacts := wfv1.Artifacts{}
+	for _, art := range wf.Spec.Templates[0].Outputs.Artifacts {
+		art.S3 = &wfv1.S3Artifact{S3Bucket: wfv1.S3Bucket{Endpoint: "my-endpoint", Bucket: "my-bucket"}, Key: art.Name}
+		artifacts = append(artifacts, art)
+	}
+	wf.Status.Nodes = wfv1.Nodes{"artifact-gc": wfv1.NodeStatus{ID: "artifact-gc", Outputs: &wfv1.Outputs{Artifacts: artifacts}}}
+	return wf
+}
+
+func TestGarbageCollectArtifacts(t *testing.T) {
+	ctx := context.Background()
+	t.Run("OnWorkflowCompletion", func(t *testing.T) {
+		wf := completedArtifactGCWf()
+		driver := &fakeArtifactDriver{}
+		cancel, controller := newController(wf, withFakeArtifactDriver(driver))
+		defer cancel()
+		un, err := util.ToUnstructured(wf)
+		assert.NoError(t, err)
+		err = controller.garbageCollectArtifacts(ctx, un, false)
+		if assert.NoError(t, err) {
+			assert.Equal(t, []string{"delete"}, driver.deleted)
+			wf, err := controller.wfclientset.ArgoprojV1alpha1().Workflows("").Get(ctx, wf.Name, metav1.GetOptions{})
+			if assert.NoError(t, err) {
+				assert.NotContains(t, wf.Finalizers, common.FinalizerArtifactGC)
+				assert.True(t, wf.Status.ArtifactGCStatus.IsStrategyProcessed(wfv1.ArtifactGCOnWorkflowCompletion))
+			}
+		}
+	})
+	t.Run("Retry", func(t *testing.T) {
+		wf := completedArtifactGCWf()
+		driver := &fakeArtifactDriver{err: fmt.Errorf("failed")}
+		cancel, controller := newController(wf, withFakeArtifactDriver(driver))
+		defer cancel()
+		un, err := util.ToUnstructured(wf)
+		assert.NoError(t, err)
+		err = controller.garbageCollectArtifacts(ctx, un, false)
+		assert.Error(t, err)
+		wf, err = controller.wfclientset.ArgoprojV1alpha1().Workflows("").Get(ctx, wf.Name, metav1.GetOptions{})
+		if assert.NoError(t, err) {
+			assert.Contains(t, wf.Finalizers, common.FinalizerArtifactGC)
+		}
+	})
+	t.Run("GiveUp", func(t *testing.T) {
+		wf := completedArtifactGCWf()
+		driver := &fakeArtifactDriver{err: fmt.Errorf("failed")}
+		cancel, controller := newController(wf, withFakeArtifactDriver(driver))
+		defer cancel()
+		un, err := util.ToUnstructured(wf)
+		assert.NoError(t, err)
+		err = controller.garbageCollectArtifacts(ctx, un, true)
+		assert.NoError(t, err)
+		wf, err = controller.wfclientset.ArgoprojV1alpha1().Workflows("").Get(ctx, wf.Name, metav1.GetOptions{})
+		if assert.NoError(t, err) {
+			assert.NotContains(t, wf.Finalizers, common.FinalizerArtifactGC)
+		}
+	})
+	t.Run("OnWorkflowDeletion", func(t *testing.T) {
+		wf := completedArtifactGCWf()
+		wf.Spec.ArtifactGC.Strategy = wfv1.ArtifactGCOnWorkflowDeletion
+		driver := &fakeArtifactDriver{}
+		cancel, controller := newController(wf, withFakeArtifactDriver(driver))
+		defer cancel()
+		un, err := util.ToUnstructured(wf)
+		assert.NoError(t, err)
+		err = controller.garbageCollectArtifacts(ctx, un, false)
+		if assert.NoError(t, err) {
+			assert.Empty(t, driver.deleted)
+		}
+		now := metav1.Now()
+		wf.DeletionTimestamp = &now
+		un, err = util.ToUnstructured(wf)
+		assert.NoError(t, err)
+		err = controller.garbageCollectArtifacts(ctx, un, false)
+		if assert.NoError(t, err) {
+			assert.Equal(t, []string{"delete"}, driver.deleted)
+			wf, err := controller.wfclientset.ArgoprojV1alpha1().Workflows("").Get(ctx, wf.Name, metav1.GetOptions{})
+			if assert.NoError(t, err) {
+				assert.NotContains(t, wf.Finalizers, common.FinalizerArtifactGC)
+			}
+		}
+	})
+}
@@ -2,26 +2,45 @@ package cache
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"regexp"
+	"sync"
 	"time"
 
+	log "github.com/sirupsen/logrus"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 
+	"github.com/simster7/argo/v2/persist/sqldb"
 	wfv1 "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1"
 )
 
 var cacheKeyRegex = regexp.MustCompile("^[a-zA-Z0-9][-a-zA-Z0-9]*$")
 
+var ErrEntryNotFound = errors.New("cache entry not found")
+
 type MemoizationCache interface {
 	Load(ctx context.Context, key string) (*Entry, error)
-	Save(ctx context.Context, key string, nodeId string, value *wfv1.Outputs) error
+	// Save saves the outputs of the node. Caches that support eviction delete the entry once it is older than maxAge,
+	// unless it is zero.
+	Save(ctx context.Context, key string, nodeId string, value *wfv1.Outputs, maxAge time.Duration) error
+	// RecordHits adds the numbers of hits to the hit counts of the entries, by key. Entries that no longer exist are
+	// skipped.
+	RecordHits(ctx context.Context, hits map[string]int64) error
+	// List returns all the entries of the cache by key
+	List(ctx context.Context) (map[string]*Entry, error)
+	// Delete deletes the entry, returning ErrEntryNotFound if there is no entry for the key
+	Delete(ctx context.Context, key string) error
+	// Prune deletes all the entries created before the time, returning the number deleted
+	Prune(ctx context.Context, createdBefore time.Time) (int, error)
 }
 
 type Entry struct {
 	NodeID            string        `json:"nodeID"`
 	Outputs           *wfv1.Outputs `json:"outputs"`
 	CreationTimestamp metav1.Time   `json:"creationTimestamp"`
+	HitCount          int64         `json:"hitCount,omitempty"`
 }
 
 func (e *Entry) Hit() bool {
@@ -47,42 +66,105 @@ func (e *Entry) GetOutputsWithMaxAge(maxAge time.Duration) (*wfv1.Outputs, bool)
 }
 
 type cacheFactory struct {
-	caches     map[string]MemoizationCache
+	caches map[string]MemoizationCache
+	// hits are the hits that have not been recorded yet, by cache and key
+	hits       map[string]map[string]int64
+	mutex      sync.Mutex
 	kubeclient kubernetes.Interface
-	namespace  string
+	repo       sqldb.MemoizationCacheRepo
 }
 
 type Factory interface {
-	GetCache(ct CacheType, name string) MemoizationCache
+	// GetCache returns the cache of the namespace of a workflow, so that workflows only share entries with the
+	// workflows of their own namespace
+	GetCache(ct CacheType, namespace string, name string) MemoizationCache
+	// RecordHit counts a hit of the entry, which is only recorded in the cache when the hits are flushed, so that
+	// recording it does not slow down the reconciliation of the workflow
+	RecordHit(ct CacheType, namespace string, name string, key string)
+	// FlushHits records the hits that have been counted in their caches. Recording hits is best effort, so the hits
+	// that cannot be recorded are logged and dropped.
+	FlushHits(ctx context.Context)
 }
 
-func NewCacheFactory(ki kubernetes.Interface, ns string) Factory {
+func NewCacheFactory(ki kubernetes.Interface, repo sqldb.MemoizationCacheRepo) Factory {
 	return &cacheFactory{
-		make(map[string]MemoizationCache),
-		ki,
-		ns,
+		caches:     make(map[string]MemoizationCache),
+		hits:       make(map[string]map[string]int64),
+		kubeclient: ki,
+		repo:       repo,
 	}
 }
 
 type CacheType string
 
 const (
-	// Only config maps are currently supported for caching
 	ConfigMapCache CacheType = "ConfigMapCache"
+	DatabaseCache  CacheType = "DatabaseCache"
 )
 
+// TypeOf returns the type of cache for the memoization cache type
+func TypeOf(ct wfv1.CacheType) CacheType {
+	if ct == wfv1.CacheTypeDatabase {
+		return DatabaseCache
+	}
+	return ConfigMapCache
+}
+
 // Returns a cache if it exists and creates it otherwise
-func (cf *cacheFactory) GetCache(ct CacheType, name string) MemoizationCache {
-	idx := string(ct) + "." + name
+func (cf *cacheFactory) GetCache(ct CacheType, namespace string, name string) MemoizationCache {
+	cf.mutex.Lock()
+	defer cf.mutex.Unlock()
+	return cf.getCache(ct, namespace, name)
+}
+
+func cacheIndex(ct CacheType, namespace string, name string) string {
+	return fmt.Sprintf("%s/%s/%s", ct, namespace, name)
+}
+
+func (cf *cacheFactory) getCache(ct CacheType, namespace string, name string) MemoizationCache {
+	idx := cacheIndex(ct, namespace, name)
 	if c := cf.caches[idx]; c != nil {
 		return c
 	}
 	switch ct {
 	case ConfigMapCache:
-		c := NewConfigMapCache(cf.namespace, cf.kubeclient, name)
+		c := NewConfigMapCache(namespace, cf.kubeclient, name)
+		cf.caches[idx] = c
+		return c
+	case DatabaseCache:
+		c := NewDatabaseCache(namespace, cf.repo, name)
 		cf.caches[idx] = c
 		return c
 	default:
 		return nil
 	}
 }
+
+func (cf *cacheFactory) RecordHit(ct CacheType, namespace string, name string, key string) {
+	cf.mutex.Lock()
+	defer cf.mutex.Unlock()
+	if cf.getCache(ct, namespace, name) == nil {
+		return
+	}
+	idx := cacheIndex(ct, namespace, name)
+	if cf.hits[idx] == nil {
+		cf.hits[idx] = make(map[string]int64)
+	}
+	cf.hits[idx][key]++
+}
+
+func (cf *cacheFactory) FlushHits(ctx context.Context) {
+	cf.mutex.Lock()
+	hits := cf.hits
+	cf.hits = make(map[string]map[string]int64)
+	caches := make(map[string]MemoizationCache, len(hits))
+	for idx := range hits {
+		caches[idx] = cf.caches[idx]
+	}
+	cf.mutex.Unlock()
+	for idx, cacheHits := range hits {
+		if err := caches[idx].RecordHits(ctx, cacheHits); err != nil {
+			log.WithField("cache", idx).WithError(err).Warn("Failed to record memoization cache hits")
+		}
+	}
+}
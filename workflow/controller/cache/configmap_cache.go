@@ -76,7 +76,8 @@ func (c *configMapCache) Load(ctx context.Context, key string) (*Entry, error) {
 	return &entry, nil
 }
 
-func (c *configMapCache) Save(ctx context.Context, key string, nodeId string, value *wfv1.Outputs) error {
+// Save saves the entry to the config map. Entries are never evicted, maxAge is only enforced when they are loaded.
+func (c *configMapCache) Save(ctx context.Context, key string, nodeId string, value *wfv1.Outputs, _ time.Duration) error {
 	if !cacheKeyRegex.MatchString(key) {
 		errString := fmt.Sprintf("invalid cache key: %s", key)
 		err := errors.New(errString)
@@ -126,3 +127,113 @@ func (c *configMapCache) Save(ctx context.Context, key string, nodeId string, va
 	}
 	return nil
 }
+
+// update applies the function to the config map's entries and updates it if they were changed
+func (c *configMapCache) update(ctx context.Context, f func(data map[string]string) (bool, error)) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	cm, err := c.kubeClient.CoreV1().ConfigMaps(c.namespace).Get(ctx, c.name, metav1.GetOptions{})
+	if err != nil {
+		if apierr.IsNotFound(err) {
+			_, err = f(map[string]string{})
+			return err
+		}
+		return fmt.Errorf("could not load config map cache: %w", err)
+	}
+	if cm.Data == nil {
+		cm.Data = make(map[string]string)
+	}
+	changed, err := f(cm.Data)
+	if err != nil || !changed {
+		return err
+	}
+	_, err = c.kubeClient.CoreV1().ConfigMaps(c.namespace).Update(ctx, cm, metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("could not update config map cache: %w", err)
+	}
+	return nil
+}
+
+// RecordHits updates the config map once for all of the hits
+func (c *configMapCache) RecordHits(ctx context.Context, hits map[string]int64) error {
+	return c.update(ctx, func(data map[string]string) (bool, error) {
+		changed := false
+		for key, count := range hits {
+			rawEntry, ok := data[key]
+			if !ok || rawEntry == "" {
+				continue
+			}
+			var entry Entry
+			err := json.Unmarshal([]byte(rawEntry), &entry)
+			if err != nil {
+				c.logError(err, log.Fields{"key": key}, "Ignoring hits of malformed cache entry")
+				continue
+			}
+			entry.HitCount += count
+			entryJSON, err := json.Marshal(entry)
+			if err != nil {
+				return false, fmt.Errorf("unable to marshal cache entry: %w", err)
+			}
+			data[key] = string(entryJSON)
+			changed = true
+		}
+		return changed, nil
+	})
+}
+
+func (c *configMapCache) List(ctx context.Context) (map[string]*Entry, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	cm, err := c.kubeClient.CoreV1().ConfigMaps(c.namespace).Get(ctx, c.name, metav1.GetOptions{})
+	if err != nil {
+		if apierr.IsNotFound(err) {
+			return map[string]*Entry{}, nil
+		}
+		return nil, fmt.Errorf("could not load config map cache: %w", err)
+	}
+	entries := make(map[string]*Entry, len(cm.Data))
+	for key, rawEntry := range cm.Data {
+		var entry Entry
+		err := json.Unmarshal([]byte(rawEntry), &entry)
+		if err != nil {
+			c.logError(err, log.Fields{"key": key}, "Ignoring malformed cache entry")
+			continue
+		}
+		entries[key] = &entry
+	}
+	return entries, nil
+}
+
+func (c *configMapCache) Delete(ctx context.Context, key string) error {
+	return c.update(ctx, func(data map[string]string) (bool, error) {
+		if _, ok := data[key]; !ok {
+			return false, ErrEntryNotFound
+		}
+		delete(data, key)
+		c.logInfo(log.Fields{"key": key}, "Deleted ConfigMap cache entry")
+		return true, nil
+	})
+}
+
+// Prune deletes the entries created before the time. Malformed entries are deleted too, as they can never be hit.
+func (c *configMapCache) Prune(ctx context.Context, createdBefore time.Time) (int, error) {
+	deleted := 0
+	err := c.update(ctx, func(data map[string]string) (bool, error) {
+		for key, rawEntry := range data {
+			var entry Entry
+			err := json.Unmarshal([]byte(rawEntry), &entry)
+			if err != nil || entry.CreationTimestamp.Time.Before(createdBefore) {
+				delete(data, key)
+				deleted++
+			}
+		}
+		return deleted > 0, nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	c.logInfo(log.Fields{"deleted": deleted}, "Pruned ConfigMap cache")
+	return deleted, nil
+}
@@ -0,0 +1,114 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/simster7/argo/v2/persist/sqldb"
+	wfv1 "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1"
+)
+
+// databaseCache stores entries in the controller's persistence database, so unlike a config map cache, its size is
+// not limited to 1MB. Entries are evicted once they are older than their maxAge, or the cache has too many entries.
+type databaseCache struct {
+	namespace string
+	name      string
+	repo      sqldb.MemoizationCacheRepo
+}
+
+func NewDatabaseCache(ns string, repo sqldb.MemoizationCacheRepo, n string) MemoizationCache {
+	return &databaseCache{
+		namespace: ns,
+		name:      n,
+		repo:      repo,
+	}
+}
+
+func (c *databaseCache) logFields(fields log.Fields) *log.Entry {
+	return log.WithFields(log.Fields{"namespace": c.namespace, "name": c.name}).WithFields(fields)
+}
+
+func (c *databaseCache) Load(_ context.Context, key string) (*Entry, error) {
+	if !cacheKeyRegex.MatchString(key) {
+		return nil, fmt.Errorf("invalid cache key: %s", key)
+	}
+	record, err := c.repo.Load(c.namespace, c.name, key)
+	if err != nil {
+		c.logFields(log.Fields{"key": key}).WithError(err).Debug("Error loading database cache")
+		return nil, fmt.Errorf("could not load database cache: %w", err)
+	}
+	if record == nil {
+		c.logFields(log.Fields{"key": key}).Info("database cache miss: entry does not exist")
+		return nil, nil
+	}
+	return &Entry{
+		NodeID:            record.NodeID,
+		Outputs:           record.Outputs,
+		CreationTimestamp: metav1.Time{Time: record.CreatedAt},
+		HitCount:          record.HitCount,
+	}, nil
+}
+
+func (c *databaseCache) Save(_ context.Context, key string, nodeId string, value *wfv1.Outputs, maxAge time.Duration) error {
+	if !cacheKeyRegex.MatchString(key) {
+		return fmt.Errorf("invalid cache key: %s", key)
+	}
+	c.logFields(log.Fields{"key": key, "nodeId": nodeId}).Info("Saving database cache entry")
+	err := c.repo.Save(c.namespace, c.name, key, nodeId, value, maxAge)
+	if err != nil {
+		c.logFields(log.Fields{"key": key, "nodeId": nodeId}).WithError(err).Debug("Error saving to database cache")
+		return fmt.Errorf("could not save to database cache: %w", err)
+	}
+	return nil
+}
+
+func (c *databaseCache) RecordHits(_ context.Context, hits map[string]int64) error {
+	for key, count := range hits {
+		err := c.repo.RecordHits(c.namespace, c.name, key, count)
+		if err != nil {
+			return fmt.Errorf("could not record database cache hits: %w", err)
+		}
+	}
+	return nil
+}
+
+func (c *databaseCache) List(_ context.Context) (map[string]*Entry, error) {
+	records, err := c.repo.List(c.namespace, c.name)
+	if err != nil {
+		return nil, fmt.Errorf("could not list database cache: %w", err)
+	}
+	entries := make(map[string]*Entry, len(records))
+	for _, record := range records {
+		entries[record.Key] = &Entry{
+			NodeID:            record.NodeID,
+			Outputs:           record.Outputs,
+			CreationTimestamp: metav1.Time{Time: record.CreatedAt},
+			HitCount:          record.HitCount,
+		}
+	}
+	return entries, nil
+}
+
+func (c *databaseCache) Delete(_ context.Context, key string) error {
+	deleted, err := c.repo.Delete(c.namespace, c.name, key)
+	if err != nil {
+		return fmt.Errorf("could not delete database cache entry: %w", err)
+	}
+	if !deleted {
+		return ErrEntryNotFound
+	}
+	c.logFields(log.Fields{"key": key}).Info("Deleted database cache entry")
+	return nil
+}
+
+func (c *databaseCache) Prune(_ context.Context, createdBefore time.Time) (int, error) {
+	deleted, err := c.repo.Prune(c.namespace, c.name, createdBefore)
+	if err != nil {
+		return 0, fmt.Errorf("could not prune database cache: %w", err)
+	}
+	return deleted, nil
+}
@@ -2,12 +2,16 @@ package controller
 
 import (
 	"context"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	apiv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/simster7/argo/v2/persist/sqldb"
+	sqldbmocks "github.com/simster7/argo/v2/persist/sqldb/mocks"
 	wfv1 "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1"
 	"github.com/simster7/argo/v2/workflow/controller/cache"
 )
@@ -81,10 +85,162 @@ func TestConfigMapCacheSave(t *testing.T) {
 	ctx := context.Background()
 	outputs := wfv1.Outputs{}
 	outputs.Parameters = append(outputs.Parameters, MockParam)
-	err := c.Save(ctx, "hi-there-world", "", &outputs)
+	err := c.Save(ctx, "hi-there-world", "", &outputs, 0)
 	assert.NoError(t, err)
 
 	cm, err := controller.kubeclientset.CoreV1().ConfigMaps("default").Get(ctx, "whalesay-cache", metav1.GetOptions{})
 	assert.NoError(t, err)
 	assert.NotNil(t, cm)
 }
+
+func TestConfigMapCacheRecordHit(t *testing.T) {
+	cancel, controller := newController()
+	defer cancel()
+
+	ctx := context.Background()
+	_, err := controller.kubeclientset.CoreV1().ConfigMaps("default").Create(ctx, sampleConfigMapCacheEntry.DeepCopy(), metav1.CreateOptions{})
+	assert.NoError(t, err)
+	c := cache.NewConfigMapCache("default", controller.kubeclientset, "whalesay-cache")
+	assert.NoError(t, c.RecordHits(ctx, map[string]int64{"hi-there-world": 2, "missing": 1}))
+	entry, err := c.Load(ctx, "hi-there-world")
+	if assert.NoError(t, err) {
+		assert.Equal(t, int64(2), entry.HitCount)
+	}
+	entry, err = c.Load(ctx, "missing")
+	if assert.NoError(t, err) {
+		assert.Nil(t, entry)
+	}
+}
+
+func TestCacheFactoryFlushHits(t *testing.T) {
+	cancel, controller := newController()
+	defer cancel()
+
+	ctx := context.Background()
+	_, err := controller.kubeclientset.CoreV1().ConfigMaps("default").Create(ctx, sampleConfigMapCacheEntry.DeepCopy(), metav1.CreateOptions{})
+	assert.NoError(t, err)
+	f := cache.NewCacheFactory(controller.kubeclientset, sqldb.NullMemoizationCacheRepo)
+	f.RecordHit(cache.ConfigMapCache, "default", "whalesay-cache", "hi-there-world")
+	f.RecordHit(cache.ConfigMapCache, "default", "whalesay-cache", "hi-there-world")
+	c := f.GetCache(cache.ConfigMapCache, "default", "whalesay-cache")
+	// the hits are only recorded once they are flushed
+	entry, err := c.Load(ctx, "hi-there-world")
+	if assert.NoError(t, err) {
+		assert.Equal(t, int64(0), entry.HitCount)
+	}
+	f.FlushHits(ctx)
+	entry, err = c.Load(ctx, "hi-there-world")
+	if assert.NoError(t, err) {
+		assert.Equal(t, int64(2), entry.HitCount)
+	}
+	f.FlushHits(ctx)
+	entry, err = c.Load(ctx, "hi-there-world")
+	if assert.NoError(t, err) {
+		assert.Equal(t, int64(2), entry.HitCount)
+	}
+}
+
+func TestConfigMapCacheListAndDelete(t *testing.T) {
+	cancel, controller := newController()
+	defer cancel()
+
+	ctx := context.Background()
+	c := cache.NewConfigMapCache("default", controller.kubeclientset, "whalesay-cache")
+	entries, err := c.List(ctx)
+	if assert.NoError(t, err) {
+		assert.Empty(t, entries)
+	}
+	_, err = controller.kubeclientset.CoreV1().ConfigMaps("default").Create(ctx, sampleConfigMapCacheEntry.DeepCopy(), metav1.CreateOptions{})
+	assert.NoError(t, err)
+	entries, err = c.List(ctx)
+	if assert.NoError(t, err) && assert.Len(t, entries, 1) {
+		assert.Equal(t, "memoized-simple-workflow-5wj2p", entries["hi-there-world"].NodeID)
+	}
+	assert.NoError(t, c.Delete(ctx, "hi-there-world"))
+	assert.Equal(t, cache.ErrEntryNotFound, c.Delete(ctx, "hi-there-world"))
+	entry, err := c.Load(ctx, "hi-there-world")
+	assert.NoError(t, err)
+	assert.Nil(t, entry)
+}
+
+func TestConfigMapCachePrune(t *testing.T) {
+	cancel, controller := newController()
+	defer cancel()
+
+	ctx := context.Background()
+	_, err := controller.kubeclientset.CoreV1().ConfigMaps("default").Create(ctx, sampleConfigMapCacheEntry.DeepCopy(), metav1.CreateOptions{})
+	assert.NoError(t, err)
+	c := cache.NewConfigMapCache("default", controller.kubeclientset, "whalesay-cache")
+	assert.NoError(t, c.Save(ctx, "new-entry", "my-node", &wfv1.Outputs{}, 0))
+
+	deleted, err := c.Prune(ctx, time.Now().Add(-time.Hour))
+	if assert.NoError(t, err) {
+		assert.Equal(t, 1, deleted)
+	}
+	entries, err := c.List(ctx)
+	if assert.NoError(t, err) && assert.Len(t, entries, 1) {
+		assert.Contains(t, entries, "new-entry")
+	}
+}
+
+func TestDatabaseCacheLoad(t *testing.T) {
+	ctx := context.Background()
+	createdAt := time.Now().Add(-time.Minute)
+	outputs := &wfv1.Outputs{Parameters: []wfv1.Parameter{{Name: "hello", Value: wfv1.AnyStringPtr("foobar")}}}
+	repo := &sqldbmocks.MemoizationCacheRepo{}
+	repo.On("Load", "default", "whalesay-cache", "hi-there-world").Return(&sqldb.MemoizationCacheEntry{Key: "hi-there-world", NodeID: "my-node", Outputs: outputs, CreatedAt: createdAt}, nil)
+	repo.On("Load", "default", "whalesay-cache", "miss").Return(nil, nil)
+	repo.On("Load", "default", "whalesay-cache", "error").Return(nil, fmt.Errorf("failed"))
+	c := cache.NewDatabaseCache("default", repo, "whalesay-cache")
+	t.Run("Hit", func(t *testing.T) {
+		entry, err := c.Load(ctx, "hi-there-world")
+		if assert.NoError(t, err) && assert.True(t, entry.Hit()) {
+			assert.Equal(t, "my-node", entry.NodeID)
+			assert.Equal(t, outputs, entry.Outputs)
+			_, ok := entry.GetOutputsWithMaxAge(time.Hour)
+			assert.True(t, ok)
+			_, ok = entry.GetOutputsWithMaxAge(time.Second)
+			assert.False(t, ok)
+		}
+	})
+	t.Run("Miss", func(t *testing.T) {
+		entry, err := c.Load(ctx, "miss")
+		if assert.NoError(t, err) {
+			assert.False(t, entry.Hit())
+		}
+	})
+	t.Run("Error", func(t *testing.T) {
+		_, err := c.Load(ctx, "error")
+		assert.Error(t, err)
+	})
+	t.Run("InvalidKey", func(t *testing.T) {
+		_, err := c.Load(ctx, "-invalid")
+		assert.Error(t, err)
+	})
+}
+
+func TestDatabaseCacheSave(t *testing.T) {
+	outputs := &wfv1.Outputs{Parameters: []wfv1.Parameter{{Name: "hello", Value: wfv1.AnyStringPtr("foobar")}}}
+	repo := &sqldbmocks.MemoizationCacheRepo{}
+	repo.On("Save", "default", "whalesay-cache", "hi-there-world", "my-node", outputs, time.Hour).Return(nil)
+	c := cache.NewDatabaseCache("default", repo, "whalesay-cache")
+	err := c.Save(context.Background(), "hi-there-world", "my-node", outputs, time.Hour)
+	if assert.NoError(t, err) {
+		repo.AssertExpectations(t)
+	}
+}
+
+func TestDatabaseCacheNotConfigured(t *testing.T) {
+	c := cache.NewCacheFactory(nil, sqldb.NullMemoizationCacheRepo).GetCache(cache.DatabaseCache, "default", "whalesay-cache")
+	_, err := c.Load(context.Background(), "hi-there-world")
+	assert.Error(t, err)
+}
+
+func TestDatabaseCacheDelete(t *testing.T) {
+	repo := &sqldbmocks.MemoizationCacheRepo{}
+	repo.On("Delete", "default", "whalesay-cache", "hi-there-world").Return(true, nil)
+	repo.On("Delete", "default", "whalesay-cache", "missing").Return(false, nil)
+	c := cache.NewDatabaseCache("default", repo, "whalesay-cache")
+	assert.NoError(t, c.Delete(context.Background(), "hi-there-world"))
+	assert.Equal(t, cache.ErrEntryNotFound, c.Delete(context.Background(), "missing"))
+}
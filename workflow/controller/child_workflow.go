@@ -0,0 +1,148 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/simster7/argo/v2/errors"
+	"github.com/simster7/argo/v2/pkg/apis/workflow"
+	wfv1 "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1"
+	"github.com/simster7/argo/v2/workflow/common"
+)
+
+// childWorkflowDeletionRequeue is how long to wait for the previous child workflow of a node, e.g. one deleted when its
+// parent was retried, to be deleted before the node's child workflow can be created
+const childWorkflowDeletionRequeue = 5 * time.Second
+
+// executeChildWorkflow creates the child workflow of the node, which is named after the node and is owned by this
+// workflow, and waits for it to complete. The node takes the phase and the outputs of the child workflow. If this
+// workflow is stopped or terminated, or exceeds its deadline, the child workflow is too.
+func (woc *wfOperationCtx) executeChildWorkflow(ctx context.Context, nodeName string, templateScope string, tmpl *wfv1.Template, orgTmpl wfv1.TemplateReferenceHolder, opts *executeTemplateOpts) (*wfv1.NodeStatus, error) {
+	node := woc.wf.GetNodeByName(nodeName)
+	if node == nil {
+		if !woc.execWf.Spec.Shutdown.ShouldExecute(opts.onExitTemplate) {
+			return woc.initializeExecutableNode(nodeName, wfv1.NodeTypeWorkflow, templateScope, tmpl, orgTmpl, opts.boundaryID, wfv1.NodeFailed, fmt.Sprintf("Stopped with strategy '%s'", woc.execWf.Spec.Shutdown)), nil
+		}
+		node = woc.initializeExecutableNode(nodeName, wfv1.NodeTypeWorkflow, templateScope, tmpl, orgTmpl, opts.boundaryID, wfv1.NodePending)
+	}
+
+	wfClient := woc.controller.wfclientset.ArgoprojV1alpha1().Workflows(woc.wf.Namespace)
+	child, err := wfClient.Get(ctx, node.ID, metav1.GetOptions{})
+	if apierr.IsNotFound(err) {
+		if node.Phase != wfv1.NodePending {
+			return woc.markNodePhase(nodeName, wfv1.NodeError, fmt.Sprintf("child workflow '%s' was deleted", node.ID)), nil
+		}
+		child, err = wfClient.Create(ctx, woc.newChildWorkflow(node.ID, tmpl.Workflow), metav1.CreateOptions{})
+		if err != nil {
+			woc.log.Infof("Failed to create child workflow %s: %v", node.ID, err)
+			return woc.requeueIfTransientErr(err, nodeName)
+		}
+		woc.log.Infof("Created child workflow %s", child.Name)
+		return woc.markNodePhase(nodeName, wfv1.NodeRunning), nil
+	}
+	if err != nil {
+		return woc.requeueIfTransientErr(err, nodeName)
+	}
+	if child.DeletionTimestamp != nil {
+		// the child workflow of a previous run of the node is still being deleted
+		woc.requeueAfter(childWorkflowDeletionRequeue)
+		return node, nil
+	}
+
+	switch child.Status.Phase {
+	case wfv1.NodeSucceeded:
+		outputs, err := childWorkflowOutputs(tmpl, child)
+		if err != nil {
+			return woc.markNodeError(nodeName, err), nil
+		}
+		node = woc.wf.GetNodeByName(nodeName)
+		node.Outputs = outputs
+		woc.wf.Status.Nodes[node.ID] = *node
+		return woc.markNodePhase(nodeName, wfv1.NodeSucceeded), nil
+	case wfv1.NodeFailed, wfv1.NodeError:
+		message := child.Status.Message
+		if message == "" {
+			message = fmt.Sprintf("child workflow '%s' %s", child.Name, child.Status.Phase)
+		}
+		return woc.markNodePhase(nodeName, child.Status.Phase, message), nil
+	}
+
+	if strategy := woc.childWorkflowShutdownStrategy(opts); strategy != "" && child.Spec.Shutdown != strategy {
+		woc.log.Infof("Shutting down child workflow %s with strategy '%s'", child.Name, strategy)
+		patch, err := json.Marshal(map[string]interface{}{"spec": map[string]interface{}{"shutdown": strategy}})
+		if err != nil {
+			return node, errors.InternalWrapError(err)
+		}
+		if _, err := wfClient.Patch(ctx, child.Name, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil && !apierr.IsNotFound(err) {
+			woc.log.Warnf("Failed to shut down child workflow %s: %v", child.Name, err)
+			woc.requeue()
+		}
+	}
+	return woc.markNodePhase(nodeName, wfv1.NodeRunning), nil
+}
+
+// newChildWorkflow returns the child workflow of the node with the ID, which runs the workflow template with the
+// arguments
+func (woc *wfOperationCtx) newChildWorkflow(nodeID string, childWorkflow *wfv1.ChildWorkflow) *wfv1.Workflow {
+	labels := map[string]string{common.LabelKeyParentWorkflow: woc.wf.Name}
+	if woc.controller.Config.InstanceID != "" {
+		labels[common.LabelKeyControllerInstanceID] = woc.controller.Config.InstanceID
+	}
+	return &wfv1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      nodeID,
+			Namespace: woc.wf.Namespace,
+			Labels:    labels,
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(woc.wf, wfv1.SchemeGroupVersion.WithKind(workflow.WorkflowKind)),
+			},
+		},
+		Spec: wfv1.WorkflowSpec{
+			WorkflowTemplateRef: childWorkflow.WorkflowTemplateRef.DeepCopy(),
+			Arguments:           *childWorkflow.Arguments.DeepCopy(),
+		},
+	}
+}
+
+// childWorkflowShutdownStrategy returns the strategy the child workflows of this workflow should be shut down with, or
+// an empty strategy if they should keep running
+func (woc *wfOperationCtx) childWorkflowShutdownStrategy(opts *executeTemplateOpts) wfv1.ShutdownStrategy {
+	if !woc.execWf.Spec.Shutdown.ShouldExecute(opts.onExitTemplate) {
+		return woc.execWf.Spec.Shutdown
+	}
+	// as with pods, the child workflows of exit handlers are not subject to the deadline
+	if !opts.onExitTemplate && woc.workflowDeadline != nil && time.Now().UTC().After(*woc.workflowDeadline) {
+		return wfv1.ShutdownStrategyTerminate
+	}
+	return ""
+}
+
+// childWorkflowOutputs returns the outputs of the node of the template, which are those of the child workflow. An
+// output parameter of the template that the child workflow did not produce takes its default value.
+func childWorkflowOutputs(tmpl *wfv1.Template, child *wfv1.Workflow) (*wfv1.Outputs, error) {
+	outputs := child.Status.Outputs.DeepCopy()
+	if outputs == nil {
+		outputs = &wfv1.Outputs{}
+	}
+	for _, param := range tmpl.Outputs.Parameters {
+		if outputs.GetParameterByName(param.Name) != nil {
+			continue
+		}
+		if param.Default == nil {
+			return nil, errors.Errorf(errors.CodeBadRequest, "child workflow '%s' did not produce the output parameter '%s'", child.Name, param.Name)
+		}
+		outputs.Parameters = append(outputs.Parameters, wfv1.Parameter{Name: param.Name, Value: param.Default})
+	}
+	for _, art := range tmpl.Outputs.Artifacts {
+		if outputs.GetArtifactByName(art.Name) == nil && !art.Optional {
+			return nil, errors.Errorf(errors.CodeBadRequest, "child workflow '%s' did not produce the output artifact '%s'", child.Name, art.Name)
+		}
+	}
+	return outputs, nil
+}
@@ -0,0 +1,159 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1"
+	"github.com/simster7/argo/v2/workflow/common"
+)
+
+var childWorkflowWf = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: parent
+  namespace: default
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: child
+        template: child
+        arguments:
+          parameters:
+          - name: message
+            value: hello
+    - - name: print
+        template: print
+        arguments:
+          parameters:
+          - name: message
+            value: "{{steps.child.outputs.parameters.result}}"
+  - name: child
+    inputs:
+      parameters:
+      - name: message
+    workflow:
+      workflowTemplateRef:
+        name: my-template
+      arguments:
+        parameters:
+        - name: message
+          value: "{{inputs.parameters.message}}"
+    outputs:
+      parameters:
+      - name: result
+  - name: print
+    inputs:
+      parameters:
+      - name: message
+    container:
+      image: docker/whalesay
+      args: ["{{inputs.parameters.message}}"]
+`
+
+func TestChildWorkflow(t *testing.T) {
+	ctx := context.Background()
+	// runChild runs the parent until its child workflow is created, and returns the child
+	runChild := func(t *testing.T) (*wfOperationCtx, *wfv1.Workflow) {
+		wf := unmarshalWF(childWorkflowWf)
+		cancel, controller := newController(wf)
+		t.Cleanup(cancel)
+		woc := newWorkflowOperationCtx(wf, controller)
+		woc.operate(ctx)
+
+		node := woc.wf.Status.Nodes.FindByDisplayName("child")
+		if assert.NotNil(t, node) {
+			assert.Equal(t, wfv1.NodeTypeWorkflow, node.Type)
+			assert.Equal(t, wfv1.NodeRunning, node.Phase)
+		}
+		child, err := controller.wfclientset.ArgoprojV1alpha1().Workflows("default").Get(ctx, node.ID, metav1.GetOptions{})
+		if assert.NoError(t, err) {
+			assert.Equal(t, "parent", child.Labels[common.LabelKeyParentWorkflow])
+			if assert.Len(t, child.OwnerReferences, 1) {
+				assert.Equal(t, "parent", child.OwnerReferences[0].Name)
+			}
+			assert.Equal(t, &wfv1.WorkflowTemplateRef{Name: "my-template"}, child.Spec.WorkflowTemplateRef)
+			assert.Equal(t, "hello", child.Spec.Arguments.GetParameterByName("message").Value.String())
+		}
+		return woc, child
+	}
+	// completeChild completes the child workflow and operates on the parent again
+	completeChild := func(t *testing.T, woc *wfOperationCtx, child *wfv1.Workflow) *wfOperationCtx {
+		_, err := woc.controller.wfclientset.ArgoprojV1alpha1().Workflows("default").Update(ctx, child, metav1.UpdateOptions{})
+		assert.NoError(t, err)
+		woc = newWorkflowOperationCtx(woc.wf, woc.controller)
+		woc.operate(ctx)
+		return woc
+	}
+
+	t.Run("Succeeded", func(t *testing.T) {
+		woc, child := runChild(t)
+		child.Status.Phase = wfv1.NodeSucceeded
+		child.Status.Outputs = &wfv1.Outputs{Parameters: []wfv1.Parameter{{Name: "result", Value: wfv1.AnyStringPtr("world")}}}
+		woc = completeChild(t, woc, child)
+
+		node := woc.wf.Status.Nodes.FindByDisplayName("child")
+		if assert.NotNil(t, node) {
+			assert.Equal(t, wfv1.NodeSucceeded, node.Phase)
+			assert.Equal(t, "world", node.Outputs.GetParameterByName("result").Value.String())
+		}
+		pods, err := listPods(woc)
+		if assert.NoError(t, err) && assert.Len(t, pods.Items, 1) {
+			assert.Equal(t, []string{"world"}, pods.Items[0].Spec.Containers[1].Args)
+		}
+	})
+	t.Run("MissingOutput", func(t *testing.T) {
+		woc, child := runChild(t)
+		child.Status.Phase = wfv1.NodeSucceeded
+		woc = completeChild(t, woc, child)
+
+		node := woc.wf.Status.Nodes.FindByDisplayName("child")
+		if assert.NotNil(t, node) {
+			assert.Equal(t, wfv1.NodeError, node.Phase)
+			assert.Equal(t, "child workflow '"+child.Name+"' did not produce the output parameter 'result'", node.Message)
+		}
+	})
+	t.Run("Failed", func(t *testing.T) {
+		woc, child := runChild(t)
+		child.Status.Phase = wfv1.NodeFailed
+		child.Status.Message = "child stage failed"
+		woc = completeChild(t, woc, child)
+
+		node := woc.wf.Status.Nodes.FindByDisplayName("child")
+		if assert.NotNil(t, node) {
+			assert.Equal(t, wfv1.NodeFailed, node.Phase)
+			assert.Equal(t, "child stage failed", node.Message)
+		}
+		assert.Equal(t, wfv1.NodeFailed, woc.wf.Status.Phase)
+	})
+	t.Run("Stopped", func(t *testing.T) {
+		woc, child := runChild(t)
+		woc.wf.Spec.Shutdown = wfv1.ShutdownStrategyStop
+		woc = newWorkflowOperationCtx(woc.wf, woc.controller)
+		woc.operate(ctx)
+
+		child, err := woc.controller.wfclientset.ArgoprojV1alpha1().Workflows("default").Get(ctx, child.Name, metav1.GetOptions{})
+		if assert.NoError(t, err) {
+			assert.Equal(t, wfv1.ShutdownStrategyStop, child.Spec.Shutdown)
+		}
+	})
+	t.Run("Deleted", func(t *testing.T) {
+		woc, child := runChild(t)
+		err := woc.controller.wfclientset.ArgoprojV1alpha1().Workflows("default").Delete(ctx, child.Name, metav1.DeleteOptions{})
+		assert.NoError(t, err)
+		woc = newWorkflowOperationCtx(woc.wf, woc.controller)
+		woc.operate(ctx)
+
+		node := woc.wf.Status.Nodes.FindByDisplayName("child")
+		if assert.NotNil(t, node) {
+			assert.Equal(t, wfv1.NodeError, node.Phase)
+			assert.Equal(t, "child workflow '"+child.Name+"' was deleted", node.Message)
+		}
+	})
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/simster7/argo/v2/persist/sqldb"
 	"github.com/simster7/argo/v2/util/instanceid"
 	"github.com/simster7/argo/v2/workflow/artifactrepositories"
+	controllercache "github.com/simster7/argo/v2/workflow/controller/cache"
 	"github.com/simster7/argo/v2/workflow/hydrator"
 )
 
@@ -27,6 +28,10 @@ func (wfc *WorkflowController) updateConfig(v interface{}) error {
 		return errors.Errorf(errors.CodeBadRequest, "ConfigMap does not have executorImage")
 	}
 	wfc.Config = *config
+	if wfc.cacheFactory != nil {
+		// record the hits counted so far, before the factory and the database session they are recorded with are replaced
+		wfc.cacheFactory.FlushHits(context.Background())
+	}
 	if wfc.session != nil {
 		err := wfc.session.Close()
 		if err != nil {
@@ -37,6 +42,7 @@ func (wfc *WorkflowController) updateConfig(v interface{}) error {
 	wfc.artifactRepositories = artifactrepositories.New(wfc.kubeclientset, wfc.namespace, &wfc.Config.ArtifactRepository)
 	wfc.offloadNodeStatusRepo = sqldb.ExplosiveOffloadNodeStatusRepo
 	wfc.wfArchive = sqldb.NullWorkflowArchive
+	wfc.memoizationCacheRepo = sqldb.NullMemoizationCacheRepo
 	wfc.archiveLabelSelector = labels.Everything()
 	persistence := wfc.Config.Persistence
 	if persistence != nil {
@@ -56,6 +62,7 @@ func (wfc *WorkflowController) updateConfig(v interface{}) error {
 		}
 
 		wfc.session = session
+		wfc.memoizationCacheRepo = sqldb.NewMemoizationCacheRepo(session, persistence.GetClusterName(), persistence.MemoizationCache.GetMaxEntries())
 		if persistence.NodeStatusOffload {
 			wfc.offloadNodeStatusRepo, err = sqldb.NewOffloadNodeStatusRepo(session, persistence.GetClusterName(), tableName)
 			if err != nil {
@@ -81,6 +88,7 @@ func (wfc *WorkflowController) updateConfig(v interface{}) error {
 		log.Info("Persistence configuration disabled")
 	}
 	wfc.hydrator = hydrator.New(wfc.offloadNodeStatusRepo)
+	wfc.cacheFactory = controllercache.NewCacheFactory(wfc.kubeclientset, wfc.memoizationCacheRepo)
 	wfc.updateEstimatorFactory()
 	return nil
 }
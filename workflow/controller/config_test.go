@@ -1,11 +1,14 @@
 package controller
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/simster7/argo/v2/config"
+	"github.com/simster7/argo/v2/workflow/controller/cache"
 )
 
 func TestUpdateConfig(t *testing.T) {
@@ -18,3 +21,28 @@ func TestUpdateConfig(t *testing.T) {
 	assert.NotNil(t, controller.wfArchive)
 	assert.NotNil(t, controller.offloadNodeStatusRepo)
 }
+
+func TestUpdateConfigFlushMemoizationCacheHits(t *testing.T) {
+	cancel, controller := newController()
+	defer cancel()
+	ctx := context.Background()
+	_, err := controller.kubeclientset.CoreV1().ConfigMaps("default").Create(ctx, sampleConfigMapCacheEntry.DeepCopy(), metav1.CreateOptions{})
+	assert.NoError(t, err)
+	hitCount := func() int64 {
+		entry, err := controller.cacheFactory.GetCache(cache.ConfigMapCache, "default", "whalesay-cache").Load(ctx, "hi-there-world")
+		if assert.NoError(t, err) && assert.NotNil(t, entry) {
+			return entry.HitCount
+		}
+		return 0
+	}
+
+	// the hits counted before the configuration is updated are recorded when it is
+	controller.cacheFactory.RecordHit(cache.ConfigMapCache, "default", "whalesay-cache", "hi-there-world")
+	assert.NoError(t, controller.updateConfig(&config.Config{ExecutorImage: "argoexec:latest"}))
+	assert.Equal(t, int64(1), hitCount())
+
+	// and those counted after it are recorded by the flush
+	controller.cacheFactory.RecordHit(cache.ConfigMapCache, "default", "whalesay-cache", "hi-there-world")
+	controller.flushMemoizationCacheHits(ctx)
+	assert.Equal(t, int64(2), hitCount())
+}
@@ -0,0 +1,174 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"time"
+
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1"
+	"github.com/simster7/argo/v2/workflow/common"
+)
+
+func (woc *wfOperationCtx) executeContainerSet(ctx context.Context, nodeName string, templateScope string, tmpl *wfv1.Template, orgTmpl wfv1.TemplateReferenceHolder, opts *executeTemplateOpts) (*wfv1.NodeStatus, error) {
+	node := woc.wf.GetNodeByName(nodeName)
+	if node == nil {
+		node = woc.initializeExecutableNode(nodeName, wfv1.NodeTypePod, templateScope, tmpl, orgTmpl, opts.boundaryID, wfv1.NodePending)
+	}
+
+	includeScriptOutput, err := woc.includeScriptOutput(nodeName, opts.boundaryID)
+	if err != nil {
+		return node, err
+	}
+
+	woc.log.Debugf("Executing node %s with container set template: %v\n", nodeName, tmpl)
+	var mainCtrs []apiv1.Container
+	for _, ctr := range tmpl.ContainerSet.Containers {
+		mainCtrs = append(mainCtrs, ctr.Container)
+	}
+	_, err = woc.createWorkflowPod(ctx, nodeName, mainCtrs, tmpl, &createWorkflowPodOpts{
+		includeScriptOutput: includeScriptOutput,
+		onExitPod:           opts.onExitTemplate,
+		executionDeadline:   opts.executionDeadline,
+	})
+	if err != nil {
+		return woc.requeueIfTransientErr(err, node.Name)
+	}
+
+	// each container of the set is a child of the pod's node, which is the boundary of the containers
+	for _, ctr := range tmpl.ContainerSet.Containers {
+		ctrNodeName := getContainerNodeName(nodeName, ctr.Name)
+		if woc.wf.GetNodeByName(ctrNodeName) == nil {
+			woc.initializeNode(ctrNodeName, wfv1.NodeTypeContainer, templateScope, orgTmpl, node.ID, wfv1.NodePending)
+		}
+		woc.addChildNode(nodeName, ctrNodeName)
+	}
+	return woc.wf.GetNodeByName(nodeName), nil
+}
+
+func getContainerNodeName(podNodeName, containerName string) string {
+	return fmt.Sprintf("%s.%s", podNodeName, containerName)
+}
+
+// assessContainerNodes updates the nodes of the containers of a container set from the statuses of the containers. A
+// container does not start running its command until the containers it depends on have succeeded, so until then its
+// node is pending, and if one of them failed, its node is omitted.
+func (woc *wfOperationCtx) assessContainerNodes(pod *apiv1.Pod, podNode wfv1.NodeStatus) {
+	tmplStr, ok := pod.Annotations[common.AnnotationKeyTemplate]
+	if !ok {
+		return
+	}
+	var tmpl wfv1.Template
+	if err := json.Unmarshal([]byte(tmplStr), &tmpl); err != nil || tmpl.ContainerSet == nil {
+		return
+	}
+	ctrStatuses := make(map[string]apiv1.ContainerStatus)
+	for _, ctrStatus := range pod.Status.ContainerStatuses {
+		ctrStatuses[ctrStatus.Name] = ctrStatus
+	}
+	for _, ctr := range tmpl.ContainerSet.Containers {
+		node := woc.wf.GetNodeByName(getContainerNodeName(podNode.Name, ctr.Name))
+		if node == nil || node.Fulfilled() {
+			continue
+		}
+		ctrStatus := ctrStatuses[ctr.Name]
+		// the time the command started running, which is after all of the dependencies finished
+		startedAt := metav1.Time{}
+		if ctrStatus.State.Running != nil {
+			startedAt = ctrStatus.State.Running.StartedAt
+		} else if ctrStatus.State.Terminated != nil {
+			startedAt = ctrStatus.State.Terminated.StartedAt
+		}
+		dependenciesSucceeded := true
+		failedDependency := ""
+		for _, dep := range ctr.Dependencies {
+			depStatus := ctrStatuses[dep]
+			if depStatus.State.Terminated == nil {
+				dependenciesSucceeded = false
+				continue
+			}
+			if depStatus.State.Terminated.ExitCode != 0 {
+				dependenciesSucceeded = false
+				failedDependency = dep
+				break
+			}
+			if depStatus.State.Terminated.FinishedAt.After(startedAt.Time) {
+				startedAt = depStatus.State.Terminated.FinishedAt
+			}
+		}
+		newNode := node.DeepCopy()
+		switch {
+		case ctrStatus.State.Terminated != nil:
+			terminated := ctrStatus.State.Terminated
+			newNode.FinishedAt = terminated.FinishedAt
+			if failedDependency != "" {
+				newNode.Phase = wfv1.NodeOmitted
+				newNode.Message = fmt.Sprintf("dependency '%s' failed", failedDependency)
+				break
+			}
+			newNode.StartedAt = startedAt
+			exitCode := fmt.Sprint(terminated.ExitCode)
+			if newNode.Outputs == nil {
+				newNode.Outputs = &wfv1.Outputs{}
+			}
+			newNode.Outputs.ExitCode = &exitCode
+			switch {
+			case terminated.ExitCode == 0:
+				newNode.Phase = wfv1.NodeSucceeded
+			case terminated.Message != "":
+				newNode.Phase, newNode.Message = wfv1.NodeFailed, terminated.Message
+			case terminated.Reason == "OOMKilled":
+				newNode.Phase, newNode.Message = wfv1.NodeFailed, terminated.Reason
+			default:
+				newNode.Phase, newNode.Message = wfv1.NodeFailed, fmt.Sprintf("failed with exit code %d", terminated.ExitCode)
+			}
+		case podNode.Fulfilled():
+			// the pod completed without the container doing so, e.g. because it was deleted or an init container failed
+			woc.completeContainerNodes(podNode)
+			return
+		case ctrStatus.State.Running != nil && dependenciesSucceeded:
+			newNode.Phase = wfv1.NodeRunning
+			newNode.StartedAt = startedAt
+		}
+		woc.updateContainerNode(*node, *newNode)
+	}
+}
+
+// completeContainerNodes marks the nodes of the containers of a completed container set's pod that are not yet
+// completed with the phase and message of the pod's node
+func (woc *wfOperationCtx) completeContainerNodes(podNode wfv1.NodeStatus) {
+	phase := wfv1.NodeError
+	if podNode.Phase == wfv1.NodeFailed {
+		phase = wfv1.NodeFailed
+	}
+	for _, childID := range podNode.Children {
+		node, ok := woc.wf.Status.Nodes[childID]
+		if !ok || node.Type != wfv1.NodeTypeContainer || node.Fulfilled() {
+			continue
+		}
+		newNode := node.DeepCopy()
+		newNode.Phase = phase
+		newNode.Message = podNode.Message
+		newNode.FinishedAt = podNode.FinishedAt
+		woc.updateContainerNode(node, *newNode)
+	}
+}
+
+func (woc *wfOperationCtx) updateContainerNode(oldNode, newNode wfv1.NodeStatus) {
+	if reflect.DeepEqual(oldNode, newNode) {
+		return
+	}
+	if newNode.Fulfilled() && newNode.FinishedAt.IsZero() {
+		newNode.FinishedAt = metav1.Time{Time: time.Now().UTC()}
+	}
+	woc.log.Infof("Updating container node %s status %s -> %s", newNode.ID, oldNode.Phase, newNode.Phase)
+	woc.wf.Status.Nodes[newNode.ID] = newNode
+	woc.updated = true
+	if newNode.Fulfilled() {
+		woc.onNodeComplete(&newNode)
+	}
+}
@@ -0,0 +1,135 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1"
+	"github.com/simster7/argo/v2/workflow/common"
+)
+
+var containerSetWf = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: container-set
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    containerSet:
+      volumeMounts:
+      - name: workspace
+        mountPath: /workspace
+      containers:
+      - name: a
+        image: argoproj/argosay:v2
+        command: [sh, -c]
+        args: ["echo a > /workspace/a"]
+      - name: main
+        image: argoproj/argosay:v2
+        command: [sh, -c]
+        args: ["cat /workspace/a"]
+        dependencies: [a]
+  volumes:
+  - name: workspace
+    emptyDir: {}
+`
+
+func withContainerStatuses(ctrStatuses ...apiv1.ContainerStatus) with {
+	return func(pod *apiv1.Pod) { pod.Status.ContainerStatuses = ctrStatuses }
+}
+
+func terminated(name string, exitCode int32) apiv1.ContainerStatus {
+	return apiv1.ContainerStatus{Name: name, State: apiv1.ContainerState{Terminated: &apiv1.ContainerStateTerminated{
+		ExitCode:   exitCode,
+		StartedAt:  metav1.Now(),
+		FinishedAt: metav1.Now(),
+	}}}
+}
+
+func TestContainerSetTemplate(t *testing.T) {
+	ctx := context.Background()
+	wf := unmarshalWF(containerSetWf)
+	cancel, controller := newController(wf)
+	defer cancel()
+
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(ctx)
+
+	pods, err := listPods(woc)
+	if assert.NoError(t, err) && assert.Len(t, pods.Items, 1) {
+		pod := pods.Items[0]
+		if assert.Len(t, pod.Spec.InitContainers, 1) {
+			assert.Contains(t, pod.Spec.InitContainers[0].VolumeMounts, apiv1.VolumeMount{Name: common.VarRunArgoVolumeName, MountPath: common.ExecutorVarRunArgoDir})
+		}
+		var names []string
+		for _, ctr := range pod.Spec.Containers {
+			names = append(names, ctr.Name)
+			if ctr.Name == common.WaitContainerName {
+				// to record the exit codes of the containers that terminate without recording them
+				assert.Contains(t, ctr.VolumeMounts, apiv1.VolumeMount{Name: common.VarRunArgoVolumeName, MountPath: common.ExecutorVarRunArgoDir})
+				continue
+			}
+			assert.Equal(t, []string{"/var/run/argo/argoexec", "run", "--", "sh", "-c"}, ctr.Command)
+			assert.Contains(t, ctr.Env, apiv1.EnvVar{Name: common.EnvVarContainerName, Value: ctr.Name})
+			assert.Contains(t, ctr.VolumeMounts, apiv1.VolumeMount{Name: "workspace", MountPath: "/workspace"})
+			assert.Contains(t, ctr.VolumeMounts, apiv1.VolumeMount{Name: common.VarRunArgoVolumeName, MountPath: common.ExecutorVarRunArgoDir})
+			if ctr.Name == common.MainContainerName {
+				assert.Contains(t, ctr.Env, apiv1.EnvVar{Name: common.EnvVarDependencies, Value: "a"})
+			}
+		}
+		assert.ElementsMatch(t, []string{common.WaitContainerName, "a", common.MainContainerName}, names)
+	}
+
+	podNode := woc.wf.GetNodeByName("container-set")
+	if assert.NotNil(t, podNode) {
+		assert.Equal(t, wfv1.NodeTypePod, podNode.Type)
+		assert.ElementsMatch(t, []string{woc.wf.NodeID("container-set.a"), woc.wf.NodeID("container-set.main")}, podNode.Children)
+	}
+	for _, name := range []string{"container-set.a", "container-set.main"} {
+		node := woc.wf.GetNodeByName(name)
+		if assert.NotNil(t, node) {
+			assert.Equal(t, wfv1.NodeTypeContainer, node.Type)
+			assert.Equal(t, wfv1.NodePending, node.Phase)
+			assert.Equal(t, podNode.ID, node.BoundaryID)
+		}
+	}
+
+	t.Run("Succeeded", func(t *testing.T) {
+		wf := unmarshalWF(containerSetWf)
+		cancel, controller := newController(wf)
+		defer cancel()
+		woc := newWorkflowOperationCtx(wf, controller)
+		woc.operate(ctx)
+		makePodsPhase(ctx, woc, apiv1.PodSucceeded, withContainerStatuses(terminated("a", 0), terminated("main", 0), terminated("wait", 0)))
+		woc = newWorkflowOperationCtx(woc.wf, controller)
+		woc.operate(ctx)
+		assert.Equal(t, wfv1.NodeSucceeded, woc.wf.Status.Phase)
+		assert.Equal(t, wfv1.NodeSucceeded, woc.wf.GetNodeByName("container-set.a").Phase)
+		assert.Equal(t, wfv1.NodeSucceeded, woc.wf.GetNodeByName("container-set.main").Phase)
+	})
+	t.Run("Failed", func(t *testing.T) {
+		wf := unmarshalWF(containerSetWf)
+		cancel, controller := newController(wf)
+		defer cancel()
+		woc := newWorkflowOperationCtx(wf, controller)
+		woc.operate(ctx)
+		// a pod's own message takes precedence over the containers', so clear the one makePodsPhase sets
+		makePodsPhase(ctx, woc, apiv1.PodFailed, func(pod *apiv1.Pod) { pod.Status.Message = "" }, withContainerStatuses(terminated("a", 2), terminated("main", 1), terminated("wait", 0)))
+		woc = newWorkflowOperationCtx(woc.wf, controller)
+		woc.operate(ctx)
+		assert.Equal(t, wfv1.NodeFailed, woc.wf.Status.Phase)
+		a := woc.wf.GetNodeByName("container-set.a")
+		assert.Equal(t, wfv1.NodeFailed, a.Phase)
+		assert.Equal(t, "failed with exit code 2", a.Message)
+		main := woc.wf.GetNodeByName("container-set.main")
+		assert.Equal(t, wfv1.NodeOmitted, main.Phase)
+		assert.Equal(t, "dependency 'a' failed", main.Message)
+		assert.Equal(t, "container 'a' failed with exit code 2", woc.wf.GetNodeByName("container-set").Message)
+	})
+}
@@ -41,7 +41,9 @@ import (
 	wfextvv1alpha1 "github.com/simster7/argo/v2/pkg/client/informers/externalversions/workflow/v1alpha1"
 	authutil "github.com/simster7/argo/v2/util/auth"
 	errorsutil "github.com/simster7/argo/v2/util/errors"
+	"github.com/simster7/argo/v2/util/slice"
 	"github.com/simster7/argo/v2/workflow/artifactrepositories"
+	artifact "github.com/simster7/argo/v2/workflow/artifacts"
 	"github.com/simster7/argo/v2/workflow/common"
 	controllercache "github.com/simster7/argo/v2/workflow/controller/cache"
 	"github.com/simster7/argo/v2/workflow/controller/estimation"
@@ -90,18 +92,23 @@ type WorkflowController struct {
 	wfQueue               workqueue.RateLimitingInterface
 	podQueue              workqueue.RateLimitingInterface
 	podCleanupQueue       workqueue.RateLimitingInterface // pods to be deleted or labelled depend on GC strategy
+	artifactGCQueue       workqueue.RateLimitingInterface // workflows whose artifacts need to be garbage collected
+	artDriverFactory      artifact.NewDriverFunc
 	throttler             sync.Throttler
 	workflowKeyLock       syncpkg.KeyLock // used to lock workflows for exclusive modification or access
 	session               sqlbuilder.Database
 	offloadNodeStatusRepo sqldb.OffloadNodeStatusRepo
 	hydrator              hydrator.Interface
 	wfArchive             sqldb.WorkflowArchive
+	memoizationCacheRepo  sqldb.MemoizationCacheRepo
 	estimatorFactory      estimation.EstimatorFactory
 	syncManager           *sync.Manager
+	syncConfig            *config.SyncConfig // nil unless semaphores and mutexes are stored in the database
 	metrics               *metrics.Metrics
 	eventRecorderManager  events.EventRecorderManager
 	archiveLabelSelector  labels.Selector
 	cacheFactory          controllercache.Factory
+	httpRequests          *httpRequests // the requests of HTTP templates, made in the background
 }
 
 const (
@@ -109,6 +116,8 @@ const (
 	workflowTemplateResyncPeriod        = 20 * time.Minute
 	podResyncPeriod                     = 30 * time.Minute
 	clusterWorkflowTemplateResyncPeriod = 20 * time.Minute
+	// memoizationCacheHitsFlushPeriod is how often the hits of memoization cache entries are recorded in their caches
+	memoizationCacheHitsFlushPeriod = 10 * time.Second
 )
 
 // NewWorkflowController instantiates a new WorkflowController
@@ -130,8 +139,9 @@ func NewWorkflowController(ctx context.Context, restConfig *rest.Config, kubecli
 		containerRuntimeExecutor:   containerRuntimeExecutor,
 		configController:           config.NewController(namespace, configMap, kubeclientset, config.EmptyConfigFunc),
 		workflowKeyLock:            syncpkg.NewKeyLock(),
-		cacheFactory:               controllercache.NewCacheFactory(kubeclientset, namespace),
 		eventRecorderManager:       events.NewEventRecorderManager(kubeclientset),
+		artDriverFactory:           artifact.NewDriver,
+		httpRequests:               newHTTPRequests(denyInternalAddresses),
 	}
 
 	wfc.UpdateConfig(ctx)
@@ -143,6 +153,7 @@ func NewWorkflowController(ctx context.Context, restConfig *rest.Config, kubecli
 	wfc.throttler = wfc.newThrottler()
 	wfc.podQueue = wfc.metrics.RateLimiterWithBusyWorkers(workqueue.DefaultControllerRateLimiter(), "pod_queue")
 	wfc.podCleanupQueue = wfc.metrics.RateLimiterWithBusyWorkers(workqueue.DefaultControllerRateLimiter(), "pod_cleanup_queue")
+	wfc.artifactGCQueue = wfc.metrics.RateLimiterWithBusyWorkers(workqueue.DefaultControllerRateLimiter(), "artifact_gc_queue")
 
 	return &wfc, nil
 }
@@ -179,6 +190,7 @@ func (wfc *WorkflowController) Run(ctx context.Context, wfWorkers, workflowTTLWo
 	defer wfc.wfQueue.ShutDown()
 	defer wfc.podQueue.ShutDown()
 	defer wfc.podCleanupQueue.ShutDown()
+	defer wfc.artifactGCQueue.ShutDown()
 
 	log.WithField("version", argo.GetVersion().Version).Info("Starting Workflow Controller")
 	log.Infof("Workers: workflow: %d, pod: %d, pod cleanup: %d", wfWorkers, podWorkers, podCleanupWorkers)
@@ -233,8 +245,12 @@ func (wfc *WorkflowController) Run(ctx context.Context, wfWorkers, workflowTTLWo
 				for i := 0; i < podCleanupWorkers; i++ {
 					go wait.UntilWithContext(ctx, wfc.runPodCleanup, time.Second)
 				}
+				go wait.UntilWithContext(ctx, wfc.runArtifactGC, time.Second)
 				go wfc.workflowGarbageCollector(ctx.Done())
 				go wfc.archivedWorkflowGarbageCollector(ctx.Done())
+				go wfc.memoizationCacheGarbageCollector(ctx.Done())
+				go wait.UntilWithContext(ctx, wfc.flushMemoizationCacheHits, memoizationCacheHitsFlushPeriod)
+				go wfc.runSyncPoller(ctx.Done())
 
 				go wfc.runTTLController(ctx, workflowTTLWorkers)
 				go wfc.runCronController(ctx)
@@ -296,7 +312,25 @@ func (wfc *WorkflowController) createSynchronizationManager(ctx context.Context)
 		wfc.wfQueue.AddRateLimited(key)
 	}
 
-	wfc.syncManager = sync.NewLockManager(getSyncLimit, nextWorkflow)
+	syncRepo := sqldb.NullSyncRepo
+	if persistence := wfc.Config.Persistence; persistence != nil && persistence.Synchronization != nil {
+		// the session is not closed when the configuration changes, as the locks must outlive it
+		session, _, err := sqldb.CreateDBSession(wfc.kubeclientset, wfc.namespace, persistence)
+		if err != nil {
+			return err
+		}
+		controllerName := persistence.Synchronization.ControllerName
+		if controllerName == "" {
+			controllerName = persistence.GetClusterName()
+			if wfc.Config.InstanceID != "" {
+				controllerName += "-" + wfc.Config.InstanceID
+			}
+		}
+		syncRepo = sqldb.NewSyncRepo(session, controllerName)
+		wfc.syncConfig = persistence.Synchronization
+	}
+
+	wfc.syncManager = sync.NewDatabaseLockManager(getSyncLimit, nextWorkflow, syncRepo)
 
 	labelSelector := v1Label.NewSelector()
 	req, _ := v1Label.NewRequirement(common.LabelKeyPhase, selection.Equals, []string{string(wfv1.NodeRunning)})
@@ -536,6 +570,56 @@ func (wfc *WorkflowController) archivedWorkflowGarbageCollector(stopCh <-chan st
 	}
 }
 
+// runSyncPoller renews this controller's lease on the database semaphores and mutexes, and enqueues the workflows
+// waiting for locks that other controllers have released
+func (wfc *WorkflowController) runSyncPoller(stopCh <-chan struct{}) {
+	if wfc.syncConfig == nil {
+		return
+	}
+	leaseDuration := wfc.syncConfig.GetLeaseDuration()
+	pollInterval := wfc.syncConfig.GetPollInterval()
+	log.WithFields(log.Fields{"leaseDuration": leaseDuration, "pollInterval": pollInterval}).Info("Polling database synchronization")
+	wait.Until(func() { wfc.syncManager.Poll(leaseDuration) }, pollInterval, stopCh)
+}
+
+// flushMemoizationCacheHits records the hits counted by the current cache factory, which is replaced when the
+// configuration is updated
+func (wfc *WorkflowController) flushMemoizationCacheHits(ctx context.Context) {
+	wfc.cacheFactory.FlushHits(ctx)
+}
+
+// memoizationCacheGarbageCollector periodically deletes database memoization cache entries older than their maxAge
+func (wfc *WorkflowController) memoizationCacheGarbageCollector(stopCh <-chan struct{}) {
+	value, ok := os.LookupEnv("MEMOIZATION_CACHE_GC_PERIOD")
+	periodicity := time.Hour
+	if ok {
+		var err error
+		periodicity, err = time.ParseDuration(value)
+		if err != nil {
+			log.WithFields(log.Fields{"err": err, "value": value}).Fatal("Failed to parse MEMOIZATION_CACHE_GC_PERIOD")
+		}
+	}
+	if wfc.Config.Persistence == nil {
+		log.Info("Persistence disabled - so memoization cache GC disabled - you must restart the controller if you enable this")
+		return
+	}
+	log.WithField("periodicity", periodicity).Info("Performing memoization cache GC")
+	ticker := time.NewTicker(periodicity)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stopCh:
+			return
+		case <-ticker.C:
+			log.Info("Performing memoization cache GC")
+			err := wfc.memoizationCacheRepo.DeleteExpired()
+			if err != nil {
+				log.WithField("err", err).Error("Failed to delete expired memoization cache entries")
+			}
+		}
+	}
+}
+
 func (wfc *WorkflowController) runWorker() {
 	ctx := context.Background()
 	for wfc.processNextItem(ctx) {
@@ -704,6 +788,12 @@ func (wfc *WorkflowController) tweakListOptions(options *metav1.ListOptions) {
 	options.LabelSelector = labelSelector.String()
 }
 
+// enqueueParentWorkflow queues the parent of the child workflow, so that the node of the child workflow is updated
+func (wfc *WorkflowController) enqueueParentWorkflow(obj interface{}) {
+	un := obj.(*unstructured.Unstructured)
+	wfc.wfQueue.Add(un.GetNamespace() + "/" + un.GetLabels()[common.LabelKeyParentWorkflow])
+}
+
 func getWfPriority(obj interface{}) (int32, time.Time) {
 	un, ok := obj.(*unstructured.Unstructured)
 	if !ok {
@@ -778,6 +868,40 @@ func (wfc *WorkflowController) addWorkflowInformerHandlers(ctx context.Context)
 		},
 	},
 	)
+	wfc.wfInformer.AddEventHandler(cache.FilteringResourceEventHandler{
+		FilterFunc: func(obj interface{}) bool {
+			un, ok := obj.(*unstructured.Unstructured)
+			return ok && slice.ContainsString(un.GetFinalizers(), common.FinalizerArtifactGC) &&
+				(common.UnstructuredHasCompletedLabel(obj) || un.GetDeletionTimestamp() != nil)
+		},
+		Handler: cache.ResourceEventHandlerFuncs{
+			AddFunc: func(obj interface{}) {
+				wfc.queueWorkflowForArtifactGC(obj)
+			},
+			UpdateFunc: func(_, obj interface{}) {
+				wfc.queueWorkflowForArtifactGC(obj)
+			},
+		},
+	},
+	)
+	wfc.wfInformer.AddEventHandler(cache.FilteringResourceEventHandler{
+		FilterFunc: func(obj interface{}) bool {
+			un, ok := obj.(*unstructured.Unstructured)
+			return ok && un.GetLabels()[common.LabelKeyParentWorkflow] != ""
+		},
+		Handler: cache.ResourceEventHandlerFuncs{
+			UpdateFunc: func(old, new interface{}) {
+				// the parent workflow only needs to know when the phase of its child workflow changes
+				if old.(*unstructured.Unstructured).GetLabels()[common.LabelKeyPhase] != new.(*unstructured.Unstructured).GetLabels()[common.LabelKeyPhase] {
+					wfc.enqueueParentWorkflow(new)
+				}
+			},
+			DeleteFunc: func(obj interface{}) {
+				wfc.enqueueParentWorkflow(obj)
+			},
+		},
+	},
+	)
 	wfc.wfInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 		DeleteFunc: func(obj interface{}) {
 			wf, ok := obj.(*unstructured.Unstructured)
@@ -815,6 +939,8 @@ func (wfc *WorkflowController) archiveWorkflowAux(ctx context.Context, obj inter
 	if err != nil {
 		return fmt.Errorf("failed to hydrate workflow: %w", err)
 	}
+	// the archive must not have the values of sensitive parameters
+	wf.Redact()
 	log.WithFields(log.Fields{"namespace": wf.Namespace, "workflow": wf.Name, "uid": wf.UID}).Info("archiving workflow")
 	err = wfc.wfArchive.ArchiveWorkflow(wf)
 	if err != nil {
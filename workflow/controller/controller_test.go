@@ -162,7 +162,8 @@ func newController(options ...interface{}) (context.CancelFunc, *WorkflowControl
 		estimatorFactory:     estimation.DummyEstimatorFactory,
 		eventRecorderManager: &testEventRecorderManager{eventRecorder: record.NewFakeRecorder(16)},
 		archiveLabelSelector: labels.Everything(),
-		cacheFactory:         controllercache.NewCacheFactory(kube, "default"),
+		cacheFactory:         controllercache.NewCacheFactory(kube, sqldb.NullMemoizationCacheRepo),
+		httpRequests:         newHTTPRequests(nil), // the test servers listen on loopback addresses
 	}
 
 	for _, opt := range options {
@@ -180,6 +181,7 @@ func newController(options ...interface{}) (context.CancelFunc, *WorkflowControl
 		wfc.throttler = wfc.newThrottler()
 		wfc.podQueue = workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
 		wfc.podCleanupQueue = workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
+		wfc.artifactGCQueue = workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
 	}
 
 	// always compare to WorkflowController.Run to see what this block of code should be doing
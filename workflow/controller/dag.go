@@ -170,6 +170,12 @@ func (d *dagContext) assessDAGPhase(targetTasks []string, nodes wfv1.Nodes) wfv1
 			if childNode := getChildNodeIndex(&node, nodes, -1); childNode != nil {
 				uniqueQueue.add(generatePhaseNodes(childNode.Children, branchPhase)...)
 			}
+		} else if node.Type == wfv1.NodeTypeLoop {
+			// Likewise, a fulfilled Loop node reflects the status of its iterations as a whole, and the traversal resumes
+			// at the children of its last iteration.
+			if childNode := lastLoopIteration(&node, nodes); childNode != nil {
+				uniqueQueue.add(generatePhaseNodes(childNode.Children, branchPhase)...)
+			}
 		} else {
 			uniqueQueue.add(generatePhaseNodes(node.Children, branchPhase)...)
 		}
@@ -261,6 +267,35 @@ func (woc *wfOperationCtx) executeDAG(ctx context.Context, nodeName string, tmpl
 		}
 	}
 
+	// Run the lifecycle hooks of the tasks. This considers every task, as a task is no longer visited once its dependents
+	// have run. The hook nodes are children of the task nodes, so the DAG does not complete until they are fulfilled.
+	for _, task := range tmpl.DAG.Tasks {
+		if len(task.Hooks) == 0 {
+			continue
+		}
+		taskNode := dagCtx.getTaskNode(task.Name)
+		if taskNode == nil {
+			continue
+		}
+		taskNodes := []*wfv1.NodeStatus{taskNode}
+		if taskNode.Type == wfv1.NodeTypeTaskGroup {
+			// the hooks of an expanded task are run for each of its expansions
+			taskNodes = nil
+			for _, childID := range taskNode.Children {
+				childNode := woc.wf.Status.Nodes[childID]
+				taskNodes = append(taskNodes, &childNode)
+			}
+		}
+		scope, err := woc.buildLocalScopeFromTask(dagCtx, &task)
+		if err != nil {
+			return node, err
+		}
+		for _, taskNode := range taskNodes {
+			params := woc.lifecycleHookParams(scope, fmt.Sprintf("tasks.%s", task.Name), taskNode)
+			woc.executeLifecycleHooks(ctx, task.Hooks, taskNode.Name, woc.lifecycleHookParentNodeName(taskNode), params, dagCtx.tmplCtx, &executeTemplateOpts{boundaryID: dagCtx.boundaryID, onExitTemplate: dagCtx.onExitTemplate})
+		}
+	}
+
 	// check if we are still running any tasks in this dag and return early if we do
 	dagPhase := dagCtx.assessDAGPhase(targetTasks, woc.wf.Status.Nodes)
 	switch dagPhase {
@@ -422,7 +457,7 @@ func (woc *wfOperationCtx) executeDAGTask(ctx context.Context, dagCtx *dagContex
 
 	// Next, expand the DAG's withItems/withParams/withSequence (if any). If there was none, then
 	// expandedTasks will be a single element list of the same task
-	expandedTasks, err := expandTask(*newTask)
+	expandedTasks, err := woc.expandTask(ctx, dagCtx.boundaryName, *newTask)
 	if err != nil {
 		woc.initializeNode(nodeName, wfv1.NodeTypeSkipped, dagTemplateScope, task, dagCtx.boundaryID, wfv1.NodeError, err.Error())
 		connectDependencies(nodeName)
@@ -442,6 +477,10 @@ func (woc *wfOperationCtx) executeDAGTask(ctx context.Context, dagCtx *dagContex
 	for _, t := range expandedTasks {
 		taskNodeName := dagCtx.taskNodeName(t.Name)
 		node = dagCtx.getTaskNode(t.Name)
+		// a task that was skipped, e.g. as its when evaluated false, is not executed
+		if node != nil && node.Type == wfv1.NodeTypeSkipped {
+			continue
+		}
 		if node == nil {
 			woc.log.Infof("All of node %s dependencies %v completed", taskNodeName, taskDependencies)
 			// Add the child relationship from our dependency's outbound nodes to this node.
@@ -461,7 +500,12 @@ func (woc *wfOperationCtx) executeDAGTask(ctx context.Context, dagCtx *dagContex
 		}
 
 		// Finally execute the template
-		node, err = woc.executeTemplate(ctx, taskNodeName, &t, dagCtx.tmplCtx, t.Arguments, &executeTemplateOpts{boundaryID: dagCtx.boundaryID, onExitTemplate: dagCtx.onExitTemplate})
+		opts := &executeTemplateOpts{boundaryID: dagCtx.boundaryID, onExitTemplate: dagCtx.onExitTemplate}
+		if t.Loop != nil {
+			node, err = woc.executeLoop(ctx, taskNodeName, &t, t.Loop, dagCtx.tmplCtx, t.Arguments, opts)
+		} else {
+			node, err = woc.executeTemplate(ctx, taskNodeName, &t, dagCtx.tmplCtx, t.Arguments, opts)
+		}
 		if err != nil {
 			switch err {
 			case ErrDeadlineExceeded:
@@ -516,7 +560,8 @@ func (woc *wfOperationCtx) buildLocalScopeFromTask(dagCtx *dagContext, task *wfv
 					ancestorNodes = append(ancestorNodes, node)
 				}
 			}
-			_, tmpl, templateStored, err := dagCtx.tmplCtx.ResolveTemplate(ancestorNode)
+			// The template of the task may be inline, so it is resolved from the task rather than from its node
+			_, tmpl, templateStored, err := dagCtx.tmplCtx.ResolveTemplate(dagCtx.GetTask(ancestor))
 			if err != nil {
 				return nil, errors.InternalWrapError(err)
 			}
@@ -552,8 +597,11 @@ func (woc *wfOperationCtx) resolveDependencyReferences(dagCtx *dagContext, task
 		return nil, err
 	}
 
-	// Replace task's parameters
-	taskBytes, err := json.Marshal(task)
+	// Replace task's parameters, but not those of its inline template, which is substituted with its own inputs when it
+	// is executed
+	taskWithoutInline := *task
+	taskWithoutInline.Inline = nil
+	taskBytes, err := json.Marshal(taskWithoutInline)
 	if err != nil {
 		return nil, errors.InternalWrapError(err)
 	}
@@ -571,6 +619,7 @@ func (woc *wfOperationCtx) resolveDependencyReferences(dagCtx *dagContext, task
 	if err != nil {
 		return nil, errors.InternalWrapError(err)
 	}
+	newTask.Inline = task.Inline
 
 	// If we are not executing, don't attempt to resolve any artifact references. We only check if we are executing after
 	// the initial parameter resolution, since it's likely that the "when" clause will contain parameter references.
@@ -606,6 +655,13 @@ func (woc *wfOperationCtx) resolveDependencyReferences(dagCtx *dagContext, task
 		resolvedArt.Name = art.Name
 		newTask.Arguments.Artifacts[j] = *resolvedArt
 	}
+	if newTask.WithArtifact != nil && newTask.WithArtifact.From != "" {
+		resolvedArt, err := scope.resolveArtifact(newTask.WithArtifact.From, "")
+		if err != nil {
+			return nil, err
+		}
+		newTask.WithArtifact.ArtifactLocation = resolvedArt.ArtifactLocation
+	}
 	return &newTask, nil
 }
 
@@ -630,10 +686,12 @@ func (d *dagContext) findLeafTaskNames(tasks []wfv1.DAGTask) []string {
 	return leafTaskNames
 }
 
-// expandTask expands a single DAG task containing withItems, withParams, withSequence into multiple parallel tasks
-func expandTask(task wfv1.DAGTask) ([]wfv1.DAGTask, error) {
+// expandTask expands a single DAG task containing withItems, withParams, withSequence, withArtifact into multiple
+// parallel tasks
+func (woc *wfOperationCtx) expandTask(ctx context.Context, boundaryName string, task wfv1.DAGTask) ([]wfv1.DAGTask, error) {
 	var err error
 	var items []wfv1.Item
+	withArtifact := task.WithArtifact
 	if len(task.WithItems) > 0 {
 		items = task.WithItems
 	} else if task.WithParam != "" {
@@ -646,10 +704,15 @@ func expandTask(task wfv1.DAGTask) ([]wfv1.DAGTask, error) {
 		if err != nil {
 			return nil, err
 		}
-	} else {
+	} else if withArtifact == nil {
 		return []wfv1.DAGTask{task}, nil
 	}
 
+	// the items are passed to an inline template as arguments, as they are to a template that is referred to by name
+	inline := task.Inline
+	task.Inline = nil
+	// the artifact has already been resolved, and is not needed by the expanded tasks
+	task.WithArtifact = nil
 	taskBytes, err := json.Marshal(task)
 	if err != nil {
 		return nil, errors.InternalWrapError(err)
@@ -666,15 +729,48 @@ func expandTask(task wfv1.DAGTask) ([]wfv1.DAGTask, error) {
 		return nil, fmt.Errorf("unable to parse argo varaible: %w", err)
 	}
 	expandedTasks := make([]wfv1.DAGTask, 0)
-	for i, item := range items {
+	expand := func(i int, item wfv1.Item, batch string) error {
 		var newTask wfv1.DAGTask
 		newTaskName, err := processItem(fstTmpl, task.Name, i, item, &newTask)
 		if err != nil {
-			return nil, err
+			return err
+		}
+		// a batch is named after the range of its items, rather than after the items themselves
+		if batch != "" {
+			newTaskName = generateNodeName(task.Name, i, batch)
 		}
 		newTask.Name = newTaskName
 		newTask.Template = task.Template
+		newTask.Inline = inline
 		expandedTasks = append(expandedTasks, newTask)
+		return nil
+	}
+	// the items of an artifact are read as the task is expanded, rather than all at once, and only until all of the
+	// children they expand into exist
+	if withArtifact != nil {
+		if children, ok := woc.withArtifactChildren(boundaryName, task.Name); ok {
+			for _, child := range children {
+				newTask := task
+				newTask.Name = strings.TrimPrefix(child.Name, boundaryName+".")
+				newTask.Arguments = withArtifactChildArguments(child)
+				// the when of the task was evaluated as its node was created
+				newTask.When = ""
+				newTask.Inline = inline
+				expandedTasks = append(expandedTasks, newTask)
+			}
+			return expandedTasks, nil
+		}
+		err = woc.forEachArtifactItem(ctx, withArtifact, expand)
+		if err != nil {
+			return nil, err
+		}
+		woc.recordWithArtifactChildren(boundaryName, task.Name, len(expandedTasks))
+		return expandedTasks, nil
+	}
+	for i, item := range items {
+		if err := expand(i, item, ""); err != nil {
+			return nil, err
+		}
 	}
 	return expandedTasks, nil
 }
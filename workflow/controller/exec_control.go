@@ -39,6 +39,7 @@ func (woc *wfOperationCtx) applyExecutionControl(ctx context.Context, pod *apiv1
 					defer wfNodesLock.Unlock()
 					node := woc.wf.Status.Nodes[pod.Name]
 					woc.markNodePhase(node.Name, wfv1.NodeFailed, fmt.Sprintf("workflow shutdown with strategy:  %s", woc.execWf.Spec.Shutdown))
+					woc.markNodeFailureReason(node.Name, pendingPodFailureReason(pod, ""))
 					return nil
 				}
 				// If we fail to delete the pod, fall back to setting the annotation
@@ -58,6 +59,7 @@ func (woc *wfOperationCtx) applyExecutionControl(ctx context.Context, pod *apiv1
 					defer wfNodesLock.Unlock()
 					node := woc.wf.Status.Nodes[pod.Name]
 					woc.markNodePhase(node.Name, wfv1.NodeFailed, "Step exceeded its deadline")
+					woc.markNodeFailureReason(node.Name, pendingPodFailureReason(pod, wfv1.FailureReasonDeadlineExceeded))
 					return nil
 				}
 				// If we fail to delete the pod, fall back to setting the annotation
@@ -0,0 +1,77 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+	apiv1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/simster7/argo/v2/errors"
+	wfv1 "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1"
+	"github.com/simster7/argo/v2/util/instanceid"
+	"github.com/simster7/argo/v2/workflow/common"
+)
+
+// succeededInThisOperation returns whether the node succeeded during the current operation
+func (woc *wfOperationCtx) succeededInThisOperation(node *wfv1.NodeStatus) bool {
+	prevPhase, ok := woc.preExecutionNodePhases[node.ID]
+	return (!ok || !prevPhase.Fulfilled()) && node.Phase == wfv1.NodeSucceeded
+}
+
+// exportOutputParameters writes the values of the output parameters of the template that are exported to config maps,
+// using the credentials of the controller. A config map that exists must have been created by an export, and be managed
+// by the controller's instance ID, so that a workflow cannot overwrite any other config map.
+func (woc *wfOperationCtx) exportOutputParameters(ctx context.Context, tmpl *wfv1.Template, node *wfv1.NodeStatus) error {
+	for _, param := range tmpl.Outputs.Parameters {
+		if param.ExportTo == nil || param.ExportTo.ConfigMapKeyRef == nil {
+			continue
+		}
+		output := node.Outputs.GetParameterByName(param.Name)
+		if output == nil || output.Value == nil {
+			return errors.Errorf(errors.CodeBadRequest, "outputs.parameters.%s has no value to export", param.Name)
+		}
+		ref := param.ExportTo.ConfigMapKeyRef
+		err := woc.writeConfigMapKey(ctx, ref.Name, ref.Key, output.Value.String())
+		if err != nil {
+			return errors.Errorf(errors.CodeBadRequest, "failed to export outputs.parameters.%s to ConfigMap '%s': %v", param.Name, ref.Name, err)
+		}
+		woc.log.WithFields(log.Fields{"nodeID": node.ID, "configMap": ref.Name, "key": ref.Key}).Info("Exported output parameter")
+	}
+	return nil
+}
+
+func (woc *wfOperationCtx) writeConfigMapKey(ctx context.Context, name, key, value string) error {
+	configMaps := woc.controller.kubeclientset.CoreV1().ConfigMaps(woc.wf.Namespace)
+	instanceIDService := instanceid.NewService(woc.controller.Config.InstanceID)
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cm, err := configMaps.Get(ctx, name, metav1.GetOptions{})
+		if apierr.IsNotFound(err) {
+			cm = &apiv1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{common.LabelKeyCreatedByWorkflowExport: "true"}},
+				Data:       map[string]string{key: value},
+			}
+			instanceIDService.Label(cm)
+			_, err = configMaps.Create(ctx, cm, metav1.CreateOptions{})
+			return err
+		}
+		if err != nil {
+			return err
+		}
+		if cm.Labels[common.LabelKeyCreatedByWorkflowExport] != "true" {
+			return fmt.Errorf("it was not created by a workflow export, as it does not have the label %s=true", common.LabelKeyCreatedByWorkflowExport)
+		}
+		if cm.Labels[common.LabelKeyControllerInstanceID] != woc.controller.Config.InstanceID {
+			return fmt.Errorf("its %s label is not '%s'", common.LabelKeyControllerInstanceID, woc.controller.Config.InstanceID)
+		}
+		if cm.Data == nil {
+			cm.Data = make(map[string]string)
+		}
+		cm.Data[key] = value
+		_, err = configMaps.Update(ctx, cm, metav1.UpdateOptions{})
+		return err
+	})
+}
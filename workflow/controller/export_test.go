@@ -0,0 +1,127 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1"
+	testutil "github.com/simster7/argo/v2/test/util"
+	"github.com/simster7/argo/v2/workflow/common"
+)
+
+var exportToWf = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: export-to
+  namespace: default
+spec:
+  entrypoint: build
+  templates:
+  - name: build
+    container:
+      image: alpine:3.7
+      command: [sh, -c, "echo sha256:abc > /tmp/digest"]
+    outputs:
+      parameters:
+      - name: digest
+        valueFrom:
+          path: /tmp/digest
+        exportTo:
+          configMapKeyRef:
+            name: build
+            key: digest
+`
+
+func TestExportOutputParameters(t *testing.T) {
+	ctx := context.Background()
+	outputs := wfv1.Outputs{Parameters: []wfv1.Parameter{{Name: "digest", Value: wfv1.AnyStringPtr("sha256:abc")}}}
+
+	t.Run("Created", func(t *testing.T) {
+		wf := unmarshalWF(exportToWf)
+		cancel, controller := newController(wf)
+		defer cancel()
+		controller.Config.InstanceID = "my-instance"
+		woc := newWorkflowOperationCtx(wf, controller)
+		woc.operate(ctx)
+		makePodsPhase(ctx, woc, apiv1.PodSucceeded, withOutputs(testutil.MustMarshallJSON(outputs)))
+		woc = newWorkflowOperationCtx(woc.wf, controller)
+		woc.operate(ctx)
+		assert.Equal(t, wfv1.NodeSucceeded, woc.wf.Status.Phase)
+		cm, err := controller.kubeclientset.CoreV1().ConfigMaps("default").Get(ctx, "build", metav1.GetOptions{})
+		if assert.NoError(t, err) {
+			assert.Equal(t, "sha256:abc", cm.Data["digest"])
+			assert.Equal(t, "my-instance", cm.Labels[common.LabelKeyControllerInstanceID])
+			assert.Equal(t, "true", cm.Labels[common.LabelKeyCreatedByWorkflowExport])
+		}
+	})
+	t.Run("Updated", func(t *testing.T) {
+		wf := unmarshalWF(exportToWf)
+		cancel, controller := newController(wf)
+		defer cancel()
+		_, err := controller.kubeclientset.CoreV1().ConfigMaps("default").Create(ctx, &apiv1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "build", Labels: map[string]string{common.LabelKeyCreatedByWorkflowExport: "true"}},
+			Data:       map[string]string{"digest": "sha256:old", "tag": "v1"},
+		}, metav1.CreateOptions{})
+		assert.NoError(t, err)
+		woc := newWorkflowOperationCtx(wf, controller)
+		woc.operate(ctx)
+		makePodsPhase(ctx, woc, apiv1.PodSucceeded, withOutputs(testutil.MustMarshallJSON(outputs)))
+		woc = newWorkflowOperationCtx(woc.wf, controller)
+		woc.operate(ctx)
+		cm, err := controller.kubeclientset.CoreV1().ConfigMaps("default").Get(ctx, "build", metav1.GetOptions{})
+		if assert.NoError(t, err) {
+			assert.Equal(t, map[string]string{"digest": "sha256:abc", "tag": "v1"}, cm.Data)
+		}
+	})
+	t.Run("NotCreatedByExport", func(t *testing.T) {
+		wf := unmarshalWF(exportToWf)
+		cancel, controller := newController(wf)
+		defer cancel()
+		_, err := controller.kubeclientset.CoreV1().ConfigMaps("default").Create(ctx, &apiv1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "build"},
+			Data:       map[string]string{"digest": "sha256:old"},
+		}, metav1.CreateOptions{})
+		assert.NoError(t, err)
+		woc := newWorkflowOperationCtx(wf, controller)
+		woc.operate(ctx)
+		makePodsPhase(ctx, woc, apiv1.PodSucceeded, withOutputs(testutil.MustMarshallJSON(outputs)))
+		woc = newWorkflowOperationCtx(woc.wf, controller)
+		woc.operate(ctx)
+		node := woc.wf.Status.Nodes.FindByDisplayName("export-to")
+		if assert.NotNil(t, node) {
+			assert.Equal(t, wfv1.NodeError, node.Phase)
+			assert.Contains(t, node.Message, "it was not created by a workflow export")
+		}
+		cm, err := controller.kubeclientset.CoreV1().ConfigMaps("default").Get(ctx, "build", metav1.GetOptions{})
+		if assert.NoError(t, err) {
+			assert.Equal(t, map[string]string{"digest": "sha256:old"}, cm.Data)
+		}
+	})
+	t.Run("OtherInstanceID", func(t *testing.T) {
+		wf := unmarshalWF(exportToWf)
+		cancel, controller := newController(wf)
+		defer cancel()
+		_, err := controller.kubeclientset.CoreV1().ConfigMaps("default").Create(ctx, &apiv1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "build", Labels: map[string]string{
+				common.LabelKeyCreatedByWorkflowExport: "true",
+				common.LabelKeyControllerInstanceID:    "other-instance",
+			}},
+		}, metav1.CreateOptions{})
+		assert.NoError(t, err)
+		woc := newWorkflowOperationCtx(wf, controller)
+		woc.operate(ctx)
+		makePodsPhase(ctx, woc, apiv1.PodSucceeded, withOutputs(testutil.MustMarshallJSON(outputs)))
+		woc = newWorkflowOperationCtx(woc.wf, controller)
+		woc.operate(ctx)
+		node := woc.wf.Status.Nodes.FindByDisplayName("export-to")
+		if assert.NotNil(t, node) {
+			assert.Equal(t, wfv1.NodeError, node.Phase)
+			assert.Contains(t, node.Message, "failed to export outputs.parameters.digest to ConfigMap 'build'")
+		}
+	})
+}
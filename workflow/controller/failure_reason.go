@@ -0,0 +1,159 @@
+package controller
+
+import (
+	"strings"
+	"time"
+
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/utils/pointer"
+
+	wfv1 "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1"
+	"github.com/simster7/argo/v2/workflow/common"
+)
+
+// podConditionDisruptionTarget is the type of the condition a pod has when it is about to be deleted because of a
+// disruption, e.g. preemption or eviction
+const podConditionDisruptionTarget apiv1.PodConditionType = "DisruptionTarget"
+
+// imagePullReasons are the reasons a container is waiting for when its image cannot be pulled
+var imagePullReasons = map[string]bool{
+	"ImagePullBackOff":  true,
+	"ErrImagePull":      true,
+	"ErrImageNeverPull": true,
+	"InvalidImageName":  true,
+}
+
+// podFailureReason returns the cause of the pod failing, which is empty if it is not known, and the exit code of its
+// main container, which is nil if it did not exit. The reason of the pod, e.g. Evicted, takes precedence over those of
+// its containers, as it is why they were stopped, unless a container is waiting for an image that cannot be pulled, as
+// that is why the pod did not run, e.g. until its deadline was exceeded.
+func podFailureReason(pod *apiv1.Pod) (wfv1.FailureReason, *int32) {
+	exitCode := podExitCode(pod)
+	if podImagePullFailed(pod) {
+		return wfv1.FailureReasonImagePull, exitCode
+	}
+	switch pod.Status.Reason {
+	case "Evicted":
+		return wfv1.FailureReasonEvicted, exitCode
+	case "DeadlineExceeded":
+		return wfv1.FailureReasonDeadlineExceeded, exitCode
+	case "NodeLost":
+		return wfv1.FailureReasonNodeLost, exitCode
+	case "Preempting":
+		return wfv1.FailureReasonPreempted, exitCode
+	}
+	for _, cond := range pod.Status.Conditions {
+		if cond.Type != podConditionDisruptionTarget || cond.Status != apiv1.ConditionTrue {
+			continue
+		}
+		switch cond.Reason {
+		case "PreemptionByKubeScheduler", "PreemptionByScheduler":
+			return wfv1.FailureReasonPreempted, exitCode
+		case "EvictionByEvictionAPI", "TerminationByKubelet":
+			return wfv1.FailureReasonEvicted, exitCode
+		case "DeletionByTaintManager", "DeletionByPodGC":
+			return wfv1.FailureReasonNodeLost, exitCode
+		}
+	}
+	// the executor kills the main container of a step that exceeds its deadline
+	if pod.Annotations[common.AnnotationKeyNodeMessage] == "Step exceeded its deadline" {
+		return wfv1.FailureReasonDeadlineExceeded, exitCode
+	}
+	// the init container loads the input artifacts
+	for _, ctr := range pod.Status.InitContainerStatuses {
+		if ctr.State.Terminated != nil && ctr.State.Terminated.ExitCode != 0 {
+			return wfv1.FailureReasonArtifactError, exitCode
+		}
+	}
+	containerSetNames := getContainerSetNames(pod)
+	waitFailed := false
+	ctrFailed := false
+	for _, ctr := range pod.Status.ContainerStatuses {
+		if ctr.State.Terminated == nil || ctr.State.Terminated.ExitCode == 0 {
+			continue
+		}
+		switch {
+		case ctr.Name == common.WaitContainerName:
+			waitFailed = true
+		case ctr.State.Terminated.Reason == "OOMKilled":
+			return wfv1.FailureReasonOOMKilled, exitCode
+		case ctr.Name == common.MainContainerName || containerSetNames[ctr.Name]:
+			ctrFailed = true
+		case ctr.State.Terminated.ExitCode != 137 && ctr.State.Terminated.ExitCode != 143:
+			// as in inferFailedReason, sidecars that were killed by the executor are ignored
+			ctrFailed = true
+		}
+	}
+	if ctrFailed {
+		return wfv1.FailureReasonExitCode, exitCode
+	}
+	// the wait container saves the outputs
+	if waitFailed {
+		return wfv1.FailureReasonArtifactError, exitCode
+	}
+	return "", exitCode
+}
+
+// podImagePullFailed returns whether an init or main container of the pod is waiting for an image that cannot be pulled
+func podImagePullFailed(pod *apiv1.Pod) bool {
+	for _, statuses := range [][]apiv1.ContainerStatus{pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses} {
+		for _, ctr := range statuses {
+			if ctr.State.Waiting != nil && imagePullReasons[ctr.State.Waiting.Reason] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// pendingPodFailureReason returns the cause of a pending pod being failed for the reason, e.g. because the workflow
+// exceeded its deadline, which is that its image cannot be pulled if a container is waiting for it
+func pendingPodFailureReason(pod *apiv1.Pod, reason wfv1.FailureReason) wfv1.FailureReason {
+	if podImagePullFailed(pod) {
+		return wfv1.FailureReasonImagePull
+	}
+	return reason
+}
+
+// pendingNodeFailureReason is pendingPodFailureReason for a pending node, whose message is the reason its pod is
+// waiting for, see getPendingReason
+func pendingNodeFailureReason(node wfv1.NodeStatus, reason wfv1.FailureReason) wfv1.FailureReason {
+	if imagePullReasons[strings.SplitN(node.Message, ":", 2)[0]] {
+		return wfv1.FailureReasonImagePull
+	}
+	return reason
+}
+
+// podExitCode returns the exit code of the main container of the pod, or of the first container of its container set
+// to fail, or nil if it did not exit
+func podExitCode(pod *apiv1.Pod) *int32 {
+	containerSetNames := getContainerSetNames(pod)
+	var exitCode *int32
+	var firstFailedAt time.Time
+	for _, ctr := range pod.Status.ContainerStatuses {
+		terminated := ctr.State.Terminated
+		if terminated == nil {
+			continue
+		}
+		if ctr.Name == common.MainContainerName {
+			return pointer.Int32Ptr(terminated.ExitCode)
+		}
+		if containerSetNames[ctr.Name] && terminated.ExitCode != 0 && (exitCode == nil || terminated.FinishedAt.Time.Before(firstFailedAt)) {
+			exitCode = pointer.Int32Ptr(terminated.ExitCode)
+			firstFailedAt = terminated.FinishedAt.Time
+		}
+	}
+	return exitCode
+}
+
+// markNodeFailureReason sets the cause of the node failing, if it is known
+func (woc *wfOperationCtx) markNodeFailureReason(nodeName string, reason wfv1.FailureReason) {
+	node := woc.wf.GetNodeByName(nodeName)
+	if node == nil || reason == "" || node.FailureReason == reason {
+		return
+	}
+	woc.log.Infof("node %s failure reason: %s", node.ID, reason)
+	node.FailureReason = reason
+	woc.wf.Status.Nodes[node.ID] = *node
+	woc.updated = true
+}
@@ -0,0 +1,198 @@
+package controller
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	wfv1 "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1"
+	"github.com/simster7/argo/v2/workflow/common"
+)
+
+func terminatedStatus(name string, exitCode int32, reason string) apiv1.ContainerStatus {
+	return apiv1.ContainerStatus{
+		Name:  name,
+		State: apiv1.ContainerState{Terminated: &apiv1.ContainerStateTerminated{ExitCode: exitCode, Reason: reason}},
+	}
+}
+
+func TestPodFailureReason(t *testing.T) {
+	for name, tt := range map[string]struct {
+		pod      apiv1.Pod
+		reason   wfv1.FailureReason
+		exitCode *int32
+	}{
+		"ExitCode": {
+			pod: apiv1.Pod{Status: apiv1.PodStatus{ContainerStatuses: []apiv1.ContainerStatus{
+				terminatedStatus(common.WaitContainerName, 0, "Completed"),
+				terminatedStatus(common.MainContainerName, 1, "Error"),
+			}}},
+			reason:   wfv1.FailureReasonExitCode,
+			exitCode: pointer.Int32Ptr(1),
+		},
+		"OOMKilled": {
+			pod: apiv1.Pod{Status: apiv1.PodStatus{ContainerStatuses: []apiv1.ContainerStatus{
+				terminatedStatus(common.MainContainerName, 137, "OOMKilled"),
+			}}},
+			reason:   wfv1.FailureReasonOOMKilled,
+			exitCode: pointer.Int32Ptr(137),
+		},
+		"Evicted": {
+			pod: apiv1.Pod{Status: apiv1.PodStatus{Reason: "Evicted", ContainerStatuses: []apiv1.ContainerStatus{
+				terminatedStatus(common.MainContainerName, 137, "Error"),
+			}}},
+			reason:   wfv1.FailureReasonEvicted,
+			exitCode: pointer.Int32Ptr(137),
+		},
+		"PodDeadlineExceeded": {
+			pod:    apiv1.Pod{Status: apiv1.PodStatus{Reason: "DeadlineExceeded"}},
+			reason: wfv1.FailureReasonDeadlineExceeded,
+		},
+		"StepDeadlineExceeded": {
+			pod: apiv1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{common.AnnotationKeyNodeMessage: "Step exceeded its deadline"}},
+				Status: apiv1.PodStatus{ContainerStatuses: []apiv1.ContainerStatus{
+					terminatedStatus(common.MainContainerName, 143, "Error"),
+				}},
+			},
+			reason:   wfv1.FailureReasonDeadlineExceeded,
+			exitCode: pointer.Int32Ptr(143),
+		},
+		"NodeLost": {
+			pod:    apiv1.Pod{Status: apiv1.PodStatus{Reason: "NodeLost"}},
+			reason: wfv1.FailureReasonNodeLost,
+		},
+		"Preempted": {
+			pod: apiv1.Pod{Status: apiv1.PodStatus{Conditions: []apiv1.PodCondition{
+				{Type: podConditionDisruptionTarget, Status: apiv1.ConditionTrue, Reason: "PreemptionByKubeScheduler"},
+			}}},
+			reason: wfv1.FailureReasonPreempted,
+		},
+		"ImagePull": {
+			pod: apiv1.Pod{Status: apiv1.PodStatus{ContainerStatuses: []apiv1.ContainerStatus{{
+				Name:  common.MainContainerName,
+				State: apiv1.ContainerState{Waiting: &apiv1.ContainerStateWaiting{Reason: "ImagePullBackOff"}},
+			}}}},
+			reason: wfv1.FailureReasonImagePull,
+		},
+		"ImagePullDeadlineExceeded": {
+			pod: apiv1.Pod{Status: apiv1.PodStatus{
+				Reason: "DeadlineExceeded",
+				InitContainerStatuses: []apiv1.ContainerStatus{{
+					Name:  common.InitContainerName,
+					State: apiv1.ContainerState{Waiting: &apiv1.ContainerStateWaiting{Reason: "ErrImagePull"}},
+				}},
+			}},
+			reason: wfv1.FailureReasonImagePull,
+		},
+		"LoadArtifacts": {
+			pod: apiv1.Pod{Status: apiv1.PodStatus{InitContainerStatuses: []apiv1.ContainerStatus{
+				terminatedStatus(common.InitContainerName, 1, "Error"),
+			}}},
+			reason: wfv1.FailureReasonArtifactError,
+		},
+		"SaveArtifacts": {
+			pod: apiv1.Pod{Status: apiv1.PodStatus{ContainerStatuses: []apiv1.ContainerStatus{
+				terminatedStatus(common.WaitContainerName, 1, "Error"),
+				terminatedStatus(common.MainContainerName, 0, "Completed"),
+				terminatedStatus("sidecar", 137, "Error"),
+			}}},
+			reason:   wfv1.FailureReasonArtifactError,
+			exitCode: pointer.Int32Ptr(0),
+		},
+		"Unknown": {
+			pod: apiv1.Pod{Status: apiv1.PodStatus{ContainerStatuses: []apiv1.ContainerStatus{
+				terminatedStatus(common.MainContainerName, 0, "Completed"),
+			}}},
+			exitCode: pointer.Int32Ptr(0),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			reason, exitCode := podFailureReason(&tt.pod)
+			assert.Equal(t, tt.reason, reason)
+			assert.Equal(t, tt.exitCode, exitCode)
+		})
+	}
+}
+
+func TestAssessNodeStatusFailureReason(t *testing.T) {
+	cancel, controller := newController()
+	defer cancel()
+	woc := newWorkflowOperationCtx(unmarshalWF(helloWorldWf), controller)
+	node := &wfv1.NodeStatus{ID: "my-pod", Phase: wfv1.NodeRunning}
+	pod := &apiv1.Pod{Status: apiv1.PodStatus{
+		Phase: apiv1.PodFailed,
+		ContainerStatuses: []apiv1.ContainerStatus{
+			terminatedStatus(common.WaitContainerName, 0, "Completed"),
+			terminatedStatus(common.MainContainerName, 137, "OOMKilled"),
+		},
+	}}
+	node = woc.assessNodeStatus(pod, node)
+	if assert.NotNil(t, node) {
+		assert.Equal(t, wfv1.NodeFailed, node.Phase)
+		assert.Equal(t, "OOMKilled", node.Message)
+		assert.Equal(t, wfv1.FailureReasonOOMKilled, node.FailureReason)
+		assert.Equal(t, pointer.Int32Ptr(137), node.ExitCode)
+	}
+}
+
+func TestPendingPodFailureReason(t *testing.T) {
+	ctx := context.Background()
+	cancel, controller := newController()
+	defer cancel()
+	woc := newWorkflowOperationCtx(unmarshalWF(helloWorldWf), controller)
+	woc.workflowDeadline = &time.Time{}
+	for name, tt := range map[string]struct {
+		waiting string
+		reason  wfv1.FailureReason
+	}{
+		"ImagePull":        {"ImagePullBackOff", wfv1.FailureReasonImagePull},
+		"DeadlineExceeded": {"ContainerCreating", wfv1.FailureReasonDeadlineExceeded},
+	} {
+		t.Run(name, func(t *testing.T) {
+			nodeID := woc.wf.NodeID(name)
+			pod := &apiv1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: nodeID, Namespace: woc.wf.Namespace},
+				Status: apiv1.PodStatus{
+					Phase: apiv1.PodPending,
+					ContainerStatuses: []apiv1.ContainerStatus{{
+						Name:  common.MainContainerName,
+						State: apiv1.ContainerState{Waiting: &apiv1.ContainerStateWaiting{Reason: tt.waiting}},
+					}},
+				},
+			}
+			_, err := controller.kubeclientset.CoreV1().Pods(pod.Namespace).Create(ctx, pod, metav1.CreateOptions{})
+			assert.NoError(t, err)
+			woc.wf.Status.Nodes = wfv1.Nodes{nodeID: {ID: nodeID, Name: name, Phase: wfv1.NodePending}}
+			err = woc.applyExecutionControl(ctx, pod, &sync.RWMutex{})
+			if assert.NoError(t, err) {
+				node := woc.wf.Status.Nodes[nodeID]
+				assert.Equal(t, wfv1.NodeFailed, node.Phase)
+				assert.Equal(t, tt.reason, node.FailureReason)
+			}
+		})
+	}
+}
+
+func TestPendingNodeFailureReason(t *testing.T) {
+	cancel, controller := newController()
+	defer cancel()
+	woc := newWorkflowOperationCtx(unmarshalWF(helloWorldWf), controller)
+	woc.workflowDeadline = &time.Time{}
+	imagePullID, unscheduledID := woc.wf.NodeID("image-pull"), woc.wf.NodeID("unscheduled")
+	woc.wf.Status.Nodes = wfv1.Nodes{
+		imagePullID:   {ID: imagePullID, Name: "image-pull", Phase: wfv1.NodePending, Message: "ImagePullBackOff: Back-off pulling image \"argoproj/argosay:v3\""},
+		unscheduledID: {ID: unscheduledID, Name: "unscheduled", Phase: wfv1.NodePending, Message: "Unschedulable: 0/3 nodes are available"},
+	}
+	err := woc.failSuspendedAndPendingNodesAfterDeadlineOrShutdown()
+	if assert.NoError(t, err) {
+		assert.Equal(t, wfv1.FailureReasonImagePull, woc.wf.Status.Nodes[imagePullID].FailureReason)
+		assert.Equal(t, wfv1.FailureReasonDeadlineExceeded, woc.wf.Status.Nodes[unscheduledID].FailureReason)
+	}
+}
@@ -0,0 +1,139 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/valyala/fasttemplate"
+
+	wfv1 "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1"
+	argoexpr "github.com/simster7/argo/v2/util/expr"
+	"github.com/simster7/argo/v2/workflow/common"
+	"github.com/simster7/argo/v2/workflow/templateresolution"
+)
+
+// executeWfLifecycleHooks runs the workflow's lifecycle hooks, with `workflow.status` being the phase of the entry node
+// once it is fulfilled. It returns whether all the hooks that have been run are fulfilled.
+func (woc *wfOperationCtx) executeWfLifecycleHooks(ctx context.Context, tmplCtx *templateresolution.Context, node *wfv1.NodeStatus) bool {
+	if len(woc.execWf.Spec.Hooks) == 0 || !woc.wf.Spec.Shutdown.ShouldExecute(true) {
+		return true
+	}
+	status := woc.wf.Status.Phase
+	if node.Fulfilled() {
+		status = node.Phase
+		if status == wfv1.NodeSkipped {
+			// treat skipped the same as Succeeded for workflow.status, as an exit handler does
+			status = wfv1.NodeSucceeded
+		}
+	}
+	params := woc.globalParams.Merge(common.Parameters{common.GlobalVarWorkflowStatus: string(status)})
+	// the hooks of the workflow do not have a parent, as its exit handler does not
+	return woc.executeLifecycleHooks(ctx, woc.execWf.Spec.Hooks, woc.wf.Name, "", params, tmplCtx, &executeTemplateOpts{})
+}
+
+// lifecycleHookParams returns the parameters a hook of the node of a step or task is evaluated with: the global
+// parameters, the scope, and the current status of the node, e.g. `steps.deploy.status`
+func (woc *wfOperationCtx) lifecycleHookParams(scope *wfScope, prefix string, node *wfv1.NodeStatus) common.Parameters {
+	hookScope := &wfScope{tmpl: scope.tmpl, scope: make(map[string]interface{})}
+	for k, v := range scope.scope {
+		hookScope.scope[k] = v
+	}
+	woc.buildLocalScope(hookScope, prefix, node)
+	// the status of a retry node is that of the retries as a whole, rather than of its last attempt
+	hookScope.addParamToScope(fmt.Sprintf("%s.status", prefix), string(node.Phase))
+	return woc.globalParams.Merge(hookScope.getParameters())
+}
+
+// executeLifecycleHooks runs each of the hooks the first time its expression evaluates to true, as a child of the parent
+// node, if there is one. A hook node is named after the hooked node, so each hook is run at most once. It returns
+// whether all the hooks that have been run are fulfilled.
+func (woc *wfOperationCtx) executeLifecycleHooks(ctx context.Context, hooks wfv1.LifecycleHooks, hookedNodeName, parentNodeName string, params common.Parameters, tmplCtx *templateresolution.Context, opts *executeTemplateOpts) bool {
+	// run the hooks in a consistent order, so that it does not change which are held back by parallelism
+	hookNames := make([]string, 0, len(hooks))
+	for name := range hooks {
+		hookNames = append(hookNames, name)
+	}
+	sort.Strings(hookNames)
+
+	fulfilled := true
+	for _, hookName := range hookNames {
+		hook := hooks[hookName]
+		hookNodeName := common.GenerateLifecycleHookNodeName(hookedNodeName, hookName)
+		exists := woc.wf.GetNodeByName(hookNodeName) != nil
+		step, err := substituteLifecycleHook(hook, params)
+		if err == nil && !exists {
+			var execute bool
+			execute, err = argoexpr.EvalBool(hook.Expression, argoexpr.EnvMap(params))
+			if err == nil && !execute {
+				continue
+			}
+		}
+		if err != nil {
+			if !exists {
+				orgTmpl := &wfv1.WorkflowStep{Template: hook.Template, TemplateRef: hook.TemplateRef}
+				woc.initializeNode(hookNodeName, wfv1.NodeTypeSkipped, tmplCtx.GetTemplateScope(), orgTmpl, opts.boundaryID, wfv1.NodeError, fmt.Sprintf("hook '%s' errored: %s", hookName, err))
+				woc.addLifecycleHookNode(parentNodeName, hookNodeName)
+			}
+			continue
+		}
+		if !exists {
+			woc.log.WithField("hook", hookName).Infof("Running lifecycle hook of %s", hookedNodeName)
+		}
+		hookNode, err := woc.executeTemplate(ctx, hookNodeName, step, tmplCtx, step.Arguments, &executeTemplateOpts{boundaryID: opts.boundaryID, onExitTemplate: opts.onExitTemplate})
+		if err != nil && err != ErrParallelismReached {
+			// the error is handled in the callee, so just log it
+			woc.log.WithError(err).WithField("hook", hookName).Errorf("error in lifecycle hook of %s", hookedNodeName)
+		}
+		if !exists && hookNode != nil {
+			// the parent is only connected once, as the latest attempt of a retry node changes
+			woc.addLifecycleHookNode(parentNodeName, hookNodeName)
+		}
+		if hookNode == nil || !hookNode.Fulfilled() {
+			fulfilled = false
+		}
+	}
+	return fulfilled
+}
+
+func (woc *wfOperationCtx) addLifecycleHookNode(parentNodeName, hookNodeName string) {
+	if parentNodeName != "" {
+		woc.addChildNode(parentNodeName, hookNodeName)
+	}
+}
+
+// lifecycleHookParentNodeName returns the name of the node that the hook nodes of the hooked node are children of. That
+// is the hooked node itself, unless it is a retry or loop node, whose last child must be its latest attempt or iteration.
+func (woc *wfOperationCtx) lifecycleHookParentNodeName(node *wfv1.NodeStatus) string {
+	if node.Type == wfv1.NodeTypeLoop {
+		if iteration := lastLoopIteration(node, woc.wf.Status.Nodes); iteration != nil {
+			return iteration.Name
+		}
+	}
+	if node.Type == wfv1.NodeTypeRetry {
+		if lastChild := getChildNodeIndex(node, woc.wf.Status.Nodes, -1); lastChild != nil {
+			return lastChild.Name
+		}
+	}
+	return node.Name
+}
+
+// substituteLifecycleHook returns the step that runs the hook, with the parameters substituted in its arguments
+func substituteLifecycleHook(hook wfv1.LifecycleHook, params common.Parameters) (*wfv1.WorkflowStep, error) {
+	step := &wfv1.WorkflowStep{Template: hook.Template, TemplateRef: hook.TemplateRef, Arguments: hook.Arguments}
+	stepBytes, err := json.Marshal(step)
+	if err != nil {
+		return nil, err
+	}
+	fstTmpl, err := fasttemplate.NewTemplate(string(stepBytes), "{{", "}}")
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse argo variable: %w", err)
+	}
+	newStepStr, err := common.Replace(fstTmpl, params, true)
+	if err != nil {
+		return nil, err
+	}
+	newStep := &wfv1.WorkflowStep{}
+	return newStep, json.Unmarshal([]byte(newStepStr), newStep)
+}
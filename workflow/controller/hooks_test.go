@@ -0,0 +1,182 @@
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1"
+)
+
+var lifecycleHooksWf = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: hooks
+spec:
+  entrypoint: main
+  hooks:
+    started:
+      expression: workflow.status == "Running"
+      template: notify
+    succeeded:
+      expression: workflow.status == "Succeeded"
+      template: notify
+  templates:
+  - name: main
+    steps:
+    - - name: deploy
+        template: deploy
+        continueOn:
+          failed: true
+        hooks:
+          failed:
+            expression: steps.deploy.status == "Failed"
+            template: notify
+    - - name: report
+        template: notify
+  - name: deploy
+    container:
+      image: alpine:3.7
+      command: [sh, -c, "exit 1"]
+  - name: notify
+    container:
+      image: alpine:3.7
+      command: [echo, hello]
+`
+
+// makeNodePodPhase simulates the transition of the pod of a single node into the phase
+func makeNodePodPhase(ctx context.Context, woc *wfOperationCtx, nodeName string, phase apiv1.PodPhase) {
+	podcs := woc.controller.kubeclientset.CoreV1().Pods(woc.wf.GetNamespace())
+	pod, err := podcs.Get(ctx, woc.wf.NodeID(nodeName), metav1.GetOptions{})
+	if err != nil {
+		panic(err)
+	}
+	pod.Status.Phase = phase
+	if phase == apiv1.PodFailed {
+		pod.Status.Message = "Pod failed"
+	}
+	updatedPod, err := podcs.Update(ctx, pod, metav1.UpdateOptions{})
+	if err != nil {
+		panic(err)
+	}
+	err = woc.controller.podInformer.GetStore().Update(updatedPod)
+	if err != nil {
+		panic(err)
+	}
+}
+
+func TestLifecycleHooks(t *testing.T) {
+	ctx := context.Background()
+	wf := unmarshalWF(lifecycleHooksWf)
+	cancel, controller := newController(wf)
+	defer cancel()
+
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(ctx)
+	pods, err := listPods(woc)
+	if assert.NoError(t, err) {
+		assert.Len(t, pods.Items, 2)
+	}
+	started := woc.wf.GetNodeByName("hooks.hooks.started")
+	if assert.NotNil(t, started) {
+		assert.Equal(t, wfv1.NodePending, started.Phase)
+	}
+	assert.Nil(t, woc.wf.GetNodeByName("hooks.hooks.succeeded"))
+	assert.Nil(t, woc.wf.GetNodeByName("hooks[0].deploy.hooks.failed"))
+
+	// the hook of the step runs once it fails
+	makeNodePodPhase(ctx, woc, "hooks[0].deploy", apiv1.PodFailed)
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate(ctx)
+	deploy := woc.wf.GetNodeByName("hooks[0].deploy")
+	failed := woc.wf.GetNodeByName("hooks[0].deploy.hooks.failed")
+	if assert.NotNil(t, deploy) && assert.NotNil(t, failed) {
+		assert.Equal(t, wfv1.NodeFailed, deploy.Phase)
+		assert.Contains(t, deploy.Children, failed.ID)
+	}
+	// the step group waits for the hook
+	assert.Nil(t, woc.wf.GetNodeByName("hooks[1].report"))
+
+	// the failure of a hook does not fail the workflow
+	makeNodePodPhase(ctx, woc, "hooks.hooks.started", apiv1.PodFailed)
+	makeNodePodPhase(ctx, woc, "hooks[0].deploy.hooks.failed", apiv1.PodSucceeded)
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate(ctx)
+	assert.NotNil(t, woc.wf.GetNodeByName("hooks[1].report"))
+
+	// the workflow waits for the hook that its completion runs
+	makeNodePodPhase(ctx, woc, "hooks[1].report", apiv1.PodSucceeded)
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate(ctx)
+	assert.Equal(t, wfv1.NodeRunning, woc.wf.Status.Phase)
+	assert.NotNil(t, woc.wf.GetNodeByName("hooks.hooks.succeeded"))
+
+	makeNodePodPhase(ctx, woc, "hooks.hooks.succeeded", apiv1.PodSucceeded)
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate(ctx)
+	assert.Equal(t, wfv1.NodeSucceeded, woc.wf.Status.Phase)
+	pods, err = listPods(woc)
+	if assert.NoError(t, err) {
+		// each hook was run once
+		assert.Len(t, pods.Items, 5)
+	}
+}
+
+var dagLifecycleHooksWf = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: dag-hooks
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    dag:
+      tasks:
+      - name: deploy
+        template: notify
+        hooks:
+          running:
+            expression: tasks.deploy.status == "Running"
+            template: notify
+  - name: notify
+    container:
+      image: alpine:3.7
+      command: [echo, hello]
+`
+
+func TestDAGLifecycleHooks(t *testing.T) {
+	ctx := context.Background()
+	wf := unmarshalWF(dagLifecycleHooksWf)
+	cancel, controller := newController(wf)
+	defer cancel()
+
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(ctx)
+	assert.Nil(t, woc.wf.GetNodeByName("dag-hooks.deploy.hooks.running"))
+
+	makeNodePodPhase(ctx, woc, "dag-hooks.deploy", apiv1.PodRunning)
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate(ctx)
+	deploy := woc.wf.GetNodeByName("dag-hooks.deploy")
+	running := woc.wf.GetNodeByName("dag-hooks.deploy.hooks.running")
+	if assert.NotNil(t, running) {
+		assert.Contains(t, deploy.Children, running.ID)
+	}
+
+	// the DAG waits for the hook
+	makeNodePodPhase(ctx, woc, "dag-hooks.deploy", apiv1.PodSucceeded)
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate(ctx)
+	assert.Equal(t, wfv1.NodeSucceeded, woc.wf.GetNodeByName("dag-hooks.deploy").Phase)
+	assert.Equal(t, wfv1.NodeRunning, woc.wf.Status.Phase)
+
+	makeNodePodPhase(ctx, woc, "dag-hooks.deploy.hooks.running", apiv1.PodSucceeded)
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate(ctx)
+	assert.Equal(t, wfv1.NodeSucceeded, woc.wf.Status.Phase)
+}
@@ -0,0 +1,252 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/antonmedv/expr"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	wfv1 "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1"
+)
+
+const (
+	defaultHTTPTimeout = 30 * time.Second
+	// maxHTTPTimeout bounds how long a request may be in flight
+	maxHTTPTimeout = 60 * time.Second
+	// maxHTTPResponseSize is the largest response body that may be stored as the result, the same limit as a script's
+	maxHTTPResponseSize = 256 * (1 << 10)
+	// defaultHTTPSuccessCondition is the success condition of a request without one
+	defaultHTTPSuccessCondition = "response.statusCode >= 200 && response.statusCode < 300"
+	// httpResponseTTL is how long a response is kept for its workflow to be operated on again, after which the workflow
+	// is assumed to have been deleted
+	httpResponseTTL = 10 * time.Minute
+)
+
+// executeHTTP makes the template's HTTP request from the controller, rather than in a pod, and records the response
+// body as the node's result. The request is made in the background, so the node is running until the workflow is
+// operated on again after the response is received. If the controller restarts before the node is recorded as
+// complete, the request is made again.
+func (woc *wfOperationCtx) executeHTTP(ctx context.Context, nodeName string, templateScope string, tmpl *wfv1.Template, orgTmpl wfv1.TemplateReferenceHolder, opts *executeTemplateOpts) (*wfv1.NodeStatus, error) {
+	node := woc.wf.GetNodeByName(nodeName)
+	if node == nil {
+		node = woc.initializeExecutableNode(nodeName, wfv1.NodeTypeHTTP, templateScope, tmpl, orgTmpl, opts.boundaryID, wfv1.NodeRunning)
+	}
+
+	requestKey := woc.wf.Namespace + "/" + node.ID
+	response, ok := woc.controller.httpRequests.take(requestKey)
+	if !ok {
+		if woc.controller.httpRequests.isInFlight(requestKey) {
+			return node, nil
+		}
+		header, err := woc.getHTTPHeader(ctx, tmpl.HTTP)
+		if err != nil {
+			return woc.markNodePhase(nodeName, wfv1.NodeError, err.Error()), nil
+		}
+		woc.log.Infof("Executing node %s with HTTP template: %s %s", nodeName, tmpl.HTTP.GetMethod(), tmpl.HTTP.URL)
+		workflowKey, _ := cache.MetaNamespaceKeyFunc(woc.wf)
+		woc.controller.httpRequests.start(ctx, requestKey, tmpl.HTTP.DeepCopy(), header, func() {
+			woc.controller.wfQueue.AddRateLimited(workflowKey)
+		})
+		return node, nil
+	}
+	if response.err != nil {
+		return woc.markNodePhase(nodeName, wfv1.NodeError, response.err.Error()), nil
+	}
+
+	if node.Outputs == nil {
+		node.Outputs = &wfv1.Outputs{}
+	}
+	node.Outputs.Result = &response.body
+	woc.wf.Status.Nodes[node.ID] = *node
+	woc.updated = true
+
+	succeeded, err := evalHTTPSuccessCondition(tmpl.HTTP.SuccessCondition, response.statusCode, response.body)
+	if err != nil {
+		return woc.markNodePhase(nodeName, wfv1.NodeError, err.Error()), nil
+	}
+	if !succeeded {
+		return woc.markNodePhase(nodeName, wfv1.NodeFailed, fmt.Sprintf("successCondition was not met, received status code %d", response.statusCode)), nil
+	}
+	return woc.markNodePhase(nodeName, wfv1.NodeSucceeded), nil
+}
+
+// getHTTPHeader returns the headers of the request, with the values of those from secrets
+func (woc *wfOperationCtx) getHTTPHeader(ctx context.Context, tmpl *wfv1.HTTP) (http.Header, error) {
+	header := http.Header{}
+	for _, h := range tmpl.Headers {
+		value := h.Value
+		if h.ValueFrom != nil && h.ValueFrom.SecretKeyRef != nil {
+			var err error
+			value, err = woc.getSecretKey(ctx, h.ValueFrom.SecretKeyRef)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get the value of header '%s': %w", h.Name, err)
+			}
+		}
+		header.Add(h.Name, value)
+	}
+	return header, nil
+}
+
+// httpResponse is the outcome of the request of an HTTP template
+type httpResponse struct {
+	statusCode int
+	body       string
+	err        error
+	receivedAt time.Time
+}
+
+// httpRequests makes the requests of HTTP templates in the background, so that operating on a workflow is not blocked
+// by them, and holds their responses, keyed by the namespace and ID of their node, until the workflow is operated on
+// again
+type httpRequests struct {
+	client    *http.Client
+	mutex     sync.Mutex
+	inFlight  map[string]bool
+	responses map[string]httpResponse
+}
+
+// newHTTPRequests returns requests that are made with a client and transport of their own, rather than the default
+// ones. dialControl, if not nil, is called with the address of each connection before it is made, and may refuse it.
+func newHTTPRequests(dialControl func(network, address string, c syscall.RawConn) error) *httpRequests {
+	dialer := &net.Dialer{Timeout: 10 * time.Second, KeepAlive: 30 * time.Second, Control: dialControl}
+	return &httpRequests{
+		client: &http.Client{
+			Timeout: maxHTTPTimeout,
+			Transport: &http.Transport{
+				DialContext:         dialer.DialContext,
+				TLSHandshakeTimeout: 10 * time.Second,
+				MaxIdleConns:        10,
+				IdleConnTimeout:     90 * time.Second,
+			},
+		},
+		inFlight:  map[string]bool{},
+		responses: map[string]httpResponse{},
+	}
+}
+
+// denyInternalAddresses refuses connections to loopback, link-local, e.g. the cloud metadata service, and unspecified
+// addresses, which are those of the controller's own host rather than of services it may be asked to call. It is given
+// the resolved address, so it also applies to redirects and to host names that resolve to such addresses.
+func denyInternalAddresses(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return fmt.Errorf("'%s' is not an IP address", host)
+	}
+	if ip.IsLoopback() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsUnspecified() {
+		return fmt.Errorf("requests to %s are not allowed", ip)
+	}
+	return nil
+}
+
+func (r *httpRequests) isInFlight(key string) bool {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.inFlight[key]
+}
+
+// start makes the request in the background, and calls done once its response has been stored
+func (r *httpRequests) start(ctx context.Context, key string, tmpl *wfv1.HTTP, header http.Header, done func()) {
+	r.mutex.Lock()
+	r.inFlight[key] = true
+	r.mutex.Unlock()
+	go func() {
+		statusCode, body, err := r.do(ctx, tmpl, header)
+		r.mutex.Lock()
+		delete(r.inFlight, key)
+		for k, response := range r.responses {
+			if time.Since(response.receivedAt) > httpResponseTTL {
+				delete(r.responses, k)
+			}
+		}
+		r.responses[key] = httpResponse{statusCode: statusCode, body: body, err: err, receivedAt: time.Now()}
+		r.mutex.Unlock()
+		done()
+	}()
+}
+
+// take returns the response of the request, if it has been received, and forgets it
+func (r *httpRequests) take(key string) (httpResponse, bool) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	response, ok := r.responses[key]
+	delete(r.responses, key)
+	return response, ok
+}
+
+// do makes the request and returns the status code and body of the response
+func (r *httpRequests) do(ctx context.Context, tmpl *wfv1.HTTP, header http.Header) (int, string, error) {
+	timeout := defaultHTTPTimeout
+	if tmpl.TimeoutSeconds != nil {
+		timeout = time.Duration(*tmpl.TimeoutSeconds) * time.Second
+	}
+	if timeout > maxHTTPTimeout {
+		timeout = maxHTTPTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	request, err := http.NewRequestWithContext(ctx, tmpl.GetMethod(), tmpl.URL, strings.NewReader(tmpl.Body))
+	if err != nil {
+		return 0, "", fmt.Errorf("failed to create HTTP request: %w", err)
+	}
+	request.Header = header
+
+	response, err := r.client.Do(request)
+	if err != nil {
+		return 0, "", fmt.Errorf("HTTP request failed: %w", err)
+	}
+	defer func() { _ = response.Body.Close() }()
+	data, err := ioutil.ReadAll(http.MaxBytesReader(nil, response.Body, maxHTTPResponseSize))
+	if err != nil {
+		return 0, "", fmt.Errorf("failed to read HTTP response, it may be larger than the maximum of %d bytes: %w", maxHTTPResponseSize, err)
+	}
+	return response.StatusCode, string(data), nil
+}
+
+func (woc *wfOperationCtx) getSecretKey(ctx context.Context, selector *apiv1.SecretKeySelector) (string, error) {
+	secret, err := woc.controller.kubeclientset.CoreV1().Secrets(woc.wf.Namespace).Get(ctx, selector.Name, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	value, ok := secret.Data[selector.Key]
+	if !ok {
+		return "", fmt.Errorf("secret '%s' does not have the key '%s'", selector.Name, selector.Key)
+	}
+	return string(value), nil
+}
+
+// evalHTTPSuccessCondition evaluates the success condition, or the default one if it is empty, over the response
+func evalHTTPSuccessCondition(successCondition string, statusCode int, body string) (bool, error) {
+	if successCondition == "" {
+		successCondition = defaultHTTPSuccessCondition
+	}
+	env := map[string]interface{}{
+		"response": map[string]interface{}{
+			"statusCode": statusCode,
+			"body":       body,
+		},
+	}
+	result, err := expr.Eval(successCondition, env)
+	if err != nil {
+		return false, fmt.Errorf("unable to evaluate successCondition '%s': %s", successCondition, err)
+	}
+	succeeded, ok := result.(bool)
+	if !ok {
+		return false, fmt.Errorf("successCondition '%s' evaluated to %v, rather than a boolean", successCondition, result)
+	}
+	return succeeded, nil
+}
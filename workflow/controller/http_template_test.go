@@ -0,0 +1,184 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	wfv1 "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1"
+)
+
+// operateUntilHTTPResponse operates on the workflow until the response of its request has been recorded
+func operateUntilHTTPResponse(ctx context.Context, woc *wfOperationCtx) *wfOperationCtx {
+	for i := 0; i < 50 && woc.wf.Status.Phase == wfv1.NodeRunning; i++ {
+		time.Sleep(100 * time.Millisecond)
+		woc = newWorkflowOperationCtx(woc.wf, woc.controller)
+		woc.operate(ctx)
+	}
+	return woc
+}
+
+var httpWf = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: http
+  namespace: default
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    http:
+      method: POST
+      url: %s
+      body: '{"message": "hello"}'
+      headers:
+      - name: Content-Type
+        value: application/json
+      - name: Authorization
+        valueFrom:
+          secretKeyRef:
+            name: my-secret
+            key: token
+      successCondition: %s
+`
+
+func TestHTTPTemplate(t *testing.T) {
+	ctx := context.Background()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		if r.Method != http.MethodPost || r.Header.Get("Authorization") != "Bearer my-token" || string(body) != `{"message": "hello"}` {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id": 1}`))
+	}))
+	defer server.Close()
+
+	for name, tt := range map[string]struct {
+		successCondition string
+		phase            wfv1.NodePhase
+		message          string
+	}{
+		"Succeeded":       {successCondition: `""`, phase: wfv1.NodeSucceeded},
+		"Condition":       {successCondition: `'response.statusCode == 201 && response.body contains "id"'`, phase: wfv1.NodeSucceeded},
+		"ConditionNotMet": {successCondition: `'response.statusCode == 200'`, phase: wfv1.NodeFailed, message: "successCondition was not met, received status code 201"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			wf := unmarshalWF(fmt.Sprintf(httpWf, server.URL, tt.successCondition))
+			cancel, controller := newController(wf)
+			defer cancel()
+			_, err := controller.kubeclientset.CoreV1().Secrets("default").Create(ctx, &apiv1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-secret"},
+				Data:       map[string][]byte{"token": []byte("Bearer my-token")},
+			}, metav1.CreateOptions{})
+			assert.NoError(t, err)
+
+			woc := newWorkflowOperationCtx(wf, controller)
+			woc.operate(ctx)
+			woc = operateUntilHTTPResponse(ctx, woc)
+
+			pods, err := listPods(woc)
+			if assert.NoError(t, err) {
+				assert.Empty(t, pods.Items)
+			}
+			assert.Equal(t, tt.phase, woc.wf.Status.Phase)
+			node := woc.wf.GetNodeByName("http")
+			if assert.NotNil(t, node) {
+				assert.Equal(t, wfv1.NodeTypeHTTP, node.Type)
+				assert.Equal(t, tt.phase, node.Phase)
+				assert.Equal(t, tt.message, node.Message)
+				if assert.NotNil(t, node.Outputs) && assert.NotNil(t, node.Outputs.Result) {
+					assert.Equal(t, `{"id": 1}`, *node.Outputs.Result)
+				}
+			}
+		})
+	}
+	t.Run("MissingSecret", func(t *testing.T) {
+		wf := unmarshalWF(fmt.Sprintf(httpWf, server.URL, `""`))
+		cancel, controller := newController(wf)
+		defer cancel()
+		woc := newWorkflowOperationCtx(wf, controller)
+		woc.operate(ctx)
+		node := woc.wf.GetNodeByName("http")
+		if assert.NotNil(t, node) {
+			assert.Equal(t, wfv1.NodeError, node.Phase)
+			assert.Contains(t, node.Message, "failed to get the value of header 'Authorization'")
+		}
+	})
+	t.Run("InBackground", func(t *testing.T) {
+		release := make(chan struct{})
+		slowServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			<-release
+			_, _ = w.Write([]byte("ok"))
+		}))
+		defer slowServer.Close()
+		wf := unmarshalWF(fmt.Sprintf(httpWf, slowServer.URL, `""`))
+		wf.Spec.Templates[0].HTTP.Headers = nil
+		cancel, controller := newController(wf)
+		defer cancel()
+
+		woc := newWorkflowOperationCtx(wf, controller)
+		woc.operate(ctx)
+		node := woc.wf.GetNodeByName("http")
+		if assert.NotNil(t, node) {
+			assert.Equal(t, wfv1.NodeRunning, node.Phase)
+		}
+		// operating on the workflow again while the request is in flight does not make it again
+		woc = newWorkflowOperationCtx(woc.wf, controller)
+		woc.operate(ctx)
+		assert.Equal(t, wfv1.NodeRunning, woc.wf.GetNodeByName("http").Phase)
+
+		close(release)
+		woc = operateUntilHTTPResponse(ctx, woc)
+		node = woc.wf.GetNodeByName("http")
+		if assert.NotNil(t, node) {
+			assert.Equal(t, wfv1.NodeSucceeded, node.Phase)
+		}
+		assert.Equal(t, wfv1.NodeSucceeded, woc.wf.Status.Phase)
+	})
+}
+
+func Test_denyInternalAddresses(t *testing.T) {
+	for _, address := range []string{"127.0.0.1:80", "[::1]:443", "169.254.169.254:80", "0.0.0.0:8080"} {
+		assert.Error(t, denyInternalAddresses("tcp", address, nil), address)
+	}
+	for _, address := range []string{"10.0.0.1:80", "93.184.216.34:443"} {
+		assert.NoError(t, denyInternalAddresses("tcp", address, nil), address)
+	}
+}
+
+func TestHTTPRequestsDenyInternalAddresses(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+	_, _, err := newHTTPRequests(denyInternalAddresses).do(context.Background(), &wfv1.HTTP{URL: server.URL}, http.Header{})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "requests to 127.0.0.1 are not allowed")
+	}
+}
+
+func Test_evalHTTPSuccessCondition(t *testing.T) {
+	succeeded, err := evalHTTPSuccessCondition("", 204, "")
+	if assert.NoError(t, err) {
+		assert.True(t, succeeded)
+	}
+	succeeded, err = evalHTTPSuccessCondition("", 404, "")
+	if assert.NoError(t, err) {
+		assert.False(t, succeeded)
+	}
+	succeeded, err = evalHTTPSuccessCondition(`response.body == "ok"`, 500, "ok")
+	if assert.NoError(t, err) {
+		assert.True(t, succeeded)
+	}
+	_, err = evalHTTPSuccessCondition("response.statusCode", 200, "")
+	assert.EqualError(t, err, "successCondition 'response.statusCode' evaluated to 200, rather than a boolean")
+}
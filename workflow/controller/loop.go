@@ -0,0 +1,194 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/argoproj/pkg/humanize"
+	"github.com/valyala/fasttemplate"
+	"k8s.io/utils/pointer"
+
+	"github.com/simster7/argo/v2/errors"
+	wfv1 "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1"
+	argoexpr "github.com/simster7/argo/v2/util/expr"
+	"github.com/simster7/argo/v2/workflow/common"
+	"github.com/simster7/argo/v2/workflow/templateresolution"
+)
+
+// executeLoop runs the step or task as a loop node whose children are its iterations, which run one after the other.
+// The loop succeeds once an iteration succeeds and its `until` expression is true, and takes the outputs of that
+// iteration. It fails if an iteration fails, or if its condition is still not met after maxIterations iterations.
+func (woc *wfOperationCtx) executeLoop(ctx context.Context, nodeName string, orgTmpl wfv1.TemplateReferenceHolder, loop *wfv1.Loop, tmplCtx *templateresolution.Context, args wfv1.Arguments, opts *executeTemplateOpts) (*wfv1.NodeStatus, error) {
+	node := woc.wf.GetNodeByName(nodeName)
+	if node == nil {
+		node = woc.initializeNode(nodeName, wfv1.NodeTypeLoop, tmplCtx.GetTemplateScope(), orgTmpl, opts.boundaryID, wfv1.NodeRunning)
+	}
+	if node.Fulfilled() {
+		return node, nil
+	}
+
+	lastIteration := getChildNodeIndex(node, woc.wf.Status.Nodes, -1)
+	if lastIteration != nil && lastIteration.Fulfilled() {
+		if lastIteration.FailedOrError() {
+			return woc.markNodePhase(nodeName, lastIteration.Phase, fmt.Sprintf("iteration '%s' %s", lastIteration.Name, lastIteration.Phase)), nil
+		}
+		done, err := woc.loopConditionMet(loop, len(node.Children)-1, lastIteration)
+		if err != nil {
+			return woc.markNodeError(nodeName, err), err
+		}
+		if done {
+			node.Outputs = lastIteration.Outputs.DeepCopy()
+			woc.wf.Status.Nodes[node.ID] = *node
+			return woc.markNodePhase(nodeName, wfv1.NodeSucceeded), nil
+		}
+		if int32(len(node.Children)) >= loop.MaxIterations {
+			woc.log.Infof("Loop node %s did not meet its condition after %d iterations", nodeName, len(node.Children))
+			return woc.markNodePhase(nodeName, wfv1.NodeFailed, fmt.Sprintf("condition not met after %d iterations", len(node.Children))), nil
+		}
+		if loop.Delay != "" {
+			delay, err := parseStringToDuration(loop.Delay)
+			if err != nil {
+				return woc.markNodeError(nodeName, err), err
+			}
+			if timeToWait := time.Until(lastIteration.FinishedAt.Add(delay)); timeToWait > 0 {
+				woc.requeueAfter(timeToWait)
+				return woc.markNodePhase(nodeName, wfv1.NodeRunning, fmt.Sprintf("Delay for %s", humanize.Duration(timeToWait))), nil
+			}
+		}
+	}
+
+	// Run the latest iteration, or start the next one
+	index := len(node.Children)
+	if lastIteration != nil && !lastIteration.Fulfilled() {
+		index--
+	}
+	iterationName := fmt.Sprintf("%s(%d)", nodeName, index)
+	newIteration := index == len(node.Children)
+	var previousOutputs *wfv1.Outputs
+	if index > 0 {
+		previousOutputs = getChildNodeIndex(node, woc.wf.Status.Nodes, index-1).Outputs
+		if previousOutputs == nil {
+			previousOutputs = &wfv1.Outputs{}
+		}
+	} else {
+		// There is no previous iteration, so the outputs of the template are all empty
+		_, tmpl, templateStored, err := tmplCtx.ResolveTemplate(orgTmpl)
+		if err != nil {
+			return woc.markNodeError(nodeName, err), err
+		}
+		// A new template was stored during resolution, persist it
+		if templateStored {
+			woc.updated = true
+		}
+		previousOutputs = emptyLoopOutputs(tmpl)
+	}
+	iterationArgs, err := substituteLoopArgs(args, index, previousOutputs)
+	if err != nil {
+		return woc.markNodeError(nodeName, err), err
+	}
+	// the iterations are bounded by the loop node
+	iterationOpts := *opts
+	iterationOpts.boundaryID = node.ID
+	iteration, err := woc.executeTemplate(ctx, iterationName, orgTmpl, tmplCtx, iterationArgs, &iterationOpts)
+	if iteration != nil && newIteration {
+		woc.addChildNode(nodeName, iterationName)
+		// clear the message of the delay before the iteration
+		woc.markNodePhase(nodeName, wfv1.NodeRunning, "")
+	}
+	if err != nil {
+		switch err {
+		case ErrDeadlineExceeded, ErrParallelismReached, ErrTimeout:
+			return woc.wf.GetNodeByName(nodeName), err
+		default:
+			return woc.markNodeError(nodeName, err), err
+		}
+	}
+	if iteration.Fulfilled() {
+		// the iteration completed within this operation, so assess the loop straight away
+		return woc.executeLoop(ctx, nodeName, orgTmpl, loop, tmplCtx, args, opts)
+	}
+	return woc.wf.GetNodeByName(nodeName), nil
+}
+
+// loopConditionMet evaluates the `until` expression of the loop with the outputs of the iteration. A loop without a
+// condition runs all of its iterations.
+func (woc *wfOperationCtx) loopConditionMet(loop *wfv1.Loop, index int, iteration *wfv1.NodeStatus) (bool, error) {
+	if loop.Until == "" {
+		return int32(index+1) >= loop.MaxIterations, nil
+	}
+	params := common.Parameters{common.LocalVarLoopIndex: strconv.Itoa(index)}
+	if outputs := iteration.Outputs; outputs != nil {
+		if outputs.Result != nil {
+			params["outputs.result"] = *outputs.Result
+		}
+		if outputs.ExitCode != nil {
+			params["outputs.exitCode"] = *outputs.ExitCode
+		}
+		for _, param := range outputs.Parameters {
+			if param.Value != nil {
+				params["outputs.parameters."+param.Name] = param.Value.String()
+			}
+		}
+	}
+	done, err := argoexpr.EvalBool(loop.Until, argoexpr.EnvMap(woc.globalParams.Merge(params)))
+	if err != nil {
+		return false, errors.Errorf(errors.CodeBadRequest, "Invalid 'until' expression '%s': %v", loop.Until, err)
+	}
+	return done, nil
+}
+
+// emptyLoopOutputs returns the outputs the first iteration of a loop of the template sees as those of the previous
+// iteration: an empty result, and an empty value for each of the output parameters of the template
+func emptyLoopOutputs(tmpl *wfv1.Template) *wfv1.Outputs {
+	outputs := &wfv1.Outputs{Result: pointer.StringPtr("")}
+	for _, param := range tmpl.Outputs.Parameters {
+		outputs.Parameters = append(outputs.Parameters, wfv1.Parameter{Name: param.Name, Value: wfv1.AnyStringPtr("")})
+	}
+	return outputs
+}
+
+// substituteLoopArgs substitutes `loop.index` and the outputs of the previous iteration in the arguments of an
+// iteration
+func substituteLoopArgs(args wfv1.Arguments, index int, previousOutputs *wfv1.Outputs) (wfv1.Arguments, error) {
+	params := common.Parameters{common.LocalVarLoopIndex: strconv.Itoa(index)}
+	if previousOutputs.Result != nil {
+		params[common.LocalVarLoopOutputsPrefix+".result"] = *previousOutputs.Result
+	}
+	for _, param := range previousOutputs.Parameters {
+		if param.Value != nil {
+			params[fmt.Sprintf("%s.parameters.%s", common.LocalVarLoopOutputsPrefix, param.Name)] = param.Value.String()
+		}
+	}
+	argsBytes, err := json.Marshal(args)
+	if err != nil {
+		return args, errors.InternalWrapError(err)
+	}
+	fstTmpl, err := fasttemplate.NewTemplate(string(argsBytes), "{{", "}}")
+	if err != nil {
+		return args, fmt.Errorf("unable to parse argo variable: %w", err)
+	}
+	newArgsStr, err := common.Replace(fstTmpl, params, true)
+	if err != nil {
+		return args, err
+	}
+	var newArgs wfv1.Arguments
+	if err := json.Unmarshal([]byte(newArgsStr), &newArgs); err != nil {
+		return args, errors.InternalWrapError(err)
+	}
+	return newArgs, nil
+}
+
+// lastLoopIteration returns the node of the last iteration of the loop node or, if that iteration was retried, of its
+// last attempt
+func lastLoopIteration(node *wfv1.NodeStatus, nodes wfv1.Nodes) *wfv1.NodeStatus {
+	iteration := getChildNodeIndex(node, nodes, -1)
+	if iteration != nil && iteration.Type == wfv1.NodeTypeRetry {
+		if attempt := getChildNodeIndex(iteration, nodes, -1); attempt != nil {
+			return attempt
+		}
+	}
+	return iteration
+}
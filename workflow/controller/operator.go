@@ -40,6 +40,7 @@ import (
 	"github.com/simster7/argo/v2/util"
 	envutil "github.com/simster7/argo/v2/util/env"
 	errorsutil "github.com/simster7/argo/v2/util/errors"
+	argoexpr "github.com/simster7/argo/v2/util/expr"
 	"github.com/simster7/argo/v2/util/intstr"
 	"github.com/simster7/argo/v2/util/resource"
 	"github.com/simster7/argo/v2/util/retry"
@@ -97,6 +98,9 @@ type wfOperationCtx struct {
 	// preExecutionNodePhases contains the phases of all the nodes before the current operation. Necessary to infer
 	// changes in phase for metric emission
 	preExecutionNodePhases map[string]wfv1.NodePhase
+	// configMaps caches the config maps that the values of parameters are from, so that each is only got once per
+	// operation
+	configMaps map[string]*apiv1.ConfigMap
 
 	// execWf holds the Workflow for use in execution.
 	// In Normal workflow scenario: It holds copy of workflow object
@@ -124,12 +128,13 @@ var (
 
 // failedNodeStatus is a subset of NodeStatus that is only used to Marshal certain fields into a JSON of failed nodes
 type failedNodeStatus struct {
-	DisplayName  string      `json:"displayName"`
-	Message      string      `json:"message"`
-	TemplateName string      `json:"templateName"`
-	Phase        string      `json:"phase"`
-	PodName      string      `json:"podName"`
-	FinishedAt   metav1.Time `json:"finishedAt"`
+	DisplayName   string             `json:"displayName"`
+	Message       string             `json:"message"`
+	TemplateName  string             `json:"templateName"`
+	Phase         string             `json:"phase"`
+	PodName       string             `json:"podName"`
+	FinishedAt    metav1.Time        `json:"finishedAt"`
+	FailureReason wfv1.FailureReason `json:"failureReason,omitempty"`
 }
 
 // newWorkflowOperationCtx creates and initializes a new wfOperationCtx object.
@@ -155,6 +160,7 @@ func newWorkflowOperationCtx(wf *wfv1.Workflow, wfc *WorkflowController) *wfOper
 		deadline:               time.Now().UTC().Add(maxOperationTime),
 		eventRecorder:          wfc.eventRecorderManager.Get(wf.Namespace),
 		preExecutionNodePhases: make(map[string]wfv1.NodePhase),
+		configMaps:             make(map[string]*apiv1.ConfigMap),
 	}
 
 	if woc.wf.Status.Nodes == nil {
@@ -243,7 +249,13 @@ func (woc *wfOperationCtx) operate(ctx context.Context) {
 		woc.preExecutionNodePhases[node.ID] = node.Phase
 	}
 
-	woc.setGlobalParameters(woc.execWf.Spec.Arguments)
+	err = woc.setGlobalParameters(ctx, woc.execWf.Spec.Arguments)
+	if err != nil {
+		woc.markWorkflowFailed(ctx, fmt.Sprintf("invalid spec: %s", err.Error()))
+		return
+	}
+
+	woc.addArtifactGCFinalizer()
 
 	// Perform one-time workflow validation
 	if woc.wf.Status.Phase == "" {
@@ -342,7 +354,7 @@ func (woc *wfOperationCtx) operate(ctx context.Context) {
 		woc.markWorkflowRunning(ctx)
 	}
 
-	node, err := woc.executeTemplate(ctx, woc.wf.ObjectMeta.Name, &wfv1.WorkflowStep{Template: woc.execWf.Spec.Entrypoint}, tmplCtx, woc.execWf.Spec.Arguments, &executeTemplateOpts{})
+	node, err := woc.executeTemplate(ctx, woc.wf.ObjectMeta.Name, &wfv1.WorkflowStep{Template: woc.execWf.Spec.Entrypoint}, tmplCtx, woc.workflowArguments(), &executeTemplateOpts{})
 	if err != nil {
 		// the error are handled in the callee so just log it.
 		msg := "error in entry template execution"
@@ -355,11 +367,17 @@ func (woc *wfOperationCtx) operate(ctx context.Context) {
 		return
 	}
 
-	if node == nil || !node.Fulfilled() {
+	if node == nil {
 		// node can be nil if a workflow created immediately in a parallelism == 0 state
 		return
 	}
 
+	// the workflow does not complete until the hooks that have been run are fulfilled
+	hooksFulfilled := woc.executeWfLifecycleHooks(ctx, tmplCtx, node)
+	if !node.Fulfilled() || !hooksFulfilled {
+		return
+	}
+
 	workflowStatus := node.Phase
 	var onExitNode *wfv1.NodeStatus
 	if woc.execWf.Spec.OnExit != "" && woc.wf.Spec.Shutdown.ShouldExecute(true) {
@@ -375,12 +393,13 @@ func (woc *wfOperationCtx) operate(ctx context.Context) {
 			if node.Phase == wfv1.NodeFailed || node.Phase == wfv1.NodeError {
 				failures = append(failures,
 					failedNodeStatus{
-						DisplayName:  node.DisplayName,
-						Message:      node.Message,
-						TemplateName: node.TemplateName,
-						Phase:        string(node.Phase),
-						PodName:      node.ID,
-						FinishedAt:   node.FinishedAt,
+						DisplayName:   node.DisplayName,
+						Message:       node.Message,
+						TemplateName:  node.TemplateName,
+						Phase:         string(node.Phase),
+						PodName:       node.ID,
+						FinishedAt:    node.FinishedAt,
+						FailureReason: node.FailureReason,
 					})
 			}
 		}
@@ -394,7 +413,7 @@ func (woc *wfOperationCtx) operate(ctx context.Context) {
 
 		woc.log.Infof("Running OnExit handler: %s", woc.execWf.Spec.OnExit)
 		onExitNodeName := common.GenerateOnExitNodeName(woc.wf.ObjectMeta.Name)
-		onExitNode, err = woc.executeTemplate(ctx, onExitNodeName, &wfv1.WorkflowStep{Template: woc.execWf.Spec.OnExit}, tmplCtx, woc.execWf.Spec.Arguments, &executeTemplateOpts{onExitTemplate: true})
+		onExitNode, err = woc.executeTemplate(ctx, onExitNodeName, &wfv1.WorkflowStep{Template: woc.execWf.Spec.OnExit}, tmplCtx, woc.workflowArguments(), &executeTemplateOpts{onExitTemplate: true})
 		if err != nil {
 			// the error are handled in the callee so just log it.
 			woc.log.WithError(err).Error("error in exit template execution")
@@ -462,7 +481,7 @@ func (woc *wfOperationCtx) getWorkflowDeadline() *time.Time {
 }
 
 // setGlobalParameters sets the globalParam map with global parameters
-func (woc *wfOperationCtx) setGlobalParameters(executionParameters wfv1.Arguments) {
+func (woc *wfOperationCtx) setGlobalParameters(ctx context.Context, executionParameters wfv1.Arguments) error {
 	woc.globalParams[common.GlobalVarWorkflowName] = woc.wf.ObjectMeta.Name
 	woc.globalParams[common.GlobalVarWorkflowNamespace] = woc.wf.ObjectMeta.Namespace
 	woc.globalParams[common.GlobalVarWorkflowServiceAccountName] = woc.execWf.Spec.ServiceAccountName
@@ -477,11 +496,36 @@ func (woc *wfOperationCtx) setGlobalParameters(executionParameters wfv1.Argument
 	}
 	woc.globalParams[common.GlobalVarWorkflowCreationTimestamp+".s"] = strconv.FormatInt(woc.wf.ObjectMeta.CreationTimestamp.Time.Unix(), 10)
 
-	if workflowParameters, err := json.Marshal(woc.execWf.Spec.Arguments.Parameters); err == nil {
+	params := make([]wfv1.Parameter, len(executionParameters.Parameters))
+	for i, param := range executionParameters.Parameters {
+		if param.Value == nil && param.ValueFrom != nil && param.ValueFrom.ConfigMapKeyRef != nil {
+			// the value is only read from the config map once, so that changing or deleting it later does not affect
+			// the running workflow
+			value, ok := woc.wf.Status.ConfigMapParameterValues[param.Name]
+			if !ok {
+				var err error
+				value, err = woc.getConfigMapParameterValue(ctx, param)
+				if err != nil {
+					return err
+				}
+				if err := common.ValidateParameterValue(param, value); err != nil {
+					return errors.Errorf(errors.CodeBadRequest, "spec.arguments.parameters.%s.value: %v", param.Name, err)
+				}
+				if woc.wf.Status.ConfigMapParameterValues == nil {
+					woc.wf.Status.ConfigMapParameterValues = make(map[string]string)
+				}
+				woc.wf.Status.ConfigMapParameterValues[param.Name] = value
+				woc.updated = true
+			}
+			param.Value = wfv1.AnyStringPtr(value)
+		}
+		params[i] = param
+	}
+	if workflowParameters, err := json.Marshal(wfv1.Redacted(params)); err == nil {
 		woc.globalParams[common.GlobalVarWorkflowParameters] = string(workflowParameters)
 	}
-	for _, param := range executionParameters.Parameters {
-		woc.globalParams["workflow.parameters."+param.Name] = param.Value.String()
+	for _, param := range params {
+		woc.globalParams["workflow.parameters."+param.Name] = common.WorkflowParameterValue(param)
 	}
 	for k, v := range woc.wf.ObjectMeta.Annotations {
 		woc.globalParams["workflow.annotations."+k] = v
@@ -494,6 +538,51 @@ func (woc *wfOperationCtx) setGlobalParameters(executionParameters wfv1.Argument
 			woc.globalParams["workflow.outputs.parameters."+param.Name] = param.Value.String()
 		}
 	}
+	return nil
+}
+
+// workflowArguments returns the arguments of the workflow, in which the parameters whose values are from config maps
+// have the values that setGlobalParameters resolved
+func (woc *wfOperationCtx) workflowArguments() wfv1.Arguments {
+	args := *woc.execWf.Spec.Arguments.DeepCopy()
+	for i, param := range args.Parameters {
+		if value, ok := woc.wf.Status.ConfigMapParameterValues[param.Name]; ok && param.Value == nil {
+			args.Parameters[i].Value = wfv1.AnyStringPtr(value)
+		}
+	}
+	return args
+}
+
+// getConfigMapParameterValue returns the value of the key of the config map, in the namespace of the workflow, that the
+// value of the parameter is from. If the config map or the key is missing, the default of the value is returned, if any.
+func (woc *wfOperationCtx) getConfigMapParameterValue(ctx context.Context, param wfv1.Parameter) (string, error) {
+	ref := param.ValueFrom.ConfigMapKeyRef
+	cm, ok := woc.configMaps[ref.Name]
+	if !ok {
+		var err error
+		cm, err = woc.controller.kubeclientset.CoreV1().ConfigMaps(woc.wf.Namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+		if apierr.IsNotFound(err) {
+			cm = nil
+		} else if err != nil {
+			return "", err
+		}
+		woc.configMaps[ref.Name] = cm
+	}
+	if cm != nil {
+		if value, ok := cm.Data[ref.Key]; ok {
+			return value, nil
+		}
+	}
+	switch {
+	case param.ValueFrom.Default != nil:
+		return param.ValueFrom.Default.String(), nil
+	case ref.Optional != nil && *ref.Optional:
+		return "", nil
+	case cm == nil:
+		return "", errors.Errorf(errors.CodeBadRequest, "ConfigMap '%s' of parameter '%s' not found", ref.Name, param.Name)
+	default:
+		return "", errors.Errorf(errors.CodeBadRequest, "key '%s' of parameter '%s' not found in ConfigMap '%s'", ref.Key, param.Name, ref.Name)
+	}
 }
 
 // persistUpdates will update a workflow with any updates made during workflow operation.
@@ -819,6 +908,37 @@ func (woc *wfOperationCtx) processNodeRetries(node *wfv1.NodeStatus, retryStrate
 		return woc.markNodePhase(node.Name, lastChildNode.Phase, lastChildNode.Message), true, nil
 	}
 
+	if retryStrategy.Expression != "" {
+		params := woc.globalParams.Merge(buildRetryStrategyLocalScope(node, lastChildNode))
+		shouldRetry, err := argoexpr.EvalBool(retryStrategy.Expression, argoexpr.EnvMap(params))
+		if err != nil {
+			return nil, false, err
+		}
+		if !shouldRetry {
+			woc.log.Infof("Node not set to be retried as retryStrategy.expression '%s' evaluated false", retryStrategy.Expression)
+			return woc.markNodePhase(node.Name, lastChildNode.Phase, lastChildNode.Message), true, nil
+		}
+	}
+
+	if len(retryStrategy.RetryOn) > 0 {
+		failure := woc.getNodeFailure(lastChildNode)
+		i, matched, err := matchRetryOn(retryStrategy.RetryOn, failure)
+		if err != nil {
+			return nil, false, err
+		}
+		if i < 0 {
+			message := fmt.Sprintf("not retried as no retryOn rule matched %s", failure)
+			if lastChildNode.Message != "" {
+				message = fmt.Sprintf("%s (%s)", lastChildNode.Message, message)
+			}
+			woc.log.Infof("Node not set to be retried as no retryOn rule matched %s", failure)
+			return woc.markNodePhase(node.Name, lastChildNode.Phase, message), true, nil
+		}
+		message := fmt.Sprintf("Retrying as %s matched retryOn[%d]", matched, i)
+		woc.log.Info(message)
+		node = woc.markNodePhase(node.Name, node.Phase, message)
+	}
+
 	if !lastChildNode.CanRetry() {
 		woc.log.Infof("Node cannot be retried. Marking it failed")
 		return woc.markNodePhase(node.Name, lastChildNode.Phase, lastChildNode.Message), true, nil
@@ -837,6 +957,22 @@ func (woc *wfOperationCtx) processNodeRetries(node *wfv1.NodeStatus, retryStrate
 	return node, true, nil
 }
 
+// buildRetryStrategyLocalScope returns the variables a retry strategy's expression is evaluated with: the number of
+// retries so far, and the exit code, phase, duration and message of the last retry
+func buildRetryStrategyLocalScope(node, lastChildNode *wfv1.NodeStatus) map[string]string {
+	exitCode := "-1"
+	if lastChildNode.Outputs != nil && lastChildNode.Outputs.ExitCode != nil {
+		exitCode = *lastChildNode.Outputs.ExitCode
+	}
+	return map[string]string{
+		common.LocalVarRetries:             strconv.Itoa(len(node.Children) - 1),
+		common.LocalVarRetriesLastExitCode: exitCode,
+		common.LocalVarRetriesLastStatus:   string(lastChildNode.Phase),
+		common.LocalVarRetriesLastDuration: fmt.Sprint(lastChildNode.FinishedAt.Sub(lastChildNode.StartedAt.Time).Seconds()),
+		common.LocalVarRetriesLastMessage:  lastChildNode.Message,
+	}
+}
+
 // podReconciliation is the process by which a workflow will examine all its related
 // pods and update the node state before continuing the evaluation of the workflow.
 // Records all pods which were observed completed, which will be labeled completed=true
@@ -867,8 +1003,10 @@ func (woc *wfOperationCtx) podReconciliation(ctx context.Context) error {
 				woc.wf.Status.Nodes[nodeID] = *newState
 				woc.addOutputsToGlobalScope(node.Outputs)
 				if node.MemoizationStatus != nil {
-					c := woc.controller.cacheFactory.GetCache(controllercache.ConfigMapCache, node.MemoizationStatus.CacheName)
-					err := c.Save(ctx, node.MemoizationStatus.Key, node.ID, node.Outputs)
+					c := woc.controller.cacheFactory.GetCache(controllercache.TypeOf(node.MemoizationStatus.GetCacheType()), woc.wf.Namespace, node.MemoizationStatus.CacheName)
+					// the max age was parsed when the node was initialized, so it is valid
+					maxAge, _ := time.ParseDuration(node.MemoizationStatus.MaxAge)
+					err := c.Save(ctx, node.MemoizationStatus.Key, node.ID, node.Outputs, maxAge)
 					if err != nil {
 						woc.log.WithFields(log.Fields{"nodeID": node.ID}).WithError(err).Error("Failed to save node outputs to cache")
 						node.Phase = wfv1.NodeError
@@ -879,6 +1017,7 @@ func (woc *wfOperationCtx) podReconciliation(ctx context.Context) error {
 				}
 				woc.updated = true
 			}
+			woc.assessContainerNodes(pod, woc.wf.Status.Nodes[nodeID])
 			node := woc.wf.Status.Nodes[pod.ObjectMeta.Name]
 			if node.Fulfilled() && !node.IsDaemoned() {
 				if tmpVal, tmpOk := pod.Labels[common.LabelKeyCompleted]; tmpOk {
@@ -955,6 +1094,7 @@ func (woc *wfOperationCtx) podReconciliation(ctx context.Context) error {
 				woc.updated = true
 			}
 			woc.markNodePhase(node.Name, wfv1.NodeError, "pod deleted")
+			woc.completeContainerNodes(woc.wf.Status.Nodes[nodeID])
 		} else {
 			// At this point we are certain that the pod associated with our node is running or has been run;
 			// it is safe to extract the k8s-node information given this knowledge.
@@ -984,13 +1124,13 @@ func (woc *wfOperationCtx) failSuspendedAndPendingNodesAfterDeadlineOrShutdown()
 	if woc.execWf.Spec.Shutdown != "" || deadlineExceeded {
 		for _, node := range woc.wf.Status.Nodes {
 			if node.IsActiveSuspendNode() || (node.Phase == wfv1.NodePending && deadlineExceeded) {
-				var message string
 				if woc.execWf.Spec.Shutdown != "" {
-					message = fmt.Sprintf("Stopped with strategy '%s'", woc.execWf.Spec.Shutdown)
+					woc.markNodePhase(node.Name, wfv1.NodeFailed, fmt.Sprintf("Stopped with strategy '%s'", woc.execWf.Spec.Shutdown))
+					woc.markNodeFailureReason(node.Name, pendingNodeFailureReason(node, ""))
 				} else {
-					message = "Step exceeded its deadline"
+					woc.markNodePhase(node.Name, wfv1.NodeFailed, "Step exceeded its deadline")
+					woc.markNodeFailureReason(node.Name, pendingNodeFailureReason(node, wfv1.FailureReasonDeadlineExceeded))
 				}
-				woc.markNodePhase(node.Name, wfv1.NodeFailed, message)
 			}
 		}
 	}
@@ -1081,6 +1221,8 @@ func (woc *wfOperationCtx) assessNodeStatus(pod *apiv1.Pod, node *wfv1.NodeStatu
 	var newPhase wfv1.NodePhase
 	var newDaemonStatus *bool
 	var message string
+	var failureReason wfv1.FailureReason
+	var exitCode *int32
 	updated := false
 	switch pod.Status.Phase {
 	case apiv1.PodPending:
@@ -1096,6 +1238,9 @@ func (woc *wfOperationCtx) assessNodeStatus(pod *apiv1.Pod, node *wfv1.NodeStatu
 			newPhase = wfv1.NodeSucceeded
 		} else {
 			newPhase, message = inferFailedReason(pod)
+			if newPhase.FailedOrError() {
+				failureReason, exitCode = podFailureReason(pod)
+			}
 			woc.log.WithField("displayName", node.DisplayName).WithField("templateName", node.TemplateName).
 				WithField("pod", pod.Name).Infof("Pod failed: %s", message)
 		}
@@ -1106,6 +1251,7 @@ func (woc *wfOperationCtx) assessNodeStatus(pod *apiv1.Pod, node *wfv1.NodeStatu
 			newPhase = wfv1.NodeError
 			newDaemonStatus = pointer.BoolPtr(false)
 			message = "pod deleted during operation"
+			failureReason, exitCode = podFailureReason(pod)
 			woc.log.WithField("displayName", node.DisplayName).WithField("templateName", node.TemplateName).
 				WithField("pod", pod.Name).Error(message)
 		} else {
@@ -1186,6 +1332,15 @@ func (woc *wfOperationCtx) assessNodeStatus(pod *apiv1.Pod, node *wfv1.NodeStatu
 		updated = true
 		node.Message = message
 	}
+	if failureReason != "" && node.FailureReason != failureReason {
+		woc.log.Infof("Updating node %s failure reason: %s", node.ID, failureReason)
+		updated = true
+		node.FailureReason = failureReason
+	}
+	if exitCode != nil && (node.ExitCode == nil || *node.ExitCode != *exitCode) {
+		updated = true
+		node.ExitCode = exitCode
+	}
 
 	if node.Fulfilled() && node.FinishedAt.IsZero() {
 		updated = true
@@ -1286,6 +1441,11 @@ func inferFailedReason(pod *apiv1.Pod) (wfv1.NodePhase, string) {
 		return wfv1.NodeError, errMsg
 	}
 	failMessages := make(map[string]string)
+	// the containers of a container set are main containers rather than sidecars, and the first of them to fail is the
+	// cause of those that depend on it failing
+	containerSetNames := getContainerSetNames(pod)
+	var firstFailedAt time.Time
+	firstFailed := ""
 	for _, ctr := range pod.Status.ContainerStatuses {
 		// Virtual Kubelet environment will not set the terminate on waiting container
 		// https://github.com/argoproj/argo/issues/3879
@@ -1320,9 +1480,15 @@ func inferFailedReason(pod *apiv1.Pod) (wfv1.NodePhase, string) {
 			failMessages[ctr.Name] = errMsg
 			continue
 		}
+		if containerSetNames[ctr.Name] && (firstFailed == "" || ctr.State.Terminated.FinishedAt.Time.Before(firstFailedAt)) {
+			firstFailed = ctr.Name
+			firstFailedAt = ctr.State.Terminated.FinishedAt.Time
+		}
 		if ctr.State.Terminated.Message != "" {
 			errMsg := ctr.State.Terminated.Message
-			if ctr.Name != common.MainContainerName {
+			if containerSetNames[ctr.Name] {
+				errMsg = fmt.Sprintf("container '%s' %s", ctr.Name, errMsg)
+			} else if ctr.Name != common.MainContainerName {
 				errMsg = fmt.Sprintf("sidecar '%s' %s", ctr.Name, errMsg)
 			}
 			failMessages[ctr.Name] = errMsg
@@ -1333,7 +1499,9 @@ func inferFailedReason(pod *apiv1.Pod) (wfv1.NodePhase, string) {
 			continue
 		}
 		errMsg := fmt.Sprintf("failed with exit code %d", ctr.State.Terminated.ExitCode)
-		if ctr.Name != common.MainContainerName {
+		if containerSetNames[ctr.Name] {
+			errMsg = fmt.Sprintf("container '%s' %s", ctr.Name, errMsg)
+		} else if ctr.Name != common.MainContainerName {
 			if ctr.State.Terminated.ExitCode == 137 || ctr.State.Terminated.ExitCode == 143 {
 				// if the sidecar was SIGKILL'd (exit code 137) assume it was because argoexec
 				// forcibly killed the container, which we ignore the error for.
@@ -1345,6 +1513,9 @@ func inferFailedReason(pod *apiv1.Pod) (wfv1.NodePhase, string) {
 		}
 		failMessages[ctr.Name] = errMsg
 	}
+	if firstFailed != "" {
+		return wfv1.NodeFailed, failMessages[firstFailed]
+	}
 	if failMsg, ok := failMessages[common.MainContainerName]; ok {
 		_, ok = failMessages[common.WaitContainerName]
 		isResourceTemplate := !ok
@@ -1371,6 +1542,19 @@ func inferFailedReason(pod *apiv1.Pod) (wfv1.NodePhase, string) {
 	return wfv1.NodeSucceeded, ""
 }
 
+// getContainerSetNames returns the names of the containers of the pod that are in a container set
+func getContainerSetNames(pod *apiv1.Pod) map[string]bool {
+	names := make(map[string]bool)
+	for _, ctr := range pod.Spec.Containers {
+		for _, env := range ctr.Env {
+			if env.Name == common.EnvVarContainerName {
+				names[ctr.Name] = true
+			}
+		}
+	}
+	return names
+}
+
 func (woc *wfOperationCtx) createPVCs(ctx context.Context) error {
 	if !(woc.wf.Status.Phase == wfv1.NodePending || woc.wf.Status.Phase == wfv1.NodeRunning) {
 		// Only attempt to create PVCs if workflow is in Pending or Running state
@@ -1544,6 +1728,20 @@ func (woc *wfOperationCtx) executeTemplate(ctx context.Context, nodeName string,
 		localParams[common.LocalVarPodName] = woc.wf.NodeID(nodeName)
 	}
 
+	resolvedTmpl, args, err = common.SetConfigMapParameterValues(resolvedTmpl, args, func(param wfv1.Parameter) (string, error) {
+		// the value was read from the config map as the node was created, and is one of its inputs, unless it is
+		// sensitive, in which case it is redacted
+		if node != nil && node.Inputs != nil {
+			if input := node.Inputs.GetParameterByName(param.Name); input != nil && input.Value != nil && !input.IsSensitive() {
+				return input.Value.String(), nil
+			}
+		}
+		return woc.getConfigMapParameterValue(ctx, param)
+	})
+	if err != nil {
+		return woc.initializeNodeOrMarkError(node, nodeName, templateScope, orgTmpl, opts.boundaryID, err), err
+	}
+
 	// Inputs has been processed with arguments already, so pass empty arguments.
 	processedTmpl, err := common.ProcessArgs(resolvedTmpl, &args, woc.globalParams, localParams, false)
 	if err != nil {
@@ -1552,10 +1750,12 @@ func (woc *wfOperationCtx) executeTemplate(ctx context.Context, nodeName string,
 
 	// If memoization is on, check if node output exists in cache
 	if node == nil && processedTmpl.Memoize != nil {
-		memoizationCache := woc.controller.cacheFactory.GetCache(controllercache.ConfigMapCache, processedTmpl.Memoize.Cache.ConfigMap.Name)
+		cacheType := processedTmpl.Memoize.Cache.GetType()
+		cacheName := processedTmpl.Memoize.Cache.GetName()
+		memoizationCache := woc.controller.cacheFactory.GetCache(controllercache.TypeOf(cacheType), woc.wf.Namespace, cacheName)
 		if memoizationCache == nil {
 			err := fmt.Errorf("cache could not be found or created")
-			woc.log.WithFields(log.Fields{"cacheName": processedTmpl.Memoize.Cache.ConfigMap.Name}).WithError(err)
+			woc.log.WithFields(log.Fields{"cacheName": cacheName}).WithError(err)
 			return woc.initializeNodeOrMarkError(node, nodeName, templateScope, orgTmpl, opts.boundaryID, err), err
 		}
 
@@ -1585,9 +1785,14 @@ func (woc *wfOperationCtx) executeTemplate(ctx context.Context, nodeName string,
 		memoizationStatus := &wfv1.MemoizationStatus{
 			Hit:       hit,
 			Key:       processedTmpl.Memoize.Key,
-			CacheName: processedTmpl.Memoize.Cache.ConfigMap.Name,
+			CacheName: cacheName,
+			MaxAge:    processedTmpl.Memoize.MaxAge,
+		}
+		if cacheType != wfv1.CacheTypeConfigMap {
+			memoizationStatus.CacheType = cacheType
 		}
 		if hit {
+			woc.controller.cacheFactory.RecordHit(controllercache.TypeOf(cacheType), woc.wf.Namespace, cacheName, processedTmpl.Memoize.Key)
 			node = woc.initializeCacheHitNode(nodeName, processedTmpl, templateScope, orgTmpl, opts.boundaryID, outputs, memoizationStatus)
 		} else {
 			node = woc.initializeCacheNode(nodeName, processedTmpl, templateScope, orgTmpl, opts.boundaryID, memoizationStatus)
@@ -1611,6 +1816,12 @@ func (woc *wfOperationCtx) executeTemplate(ctx context.Context, nodeName string,
 					woc.computeMetrics(processedTmpl.Metrics.Prometheus, localScope, realTimeScope, false)
 				}
 			}
+			// As with metrics, export the outputs of a node that succeeded between executions
+			if woc.succeededInThisOperation(node) {
+				if err := woc.exportOutputParameters(ctx, processedTmpl, node); err != nil {
+					return woc.markNodeError(nodeName, err), err
+				}
+			}
 			return node, nil
 		}
 		woc.log.Debugf("Executing node %s of %s is %s", nodeName, node.Type, node.Phase)
@@ -1751,6 +1962,12 @@ func (woc *wfOperationCtx) executeTemplate(ctx context.Context, nodeName string,
 		node, err = woc.executeDAG(ctx, nodeName, newTmplCtx, templateScope, processedTmpl, orgTmpl, opts)
 	case wfv1.TemplateTypeSuspend:
 		node, err = woc.executeSuspend(nodeName, templateScope, processedTmpl, orgTmpl, opts)
+	case wfv1.TemplateTypeContainerSet:
+		node, err = woc.executeContainerSet(ctx, nodeName, templateScope, processedTmpl, orgTmpl, opts)
+	case wfv1.TemplateTypeHTTP:
+		node, err = woc.executeHTTP(ctx, nodeName, templateScope, processedTmpl, orgTmpl, opts)
+	case wfv1.TemplateTypeWorkflow:
+		node, err = woc.executeChildWorkflow(ctx, nodeName, templateScope, processedTmpl, orgTmpl, opts)
 	default:
 		err = errors.Errorf(errors.CodeBadRequest, "Template '%s' missing specification", processedTmpl.Name)
 		return woc.initializeNode(nodeName, wfv1.NodeTypeSkipped, templateScope, orgTmpl, opts.boundaryID, wfv1.NodeError, err.Error()), err
@@ -1797,6 +2014,13 @@ func (woc *wfOperationCtx) executeTemplate(ctx context.Context, nodeName string,
 
 	node = woc.wf.GetNodeByName(node.Name)
 
+	// Export the outputs of a node that succeeded within the current execution
+	if woc.succeededInThisOperation(node) {
+		if err := woc.exportOutputParameters(ctx, processedTmpl, node); err != nil {
+			return woc.markNodeError(node.Name, err), err
+		}
+	}
+
 	// Swap the node back to retry node
 	if retryNodeName != "" {
 		retryNode := woc.wf.GetNodeByName(retryNodeName)
@@ -1966,6 +2190,7 @@ func (woc *wfOperationCtx) initializeExecutableNode(nodeName string, nodeType wf
 	// Set the input values to the node.
 	if executeTmpl.Inputs.HasInputs() {
 		node.Inputs = executeTmpl.Inputs.DeepCopy()
+		node.Inputs.Parameters = wfv1.Redacted(node.Inputs.Parameters)
 	}
 
 	if nodeType == wfv1.NodeTypeSuspend {
@@ -2040,6 +2265,10 @@ func (woc *wfOperationCtx) initializeNode(nodeName string, nodeType wfv1.NodeTyp
 		if stepsOrDagSeparator.MatchString(node.DisplayName) {
 			node.DisplayName = stepsOrDagSeparator.ReplaceAllString(node.DisplayName, "")
 		}
+		// An iteration of a loop is named after the loop, e.g. `poll(0)`
+		if boundaryNode.Type == wfv1.NodeTypeLoop {
+			node.DisplayName = boundaryNode.DisplayName + node.DisplayName
+		}
 	} else {
 		node.DisplayName = nodeName
 	}
@@ -2217,7 +2446,7 @@ func (woc *wfOperationCtx) executeContainer(ctx context.Context, nodeName string
 	}
 
 	woc.log.Debugf("Executing node %s with container template: %v\n", nodeName, tmpl)
-	_, err = woc.createWorkflowPod(ctx, nodeName, *tmpl.Container, tmpl, &createWorkflowPodOpts{
+	_, err = woc.createWorkflowPod(ctx, nodeName, []apiv1.Container{*tmpl.Container}, tmpl, &createWorkflowPodOpts{
 		includeScriptOutput: includeScriptOutput,
 		onExitPod:           opts.onExitTemplate,
 		executionDeadline:   opts.executionDeadline,
@@ -2233,7 +2462,7 @@ func (woc *wfOperationCtx) executeContainer(ctx context.Context, nodeName string
 func (woc *wfOperationCtx) getOutboundNodes(nodeID string) []string {
 	node := woc.wf.Status.Nodes[nodeID]
 	switch node.Type {
-	case wfv1.NodeTypePod, wfv1.NodeTypeSkipped, wfv1.NodeTypeSuspend:
+	case wfv1.NodeTypePod, wfv1.NodeTypeSkipped, wfv1.NodeTypeSuspend, wfv1.NodeTypeHTTP, wfv1.NodeTypeWorkflow:
 		return []string{node.ID}
 	case wfv1.NodeTypeTaskGroup:
 		if len(node.Children) == 0 {
@@ -2249,11 +2478,16 @@ func (woc *wfOperationCtx) getOutboundNodes(nodeID string) []string {
 		if numChildren > 0 {
 			return []string{node.Children[numChildren-1]}
 		}
+	case wfv1.NodeTypeLoop:
+		numChildren := len(node.Children)
+		if numChildren > 0 {
+			return woc.getOutboundNodes(node.Children[numChildren-1])
+		}
 	}
 	outbound := make([]string, 0)
 	for _, outboundNodeID := range node.OutboundNodes {
 		outNode := woc.wf.Status.Nodes[outboundNodeID]
-		if outNode.Type == wfv1.NodeTypePod {
+		if outNode.Type == wfv1.NodeTypePod || outNode.Type == wfv1.NodeTypeHTTP || outNode.Type == wfv1.NodeTypeWorkflow {
 			outbound = append(outbound, outboundNodeID)
 		} else {
 			subOutIDs := woc.getOutboundNodes(outboundNodeID)
@@ -2365,7 +2599,7 @@ func (woc *wfOperationCtx) executeScript(ctx context.Context, nodeName string, t
 	}
 
 	mainCtr := extractMainCtrFromScriptTemplate(tmpl)
-	_, err = woc.createWorkflowPod(ctx, nodeName, mainCtr, tmpl, &createWorkflowPodOpts{
+	_, err = woc.createWorkflowPod(ctx, nodeName, []apiv1.Container{mainCtr}, tmpl, &createWorkflowPodOpts{
 		includeScriptOutput: includeScriptOutput,
 		onExitPod:           opts.onExitTemplate,
 		executionDeadline:   opts.executionDeadline,
@@ -2393,6 +2627,12 @@ func (woc *wfOperationCtx) buildLocalScope(scope *wfScope, prefix string, node *
 	if node.Type == wfv1.NodeTypeRetry {
 		node = getChildNodeIndex(node, woc.wf.Status.Nodes, -1)
 	}
+	// Likewise, the outputs of a loop node are those of its last iteration
+	if node.Type == wfv1.NodeTypeLoop {
+		if iteration := lastLoopIteration(node, woc.wf.Status.Nodes); iteration != nil {
+			node = iteration
+		}
+	}
 
 	if node.ID != "" {
 		key := fmt.Sprintf("%s.id", prefix)
@@ -2512,7 +2752,7 @@ func (woc *wfOperationCtx) processAggregateNodeOutputs(tmpl *wfv1.Template, scop
 			resultsList = append(resultsList, item)
 		}
 	}
-	if tmpl.GetType() == wfv1.TemplateTypeScript || tmpl.GetType() == wfv1.TemplateTypeContainer {
+	if tmpl.GetType() == wfv1.TemplateTypeScript || tmpl.GetType() == wfv1.TemplateTypeContainer || tmpl.GetType() == wfv1.TemplateTypeHTTP {
 		resultsJSON, err := json.Marshal(resultsList)
 		if err != nil {
 			return err
@@ -2663,7 +2903,7 @@ func (woc *wfOperationCtx) executeResource(ctx context.Context, nodeName string,
 
 	mainCtr := woc.newExecContainer(common.MainContainerName, tmpl)
 	mainCtr.Command = []string{"argoexec", "resource", tmpl.Resource.Action}
-	_, err = woc.createWorkflowPod(ctx, nodeName, *mainCtr, tmpl, &createWorkflowPodOpts{onExitPod: opts.onExitTemplate, executionDeadline: opts.executionDeadline})
+	_, err = woc.createWorkflowPod(ctx, nodeName, []apiv1.Container{*mainCtr}, tmpl, &createWorkflowPodOpts{onExitPod: opts.onExitTemplate, executionDeadline: opts.executionDeadline})
 	if err != nil {
 		return woc.requeueIfTransientErr(err, node.Name)
 	}
@@ -2905,7 +3145,7 @@ func (woc *wfOperationCtx) runOnExitNode(ctx context.Context, templateRef, paren
 	if templateRef != "" && woc.wf.Spec.Shutdown.ShouldExecute(true) {
 		woc.log.Infof("Running OnExit handler: %s", templateRef)
 		onExitNodeName := common.GenerateOnExitNodeName(parentDisplayName)
-		onExitNode, err := woc.executeTemplate(ctx, onExitNodeName, &wfv1.WorkflowStep{Template: templateRef}, tmplCtx, woc.execWf.Spec.Arguments, &executeTemplateOpts{
+		onExitNode, err := woc.executeTemplate(ctx, onExitNodeName, &wfv1.WorkflowStep{Template: templateRef}, tmplCtx, woc.workflowArguments(), &executeTemplateOpts{
 			boundaryID:     boundaryID,
 			onExitTemplate: true,
 		})
@@ -3107,6 +3347,10 @@ func (woc *wfOperationCtx) deletePDBResource(ctx context.Context) error {
 // execution.
 func (woc *wfOperationCtx) includeScriptOutput(nodeName, boundaryID string) (bool, error) {
 	if boundaryNode, ok := woc.wf.Status.Nodes[boundaryID]; ok {
+		// The result of an iteration can be referred to by the next iteration and the condition of its loop
+		if boundaryNode.Type == wfv1.NodeTypeLoop {
+			return true, nil
+		}
 		tmplCtx, err := woc.createTemplateContext(boundaryNode.GetTemplateScope())
 		if err != nil {
 			return false, err
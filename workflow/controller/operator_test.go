@@ -30,6 +30,8 @@ import (
 	"sigs.k8s.io/yaml"
 
 	"github.com/simster7/argo/v2/config"
+	"github.com/simster7/argo/v2/persist/sqldb"
+	sqldbmocks "github.com/simster7/argo/v2/persist/sqldb/mocks"
 	wfv1 "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1"
 	"github.com/simster7/argo/v2/test"
 	testutil "github.com/simster7/argo/v2/test/util"
@@ -502,6 +504,128 @@ func TestProcessNodesWithRetries(t *testing.T) {
 	assert.Equal(t, n.Phase, wfv1.NodeFailed)
 }
 
+// TestProcessNodesWithRetriesWithExpression tests retrying only when the retry strategy's expression evaluates to true
+func TestProcessNodesWithRetriesWithExpression(t *testing.T) {
+	cancel, controller := newController()
+	defer cancel()
+	wf := unmarshalWF(helloWorldWf)
+	woc := newWorkflowOperationCtx(wf, controller)
+
+	nodeName := "test-node"
+	node := woc.initializeNode(nodeName, wfv1.NodeTypeRetry, "", &wfv1.Template{}, "", wfv1.NodeRunning)
+	retries := wfv1.RetryStrategy{
+		Limit:      intstrutil.ParsePtr("10"),
+		Expression: `lastRetry.exitCode == 2 && lastRetry.status == "Failed" && retries < 1`,
+	}
+	woc.wf.Status.Nodes[node.ID] = *node
+
+	addFailedChild := func(i int, exitCode string) {
+		childNode := fmt.Sprintf("child-node-%d", i)
+		woc.initializeNode(childNode, wfv1.NodeTypePod, "", &wfv1.Template{}, "", wfv1.NodeFailed)
+		child := woc.wf.GetNodeByName(childNode)
+		child.Outputs = &wfv1.Outputs{ExitCode: &exitCode}
+		woc.wf.Status.Nodes[child.ID] = *child
+		woc.addChildNode(nodeName, childNode)
+	}
+
+	// the first attempt exited with the exit code that is retried
+	addFailedChild(0, "2")
+	n, _, err := woc.processNodeRetries(woc.wf.GetNodeByName(nodeName), retries, &executeTemplateOpts{})
+	assert.NoError(t, err)
+	assert.Equal(t, wfv1.NodeRunning, n.Phase)
+
+	// the expression only allows one retry
+	addFailedChild(1, "2")
+	n, _, err = woc.processNodeRetries(woc.wf.GetNodeByName(nodeName), retries, &executeTemplateOpts{})
+	assert.NoError(t, err)
+	assert.Equal(t, wfv1.NodeFailed, n.Phase)
+
+	retries.Expression = "lastRetry.exitCode =="
+	woc.markNodePhase(nodeName, wfv1.NodeRunning)
+	_, _, err = woc.processNodeRetries(woc.wf.GetNodeByName(nodeName), retries, &executeTemplateOpts{})
+	assert.Error(t, err)
+}
+
+func TestProcessNodesWithRetriesWithRetryOn(t *testing.T) {
+	cancel, controller := newController()
+	defer cancel()
+	wf := unmarshalWF(helloWorldWf)
+	wf.Namespace = "default"
+	woc := newWorkflowOperationCtx(wf, controller)
+
+	nodeName := "test-node"
+	node := woc.initializeNode(nodeName, wfv1.NodeTypeRetry, "", &wfv1.Template{}, "", wfv1.NodeRunning)
+	retries := wfv1.RetryStrategy{
+		Limit: intstrutil.ParsePtr("10"),
+		RetryOn: []wfv1.RetryOn{
+			{ExitCodes: []int32{137, 143}},
+			{Reasons: []string{"Evicted", "ImagePullBackOff"}},
+			{MessagePattern: "connection (refused|reset)"},
+		},
+	}
+	woc.wf.Status.Nodes[node.ID] = *node
+
+	addFailedChild := func(i int, message string, status apiv1.PodStatus) {
+		childNode := fmt.Sprintf("child-node-%d", i)
+		child := woc.initializeNode(childNode, wfv1.NodeTypePod, "", &wfv1.Template{}, "", wfv1.NodeFailed, message)
+		woc.addChildNode(nodeName, childNode)
+		pod := &apiv1.Pod{ObjectMeta: metav1.ObjectMeta{Name: child.ID, Namespace: woc.wf.Namespace}, Status: status}
+		assert.NoError(t, controller.podInformer.GetStore().Add(pod))
+	}
+	terminated := func(exitCode int32, reason string) apiv1.PodStatus {
+		return apiv1.PodStatus{ContainerStatuses: []apiv1.ContainerStatus{{
+			Name:  common.MainContainerName,
+			State: apiv1.ContainerState{Terminated: &apiv1.ContainerStateTerminated{ExitCode: exitCode, Reason: reason}},
+		}}}
+	}
+
+	// the exit code of the main container matches the first rule
+	addFailedChild(0, "OOMKilled (exit code 137)", terminated(137, "OOMKilled"))
+	n, _, err := woc.processNodeRetries(woc.wf.GetNodeByName(nodeName), retries, &executeTemplateOpts{})
+	assert.NoError(t, err)
+	assert.Equal(t, wfv1.NodeRunning, n.Phase)
+	assert.Equal(t, "Retrying as exit code 137 matched retryOn[0]", n.Message)
+
+	// the reason of the pod matches the second rule
+	addFailedChild(1, "The node was low on resource: memory.", apiv1.PodStatus{Reason: "Evicted"})
+	n, _, err = woc.processNodeRetries(woc.wf.GetNodeByName(nodeName), retries, &executeTemplateOpts{})
+	assert.NoError(t, err)
+	assert.Equal(t, wfv1.NodeRunning, n.Phase)
+	assert.Equal(t, "Retrying as reason Evicted matched retryOn[1]", n.Message)
+
+	// the message of the node matches the third rule
+	addFailedChild(2, "dial tcp: connection refused", terminated(1, "Error"))
+	n, _, err = woc.processNodeRetries(woc.wf.GetNodeByName(nodeName), retries, &executeTemplateOpts{})
+	assert.NoError(t, err)
+	assert.Equal(t, wfv1.NodeRunning, n.Phase)
+	assert.Equal(t, "Retrying as message matching 'connection (refused|reset)' matched retryOn[2]", n.Message)
+
+	// a bug in the code is not retried
+	addFailedChild(3, "Error (exit code 1)", terminated(1, "Error"))
+	n, _, err = woc.processNodeRetries(woc.wf.GetNodeByName(nodeName), retries, &executeTemplateOpts{})
+	assert.NoError(t, err)
+	assert.Equal(t, wfv1.NodeFailed, n.Phase)
+	assert.Equal(t, "Error (exit code 1) (not retried as no retryOn rule matched exit code 1 and reasons Error)", n.Message)
+
+	// all the conditions of a rule must match
+	retries.RetryOn = []wfv1.RetryOn{{ExitCodes: []int32{1}, MessagePattern: "timeout"}}
+	woc.markNodePhase(nodeName, wfv1.NodeRunning)
+	n, _, err = woc.processNodeRetries(woc.wf.GetNodeByName(nodeName), retries, &executeTemplateOpts{})
+	assert.NoError(t, err)
+	assert.Equal(t, wfv1.NodeFailed, n.Phase)
+
+	// the failure reason of the node matches even if its pod was deleted
+	retries.RetryOn = []wfv1.RetryOn{{Reasons: []string{string(wfv1.FailureReasonNodeLost)}}}
+	woc.markNodePhase(nodeName, wfv1.NodeRunning)
+	woc.initializeNode("child-node-4", wfv1.NodeTypePod, "", &wfv1.Template{}, "", wfv1.NodeFailed, "pod deleted")
+	woc.markNodeFailureReason("child-node-4", wfv1.FailureReasonNodeLost)
+	woc.addChildNode(nodeName, "child-node-4")
+	n, _, err = woc.processNodeRetries(woc.wf.GetNodeByName(nodeName), retries, &executeTemplateOpts{})
+	assert.NoError(t, err)
+	assert.Equal(t, wfv1.NodeRunning, n.Phase)
+	assert.Equal(t, "Retrying as reason NodeLost matched retryOn[0]", n.Message)
+}
+
 // TestProcessNodesWithRetries tests retrying when RetryOn.Error is enabled
 func TestProcessNodesWithRetriesOnErrors(t *testing.T) {
 	cancel, controller := newController()
@@ -1765,7 +1889,7 @@ func TestExpandWithItems(t *testing.T) {
 	wf, err := wfcset.Create(ctx, wf, metav1.CreateOptions{})
 	assert.NoError(t, err)
 	woc := newWorkflowOperationCtx(wf, controller)
-	newSteps, err := woc.expandStep(wf.Spec.Templates[0].Steps[0].Steps[0])
+	newSteps, err := woc.expandStep(context.Background(), "", wf.Spec.Templates[0].Steps[0].Steps[0])
 	assert.NoError(t, err)
 	assert.Equal(t, 5, len(newSteps))
 	woc.operate(ctx)
@@ -1815,7 +1939,7 @@ func TestExpandWithItemsMap(t *testing.T) {
 	wf, err := wfcset.Create(ctx, wf, metav1.CreateOptions{})
 	assert.NoError(t, err)
 	woc := newWorkflowOperationCtx(wf, controller)
-	newSteps, err := woc.expandStep(wf.Spec.Templates[0].Steps[0].Steps[0])
+	newSteps, err := woc.expandStep(context.Background(), "", wf.Spec.Templates[0].Steps[0].Steps[0])
 	assert.NoError(t, err)
 	assert.Equal(t, 3, len(newSteps))
 	assert.Equal(t, "debian 9.1 JSON({\"os\":\"debian\",\"version\":9.1})", newSteps[0].Arguments.Parameters[0].Value.String())
@@ -4327,6 +4451,7 @@ apiVersion: argoproj.io/v1alpha1
 kind: Workflow
 metadata:
   name: memoized-workflow-test
+  namespace: default
 spec:
   entrypoint: whalesay
   arguments:
@@ -4396,6 +4521,7 @@ apiVersion: argoproj.io/v1alpha1
 kind: Workflow
 metadata:
   name: memoized-workflow-test
+  namespace: default
 spec:
   entrypoint: whalesay
   arguments:
@@ -4555,6 +4681,96 @@ func TestConfigMapCacheSaveOperate(t *testing.T) {
 	}
 }
 
+var workflowDatabaseCached = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: memoized-workflow-test
+  namespace: default
+spec:
+  entrypoint: whalesay
+  templates:
+  - name: whalesay
+    memoize:
+      key: hi-there-world
+      maxAge: 1h
+      cache:
+        database:
+          name: whalesay-cache
+    container:
+      image: docker/whalesay:latest
+`
+
+func TestDatabaseCacheOperate(t *testing.T) {
+	sampleOutputs := wfv1.Outputs{
+		Parameters: []wfv1.Parameter{
+			{Name: "hello", Value: wfv1.AnyStringPtr("foobar")},
+		},
+	}
+	t.Run("Save", func(t *testing.T) {
+		repo := &sqldbmocks.MemoizationCacheRepo{}
+		repo.On("Load", "default", "whalesay-cache", "hi-there-world").Return(nil, nil)
+		repo.On("Save", "default", "whalesay-cache", "hi-there-world", "memoized-workflow-test", &sampleOutputs, time.Hour).Return(nil)
+		wf := unmarshalWF(workflowDatabaseCached)
+		cancel, controller := newController(wf, func(wfc *WorkflowController) {
+			wfc.cacheFactory = cache.NewCacheFactory(wfc.kubeclientset, repo)
+		})
+		defer cancel()
+
+		ctx := context.Background()
+		woc := newWorkflowOperationCtx(wf, controller)
+		woc.operate(ctx)
+		node := woc.wf.Status.Nodes[woc.wf.Name]
+		if assert.NotNil(t, node.MemoizationStatus) {
+			assert.False(t, node.MemoizationStatus.Hit)
+			assert.Equal(t, wfv1.CacheTypeDatabase, node.MemoizationStatus.CacheType)
+		}
+		makePodsPhase(ctx, woc, apiv1.PodSucceeded, withOutputs(testutil.MustMarshallJSON(sampleOutputs)))
+		woc = newWorkflowOperationCtx(woc.wf, controller)
+		woc.operate(ctx)
+		repo.AssertExpectations(t)
+		assert.Equal(t, wfv1.NodeSucceeded, woc.wf.Status.Phase)
+	})
+	t.Run("Hit", func(t *testing.T) {
+		repo := &sqldbmocks.MemoizationCacheRepo{}
+		repo.On("Load", "default", "whalesay-cache", "hi-there-world").Return(&sqldb.MemoizationCacheEntry{NodeID: "my-node", Outputs: &sampleOutputs, CreatedAt: time.Now()}, nil)
+		repo.On("RecordHits", "default", "whalesay-cache", "hi-there-world", int64(1)).Return(nil)
+		wf := unmarshalWF(workflowDatabaseCached)
+		cancel, controller := newController(wf, func(wfc *WorkflowController) {
+			wfc.cacheFactory = cache.NewCacheFactory(wfc.kubeclientset, repo)
+		})
+		defer cancel()
+
+		ctx := context.Background()
+		woc := newWorkflowOperationCtx(wf, controller)
+		woc.operate(ctx)
+		node := woc.wf.Status.Nodes[woc.wf.Name]
+		if assert.NotNil(t, node.MemoizationStatus) {
+			assert.True(t, node.MemoizationStatus.Hit)
+		}
+		assert.Equal(t, wfv1.NodeSucceeded, node.Phase)
+		// the hit is recorded once the hits are flushed, rather than as the workflow is reconciled
+		repo.AssertNotCalled(t, "RecordHits", "default", "whalesay-cache", "hi-there-world", int64(1))
+		controller.cacheFactory.FlushHits(ctx)
+		repo.AssertExpectations(t)
+	})
+	t.Run("WorkflowNamespace", func(t *testing.T) {
+		// the entries are kept by the namespace of the workflow, rather than that of the controller
+		repo := &sqldbmocks.MemoizationCacheRepo{}
+		repo.On("Load", "other", "whalesay-cache", "hi-there-world").Return(nil, nil)
+		wf := unmarshalWF(workflowDatabaseCached)
+		wf.Namespace = "other"
+		cancel, controller := newController(wf, func(wfc *WorkflowController) {
+			wfc.cacheFactory = cache.NewCacheFactory(wfc.kubeclientset, repo)
+		})
+		defer cancel()
+
+		woc := newWorkflowOperationCtx(wf, controller)
+		woc.operate(context.Background())
+		repo.AssertExpectations(t)
+	})
+}
+
 var propagate = `
 apiVersion: argoproj.io/v1alpha1
 kind: Workflow
@@ -5744,3 +5960,451 @@ func TestRetryOnDiffHost(t *testing.T) {
 	}
 	assert.Equal(t, sourceNodeSelectorRequirement, targetNodeSelectorRequirement)
 }
+
+var configMapParametersWf = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: config-map-parameters
+  namespace: default
+spec:
+  entrypoint: main
+  arguments:
+    parameters:
+    - name: region
+      valueFrom:
+        configMapKeyRef:
+          name: settings
+          key: region
+  templates:
+  - name: main
+    inputs:
+      parameters:
+      - name: replicas
+        valueFrom:
+          configMapKeyRef:
+            name: settings
+            key: replicas
+      - name: tier
+        valueFrom:
+          configMapKeyRef:
+            name: settings
+            key: tier
+          default: standard
+    container:
+      image: alpine:3.7
+      command: [echo, "{{workflow.parameters.region}}", "{{inputs.parameters.replicas}}", "{{inputs.parameters.tier}}"]
+`
+
+func TestConfigMapParameters(t *testing.T) {
+	ctx := context.Background()
+	settings := &apiv1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "settings", Namespace: "default"},
+		Data:       map[string]string{"region": "eu-west-1", "replicas": "3"},
+	}
+
+	t.Run("Resolved", func(t *testing.T) {
+		wf := unmarshalWF(configMapParametersWf)
+		cancel, controller := newController(wf)
+		defer cancel()
+		_, err := controller.kubeclientset.CoreV1().ConfigMaps("default").Create(ctx, settings, metav1.CreateOptions{})
+		assert.NoError(t, err)
+		woc := newWorkflowOperationCtx(wf, controller)
+		woc.operate(ctx)
+		assert.Equal(t, wfv1.NodeRunning, woc.wf.Status.Phase)
+		pods, err := listPods(woc)
+		if assert.NoError(t, err) && assert.Len(t, pods.Items, 1) {
+			for _, ctr := range pods.Items[0].Spec.Containers {
+				if ctr.Name == common.MainContainerName {
+					assert.Equal(t, []string{"echo", "eu-west-1", "3", "standard"}, ctr.Command)
+				}
+			}
+		}
+		assert.Equal(t, map[string]string{"region": "eu-west-1"}, woc.wf.Status.ConfigMapParameterValues)
+
+		// the values are not read again, so deleting the config map does not affect the running workflow
+		err = controller.kubeclientset.CoreV1().ConfigMaps("default").Delete(ctx, "settings", metav1.DeleteOptions{})
+		assert.NoError(t, err)
+		makePodsPhase(ctx, woc, apiv1.PodRunning)
+		woc = newWorkflowOperationCtx(woc.wf, controller)
+		woc.operate(ctx)
+		assert.Equal(t, wfv1.NodeRunning, woc.wf.Status.Phase)
+		assert.Equal(t, wfv1.NodeRunning, woc.wf.Status.Nodes.FindByDisplayName("config-map-parameters").Phase, woc.wf.Status.Nodes.FindByDisplayName("config-map-parameters").Message)
+		assert.Equal(t, "eu-west-1", woc.globalParams["workflow.parameters.region"])
+	})
+	t.Run("MissingConfigMap", func(t *testing.T) {
+		wf := unmarshalWF(configMapParametersWf)
+		cancel, controller := newController(wf)
+		defer cancel()
+		woc := newWorkflowOperationCtx(wf, controller)
+		woc.operate(ctx)
+		assert.Equal(t, wfv1.NodeFailed, woc.wf.Status.Phase)
+		assert.Equal(t, "invalid spec: ConfigMap 'settings' of parameter 'region' not found", woc.wf.Status.Message)
+	})
+	t.Run("MissingKey", func(t *testing.T) {
+		wf := unmarshalWF(configMapParametersWf)
+		cancel, controller := newController(wf)
+		defer cancel()
+		cm := settings.DeepCopy()
+		delete(cm.Data, "replicas")
+		_, err := controller.kubeclientset.CoreV1().ConfigMaps("default").Create(ctx, cm, metav1.CreateOptions{})
+		assert.NoError(t, err)
+		woc := newWorkflowOperationCtx(wf, controller)
+		woc.operate(ctx)
+		node := woc.wf.Status.Nodes.FindByDisplayName("config-map-parameters")
+		if assert.NotNil(t, node) {
+			assert.Equal(t, wfv1.NodeError, node.Phase)
+			assert.Equal(t, "key 'replicas' of parameter 'replicas' not found in ConfigMap 'settings'", node.Message)
+		}
+	})
+}
+
+var inlineTemplatesWf = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: inline-templates
+  namespace: default
+spec:
+  entrypoint: main
+  templateDefaults:
+    activeDeadlineSeconds: 300
+  templates:
+  - name: main
+    inputs:
+      parameters:
+      - name: message
+        value: hello
+    steps:
+    - - name: say
+        withItems: [a, b]
+        arguments:
+          parameters:
+          - name: message
+            value: "{{item}}"
+        inline:
+          inputs:
+            parameters:
+            - name: message
+          container:
+            image: docker/whalesay
+            command: [cowsay]
+            args: ["{{inputs.parameters.message}}"]
+          outputs:
+            parameters:
+            - name: said
+              valueFrom:
+                path: /tmp/said
+    - - name: done
+        arguments:
+          parameters:
+          - name: said
+            value: "{{steps.say.outputs.parameters.said}}"
+        inline:
+          inputs:
+            parameters:
+            - name: said
+          container:
+            image: docker/whalesay
+            command: [cowsay]
+            args: ["{{inputs.parameters.said}}"]
+`
+
+func TestInlineTemplates(t *testing.T) {
+	ctx := context.Background()
+	wf := unmarshalWF(inlineTemplatesWf)
+	cancel, controller := newController(wf)
+	defer cancel()
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(ctx)
+	pods, err := listPods(woc)
+	if assert.NoError(t, err) && assert.Len(t, pods.Items, 2) {
+		var args []string
+		for _, pod := range pods.Items {
+			// the template defaults are merged into the inline template
+			if assert.NotNil(t, pod.Spec.ActiveDeadlineSeconds) {
+				assert.Equal(t, int64(300), *pod.Spec.ActiveDeadlineSeconds)
+			}
+			for _, ctr := range pod.Spec.Containers {
+				if ctr.Name == common.MainContainerName {
+					args = append(args, ctr.Args...)
+				}
+			}
+		}
+		// the inline template is substituted with its own inputs, rather than with those of the steps template
+		assert.ElementsMatch(t, []string{"a", "b"}, args)
+	}
+
+	makePodsPhase(ctx, woc, apiv1.PodSucceeded, withOutputs(`{"parameters": [{"name": "said", "value": "moo"}]}`))
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate(ctx)
+	node := woc.wf.Status.Nodes.FindByDisplayName("done")
+	if assert.NotNil(t, node) {
+		assert.Empty(t, node.TemplateName)
+		assert.Equal(t, `["moo","moo"]`, node.Inputs.GetParameterByName("said").Value.String())
+	}
+}
+
+var inlineDAGTemplatesWf = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: inline-dag-templates
+  namespace: default
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    dag:
+      tasks:
+      - name: say
+        withItems: [a, b]
+        arguments:
+          parameters:
+          - name: message
+            value: "{{item}}"
+        inline:
+          inputs:
+            parameters:
+            - name: message
+          container:
+            image: docker/whalesay
+            command: [cowsay]
+            args: ["{{inputs.parameters.message}}"]
+          outputs:
+            parameters:
+            - name: said
+              valueFrom:
+                path: /tmp/said
+      - name: done
+        dependencies: [say]
+        arguments:
+          parameters:
+          - name: said
+            value: "{{tasks.say.outputs.parameters.said}}"
+        inline:
+          inputs:
+            parameters:
+            - name: said
+          container:
+            image: docker/whalesay
+            command: [cowsay]
+            args: ["{{inputs.parameters.said}}"]
+`
+
+func TestInlineDAGTemplates(t *testing.T) {
+	ctx := context.Background()
+	wf := unmarshalWF(inlineDAGTemplatesWf)
+	cancel, controller := newController(wf)
+	defer cancel()
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(ctx)
+	pods, err := listPods(woc)
+	if assert.NoError(t, err) {
+		assert.Len(t, pods.Items, 2)
+	}
+
+	makePodsPhase(ctx, woc, apiv1.PodSucceeded, withOutputs(`{"parameters": [{"name": "said", "value": "moo"}]}`))
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate(ctx)
+	node := woc.wf.Status.Nodes.FindByDisplayName("done")
+	if assert.NotNil(t, node) {
+		assert.Equal(t, `["moo","moo"]`, node.Inputs.GetParameterByName("said").Value.String())
+	}
+}
+
+var loopWf = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: loop
+  namespace: default
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: poll
+        template: poll
+        arguments:
+          parameters:
+          - name: previous
+            value: "{{loop.index}}:{{loop.outputs.parameters.status}}"
+        loop:
+          until: outputs.parameters.status == "ready"
+          maxIterations: 3
+    - - name: done
+        template: poll
+        arguments:
+          parameters:
+          - name: previous
+            value: "{{steps.poll.outputs.parameters.status}}"
+  - name: poll
+    inputs:
+      parameters:
+      - name: previous
+    container:
+      image: docker/whalesay
+      command: [cowsay]
+      args: ["{{inputs.parameters.previous}}"]
+    outputs:
+      parameters:
+      - name: status
+        valueFrom:
+          path: /tmp/status
+`
+
+func TestLoop(t *testing.T) {
+	ctx := context.Background()
+	wf := unmarshalWF(loopWf)
+	cancel, controller := newController(wf)
+	defer cancel()
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(ctx)
+	loopNode := woc.wf.Status.Nodes.FindByDisplayName("poll")
+	if assert.NotNil(t, loopNode) {
+		assert.Equal(t, wfv1.NodeTypeLoop, loopNode.Type)
+		assert.Len(t, loopNode.Children, 1)
+	}
+
+	makePodsPhase(ctx, woc, apiv1.PodSucceeded, withOutputs(`{"parameters": [{"name": "status", "value": "pending"}]}`))
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate(ctx)
+	makePodsPhase(ctx, woc, apiv1.PodSucceeded, withOutputs(`{"parameters": [{"name": "status", "value": "ready"}]}`))
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate(ctx)
+
+	loopNode = woc.wf.Status.Nodes.FindByDisplayName("poll")
+	if assert.NotNil(t, loopNode) {
+		assert.Equal(t, wfv1.NodeSucceeded, loopNode.Phase)
+		assert.Len(t, loopNode.Children, 2)
+		assert.Equal(t, "ready", loopNode.Outputs.GetParameterByName("status").Value.String())
+		// the iterations are bounded by the loop node
+		for _, childID := range loopNode.Children {
+			assert.Equal(t, loopNode.ID, woc.wf.Status.Nodes[childID].BoundaryID)
+		}
+		assert.NotEqual(t, loopNode.ID, loopNode.BoundaryID)
+	}
+	pods, err := listPods(woc)
+	if assert.NoError(t, err) && assert.Len(t, pods.Items, 3) {
+		var args []string
+		for _, pod := range pods.Items {
+			for _, ctr := range pod.Spec.Containers {
+				if ctr.Name == common.MainContainerName {
+					args = append(args, ctr.Args...)
+				}
+			}
+		}
+		// each iteration sees the index and the outputs of the previous one, and the next step those of the last one
+		assert.ElementsMatch(t, []string{"0:", "1:pending", "ready"}, args)
+	}
+}
+
+func TestLoopMaxIterations(t *testing.T) {
+	ctx := context.Background()
+	wf := unmarshalWF(loopWf)
+	cancel, controller := newController(wf)
+	defer cancel()
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(ctx)
+	for i := 0; i < 3; i++ {
+		makePodsPhase(ctx, woc, apiv1.PodSucceeded, withOutputs(`{"parameters": [{"name": "status", "value": "pending"}]}`))
+		woc = newWorkflowOperationCtx(woc.wf, controller)
+		woc.operate(ctx)
+	}
+	loopNode := woc.wf.Status.Nodes.FindByDisplayName("poll")
+	if assert.NotNil(t, loopNode) {
+		assert.Equal(t, wfv1.NodeFailed, loopNode.Phase)
+		assert.Len(t, loopNode.Children, 3)
+		assert.Equal(t, "condition not met after 3 iterations", loopNode.Message)
+	}
+	assert.Equal(t, wfv1.NodeFailed, woc.wf.Status.Phase)
+}
+
+var loopDAGWf = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: loop-dag
+  namespace: default
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    dag:
+      tasks:
+      - name: count
+        template: count
+        arguments:
+          parameters:
+          - name: index
+            value: "{{loop.index}}"
+        loop:
+          maxIterations: 2
+          delay: 1h
+      - name: done
+        dependencies: [count]
+        template: count
+        arguments:
+          parameters:
+          - name: index
+            value: "{{tasks.count.outputs.result}}"
+  - name: count
+    inputs:
+      parameters:
+      - name: index
+    script:
+      image: python:alpine3.6
+      command: [python]
+      source: print({{inputs.parameters.index}})
+`
+
+func TestLoopDAG(t *testing.T) {
+	ctx := context.Background()
+	wf := unmarshalWF(loopDAGWf)
+	cancel, controller := newController(wf)
+	defer cancel()
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(ctx)
+	pods, err := listPods(woc)
+	if assert.NoError(t, err) && assert.Len(t, pods.Items, 1) {
+		// the result of an iteration is captured, as it is that of the loop
+		podbyte, err := json.Marshal(pods.Items[0])
+		assert.NoError(t, err)
+		assert.Contains(t, string(podbyte), "includeScriptOutput")
+	}
+	makePodsPhase(ctx, woc, apiv1.PodSucceeded, withOutputs(`{"result": "0"}`))
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate(ctx)
+
+	// the next iteration waits for the delay
+	loopNode := woc.wf.Status.Nodes.FindByDisplayName("count")
+	if assert.NotNil(t, loopNode) {
+		assert.Equal(t, wfv1.NodeRunning, loopNode.Phase)
+		assert.Len(t, loopNode.Children, 1)
+		assert.Contains(t, loopNode.Message, "Delay for")
+	}
+
+	// as if the delay had passed
+	iteration := woc.wf.Status.Nodes.FindByDisplayName("count(0)")
+	if assert.NotNil(t, iteration) {
+		iteration.FinishedAt = metav1.NewTime(iteration.FinishedAt.Add(-2 * time.Hour))
+		woc.wf.Status.Nodes[iteration.ID] = *iteration
+	}
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate(ctx)
+	makePodsPhase(ctx, woc, apiv1.PodSucceeded, withOutputs(`{"result": "1"}`))
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate(ctx)
+
+	loopNode = woc.wf.Status.Nodes.FindByDisplayName("count")
+	if assert.NotNil(t, loopNode) {
+		assert.Equal(t, wfv1.NodeSucceeded, loopNode.Phase)
+		assert.Len(t, loopNode.Children, 2)
+		assert.Empty(t, loopNode.Message)
+	}
+	node := woc.wf.Status.Nodes.FindByDisplayName("done")
+	if assert.NotNil(t, node) {
+		assert.Equal(t, "1", node.Inputs.GetParameterByName("index").Value.String())
+	}
+}
@@ -0,0 +1,136 @@
+package controller
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/pointer"
+
+	"github.com/simster7/argo/v2/errors"
+	wfv1 "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1"
+	"github.com/simster7/argo/v2/util/slice"
+	"github.com/simster7/argo/v2/workflow/common"
+)
+
+// nodeFailure is what is known about why a node failed, which the retryOn rules of a retry strategy are matched against
+type nodeFailure struct {
+	// exitCode is the exit code of the main container, if known
+	exitCode *int32
+	// reasons are the reasons of the pod and of its containers, e.g. Evicted, OOMKilled or ImagePullBackOff
+	reasons []string
+	message string
+}
+
+// String describes the failure for the message of the node
+func (f nodeFailure) String() string {
+	var parts []string
+	if f.exitCode != nil {
+		parts = append(parts, fmt.Sprintf("exit code %d", *f.exitCode))
+	}
+	if len(f.reasons) > 0 {
+		parts = append(parts, fmt.Sprintf("reasons %s", strings.Join(f.reasons, ", ")))
+	}
+	if len(parts) == 0 {
+		return "the failure"
+	}
+	return strings.Join(parts, " and ")
+}
+
+// getNodeFailure returns what is known about why the node failed. The exit code and the reasons are taken from the node,
+// e.g. its failure reason, and from its pod, if it still exists.
+func (woc *wfOperationCtx) getNodeFailure(node *wfv1.NodeStatus) nodeFailure {
+	failure := nodeFailure{message: node.Message, exitCode: node.ExitCode}
+	if node.FailureReason != "" {
+		failure.reasons = append(failure.reasons, string(node.FailureReason))
+	}
+	if failure.exitCode == nil && node.Outputs != nil && node.Outputs.ExitCode != nil {
+		if exitCode, err := strconv.ParseInt(*node.Outputs.ExitCode, 10, 32); err == nil {
+			failure.exitCode = pointer.Int32Ptr(int32(exitCode))
+		}
+	}
+	if node.Type != wfv1.NodeTypePod {
+		return failure
+	}
+	obj, exists, err := woc.controller.podInformer.GetStore().Get(cache.ExplicitKey(woc.wf.Namespace + "/" + node.ID))
+	if err != nil || !exists {
+		return failure
+	}
+	pod, ok := obj.(*apiv1.Pod)
+	if !ok {
+		return failure
+	}
+	seen := map[string]bool{string(node.FailureReason): true}
+	addReason := func(reason string) {
+		if reason != "" && !seen[reason] {
+			seen[reason] = true
+			failure.reasons = append(failure.reasons, reason)
+		}
+	}
+	addReason(pod.Status.Reason)
+	for _, ctr := range append(pod.Status.InitContainerStatuses, pod.Status.ContainerStatuses...) {
+		for _, state := range []apiv1.ContainerState{ctr.State, ctr.LastTerminationState} {
+			if state.Waiting != nil {
+				addReason(state.Waiting.Reason)
+			}
+			if state.Terminated != nil {
+				addReason(state.Terminated.Reason)
+			}
+		}
+		if ctr.Name == common.MainContainerName && ctr.State.Terminated != nil && failure.exitCode == nil {
+			failure.exitCode = pointer.Int32Ptr(ctr.State.Terminated.ExitCode)
+		}
+	}
+	return failure
+}
+
+// matchRetryOn returns the index of the first of the rules that the failure matches, or -1 if it matches none of them,
+// and a description of what matched
+func matchRetryOn(rules []wfv1.RetryOn, failure nodeFailure) (int, string, error) {
+	for i, rule := range rules {
+		var matched []string
+		if len(rule.ExitCodes) > 0 {
+			if failure.exitCode == nil || !containsInt32(rule.ExitCodes, *failure.exitCode) {
+				continue
+			}
+			matched = append(matched, fmt.Sprintf("exit code %d", *failure.exitCode))
+		}
+		if len(rule.Reasons) > 0 {
+			reason := ""
+			for _, r := range failure.reasons {
+				if slice.ContainsString(rule.Reasons, r) {
+					reason = r
+					break
+				}
+			}
+			if reason == "" {
+				continue
+			}
+			matched = append(matched, fmt.Sprintf("reason %s", reason))
+		}
+		if rule.MessagePattern != "" {
+			pattern, err := regexp.Compile(rule.MessagePattern)
+			if err != nil {
+				return -1, "", errors.Errorf(errors.CodeBadRequest, "retryStrategy.retryOn[%d].messagePattern is invalid: %v", i, err)
+			}
+			if !pattern.MatchString(failure.message) {
+				continue
+			}
+			matched = append(matched, fmt.Sprintf("message matching '%s'", rule.MessagePattern))
+		}
+		return i, strings.Join(matched, " and "), nil
+	}
+	return -1, "", nil
+}
+
+func containsInt32(values []int32, value int32) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
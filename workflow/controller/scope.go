@@ -112,6 +112,9 @@ func (s *wfScope) resolveArtifact(v string, subPath string) (*wfv1.Artifact, err
 		case wfv1.ArtifactLocationGCS:
 			copyArt.GCS.Key = path.Join(copyArt.GCS.Key, resolvedSubPath)
 
+		case wfv1.ArtifactLocationAzure:
+			copyArt.Azure.Blob = path.Join(copyArt.Azure.Blob, resolvedSubPath)
+
 		case wfv1.ArtifactLocationArtifactory:
 			u, err := url.Parse(copyArt.Artifactory.URL)
 			if err != nil {
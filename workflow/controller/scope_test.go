@@ -136,6 +136,30 @@ func TestSubPathResolution(t *testing.T) {
       name: my-gcs-cred
   `
 
+	var AzureArtifact = `
+  name: azure-artifact
+  path: some/local/path
+  azure:
+    endpoint: https://myaccount.blob.core.windows.net
+    container: test-container
+    blob: path/to/some/blob
+    accountKeySecret:
+      key: accountkey
+      name: my-azure-cred
+  `
+
+	var AzureArtifactWithSubpath = `
+  name: azure-artifact
+  path: some/local/path
+  azure:
+    endpoint: https://myaccount.blob.core.windows.net
+    container: test-container
+    blob: path/to/some/blob/some/subkey
+    accountKeySecret:
+      key: accountkey
+      name: my-azure-cred
+  `
+
 	var HDFSArtifact = `
   name: hdfs-artifact
   path: some/local/path
@@ -222,6 +246,9 @@ func TestSubPathResolution(t *testing.T) {
 	t.Run("GCS Artifact SubPath Resolution", func(t *testing.T) {
 		artifactSubPathResolution(t, GCSArtifact, GCSArtifactWithSubpath)
 	})
+	t.Run("Azure Artifact SubPath Resolution", func(t *testing.T) {
+		artifactSubPathResolution(t, AzureArtifact, AzureArtifactWithSubpath)
+	})
 	t.Run("HDFS Artifact SubPath Resolution", func(t *testing.T) {
 		artifactSubPathResolution(t, HDFSArtifact, HDFSArtifactWithSubpath)
 	})
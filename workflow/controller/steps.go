@@ -12,6 +12,7 @@ import (
 
 	"github.com/simster7/argo/v2/errors"
 	wfv1 "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1"
+	argoexpr "github.com/simster7/argo/v2/util/expr"
 	"github.com/simster7/argo/v2/workflow/common"
 	"github.com/simster7/argo/v2/workflow/templateresolution"
 )
@@ -125,8 +126,9 @@ func (woc *wfOperationCtx) executeSteps(ctx context.Context, nodeName string, tm
 					}
 				}
 				if len(childNodes) > 0 {
-					// Expanded child nodes should be created from the same template.
-					_, tmpl, templateStored, err := stepsCtx.tmplCtx.ResolveTemplate(&childNodes[0])
+					// Expanded child nodes are created from the template of the step, which may be inline, so the
+					// template is resolved from the step rather than from the nodes.
+					_, tmpl, templateStored, err := stepsCtx.tmplCtx.ResolveTemplate(&step)
 					if err != nil {
 						return node, err
 					}
@@ -205,7 +207,7 @@ func (woc *wfOperationCtx) executeStepGroup(ctx context.Context, stepGroup []wfv
 	}
 
 	// Next, expand the step's withItems (if any)
-	stepGroup, err = woc.expandStepGroup(sgNodeName, stepGroup, stepsCtx)
+	stepGroup, err = woc.expandStepGroup(ctx, sgNodeName, stepGroup, stepsCtx)
 	if err != nil {
 		return woc.markNodeError(sgNodeName, err)
 	}
@@ -238,7 +240,13 @@ func (woc *wfOperationCtx) executeStepGroup(ctx context.Context, stepGroup []wfv
 			continue
 		}
 
-		childNode, err := woc.executeTemplate(ctx, childNodeName, &step, stepsCtx.tmplCtx, step.Arguments, &executeTemplateOpts{boundaryID: stepsCtx.boundaryID, onExitTemplate: stepsCtx.onExitTemplate})
+		var childNode *wfv1.NodeStatus
+		opts := &executeTemplateOpts{boundaryID: stepsCtx.boundaryID, onExitTemplate: stepsCtx.onExitTemplate}
+		if step.Loop != nil {
+			childNode, err = woc.executeLoop(ctx, childNodeName, &step, step.Loop, stepsCtx.tmplCtx, step.Arguments, opts)
+		} else {
+			childNode, err = woc.executeTemplate(ctx, childNodeName, &step, stepsCtx.tmplCtx, step.Arguments, opts)
+		}
 		if err != nil {
 			switch err {
 			case ErrDeadlineExceeded:
@@ -259,9 +267,28 @@ func (woc *wfOperationCtx) executeStepGroup(ctx context.Context, stepGroup []wfv
 		}
 	}
 
+	// Run the lifecycle hooks of the steps. The step group does not complete until the hooks that have been run are
+	// fulfilled, though their phases do not affect its phase.
+	hooksFulfilled := true
+	for _, step := range stepGroup {
+		if len(step.Hooks) == 0 {
+			continue
+		}
+		childNode := woc.wf.GetNodeByName(fmt.Sprintf("%s.%s", sgNodeName, step.Name))
+		if childNode == nil {
+			continue
+		}
+		// the hooks of an expanded step refer to its status by the name of the step, e.g. `steps.deploy.status`
+		stepName := strings.SplitN(step.Name, "(", 2)[0]
+		params := woc.lifecycleHookParams(stepsCtx.scope, fmt.Sprintf("steps.%s", stepName), childNode)
+		if !woc.executeLifecycleHooks(ctx, step.Hooks, childNode.Name, woc.lifecycleHookParentNodeName(childNode), params, stepsCtx.tmplCtx, &executeTemplateOpts{boundaryID: stepsCtx.boundaryID, onExitTemplate: stepsCtx.onExitTemplate}) {
+			hooksFulfilled = false
+		}
+	}
+
 	node = woc.wf.GetNodeByName(sgNodeName)
 	// Return if not all children completed
-	completed := true
+	completed := hooksFulfilled
 	for _, childNodeID := range node.Children {
 		childNode := woc.wf.Status.Nodes[childNodeID]
 		step := nodeSteps[childNode.Name]
@@ -295,11 +322,28 @@ func (woc *wfOperationCtx) executeStepGroup(ctx context.Context, stepGroup []wfv
 	return woc.markNodePhase(node.Name, wfv1.NodeSucceeded)
 }
 
-// shouldExecute evaluates a already substituted when expression to decide whether or not a step should execute
+// shouldExecute evaluates a already substituted when expression to decide whether or not a step should execute. It is
+// evaluated with expr if it is an expr expression without variables, e.g. `"heads" == "heads"` or `asInt("2") > 1`, whose
+// integer literals are floats, as in govaluate.
+// Otherwise, e.g. `heads == heads` or `Error in (Failed, Error)`, it is evaluated with govaluate, in which words are
+// strings.
 func shouldExecute(when string) (bool, error) {
 	if when == "" {
 		return true, nil
 	}
+	if strings.Contains(when, "{{=") {
+		return false, errors.Errorf(errors.CodeBadRequest, "Invalid 'when' expression '%s': it refers to variables that could not be resolved", when)
+	}
+	if variables, err := argoexpr.Variables(when); err == nil && len(variables) == 0 {
+		if result, err := argoexpr.EvalBoolWithFloats(when, nil); err == nil {
+			return result, nil
+		}
+	}
+	return shouldExecuteGovaluate(when)
+}
+
+// shouldExecuteGovaluate evaluates the when expression with govaluate, with its variables as strings
+func shouldExecuteGovaluate(when string) (bool, error) {
 	expression, err := govaluate.NewEvaluableExpression(when)
 	if err != nil {
 		if strings.Contains(err.Error(), "Invalid token") {
@@ -352,8 +396,11 @@ func (woc *wfOperationCtx) resolveReferences(stepGroup []wfv1.WorkflowStep, scop
 	}
 
 	for i, step := range stepGroup {
-		// Step 1: replace all parameter scope references in the step
+		// Step 1: replace all parameter scope references in the step, but not in its inline template, which is
+		// substituted with its own inputs when it is executed
 		// TODO: improve this
+		inline := step.Inline
+		step.Inline = nil
 		stepBytes, err := json.Marshal(step)
 		if err != nil {
 			return nil, errors.InternalWrapError(err)
@@ -372,6 +419,7 @@ func (woc *wfOperationCtx) resolveReferences(stepGroup []wfv1.WorkflowStep, scop
 		if err != nil {
 			return nil, errors.InternalWrapError(err)
 		}
+		newStep.Inline = inline
 
 		// If we are not executing, don't attempt to resolve any artifact references. We only check if we are executing after
 		// the initial parameter resolution, since it's likely that the "when" clause will contain parameter references.
@@ -407,21 +455,28 @@ func (woc *wfOperationCtx) resolveReferences(stepGroup []wfv1.WorkflowStep, scop
 			resolvedArt.Name = art.Name
 			newStep.Arguments.Artifacts[j] = *resolvedArt
 		}
+		if newStep.WithArtifact != nil && newStep.WithArtifact.From != "" {
+			resolvedArt, err := scope.resolveArtifact(newStep.WithArtifact.From, "")
+			if err != nil {
+				return nil, fmt.Errorf("unable to resolve references: %s", err)
+			}
+			newStep.WithArtifact.ArtifactLocation = resolvedArt.ArtifactLocation
+		}
 
 		newStepGroup[i] = newStep
 	}
 	return newStepGroup, nil
 }
 
-// expandStepGroup looks at each step in a collection of parallel steps, and expands all steps using withItems/withParam
-func (woc *wfOperationCtx) expandStepGroup(sgNodeName string, stepGroup []wfv1.WorkflowStep, stepsCtx *stepsContext) ([]wfv1.WorkflowStep, error) {
+// expandStepGroup looks at each step in a collection of parallel steps, and expands all steps using withItems/withParam/withArtifact
+func (woc *wfOperationCtx) expandStepGroup(ctx context.Context, sgNodeName string, stepGroup []wfv1.WorkflowStep, stepsCtx *stepsContext) ([]wfv1.WorkflowStep, error) {
 	newStepGroup := make([]wfv1.WorkflowStep, 0)
 	for _, step := range stepGroup {
 		if !step.ShouldExpand() {
 			newStepGroup = append(newStepGroup, step)
 			continue
 		}
-		expandedStep, err := woc.expandStep(step)
+		expandedStep, err := woc.expandStep(ctx, sgNodeName, step)
 		if err != nil {
 			return nil, err
 		}
@@ -442,10 +497,11 @@ func (woc *wfOperationCtx) expandStepGroup(sgNodeName string, stepGroup []wfv1.W
 }
 
 // expandStep expands a step containing withItems or withParams into multiple parallel steps
-func (woc *wfOperationCtx) expandStep(step wfv1.WorkflowStep) ([]wfv1.WorkflowStep, error) {
+func (woc *wfOperationCtx) expandStep(ctx context.Context, sgNodeName string, step wfv1.WorkflowStep) ([]wfv1.WorkflowStep, error) {
 	var err error
 	expandedStep := make([]wfv1.WorkflowStep, 0)
 	var items []wfv1.Item
+	withArtifact := step.WithArtifact
 	if len(step.WithItems) > 0 {
 		items = step.WithItems
 	} else if step.WithParam != "" {
@@ -458,7 +514,7 @@ func (woc *wfOperationCtx) expandStep(step wfv1.WorkflowStep) ([]wfv1.WorkflowSt
 		if err != nil {
 			return nil, err
 		}
-	} else {
+	} else if withArtifact == nil {
 		// this should have been prevented in expandStepGroup()
 		return nil, errors.InternalError("expandStep() was called with withItems and withParam empty")
 	}
@@ -468,6 +524,10 @@ func (woc *wfOperationCtx) expandStep(step wfv1.WorkflowStep) ([]wfv1.WorkflowSt
 	step.WithItems = nil
 	step.WithParam = ""
 	step.WithSequence = nil
+	step.WithArtifact = nil
+	// the items are passed to an inline template as arguments, as they are to a template that is referred to by name
+	inline := step.Inline
+	step.Inline = nil
 
 	stepBytes, err := json.Marshal(step)
 	if err != nil {
@@ -478,15 +538,51 @@ func (woc *wfOperationCtx) expandStep(step wfv1.WorkflowStep) ([]wfv1.WorkflowSt
 		return nil, fmt.Errorf("unable to parse argo varaible: %w", err)
 	}
 
-	for i, item := range items {
+	expand := func(i int, item wfv1.Item, batch string) error {
 		var newStep wfv1.WorkflowStep
 		newStepName, err := processItem(fstTmpl, step.Name, i, item, &newStep)
 		if err != nil {
-			return nil, err
+			return err
+		}
+		// a batch is named after the range of its items, rather than after the items themselves
+		if batch != "" {
+			newStepName = generateNodeName(step.Name, i, batch)
 		}
 		newStep.Name = newStepName
 		newStep.Template = step.Template
+		newStep.Inline = inline
 		expandedStep = append(expandedStep, newStep)
+		return nil
+	}
+	// the items of an artifact are read as the step is expanded, rather than all at once, and only until all of the
+	// children they expand into exist
+	if withArtifact != nil {
+		if children, ok := woc.withArtifactChildren(sgNodeName, step.Name); ok {
+			for _, child := range children {
+				newStep := step
+				newStep.Name = strings.TrimPrefix(child.Name, sgNodeName+".")
+				newStep.Arguments = withArtifactChildArguments(child)
+				// the when of the step was evaluated as its node was created
+				newStep.When = ""
+				if child.Type == wfv1.NodeTypeSkipped {
+					newStep.When = "false"
+				}
+				newStep.Inline = inline
+				expandedStep = append(expandedStep, newStep)
+			}
+			return expandedStep, nil
+		}
+		err = woc.forEachArtifactItem(ctx, withArtifact, expand)
+		if err != nil {
+			return nil, err
+		}
+		woc.recordWithArtifactChildren(sgNodeName, step.Name, len(expandedStep))
+		return expandedStep, nil
+	}
+	for i, item := range items {
+		if err := expand(i, item, ""); err != nil {
+			return nil, err
+		}
 	}
 	return expandedStep, nil
 }
@@ -511,8 +607,15 @@ func (woc *wfOperationCtx) prepareMetricScope(node *wfv1.NodeStatus) (map[string
 		localScope[common.LocalVarStatus] = string(node.Phase)
 	}
 
+	if node.FailureReason != "" {
+		localScope[common.LocalVarFailureReason] = string(node.FailureReason)
+	}
+
 	if node.Inputs != nil {
 		for _, param := range node.Inputs.Parameters {
+			if param.Value == nil {
+				continue
+			}
 			key := fmt.Sprintf("inputs.parameters.%s", param.Name)
 			localScope[key] = param.Value.String()
 		}
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/yaml"
 
@@ -299,3 +300,65 @@ func TestOptionalArgumentAndParameter(t *testing.T) {
 	woc.operate(ctx)
 	assert.Equal(t, wfv1.NodeRunning, woc.wf.Status.Phase)
 }
+
+var stepsWithExpressions = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: expressions
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: count
+        template: echo
+        arguments:
+          parameters:
+          - name: message
+            value: "2"
+    - - name: increment
+        template: echo
+        when: "{{= asInt(steps.count.outputs.result) > 1}}"
+        arguments:
+          parameters:
+          - name: message
+            value: "{{= asInt(steps.count.outputs.result) + 1}}"
+      - name: skipped
+        template: echo
+        when: "{{= steps.count.outputs.result == \"1\"}}"
+        arguments:
+          parameters:
+          - name: message
+            value: "1"
+  - name: echo
+    inputs:
+      parameters:
+      - name: message
+    container:
+      image: alpine:3.7
+      command: [echo, "{{inputs.parameters.message}}"]
+`
+
+func TestStepsWithExpressions(t *testing.T) {
+	ctx := context.Background()
+	wf := unmarshalWF(stepsWithExpressions)
+	cancel, controller := newController(wf)
+	defer cancel()
+
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(ctx)
+	makePodsPhase(ctx, woc, apiv1.PodSucceeded, withOutputs(`{"result": "2"}`))
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate(ctx)
+
+	increment := woc.wf.GetNodeByName("expressions[1].increment")
+	if assert.NotNil(t, increment) && assert.NotNil(t, increment.Inputs) {
+		assert.Equal(t, wfv1.NodePending, increment.Phase)
+		assert.Equal(t, "3", increment.Inputs.Parameters[0].Value.String())
+	}
+	skipped := woc.wf.GetNodeByName("expressions[1].skipped")
+	if assert.NotNil(t, skipped) {
+		assert.Equal(t, wfv1.NodeSkipped, skipped.Phase)
+	}
+}
@@ -51,3 +51,29 @@ func TestShouldExecute(t *testing.T) {
 		assert.False(t, res)
 	}
 }
+
+func TestShouldExecuteExpr(t *testing.T) {
+	for when, expected := range map[string]bool{
+		`"heads" == "heads"`:              true,
+		`asInt("2") > 1`:                  true,
+		`"a" in ["a", "b"]`:               true,
+		`"abc" matches "^a"`:              true,
+		`"Failed" == "Succeeded"`:         false,
+		`len("abc") == 3 && !("a" == "")`: true,
+		// not expr expressions, or not ones that evaluate to booleans, so they are evaluated with govaluate
+		`foo == foo`: true,
+		`1 + 1 == 2`: true,
+	} {
+		res, err := shouldExecute(when)
+		if assert.NoError(t, err, when) {
+			assert.Equal(t, expected, res, when)
+		}
+	}
+	// a tag that was not substituted is compared as a string, as it was before expressions were supported
+	res, err := shouldExecute("'{{steps.flip.outputs.result}}' == heads")
+	if assert.NoError(t, err) {
+		assert.False(t, res)
+	}
+	_, err = shouldExecute(`{{= steps.flip.outputs.result == "heads"}}`)
+	assert.Error(t, err)
+}
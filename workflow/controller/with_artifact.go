@@ -0,0 +1,191 @@
+package controller
+
+import (
+	"archive/tar"
+	"bufio"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/simster7/argo/v2/errors"
+	wfv1 "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1"
+)
+
+// forEachArtifactItem streams the JSON list stored in the artifact of withArtifact, so that the list is never held in
+// memory as a whole, and calls f with each of its items or, if the batch size is greater than one, with each batch of
+// items as a JSON list. The description of a batch is the range of the indexes of its items, e.g. `100-199`, and is
+// empty for a single item.
+func (woc *wfOperationCtx) forEachArtifactItem(ctx context.Context, withArtifact *wfv1.WithArtifact, f func(index int, item wfv1.Item, batch string) error) error {
+	art := &wfv1.Artifact{Name: "withArtifact", ArtifactLocation: withArtifact.ArtifactLocation}
+	if !art.HasLocation() {
+		return errors.Errorf(errors.CodeBadRequest, "withArtifact artifact '%s' has no location", withArtifact.From)
+	}
+	driver, err := woc.controller.artDriverFactory(ctx, art, artifactGCResources{woc.controller.kubeclientset, woc.wf.Namespace})
+	if err != nil {
+		return fmt.Errorf("failed to create driver for withArtifact: %w", err)
+	}
+	stream, err := driver.OpenStream(art)
+	if err != nil {
+		return fmt.Errorf("failed to open withArtifact: %w", err)
+	}
+	defer func() { _ = stream.Close() }()
+	reader, err := unarchivedReader(stream)
+	if err != nil {
+		return fmt.Errorf("failed to read withArtifact: %w", err)
+	}
+
+	decoder := json.NewDecoder(reader)
+	if token, err := decoder.Token(); err != nil || token != json.Delim('[') {
+		return errors.New(errors.CodeBadRequest, "withArtifact value could not be parsed as a JSON list")
+	}
+	batchSize := withArtifact.GetBatchSize()
+	batch := make([]wfv1.Item, 0, batchSize)
+	index := 0
+	flush := func() error {
+		defer func() {
+			batch = batch[:0]
+			index++
+		}()
+		if batchSize == 1 {
+			return f(index, batch[0], "")
+		}
+		value, err := json.Marshal(batch)
+		if err != nil {
+			return errors.InternalWrapError(err)
+		}
+		first := index * batchSize
+		return f(index, wfv1.Item{Value: value}, fmt.Sprintf("%d-%d", first, first+len(batch)-1))
+	}
+	for decoder.More() {
+		var item wfv1.Item
+		if err := decoder.Decode(&item); err != nil {
+			return errors.Errorf(errors.CodeBadRequest, "withArtifact value could not be parsed as a JSON list: %v", err)
+		}
+		batch = append(batch, item)
+		if len(batch) == batchSize {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+	if len(batch) > 0 {
+		return flush()
+	}
+	return nil
+}
+
+// withArtifactChildren returns the nodes that the withArtifact of the step or task has been expanded into, in the order
+// of their items, if all of them exist, in which case the artifact does not need to be read again. parentNodeName is
+// the name of the step group or DAG the children belong to. A child that has sensitive inputs is treated as missing, as
+// their values are redacted in its node, so it can only be expanded from the artifact.
+func (woc *wfOperationCtx) withArtifactChildren(parentNodeName string, name string) ([]wfv1.NodeStatus, bool) {
+	parent := woc.wf.GetNodeByName(parentNodeName)
+	if parent == nil {
+		return nil, false
+	}
+	count, ok := parent.WithArtifactChildren[name]
+	if !ok {
+		return nil, false
+	}
+	prefix := fmt.Sprintf("%s.%s(", parentNodeName, name)
+	children := make(map[int]wfv1.NodeStatus)
+	for _, node := range woc.wf.Status.Nodes {
+		if !strings.HasPrefix(node.Name, prefix) {
+			continue
+		}
+		index, err := strconv.Atoi(strings.SplitN(strings.TrimPrefix(node.Name, prefix), ":", 2)[0])
+		if err != nil {
+			continue
+		}
+		// the hooks and the descendants of a child are named after it, so the child is the node with the shortest name
+		if child, ok := children[index]; ok && len(child.Name) <= len(node.Name) {
+			continue
+		}
+		children[index] = node
+	}
+	ordered := make([]wfv1.NodeStatus, 0, count)
+	for index := 0; index < int(count); index++ {
+		child, ok := children[index]
+		if !ok {
+			return nil, false
+		}
+		if child.Inputs != nil {
+			for _, param := range child.Inputs.Parameters {
+				if param.IsSensitive() {
+					return nil, false
+				}
+			}
+		}
+		ordered = append(ordered, child)
+	}
+	return ordered, true
+}
+
+// withArtifactChildArguments returns the arguments that a child of a withArtifact was expanded with, which are its inputs
+func withArtifactChildArguments(child wfv1.NodeStatus) wfv1.Arguments {
+	var args wfv1.Arguments
+	if child.Inputs == nil {
+		return args
+	}
+	for _, param := range child.Inputs.Parameters {
+		args.Parameters = append(args.Parameters, wfv1.Parameter{Name: param.Name, Value: param.Value})
+	}
+	for _, art := range child.Inputs.Artifacts {
+		if art.HasLocation() {
+			args.Artifacts = append(args.Artifacts, art)
+		}
+	}
+	return args
+}
+
+// recordWithArtifactChildren records on the step group or DAG the number of children that the withArtifact of the
+// step or task was expanded into
+func (woc *wfOperationCtx) recordWithArtifactChildren(parentNodeName string, name string, count int) {
+	parent := woc.wf.GetNodeByName(parentNodeName)
+	if parent == nil {
+		return
+	}
+	if recorded, ok := parent.WithArtifactChildren[name]; ok && int(recorded) == count {
+		return
+	}
+	if parent.WithArtifactChildren == nil {
+		parent.WithArtifactChildren = make(map[string]int32)
+	}
+	parent.WithArtifactChildren[name] = int32(count)
+	woc.wf.Status.Nodes[parent.ID] = *parent
+	woc.updated = true
+}
+
+// unarchivedReader returns a reader of the file stored in the artifact which, as output artifacts are by default, may
+// be archived as a gzipped tarball
+func unarchivedReader(r io.Reader) (io.Reader, error) {
+	buffered := bufio.NewReader(r)
+	magic, err := buffered.Peek(2)
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	if len(magic) < 2 || magic[0] != 0x1f || magic[1] != 0x8b {
+		return buffered, nil
+	}
+	gzipReader, err := gzip.NewReader(buffered)
+	if err != nil {
+		return nil, err
+	}
+	tarReader := tar.NewReader(gzipReader)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			return nil, fmt.Errorf("archive does not contain a file")
+		}
+		if err != nil {
+			return nil, err
+		}
+		if header.Typeflag == tar.TypeReg {
+			return tarReader, nil
+		}
+	}
+}
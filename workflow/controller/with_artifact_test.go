@@ -0,0 +1,236 @@
+package controller
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
+
+	wfv1 "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1"
+	artifact "github.com/simster7/argo/v2/workflow/artifacts"
+	"github.com/simster7/argo/v2/workflow/artifacts/resource"
+	"github.com/simster7/argo/v2/workflow/common"
+)
+
+// streamArtifactDriver is a fake artifact driver that opens the content of S3 artifacts by key
+type streamArtifactDriver struct {
+	artifact.ArtifactDriver
+	content map[string][]byte
+}
+
+func (d *streamArtifactDriver) OpenStream(art *wfv1.Artifact) (io.ReadCloser, error) {
+	return ioutil.NopCloser(bytes.NewReader(d.content[art.S3.Key])), nil
+}
+
+func withStreamArtifactDriver(content map[string][]byte) func(*WorkflowController) {
+	return func(wfc *WorkflowController) {
+		wfc.artDriverFactory = func(context.Context, *wfv1.Artifact, resource.Interface) (artifact.ArtifactDriver, error) {
+			return &streamArtifactDriver{content: content}, nil
+		}
+	}
+}
+
+func tarball(t *testing.T, name string, content []byte) []byte {
+	var buf bytes.Buffer
+	gzipWriter := gzip.NewWriter(&buf)
+	tarWriter := tar.NewWriter(gzipWriter)
+	assert.NoError(t, tarWriter.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(content)), Typeflag: tar.TypeReg}))
+	_, err := tarWriter.Write(content)
+	assert.NoError(t, err)
+	assert.NoError(t, tarWriter.Close())
+	assert.NoError(t, gzipWriter.Close())
+	return buf.Bytes()
+}
+
+func TestForEachArtifactItem(t *testing.T) {
+	content := map[string][]byte{
+		"list.json":   []byte(`["a", {"b": 1}, 2, "c", "d"]`),
+		"list.tgz":    tarball(t, "list.json", []byte(`["a", "b"]`)),
+		"object.json": []byte(`{"a": 1}`),
+	}
+	cancel, controller := newController(unmarshalWF(helloWorldWf), withStreamArtifactDriver(content))
+	defer cancel()
+	woc := newWorkflowOperationCtx(unmarshalWF(helloWorldWf), controller)
+	items := func(key string, batchSize int32) ([]string, error) {
+		var items []string
+		err := woc.forEachArtifactItem(context.Background(), &wfv1.WithArtifact{ArtifactLocation: wfv1.ArtifactLocation{S3: &wfv1.S3Artifact{S3Bucket: wfv1.S3Bucket{Endpoint: "my-endpoint", Bucket: "my-bucket"}, Key: key}}, BatchSize: batchSize}, func(index int, item wfv1.Item, batch string) error {
+			items = append(items, item.String()+" "+batch)
+			return nil
+		})
+		return items, err
+	}
+	t.Run("Items", func(t *testing.T) {
+		list, err := items("list.json", 0)
+		if assert.NoError(t, err) {
+			assert.Equal(t, []string{"a ", `{"b":1} `, "2 ", "c ", "d "}, list)
+		}
+	})
+	t.Run("Batches", func(t *testing.T) {
+		list, err := items("list.json", 2)
+		if assert.NoError(t, err) {
+			assert.Equal(t, []string{`["a",{"b":1}] 0-1`, `[2,"c"] 2-3`, `["d"] 4-4`}, list)
+		}
+	})
+	t.Run("Archived", func(t *testing.T) {
+		list, err := items("list.tgz", 1)
+		if assert.NoError(t, err) {
+			assert.Equal(t, []string{"a ", "b "}, list)
+		}
+	})
+	t.Run("NotAList", func(t *testing.T) {
+		_, err := items("object.json", 1)
+		assert.EqualError(t, err, "withArtifact value could not be parsed as a JSON list")
+	})
+	t.Run("NoLocation", func(t *testing.T) {
+		err := woc.forEachArtifactItem(context.Background(), &wfv1.WithArtifact{From: "{{steps.a.outputs.artifacts.b}}"}, nil)
+		assert.EqualError(t, err, "withArtifact artifact '{{steps.a.outputs.artifacts.b}}' has no location")
+	})
+}
+
+var withArtifactWf = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: with-artifact
+  namespace: default
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    dag:
+      tasks:
+      - name: generate
+        template: generate
+      - name: process
+        dependencies: [generate]
+        template: process
+        arguments:
+          parameters:
+          - name: items
+            value: "{{item}}"
+        withArtifact:
+          from: "{{tasks.generate.outputs.artifacts.items}}"
+          batchSize: 2
+  - name: generate
+    container:
+      image: docker/whalesay
+    outputs:
+      artifacts:
+      - name: items
+        path: /tmp/items.json
+  - name: process
+    inputs:
+      parameters:
+      - name: items
+    container:
+      image: docker/whalesay
+      args: ["{{inputs.parameters.items}}"]
+`
+
+func TestWithArtifact(t *testing.T) {
+	ctx := context.Background()
+	wf := unmarshalWF(withArtifactWf)
+	content := map[string][]byte{
+		"items.tgz": tarball(t, "items.json", []byte(`[1, 2, 3, 4, 5]`)),
+	}
+	cancel, controller := newController(wf, withStreamArtifactDriver(content))
+	defer cancel()
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(ctx)
+	makePodsPhase(ctx, woc, apiv1.PodSucceeded, withOutputs(`{"artifacts": [{"name": "items", "s3": {"endpoint": "my-endpoint", "bucket": "my-bucket", "key": "items.tgz"}}]}`))
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate(ctx)
+
+	for _, name := range []string{"process(0:0-1)", "process(1:2-3)", "process(2:4-4)"} {
+		assert.NotNil(t, woc.wf.Status.Nodes.FindByDisplayName(name), name)
+	}
+	pods, err := listPods(woc)
+	if assert.NoError(t, err) && assert.Len(t, pods.Items, 4) {
+		var args []string
+		for _, pod := range pods.Items {
+			for _, ctr := range pod.Spec.Containers {
+				if ctr.Name == common.MainContainerName {
+					args = append(args, ctr.Args...)
+				}
+			}
+		}
+		assert.ElementsMatch(t, []string{"[1,2]", "[3,4]", "[5]"}, args)
+	}
+	assert.Equal(t, map[string]int32{"process": 3}, woc.wf.Status.Nodes.FindByDisplayName("with-artifact").WithArtifactChildren)
+
+	t.Run("ChildrenRebuiltFromNodes", func(t *testing.T) {
+		// the artifact is no longer read, as all of the children exist
+		delete(content, "items.tgz")
+		makePodsPhase(ctx, woc, apiv1.PodRunning)
+		woc = newWorkflowOperationCtx(woc.wf, controller)
+		woc.operate(ctx)
+		assert.Equal(t, wfv1.NodeRunning, woc.wf.Status.Phase)
+		makePodsPhase(ctx, woc, apiv1.PodSucceeded)
+		woc = newWorkflowOperationCtx(woc.wf, controller)
+		woc.operate(ctx)
+		assert.Equal(t, wfv1.NodeSucceeded, woc.wf.Status.Phase)
+	})
+}
+
+var withArtifactStepsWf = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: with-artifact-steps
+  namespace: default
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: process
+        template: process
+        when: "{{item}} != 2"
+        arguments:
+          parameters:
+          - name: item
+            value: "{{item}}"
+        withArtifact:
+          s3:
+            endpoint: my-endpoint
+            bucket: my-bucket
+            key: items.json
+  - name: process
+    inputs:
+      parameters:
+      - name: item
+    container:
+      image: docker/whalesay
+      args: ["{{inputs.parameters.item}}"]
+`
+
+func TestWithArtifactSteps(t *testing.T) {
+	ctx := context.Background()
+	wf := unmarshalWF(withArtifactStepsWf)
+	content := map[string][]byte{"items.json": []byte(`[1, 2, 3]`)}
+	cancel, controller := newController(wf, withStreamArtifactDriver(content))
+	defer cancel()
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(ctx)
+
+	assert.Equal(t, map[string]int32{"process": 3}, woc.wf.Status.Nodes.FindByDisplayName("[0]").WithArtifactChildren)
+	assert.Equal(t, wfv1.NodeSkipped, woc.wf.Status.Nodes.FindByDisplayName("process(1:2)").Phase)
+
+	// the artifact is no longer read, as all of the children exist
+	delete(content, "items.json")
+	makePodsPhase(ctx, woc, apiv1.PodSucceeded)
+	woc = newWorkflowOperationCtx(woc.wf, controller)
+	woc.operate(ctx)
+	assert.Equal(t, wfv1.NodeSucceeded, woc.wf.Status.Phase)
+	assert.Equal(t, wfv1.NodeSkipped, woc.wf.Status.Nodes.FindByDisplayName("process(1:2)").Phase)
+	pods, err := listPods(woc)
+	if assert.NoError(t, err) {
+		assert.Len(t, pods.Items, 2)
+	}
+}
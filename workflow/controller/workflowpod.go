@@ -8,6 +8,7 @@ import (
 	"path"
 	"path/filepath"
 	"strconv"
+	"strings"
 	"time"
 
 	log "github.com/sirupsen/logrus"
@@ -24,6 +25,7 @@ import (
 	wfv1 "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1"
 	errorsutil "github.com/simster7/argo/v2/util/errors"
 	"github.com/simster7/argo/v2/util/intstr"
+	"github.com/simster7/argo/v2/util/slice"
 	"github.com/simster7/argo/v2/workflow/common"
 	"github.com/simster7/argo/v2/workflow/util"
 )
@@ -129,7 +131,7 @@ type createWorkflowPodOpts struct {
 	executionDeadline   time.Time
 }
 
-func (woc *wfOperationCtx) createWorkflowPod(ctx context.Context, nodeName string, mainCtr apiv1.Container, tmpl *wfv1.Template, opts *createWorkflowPodOpts) (*apiv1.Pod, error) {
+func (woc *wfOperationCtx) createWorkflowPod(ctx context.Context, nodeName string, mainCtrs []apiv1.Container, tmpl *wfv1.Template, opts *createWorkflowPodOpts) (*apiv1.Pod, error) {
 	nodeID := woc.wf.NodeID(nodeName)
 	woc.log.Debugf("Creating Pod: %s (%s)", nodeName, nodeID)
 
@@ -151,14 +153,22 @@ func (woc *wfOperationCtx) createWorkflowPod(ctx context.Context, nodeName strin
 	tmpl = tmpl.DeepCopy()
 	wfSpec := woc.execWf.Spec.DeepCopy()
 
-	mainCtr.Name = common.MainContainerName
-	// Allow customization of main container resources.
-	if isResourcesSpecified(woc.controller.Config.MainContainer) {
-		mainCtr.Resources = *woc.controller.Config.MainContainer.Resources.DeepCopy()
-	}
-	// Container resources in workflow spec takes precedence over the main container's configuration in controller.
-	if isResourcesSpecified(tmpl.Container) && tmpl.Container.Name == common.MainContainerName {
-		mainCtr.Resources = *tmpl.Container.Resources.DeepCopy()
+	for i, mainCtr := range mainCtrs {
+		if tmpl.ContainerSet != nil {
+			// the containers of a container set keep their names, and run through argoexec
+			mainCtrs[i] = woc.newContainerSetContainer(tmpl, mainCtr)
+			continue
+		}
+		mainCtr.Name = common.MainContainerName
+		// Allow customization of main container resources.
+		if isResourcesSpecified(woc.controller.Config.MainContainer) {
+			mainCtr.Resources = *woc.controller.Config.MainContainer.Resources.DeepCopy()
+		}
+		// Container resources in workflow spec takes precedence over the main container's configuration in controller.
+		if isResourcesSpecified(tmpl.Container) && tmpl.Container.Name == common.MainContainerName {
+			mainCtr.Resources = *tmpl.Container.Resources.DeepCopy()
+		}
+		mainCtrs[i] = mainCtr
 	}
 
 	var activeDeadlineSeconds *int64
@@ -251,11 +261,12 @@ func (woc *wfOperationCtx) createWorkflowPod(ctx context.Context, nodeName strin
 	// each container sequentially in the order that they appear in this list. For PNS we want the
 	// wait container to start before the main, so that it always has the chance to see the main
 	// container's PID and root filesystem.
-	pod.Spec.Containers = append(pod.Spec.Containers, mainCtr)
+	pod.Spec.Containers = append(pod.Spec.Containers, mainCtrs...)
 
 	// Add init container only if it needs input artifacts. This is also true for
-	// script templates (which needs to populate the script)
-	if len(tmpl.Inputs.Artifacts) > 0 || tmpl.GetType() == wfv1.TemplateTypeScript {
+	// script templates (which needs to populate the script), and container sets
+	// and the emissary executor (which need argoexec to run their containers through)
+	if len(tmpl.Inputs.Artifacts) > 0 || tmpl.GetType() == wfv1.TemplateTypeScript || tmpl.GetType() == wfv1.TemplateTypeContainerSet || woc.isEmissary(tmpl) {
 		initCtr := woc.newInitContainer(tmpl)
 		pod.Spec.InitContainers = []apiv1.Container{initCtr}
 	}
@@ -277,6 +288,10 @@ func (woc *wfOperationCtx) createWorkflowPod(ctx context.Context, nodeName strin
 		addScriptStagingVolume(pod)
 	}
 
+	if tmpl.GetType() == wfv1.TemplateTypeContainerSet || woc.isEmissary(tmpl) {
+		addVarRunArgoVolume(pod, tmpl)
+	}
+
 	// addInitContainers, addSidecars and addOutputArtifactsVolumes should be called after all
 	// volumes have been manipulated in the main container since volumeMounts are mirrored
 	err = addInitContainers(pod, tmpl)
@@ -289,14 +304,30 @@ func (woc *wfOperationCtx) createWorkflowPod(ctx context.Context, nodeName strin
 	}
 	addOutputArtifactsVolumes(pod, tmpl)
 
+	// The values of sensitive parameters are only made available to the pod via environment variables, and are
+	// redacted from the template in its annotations
+	tmplBytes, err := json.Marshal(tmpl)
+	if err != nil {
+		return nil, err
+	}
+	addSensitiveParameterEnvVars(pod, common.SensitiveParameterEnvVars(tmpl, string(tmplBytes), woc.workflowArguments().Parameters), tmpl)
+	tmpl.Redact()
+
 	// Set the container template JSON in pod annotations, which executor examines for things like
 	// artifact location/path.
-	tmplBytes, err := json.Marshal(tmpl)
+	tmplBytes, err = json.Marshal(tmpl)
 	if err != nil {
 		return nil, err
 	}
 	pod.ObjectMeta.Annotations[common.AnnotationKeyTemplate] = string(tmplBytes)
 
+	if woc.isEmissary(tmpl) {
+		err = addEmissary(pod, tmpl, string(tmplBytes))
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Perform one last variable substitution here. Some variables come from the from workflow
 	// configmap (e.g. archive location) or volumes attribute, and were not substituted
 	// in executeTemplate.
@@ -399,7 +430,7 @@ func (woc *wfOperationCtx) createWorkflowPod(ctx context.Context, nodeName strin
 func substitutePodParams(pod *apiv1.Pod, globalParams common.Parameters, tmpl *wfv1.Template) (*apiv1.Pod, error) {
 	podParams := globalParams.DeepCopy()
 	for _, inParam := range tmpl.Inputs.Parameters {
-		podParams["inputs.parameters."+inParam.Name] = inParam.Value.String()
+		podParams["inputs.parameters."+inParam.Name] = common.InputParameterValue(inParam)
 	}
 	podParams[common.LocalVarPodName] = pod.Name
 	specBytes, err := json.Marshal(pod)
@@ -428,6 +459,27 @@ func (woc *wfOperationCtx) newInitContainer(tmpl *wfv1.Template) apiv1.Container
 	return *ctr
 }
 
+// newContainerSetContainer returns the container of a container set, with its command run through argoexec, which
+// waits for the containers it depends on to succeed before running it
+func (woc *wfOperationCtx) newContainerSetContainer(tmpl *wfv1.Template, ctr apiv1.Container) apiv1.Container {
+	var dependencies []string
+	for _, c := range tmpl.ContainerSet.Containers {
+		if c.Name == ctr.Name {
+			dependencies = c.Dependencies
+		}
+	}
+	if !isResourcesSpecified(&ctr) && isResourcesSpecified(woc.controller.Config.MainContainer) {
+		ctr.Resources = *woc.controller.Config.MainContainer.Resources.DeepCopy()
+	}
+	ctr.Command = append([]string{filepath.Join(common.ExecutorVarRunArgoDir, "argoexec"), "run", "--"}, ctr.Command...)
+	ctr.Env = append([]apiv1.EnvVar{
+		{Name: common.EnvVarContainerName, Value: ctr.Name},
+		{Name: common.EnvVarDependencies, Value: strings.Join(dependencies, ",")},
+	}, ctr.Env...)
+	ctr.VolumeMounts = append(append([]apiv1.VolumeMount{}, tmpl.ContainerSet.VolumeMounts...), ctr.VolumeMounts...)
+	return ctr
+}
+
 func (woc *wfOperationCtx) newWaitContainer(tmpl *wfv1.Template) (*apiv1.Container, error) {
 	ctr := woc.newExecContainer(common.WaitContainerName, tmpl)
 	ctr.Command = []string{"argoexec", "wait"}
@@ -458,6 +510,13 @@ func hasPrivilegedContainers(tmpl *wfv1.Template) bool {
 	if containerIsPrivileged(tmpl.Container) {
 		return true
 	}
+	if tmpl.ContainerSet != nil {
+		for _, ctr := range tmpl.ContainerSet.Containers {
+			if containerIsPrivileged(&ctr.Container) {
+				return true
+			}
+		}
+	}
 	for _, side := range tmpl.Sidecars {
 		if containerIsPrivileged(&side.Container) {
 			return true
@@ -518,7 +577,7 @@ func (woc *wfOperationCtx) createEnvVars() []apiv1.EnvVar {
 				Value: strconv.FormatBool(woc.controller.Config.KubeletInsecure),
 			},
 		)
-	case common.ContainerRuntimeExecutorPNS:
+	case common.ContainerRuntimeExecutorPNS, common.ContainerRuntimeExecutorEmissary:
 		execEnvVars = append(execEnvVars,
 			apiv1.EnvVar{
 				Name:  common.EnvVarContainerRuntimeExecutor,
@@ -548,7 +607,7 @@ func (woc *wfOperationCtx) createVolumes(tmpl *wfv1.Template) []apiv1.Volume {
 		})
 	}
 	switch woc.controller.GetContainerRuntimeExecutor() {
-	case common.ContainerRuntimeExecutorKubelet, common.ContainerRuntimeExecutorK8sAPI, common.ContainerRuntimeExecutorPNS:
+	case common.ContainerRuntimeExecutorKubelet, common.ContainerRuntimeExecutorK8sAPI, common.ContainerRuntimeExecutorPNS, common.ContainerRuntimeExecutorEmissary:
 		return volumes
 	default:
 		return append(volumes, woc.getVolumeDockerSock(tmpl))
@@ -702,7 +761,7 @@ func addSchedulingConstraints(pod *apiv1.Pod, wfSpec *wfv1.WorkflowSpec, tmpl *w
 // These are either specified in the workflow.spec.volumes or the workflow.spec.volumeClaimTemplate section
 func addVolumeReferences(pod *apiv1.Pod, vols []apiv1.Volume, tmpl *wfv1.Template, pvcs []apiv1.Volume) error {
 	switch tmpl.GetType() {
-	case wfv1.TemplateTypeContainer, wfv1.TemplateTypeScript:
+	case wfv1.TemplateTypeContainer, wfv1.TemplateTypeScript, wfv1.TemplateTypeContainerSet:
 	default:
 		return nil
 	}
@@ -765,6 +824,18 @@ func addVolumeReferences(pod *apiv1.Pod, vols []apiv1.Volume, tmpl *wfv1.Templat
 			return err
 		}
 	}
+	if tmpl.ContainerSet != nil {
+		err := addVolumeRef(tmpl.ContainerSet.VolumeMounts)
+		if err != nil {
+			return err
+		}
+		for _, ctr := range tmpl.ContainerSet.Containers {
+			err := addVolumeRef(ctr.VolumeMounts)
+			if err != nil {
+				return err
+			}
+		}
+	}
 
 	for _, container := range tmpl.InitContainers {
 		err := addVolumeRef(container.VolumeMounts)
@@ -837,13 +908,7 @@ func (woc *wfOperationCtx) addInputArtifactsVolumes(pod *apiv1.Pod, tmpl *wfv1.T
 			// We also add the user supplied mount paths to the init container,
 			// in case the executor needs to load artifacts to this volume
 			// instead of the artifacts volume
-			tmplVolumeMounts := []apiv1.VolumeMount{}
-			if tmpl.Container != nil {
-				tmplVolumeMounts = tmpl.Container.VolumeMounts
-			} else if tmpl.Script != nil {
-				tmplVolumeMounts = tmpl.Script.Container.VolumeMounts
-			}
-			for _, mnt := range tmplVolumeMounts {
+			for _, mnt := range common.GetMainContainerVolumeMounts(tmpl) {
 				mnt.MountPath = filepath.Join(common.ExecutorMainFilesystemDir, mnt.MountPath)
 				initCtr.VolumeMounts = append(initCtr.VolumeMounts, mnt)
 			}
@@ -853,17 +918,17 @@ func (woc *wfOperationCtx) addInputArtifactsVolumes(pod *apiv1.Pod, tmpl *wfv1.T
 		}
 	}
 
-	mainCtrIndex := -1
+	// the input artifacts are mounted into every container of a container set
+	mainCtrNames := common.GetMainContainerNames(tmpl)
+	var mainCtrIndexes []int
 	for i, ctr := range pod.Spec.Containers {
-		switch ctr.Name {
-		case common.MainContainerName:
-			mainCtrIndex = i
+		if slice.ContainsString(mainCtrNames, ctr.Name) {
+			mainCtrIndexes = append(mainCtrIndexes, i)
 		}
 	}
-	if mainCtrIndex == -1 {
+	if len(mainCtrIndexes) == 0 {
 		panic("Could not find main or wait container in pod spec")
 	}
-	mainCtr := &pod.Spec.Containers[mainCtrIndex]
 
 	for _, art := range tmpl.Inputs.Artifacts {
 		if art.Path == "" {
@@ -888,12 +953,10 @@ func (woc *wfOperationCtx) addInputArtifactsVolumes(pod *apiv1.Pod, tmpl *wfv1.T
 			MountPath: art.Path,
 			SubPath:   art.Name,
 		}
-		if mainCtr.VolumeMounts == nil {
-			mainCtr.VolumeMounts = make([]apiv1.VolumeMount, 0)
+		for _, i := range mainCtrIndexes {
+			pod.Spec.Containers[i].VolumeMounts = append(pod.Spec.Containers[i].VolumeMounts, volMount)
 		}
-		mainCtr.VolumeMounts = append(mainCtr.VolumeMounts, volMount)
 	}
-	pod.Spec.Containers[mainCtrIndex] = *mainCtr
 	return nil
 }
 
@@ -917,6 +980,10 @@ func addOutputArtifactsVolumes(pod *apiv1.Pod, tmpl *wfv1.Template) {
 			waitCtrIndex = i
 		}
 	}
+	if mainCtrIndex == -1 && tmpl.ContainerSet != nil {
+		// outputs are only collected from a container set that has a container named main
+		return
+	}
 	if mainCtrIndex == -1 || waitCtrIndex == -1 {
 		panic("Could not find main or wait container in pod spec")
 	}
@@ -942,7 +1009,7 @@ func (woc *wfOperationCtx) addArchiveLocation(tmpl *wfv1.Template) error {
 	var needLocation bool
 
 	if tmpl.ArchiveLocation != nil {
-		if tmpl.ArchiveLocation.S3 != nil || tmpl.ArchiveLocation.Artifactory != nil || tmpl.ArchiveLocation.HDFS != nil || tmpl.ArchiveLocation.OSS != nil || tmpl.ArchiveLocation.GCS != nil {
+		if tmpl.ArchiveLocation.S3 != nil || tmpl.ArchiveLocation.Artifactory != nil || tmpl.ArchiveLocation.HDFS != nil || tmpl.ArchiveLocation.OSS != nil || tmpl.ArchiveLocation.GCS != nil || tmpl.ArchiveLocation.Azure != nil {
 			// User explicitly set the location. nothing else to do.
 			return nil
 		}
@@ -1027,6 +1094,16 @@ func (woc *wfOperationCtx) addArchiveLocation(tmpl *wfv1.Template) error {
 			},
 			Key: artLocationKey,
 		}
+	} else if azureLocation := woc.artifactRepository.Azure; azureLocation != nil {
+		woc.log.Debugf("Setting Azure artifact repository information")
+		artLocationBlob := azureLocation.BlobNameFormat
+		if artLocationBlob == "" {
+			artLocationBlob = common.DefaultArchivePattern
+		}
+		tmpl.ArchiveLocation.Azure = &wfv1.AzureArtifact{
+			AzureBlobContainer: azureLocation.AzureBlobContainer,
+			Blob:               artLocationBlob,
+		}
 	} else {
 		return errors.Errorf(errors.CodeBadRequest, "controller is not configured with a default archive location")
 	}
@@ -1117,13 +1194,110 @@ func addScriptStagingVolume(pod *apiv1.Pod) {
 	}
 }
 
+// addVarRunArgoVolume sets up the volume shared between the init, the wait and the containers of a container set. Init
+// copies argoexec into it, and the containers run their commands through it and record their exit codes in it. The wait
+// container records the exit codes of the containers that terminate without recording them.
+func addVarRunArgoVolume(pod *apiv1.Pod, tmpl *wfv1.Template) {
+	pod.Spec.Volumes = append(pod.Spec.Volumes, apiv1.Volume{
+		Name: common.VarRunArgoVolumeName,
+		VolumeSource: apiv1.VolumeSource{
+			EmptyDir: &apiv1.EmptyDirVolumeSource{},
+		},
+	})
+	volMount := apiv1.VolumeMount{
+		Name:      common.VarRunArgoVolumeName,
+		MountPath: common.ExecutorVarRunArgoDir,
+	}
+	for i, initCtr := range pod.Spec.InitContainers {
+		if initCtr.Name == common.InitContainerName {
+			pod.Spec.InitContainers[i].VolumeMounts = append(initCtr.VolumeMounts, volMount)
+			break
+		}
+	}
+	mainCtrNames := common.GetMainContainerNames(tmpl)
+	for i, ctr := range pod.Spec.Containers {
+		if ctr.Name == common.WaitContainerName || slice.ContainsString(mainCtrNames, ctr.Name) {
+			pod.Spec.Containers[i].VolumeMounts = append(ctr.VolumeMounts, volMount)
+		}
+	}
+}
+
+// addSensitiveParameterEnvVars adds the environment variables of the sensitive parameters to the main containers, whose
+// commands and args Kubernetes expands them in, and to the init container, which expands them in the source of a script
+func addSensitiveParameterEnvVars(pod *apiv1.Pod, env []apiv1.EnvVar, tmpl *wfv1.Template) {
+	if len(env) == 0 {
+		return
+	}
+	for i, ctr := range pod.Spec.InitContainers {
+		if ctr.Name == common.InitContainerName {
+			pod.Spec.InitContainers[i].Env = append(append([]apiv1.EnvVar{}, env...), ctr.Env...)
+		}
+	}
+	mainCtrNames := common.GetMainContainerNames(tmpl)
+	for i, ctr := range pod.Spec.Containers {
+		if slice.ContainsString(mainCtrNames, ctr.Name) {
+			pod.Spec.Containers[i].Env = append(append([]apiv1.EnvVar{}, env...), ctr.Env...)
+		}
+	}
+}
+
+// isEmissary returns whether the commands of the template's pod are run through argoexec, for the emissary executor.
+// Resource templates are not, as argoexec is their main container.
+func (woc *wfOperationCtx) isEmissary(tmpl *wfv1.Template) bool {
+	return woc.controller.GetContainerRuntimeExecutor() == common.ContainerRuntimeExecutorEmissary && tmpl.GetType() != wfv1.TemplateTypeResource
+}
+
+// addEmissary runs the commands of the main containers and the sidecars through argoexec, for the emissary executor.
+// The wait container then reads their output, exit codes and outputs from, and writes the signals to send to them to,
+// the /var/run/argo volume, rather than using the container runtime or sharing their process namespace.
+func addEmissary(pod *apiv1.Pod, tmpl *wfv1.Template, tmplJSON string) error {
+	volMount := apiv1.VolumeMount{
+		Name:      common.VarRunArgoVolumeName,
+		MountPath: common.ExecutorVarRunArgoDir,
+	}
+	for i, ctr := range pod.Spec.Containers {
+		if !hasVolumeMountPath(ctr, common.ExecutorVarRunArgoDir) {
+			ctr.VolumeMounts = append(ctr.VolumeMounts, volMount)
+		}
+		if ctr.Name == common.WaitContainerName {
+			pod.Spec.Containers[i] = ctr
+			continue
+		}
+		env := []apiv1.EnvVar{{Name: common.EnvVarContainerRuntimeExecutor, Value: common.ContainerRuntimeExecutorEmissary}}
+		if tmpl.ContainerSet == nil || !slice.ContainsString(tmpl.ContainerSet.GetContainerNames(), ctr.Name) {
+			// the containers of a container set already run through argoexec
+			if len(ctr.Command) == 0 {
+				return errors.Errorf(errors.CodeBadRequest, "container '%s' must specify a command when using the emissary executor", ctr.Name)
+			}
+			ctr.Command = append([]string{filepath.Join(common.ExecutorVarRunArgoDir, "argoexec"), "run", "--"}, ctr.Command...)
+			env = append(env, apiv1.EnvVar{Name: common.EnvVarContainerName, Value: ctr.Name})
+		}
+		if ctr.Name == common.MainContainerName {
+			// argoexec saves the outputs in the base image layer, which the wait container cannot read
+			env = append(env, apiv1.EnvVar{Name: common.EnvVarTemplate, Value: tmplJSON})
+		}
+		ctr.Env = append(env, ctr.Env...)
+		pod.Spec.Containers[i] = ctr
+	}
+	return nil
+}
+
+func hasVolumeMountPath(ctr apiv1.Container, mountPath string) bool {
+	for _, m := range ctr.VolumeMounts {
+		if m.MountPath == mountPath {
+			return true
+		}
+	}
+	return false
+}
+
 // addInitContainers adds all init containers to the pod spec of the step
 // Optionally volume mounts from the main container to the init containers
 func addInitContainers(pod *apiv1.Pod, tmpl *wfv1.Template) error {
 	if len(tmpl.InitContainers) == 0 {
 		return nil
 	}
-	mainCtr := findMainContainer(pod)
+	mainCtr := findMirroredContainer(pod, tmpl)
 	if mainCtr == nil {
 		panic("Unable to locate main container")
 	}
@@ -1143,7 +1317,7 @@ func addSidecars(pod *apiv1.Pod, tmpl *wfv1.Template) error {
 	if len(tmpl.Sidecars) == 0 {
 		return nil
 	}
-	mainCtr := findMainContainer(pod)
+	mainCtr := findMirroredContainer(pod, tmpl)
 	if mainCtr == nil {
 		panic("Unable to locate main container")
 	}
@@ -1225,6 +1399,9 @@ func createArchiveLocationSecret(tmpl *wfv1.Template, volMap map[string]apiv1.Vo
 		createSecretVal(volMap, &ossRepo.SecretKeySecret, uniqueKeyMap)
 	} else if gcsRepo := tmpl.ArchiveLocation.GCS; gcsRepo != nil {
 		createSecretVal(volMap, &gcsRepo.ServiceAccountKeySecret, uniqueKeyMap)
+	} else if azureRepo := tmpl.ArchiveLocation.Azure; azureRepo != nil {
+		createSecretVal(volMap, azureRepo.AccountKeySecret, uniqueKeyMap)
+		createSecretVal(volMap, azureRepo.SASTokenSecret, uniqueKeyMap)
 	}
 }
 
@@ -1247,6 +1424,9 @@ func createSecretVolume(volMap map[string]apiv1.Volume, art wfv1.Artifact, keyMa
 		createSecretVal(volMap, &art.OSS.SecretKeySecret, keyMap)
 	} else if art.GCS != nil {
 		createSecretVal(volMap, &art.GCS.ServiceAccountKeySecret, keyMap)
+	} else if art.Azure != nil {
+		createSecretVal(volMap, art.Azure.AccountKeySecret, keyMap)
+		createSecretVal(volMap, art.Azure.SASTokenSecret, keyMap)
 	}
 }
 
@@ -1296,6 +1476,15 @@ func findMainContainer(pod *apiv1.Pod) *apiv1.Container {
 	return mainCtr
 }
 
+// findMirroredContainer finds the container whose volume mounts are mirrored to init containers and sidecars. For a
+// container set, this is a container with just the volume mounts shared by the set.
+func findMirroredContainer(pod *apiv1.Pod, tmpl *wfv1.Template) *apiv1.Container {
+	if tmpl.ContainerSet != nil {
+		return &apiv1.Container{VolumeMounts: tmpl.ContainerSet.VolumeMounts}
+	}
+	return findMainContainer(pod)
+}
+
 // mirrorVolumeMounts mirrors volumeMounts of source container to target container
 func mirrorVolumeMounts(sourceContainer, targetContainer *apiv1.Container) {
 	for _, volMnt := range sourceContainer.VolumeMounts {
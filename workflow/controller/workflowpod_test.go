@@ -189,7 +189,7 @@ func TestScriptTemplateWithoutVolumeOptionalArtifact(t *testing.T) {
 	mainCtr := tmpl.Script.Container
 	mainCtr.Args = append(mainCtr.Args, common.ExecutorScriptSourcePath)
 	ctx := context.Background()
-	pod, err := woc.createWorkflowPod(ctx, tmpl.Name, mainCtr, tmpl, &createWorkflowPodOpts{})
+	pod, err := woc.createWorkflowPod(ctx, tmpl.Name, []apiv1.Container{mainCtr}, tmpl, &createWorkflowPodOpts{})
 	assert.NoError(t, err)
 	// Note: pod.Spec.Containers[0] is wait
 	assert.Contains(t, pod.Spec.Containers[1].VolumeMounts, volumeMount)
@@ -204,7 +204,7 @@ func TestScriptTemplateWithoutVolumeOptionalArtifact(t *testing.T) {
 	woc = newWoc(*wf)
 	mainCtr = tmpl.Script.Container
 	mainCtr.Args = append(mainCtr.Args, common.ExecutorScriptSourcePath)
-	pod, err = woc.createWorkflowPod(ctx, tmpl.Name, mainCtr, tmpl, &createWorkflowPodOpts{includeScriptOutput: true})
+	pod, err = woc.createWorkflowPod(ctx, tmpl.Name, []apiv1.Container{mainCtr}, tmpl, &createWorkflowPodOpts{includeScriptOutput: true})
 	assert.NoError(t, err)
 	assert.NotContains(t, pod.Spec.Containers[1].VolumeMounts, volumeMount)
 	assert.Contains(t, pod.Spec.Containers[1].VolumeMounts, customVolumeMount)
@@ -216,7 +216,7 @@ func TestScriptTemplateWithoutVolumeOptionalArtifact(t *testing.T) {
 	mainCtr = tmpl.Script.Container
 	mainCtr.Args = append(mainCtr.Args, common.ExecutorScriptSourcePath)
 
-	pod, err = woc.createWorkflowPod(ctx, tmpl.Name, mainCtr, tmpl, &createWorkflowPodOpts{})
+	pod, err = woc.createWorkflowPod(ctx, tmpl.Name, []apiv1.Container{mainCtr}, tmpl, &createWorkflowPodOpts{})
 	assert.NoError(t, err)
 	assert.NotContains(t, pod.Spec.Containers[1].VolumeMounts, volumeMount)
 }
@@ -1174,19 +1174,19 @@ func TestPodSpecPatch(t *testing.T) {
 	ctx := context.Background()
 	woc := newWoc(*wf)
 	mainCtr := woc.execWf.Spec.Templates[0].Container
-	pod, _ := woc.createWorkflowPod(ctx, wf.Name, *mainCtr, &wf.Spec.Templates[0], &createWorkflowPodOpts{})
+	pod, _ := woc.createWorkflowPod(ctx, wf.Name, []apiv1.Container{*mainCtr}, &wf.Spec.Templates[0], &createWorkflowPodOpts{})
 	assert.Equal(t, "0.800", pod.Spec.Containers[1].Resources.Limits.Cpu().AsDec().String())
 
 	wf = unmarshalWF(helloWorldWfWithWFPatch)
 	woc = newWoc(*wf)
 	mainCtr = woc.execWf.Spec.Templates[0].Container
-	pod, _ = woc.createWorkflowPod(ctx, wf.Name, *mainCtr, &wf.Spec.Templates[0], &createWorkflowPodOpts{})
+	pod, _ = woc.createWorkflowPod(ctx, wf.Name, []apiv1.Container{*mainCtr}, &wf.Spec.Templates[0], &createWorkflowPodOpts{})
 	assert.Equal(t, "0.800", pod.Spec.Containers[1].Resources.Limits.Cpu().AsDec().String())
 
 	wf = unmarshalWF(helloWorldWfWithWFYAMLPatch)
 	woc = newWoc(*wf)
 	mainCtr = woc.execWf.Spec.Templates[0].Container
-	pod, _ = woc.createWorkflowPod(ctx, wf.Name, *mainCtr, &wf.Spec.Templates[0], &createWorkflowPodOpts{})
+	pod, _ = woc.createWorkflowPod(ctx, wf.Name, []apiv1.Container{*mainCtr}, &wf.Spec.Templates[0], &createWorkflowPodOpts{})
 
 	assert.Equal(t, "0.800", pod.Spec.Containers[1].Resources.Limits.Cpu().AsDec().String())
 	assert.Equal(t, "104857600", pod.Spec.Containers[1].Resources.Limits.Memory().AsDec().String())
@@ -1210,7 +1210,7 @@ func TestMainContainerCustomization(t *testing.T) {
 	ctx := context.Background()
 	woc.controller.Config.MainContainer = mainCtrSpec
 	mainCtr := woc.execWf.Spec.Templates[0].Container
-	pod, _ := woc.createWorkflowPod(ctx, wf.Name, *mainCtr, &wf.Spec.Templates[0], &createWorkflowPodOpts{})
+	pod, _ := woc.createWorkflowPod(ctx, wf.Name, []apiv1.Container{*mainCtr}, &wf.Spec.Templates[0], &createWorkflowPodOpts{})
 	assert.Equal(t, "0.800", pod.Spec.Containers[1].Resources.Limits.Cpu().AsDec().String())
 
 	// The main container's resources should be changed since the existing
@@ -1220,7 +1220,7 @@ func TestMainContainerCustomization(t *testing.T) {
 	woc.controller.Config.MainContainer = mainCtrSpec
 	mainCtr = woc.execWf.Spec.Templates[0].Container
 	mainCtr.Resources = apiv1.ResourceRequirements{Limits: apiv1.ResourceList{}}
-	pod, _ = woc.createWorkflowPod(ctx, wf.Name, *mainCtr, &wf.Spec.Templates[0], &createWorkflowPodOpts{})
+	pod, _ = woc.createWorkflowPod(ctx, wf.Name, []apiv1.Container{*mainCtr}, &wf.Spec.Templates[0], &createWorkflowPodOpts{})
 	assert.Equal(t, "0.200", pod.Spec.Containers[1].Resources.Limits.Cpu().AsDec().String())
 
 	// Workflow spec's main container takes precedence over config in controller
@@ -1236,7 +1236,7 @@ func TestMainContainerCustomization(t *testing.T) {
 			apiv1.ResourceMemory: resource.MustParse("512Mi"),
 		},
 	}
-	pod, _ = woc.createWorkflowPod(ctx, wf.Name, *mainCtr, &wf.Spec.Templates[0], &createWorkflowPodOpts{})
+	pod, _ = woc.createWorkflowPod(ctx, wf.Name, []apiv1.Container{*mainCtr}, &wf.Spec.Templates[0], &createWorkflowPodOpts{})
 	assert.Equal(t, "0.900", pod.Spec.Containers[1].Resources.Limits.Cpu().AsDec().String())
 
 	// If the name of the container in the workflow spec is not "main",
@@ -1257,7 +1257,7 @@ func TestMainContainerCustomization(t *testing.T) {
 			apiv1.ResourceMemory: resource.MustParse("512Mi"),
 		},
 	}
-	pod, _ = woc.createWorkflowPod(ctx, wf.Name, *mainCtr, &wf.Spec.Templates[0], &createWorkflowPodOpts{})
+	pod, _ = woc.createWorkflowPod(ctx, wf.Name, []apiv1.Container{*mainCtr}, &wf.Spec.Templates[0], &createWorkflowPodOpts{})
 	assert.Equal(t, "0.100", pod.Spec.Containers[1].Resources.Limits.Cpu().AsDec().String())
 }
 
@@ -1317,7 +1317,7 @@ func TestHybridWfVolumesWindows(t *testing.T) {
 
 	ctx := context.Background()
 	mainCtr := woc.execWf.Spec.Templates[0].Container
-	pod, _ := woc.createWorkflowPod(ctx, wf.Name, *mainCtr, &wf.Spec.Templates[0], &createWorkflowPodOpts{})
+	pod, _ := woc.createWorkflowPod(ctx, wf.Name, []apiv1.Container{*mainCtr}, &wf.Spec.Templates[0], &createWorkflowPodOpts{})
 	assert.Equal(t, "\\\\.\\pipe\\docker_engine", pod.Spec.Containers[0].VolumeMounts[1].MountPath)
 	assert.Equal(t, false, pod.Spec.Containers[0].VolumeMounts[1].ReadOnly)
 	assert.Equal(t, (*apiv1.HostPathType)(nil), pod.Spec.Volumes[1].HostPath.Type)
@@ -1329,7 +1329,7 @@ func TestHybridWfVolumesLinux(t *testing.T) {
 
 	ctx := context.Background()
 	mainCtr := woc.execWf.Spec.Templates[0].Container
-	pod, _ := woc.createWorkflowPod(ctx, wf.Name, *mainCtr, &wf.Spec.Templates[0], &createWorkflowPodOpts{})
+	pod, _ := woc.createWorkflowPod(ctx, wf.Name, []apiv1.Container{*mainCtr}, &wf.Spec.Templates[0], &createWorkflowPodOpts{})
 	assert.Equal(t, "/var/run/docker.sock", pod.Spec.Containers[0].VolumeMounts[1].MountPath)
 	assert.Equal(t, true, pod.Spec.Containers[0].VolumeMounts[1].ReadOnly)
 	assert.Equal(t, &hostPathSocket, pod.Spec.Volumes[1].HostPath.Type)
@@ -1356,10 +1356,156 @@ func TestPropagateMaxDuration(t *testing.T) {
 	woc := newWoc()
 	deadline := time.Now()
 	ctx := context.Background()
-	pod, err := woc.createWorkflowPod(ctx, tmpl.Name, *tmpl.Container, tmpl, &createWorkflowPodOpts{executionDeadline: deadline})
+	pod, err := woc.createWorkflowPod(ctx, tmpl.Name, []apiv1.Container{*tmpl.Container}, tmpl, &createWorkflowPodOpts{executionDeadline: deadline})
 	assert.NoError(t, err)
 	out, err := json.Marshal(map[string]time.Time{"deadline": deadline})
 	if assert.NoError(t, err) {
 		assert.Equal(t, string(out), pod.Annotations[common.AnnotationKeyExecutionControl])
 	}
 }
+
+var emissaryWf = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: emissary
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    container:
+      image: argoproj/argosay:v2
+      command: [/argosay]
+      args: [echo, hello, /tmp/message]
+    sidecars:
+    - name: nginx
+      image: nginx:1.13
+      command: [nginx, -g, daemon off;]
+      mirrorVolumeMounts: true
+    outputs:
+      parameters:
+      - name: message
+        valueFrom:
+          path: /tmp/message
+`
+
+func TestEmissaryExecutor(t *testing.T) {
+	ctx := context.Background()
+	wf := unmarshalWF(emissaryWf)
+	cancel, controller := newController(wf)
+	defer cancel()
+	controller.Config.ContainerRuntimeExecutor = common.ContainerRuntimeExecutorEmissary
+
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(ctx)
+
+	pods, err := listPods(woc)
+	if assert.NoError(t, err) && assert.Len(t, pods.Items, 1) {
+		pod := pods.Items[0]
+		volMount := apiv1.VolumeMount{Name: common.VarRunArgoVolumeName, MountPath: common.ExecutorVarRunArgoDir}
+		var volumes []string
+		for _, v := range pod.Spec.Volumes {
+			volumes = append(volumes, v.Name)
+		}
+		assert.NotContains(t, volumes, common.DockerSockVolumeName)
+		assert.Contains(t, volumes, common.VarRunArgoVolumeName)
+		assert.Nil(t, pod.Spec.ShareProcessNamespace)
+		if assert.Len(t, pod.Spec.InitContainers, 1) {
+			assert.Contains(t, pod.Spec.InitContainers[0].VolumeMounts, volMount)
+		}
+		for _, ctr := range pod.Spec.Containers {
+			assert.Contains(t, ctr.VolumeMounts, volMount)
+			assert.Contains(t, ctr.Env, apiv1.EnvVar{Name: common.EnvVarContainerRuntimeExecutor, Value: common.ContainerRuntimeExecutorEmissary})
+			switch ctr.Name {
+			case common.WaitContainerName:
+				assert.Equal(t, []string{"argoexec", "wait"}, ctr.Command)
+				assert.Nil(t, ctr.SecurityContext)
+			case common.MainContainerName:
+				assert.Equal(t, []string{"/var/run/argo/argoexec", "run", "--", "/argosay"}, ctr.Command)
+				assert.Equal(t, []string{"echo", "hello", "/tmp/message"}, ctr.Args)
+				assert.Contains(t, ctr.Env, apiv1.EnvVar{Name: common.EnvVarContainerName, Value: common.MainContainerName})
+				assert.Contains(t, ctr.Env, apiv1.EnvVar{Name: common.EnvVarTemplate, Value: pod.Annotations[common.AnnotationKeyTemplate]})
+			case "nginx":
+				assert.Equal(t, []string{"/var/run/argo/argoexec", "run", "--", "nginx", "-g", "daemon off;"}, ctr.Command)
+				assert.Contains(t, ctr.Env, apiv1.EnvVar{Name: common.EnvVarContainerName, Value: "nginx"})
+			default:
+				t.Errorf("unexpected container %s", ctr.Name)
+			}
+		}
+	}
+
+	t.Run("NoCommand", func(t *testing.T) {
+		wf := unmarshalWF(emissaryWf)
+		wf.Spec.Templates[0].Sidecars[0].Command = nil
+		cancel, controller := newController(wf)
+		defer cancel()
+		controller.Config.ContainerRuntimeExecutor = common.ContainerRuntimeExecutorEmissary
+		woc := newWorkflowOperationCtx(wf, controller)
+		woc.operate(ctx)
+		assert.Equal(t, wfv1.NodeFailed, woc.wf.Status.Phase)
+		assert.Contains(t, woc.wf.Status.Message, "templates.main.sidecars.nginx.command must be specified when using the emissary executor")
+	})
+}
+
+var sensitiveParametersWf = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: sensitive-parameters
+spec:
+  entrypoint: main
+  arguments:
+    parameters:
+    - name: password
+      value: my-password
+      sensitive: true
+  templates:
+  - name: main
+    inputs:
+      parameters:
+      - name: token
+        valueFrom:
+          secretKeyRef:
+            name: my-secret
+            key: token
+      - name: message
+        default: my-message
+        sensitive: true
+    container:
+      image: alpine:3.7
+      command: [sh, -c]
+      args: ["login {{inputs.parameters.token}} {{workflow.parameters.password}} {{inputs.parameters.message}}"]
+`
+
+func TestSensitiveParameters(t *testing.T) {
+	ctx := context.Background()
+	wf := unmarshalWF(sensitiveParametersWf)
+	cancel, controller := newController(wf)
+	defer cancel()
+
+	woc := newWorkflowOperationCtx(wf, controller)
+	woc.operate(ctx)
+
+	pods, err := listPods(woc)
+	if assert.NoError(t, err) && assert.Len(t, pods.Items, 1) {
+		pod := pods.Items[0]
+		assert.NotContains(t, pod.Annotations[common.AnnotationKeyTemplate], "my-password")
+		assert.NotContains(t, pod.Annotations[common.AnnotationKeyTemplate], "my-message")
+		for _, ctr := range pod.Spec.Containers {
+			if ctr.Name != common.MainContainerName {
+				continue
+			}
+			assert.Equal(t, []string{"login $(ARGO_PARAMETER_TOKEN) $(ARGO_WORKFLOW_PARAMETER_PASSWORD) $(ARGO_PARAMETER_MESSAGE)"}, ctr.Args)
+			assert.Equal(t, []apiv1.EnvVar{
+				{Name: "ARGO_WORKFLOW_PARAMETER_PASSWORD", Value: "my-password"},
+				{Name: "ARGO_PARAMETER_TOKEN", ValueFrom: &apiv1.EnvVarSource{SecretKeyRef: &apiv1.SecretKeySelector{LocalObjectReference: apiv1.LocalObjectReference{Name: "my-secret"}, Key: "token"}}},
+				{Name: "ARGO_PARAMETER_MESSAGE", Value: "my-message"},
+			}, ctr.Env[:3])
+		}
+	}
+	node := woc.wf.Status.Nodes.FindByDisplayName("sensitive-parameters")
+	if assert.NotNil(t, node) && assert.NotNil(t, node.Inputs) {
+		assert.Nil(t, node.Inputs.Parameters[0].Value)
+		assert.Equal(t, wfv1.RedactedValue, node.Inputs.Parameters[1].Value.String())
+	}
+}
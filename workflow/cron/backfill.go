@@ -0,0 +1,201 @@
+package cron
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
+
+	"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1"
+	"github.com/simster7/argo/v2/pkg/client/clientset/versioned"
+	"github.com/simster7/argo/v2/workflow/common"
+	"github.com/simster7/argo/v2/workflow/util"
+)
+
+// ScheduledTimeParameter is the name of the parameter that backfilled Workflows are passed their scheduled time in, it
+// is formatted as RFC3339
+const ScheduledTimeParameter = "scheduledTime"
+
+// maxBackfillRuns is the maximum number of Workflows that a single backfill may create
+const maxBackfillRuns = 1000
+
+// BackfillOpts are the options of a backfill
+type BackfillOpts struct {
+	// Start and End are the (inclusive) bounds of the scheduled times to run
+	Start time.Time
+	End   time.Time
+	// Parallelism is the number of backfilled Workflows that may run at the same time, defaults to 1
+	Parallelism int
+}
+
+// GetScheduledTimes returns the times the cron workflow was scheduled to run at between start and end (inclusive)
+func GetScheduledTimes(cronWf *v1alpha1.CronWorkflow, start, end time.Time) ([]time.Time, error) {
+	if end.Before(start) {
+		return nil, fmt.Errorf("end %s is before start %s", end.Format(time.RFC3339), start.Format(time.RFC3339))
+	}
+	cronSchedule, loc, err := getCronSchedule(cronWf)
+	if err != nil {
+		return nil, err
+	}
+	var scheduledTimes []time.Time
+	// Next returns the first time strictly after the one given, so we step back a second to include the start
+	for t := cronSchedule.Next(start.In(loc).Truncate(time.Second).Add(-time.Second)); !t.After(end); t = cronSchedule.Next(t) {
+		if len(scheduledTimes) == maxBackfillRuns {
+			return nil, fmt.Errorf("more than %d runs were scheduled between %s and %s", maxBackfillRuns, start.Format(time.RFC3339), end.Format(time.RFC3339))
+		}
+		scheduledTimes = append(scheduledTimes, t)
+	}
+	return scheduledTimes, nil
+}
+
+// Backfill creates a Workflow for each time the cron workflow was scheduled to run at between the start and end of the
+// options. Workflows that may not run yet, because of the parallelism or the concurrency policy, are created suspended
+// and the cron controller resumes them, oldest first, as the others complete. Scheduled times that already have a
+// Workflow are skipped, so a backfill may be safely retried.
+func Backfill(ctx context.Context, wfClientset versioned.Interface, cronWf *v1alpha1.CronWorkflow, opts BackfillOpts) ([]*v1alpha1.Workflow, error) {
+	scheduledTimes, err := GetScheduledTimes(cronWf, opts.Start, opts.End)
+	if err != nil {
+		return nil, err
+	}
+	parallelism := opts.Parallelism
+	if parallelism <= 0 {
+		parallelism = 1
+	}
+	wfClient := wfClientset.ArgoprojV1alpha1().Workflows(cronWf.Namespace)
+	// running is the number of the cron workflow's Workflows that count towards the parallelism
+	running := 0
+	switch cronWf.Spec.ConcurrencyPolicy {
+	case v1alpha1.AllowConcurrent, "":
+	case v1alpha1.ForbidConcurrent:
+		parallelism = 1
+		running = len(cronWf.Status.Active)
+	case v1alpha1.ReplaceConcurrent:
+		// every run would replace the one before it, so only the latest one is run
+		if len(scheduledTimes) > 1 {
+			scheduledTimes = scheduledTimes[len(scheduledTimes)-1:]
+		}
+		parallelism = 1
+		for _, wfObjectRef := range cronWf.Status.Active {
+			err := util.TerminateWorkflow(ctx, wfClient, wfObjectRef.Name)
+			if err != nil && !errors.IsNotFound(err) {
+				return nil, fmt.Errorf("failed to terminate workflow %s: %w", wfObjectRef.Name, err)
+			}
+		}
+	default:
+		return nil, fmt.Errorf("invalid ConcurrencyPolicy: %s", cronWf.Spec.ConcurrencyPolicy)
+	}
+
+	backfillID := strconv.FormatInt(time.Now().Unix(), 10)
+	var workflows []*v1alpha1.Workflow
+	for _, scheduledTime := range scheduledTimes {
+		wf := common.ConvertCronWorkflowToWorkflowWithName(cronWf, getChildWorkflowName(cronWf.Name, scheduledTime))
+		wf.Labels[common.LabelKeyCronWorkflowBackfill] = backfillID
+		wf.Annotations[common.AnnotationKeyCronWorkflowScheduledTime] = scheduledTime.Format(time.RFC3339)
+		wf.Annotations[common.AnnotationKeyBackfillParallelism] = strconv.Itoa(parallelism)
+		setParameter(wf, ScheduledTimeParameter, scheduledTime.Format(time.RFC3339))
+		pending := running >= parallelism
+		if pending {
+			wf.Spec.Suspend = pointer.BoolPtr(true)
+			wf.Annotations[common.AnnotationKeyBackfillPending] = "true"
+		}
+		runWf, err := util.SubmitWorkflow(ctx, wfClient, wfClientset, cronWf.Namespace, wf, &v1alpha1.SubmitOpts{})
+		if err != nil {
+			if errors.IsAlreadyExists(err) {
+				continue
+			}
+			return workflows, fmt.Errorf("failed to submit workflow for %s: %w", scheduledTime.Format(time.RFC3339), err)
+		}
+		if !pending {
+			running++
+		}
+		workflows = append(workflows, runWf)
+	}
+	return workflows, nil
+}
+
+// setParameter sets the value of the workflow's argument, without modifying the cron workflow's arguments it shares
+func setParameter(wf *v1alpha1.Workflow, name, value string) {
+	var parameters []v1alpha1.Parameter
+	for _, param := range wf.Spec.Arguments.Parameters {
+		if param.Name != name {
+			parameters = append(parameters, param)
+		}
+	}
+	wf.Spec.Arguments.Parameters = append(parameters, v1alpha1.Parameter{Name: name, Value: v1alpha1.AnyStringPtr(value)})
+}
+
+// resumePendingBackfills resumes the pending Workflows of each backfill, oldest first, while the backfill has fewer
+// running Workflows than its parallelism
+func (woc *cronWfOperationCtx) resumePendingBackfills(ctx context.Context, workflows []v1alpha1.Workflow) error {
+	running := make(map[string]int)
+	pending := make(map[string][]v1alpha1.Workflow)
+	for _, wf := range workflows {
+		backfillID, ok := wf.Labels[common.LabelKeyCronWorkflowBackfill]
+		if !ok || wf.Status.Fulfilled() {
+			continue
+		}
+		if wf.Annotations[common.AnnotationKeyBackfillPending] == "true" {
+			pending[backfillID] = append(pending[backfillID], wf)
+		} else {
+			running[backfillID]++
+		}
+	}
+	// with the Forbid policy, no backfilled Workflow may run alongside any other of the cron workflow's Workflows
+	available := 1
+	for _, wf := range workflows {
+		if !wf.Status.Fulfilled() && wf.Annotations[common.AnnotationKeyBackfillPending] != "true" {
+			available--
+		}
+	}
+	for backfillID, wfs := range pending {
+		sort.Slice(wfs, func(i, j int) bool {
+			return wfs[i].Annotations[common.AnnotationKeyCronWorkflowScheduledTime] < wfs[j].Annotations[common.AnnotationKeyCronWorkflowScheduledTime]
+		})
+		parallelism, err := strconv.Atoi(wfs[0].Annotations[common.AnnotationKeyBackfillParallelism])
+		if err != nil || parallelism <= 0 {
+			parallelism = 1
+		}
+		for _, wf := range wfs {
+			if running[backfillID] >= parallelism {
+				break
+			}
+			if woc.cronWf.Spec.ConcurrencyPolicy == v1alpha1.ForbidConcurrent {
+				if available <= 0 {
+					break
+				}
+				available--
+			}
+			err := woc.resumeBackfilledWorkflow(ctx, wf.Name)
+			if err != nil {
+				return err
+			}
+			running[backfillID]++
+		}
+	}
+	return nil
+}
+
+func (woc *cronWfOperationCtx) resumeBackfilledWorkflow(ctx context.Context, name string) error {
+	data, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]interface{}{common.AnnotationKeyBackfillPending: nil},
+		},
+		"spec": map[string]interface{}{"suspend": nil},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = woc.wfClient.Patch(ctx, name, types.MergePatchType, data, v1.PatchOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to resume backfilled workflow %s: %w", name, err)
+	}
+	woc.log.Infof("Resumed backfilled workflow %s", name)
+	return nil
+}
@@ -0,0 +1,161 @@
+package cron
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1"
+	"github.com/simster7/argo/v2/pkg/client/clientset/versioned/fake"
+	"github.com/simster7/argo/v2/workflow/common"
+)
+
+var hourlyWf = `
+apiVersion: argoproj.io/v1alpha1
+kind: CronWorkflow
+metadata:
+  name: hourly
+  namespace: argo
+  uid: 6a4e0d3e-5a2c-4b43-9f1c-3e1c0f8a2b11
+spec:
+  schedule: "0 * * * *"
+  timezone: UTC
+  workflowSpec:
+    entrypoint: whalesay
+    arguments:
+      parameters:
+      - name: scheduledTime
+        value: now
+      - name: message
+        value: hello
+    templates:
+    - name: whalesay
+      container:
+        image: docker/whalesay:latest
+`
+
+func newHourlyCronWf(t *testing.T, concurrencyPolicy v1alpha1.ConcurrencyPolicy) *v1alpha1.CronWorkflow {
+	var cronWf v1alpha1.CronWorkflow
+	err := yaml.Unmarshal([]byte(hourlyWf), &cronWf)
+	if assert.NoError(t, err) {
+		cronWf.Spec.ConcurrencyPolicy = concurrencyPolicy
+	}
+	return &cronWf
+}
+
+func TestGetScheduledTimes(t *testing.T) {
+	cronWf := newHourlyCronWf(t, "")
+	start := time.Date(2020, 10, 1, 1, 0, 0, 0, time.UTC)
+	t.Run("Inclusive", func(t *testing.T) {
+		times, err := GetScheduledTimes(cronWf, start, start.Add(2*time.Hour))
+		if assert.NoError(t, err) && assert.Len(t, times, 3) {
+			assert.True(t, start.Equal(times[0]))
+			assert.True(t, start.Add(2*time.Hour).Equal(times[2]))
+		}
+	})
+	t.Run("EndBeforeStart", func(t *testing.T) {
+		_, err := GetScheduledTimes(cronWf, start, start.Add(-time.Hour))
+		assert.EqualError(t, err, "end 2020-10-01T00:00:00Z is before start 2020-10-01T01:00:00Z")
+	})
+	t.Run("TooMany", func(t *testing.T) {
+		_, err := GetScheduledTimes(cronWf, start, start.Add(maxBackfillRuns*time.Hour))
+		assert.Error(t, err)
+	})
+}
+
+func TestBackfill(t *testing.T) {
+	ctx := context.Background()
+	start := time.Date(2020, 10, 1, 1, 0, 0, 0, time.UTC)
+	end := start.Add(2 * time.Hour)
+	t.Run("Allow", func(t *testing.T) {
+		cs := fake.NewSimpleClientset()
+		cronWf := newHourlyCronWf(t, v1alpha1.AllowConcurrent)
+		wfs, err := Backfill(ctx, cs, cronWf, BackfillOpts{Start: start, End: end, Parallelism: 2})
+		if assert.NoError(t, err) && assert.Len(t, wfs, 3) {
+			assert.Equal(t, "hourly-1601514000", wfs[0].Name)
+			assert.Equal(t, "hourly", wfs[0].Labels[common.LabelKeyCronWorkflow])
+			assert.NotEmpty(t, wfs[0].Labels[common.LabelKeyCronWorkflowBackfill])
+			assert.Equal(t, "2020-10-01T01:00:00Z", wfs[0].Annotations[common.AnnotationKeyCronWorkflowScheduledTime])
+			assert.Equal(t, "2020-10-01T01:00:00Z", wfs[0].Spec.Arguments.GetParameterByName(ScheduledTimeParameter).Value.String())
+			assert.Equal(t, "hello", wfs[0].Spec.Arguments.GetParameterByName("message").Value.String())
+			assert.Nil(t, wfs[1].Spec.Suspend)
+			assert.True(t, *wfs[2].Spec.Suspend)
+			assert.Equal(t, "true", wfs[2].Annotations[common.AnnotationKeyBackfillPending])
+		}
+		assert.Equal(t, "now", cronWf.Spec.WorkflowSpec.Arguments.GetParameterByName(ScheduledTimeParameter).Value.String())
+
+		// backfilling again does not duplicate the workflows
+		wfs, err = Backfill(ctx, cs, cronWf, BackfillOpts{Start: start, End: end})
+		if assert.NoError(t, err) {
+			assert.Empty(t, wfs)
+		}
+	})
+	t.Run("Forbid", func(t *testing.T) {
+		cs := fake.NewSimpleClientset()
+		cronWf := newHourlyCronWf(t, v1alpha1.ForbidConcurrent)
+		cronWf.Status.Active = append(cronWf.Status.Active, getWorkflowObjectReference(&v1alpha1.Workflow{}, &v1alpha1.Workflow{}))
+		wfs, err := Backfill(ctx, cs, cronWf, BackfillOpts{Start: start, End: end, Parallelism: 2})
+		if assert.NoError(t, err) && assert.Len(t, wfs, 3) {
+			for _, wf := range wfs {
+				assert.True(t, *wf.Spec.Suspend)
+			}
+		}
+	})
+	t.Run("Replace", func(t *testing.T) {
+		cs := fake.NewSimpleClientset()
+		cronWf := newHourlyCronWf(t, v1alpha1.ReplaceConcurrent)
+		wfs, err := Backfill(ctx, cs, cronWf, BackfillOpts{Start: start, End: end, Parallelism: 2})
+		if assert.NoError(t, err) && assert.Len(t, wfs, 1) {
+			assert.Equal(t, "hourly-1601521200", wfs[0].Name)
+			assert.Nil(t, wfs[0].Spec.Suspend)
+		}
+	})
+}
+
+func TestResumePendingBackfills(t *testing.T) {
+	ctx := context.Background()
+	cs := fake.NewSimpleClientset()
+	cronWf := newHourlyCronWf(t, v1alpha1.AllowConcurrent)
+	start := time.Date(2020, 10, 1, 1, 0, 0, 0, time.UTC)
+	_, err := Backfill(ctx, cs, cronWf, BackfillOpts{Start: start, End: start.Add(3 * time.Hour), Parallelism: 2})
+	assert.NoError(t, err)
+	woc := &cronWfOperationCtx{
+		wfClientset: cs,
+		wfClient:    cs.ArgoprojV1alpha1().Workflows(cronWf.Namespace),
+		cronWf:      cronWf,
+		log:         logrus.WithFields(logrus.Fields{}),
+	}
+
+	list := func() []v1alpha1.Workflow {
+		wfList, err := woc.wfClient.List(ctx, v1.ListOptions{})
+		assert.NoError(t, err)
+		return wfList.Items
+	}
+	pending := func() []string {
+		var names []string
+		for _, wf := range list() {
+			if wf.Spec.Suspend != nil && *wf.Spec.Suspend {
+				names = append(names, wf.Name)
+			}
+		}
+		return names
+	}
+
+	// both running workflows are still running, so nothing is resumed
+	assert.NoError(t, woc.resumePendingBackfills(ctx, list()))
+	assert.Equal(t, []string{"hourly-1601521200", "hourly-1601524800"}, pending())
+
+	wf, err := woc.wfClient.Get(ctx, "hourly-1601514000", v1.GetOptions{})
+	if assert.NoError(t, err) {
+		wf.Status.Phase = v1alpha1.NodeSucceeded
+		_, err = woc.wfClient.Update(ctx, wf, v1.UpdateOptions{})
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, woc.resumePendingBackfills(ctx, list()))
+	assert.Equal(t, []string{"hourly-1601524800"}, pending())
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1"
 	"github.com/simster7/argo/v2/pkg/client/clientset/versioned"
 	"github.com/simster7/argo/v2/workflow/common"
+	"github.com/simster7/argo/v2/workflow/cron/schedule"
 	"github.com/simster7/argo/v2/workflow/events"
 	"github.com/simster7/argo/v2/workflow/metrics"
 	"github.com/simster7/argo/v2/workflow/util"
@@ -52,6 +53,8 @@ type Controller struct {
 const (
 	cronWorkflowResyncPeriod = 20 * time.Minute
 	cronWorkflowWorkers      = 8
+	// nextScheduledTimesToLog is the number of upcoming runs that are logged when a CronWorkflow is scheduled
+	nextScheduledTimesToLog = 5
 )
 
 func NewCronController(wfclientset versioned.Interface, dynamicInterface dynamic.Interface, namespace string, managedNamespace string, instanceId string, metrics *metrics.Metrics, eventRecorderManager events.EventRecorderManager) *Controller {
@@ -166,20 +169,15 @@ func (cc *Controller) processNextCronItem(ctx context.Context) bool {
 	// The job is currently scheduled, remove it and re add it.
 	cc.cron.Delete(key.(string))
 
-	cronSchedule := cronWf.Spec.Schedule
-	if cronWf.Spec.Timezone != "" {
-		cronSchedule = "CRON_TZ=" + cronWf.Spec.Timezone + " " + cronSchedule
-	}
-
-	lastScheduledTimeFunc, err := cc.cron.AddJob(key.(string), cronSchedule, cronWorkflowOperationCtx)
+	cronSchedule, err := schedule.Parse(&cronWf.Spec)
 	if err != nil {
 		logCtx.WithError(err).Error("could not schedule CronWorkflow")
 		return true
 	}
 
-	cronWorkflowOperationCtx.scheduledTimeFunc = lastScheduledTimeFunc
+	cronWorkflowOperationCtx.scheduledTimeFunc = cc.cron.AddJob(key.(string), cronSchedule, cronWorkflowOperationCtx)
 
-	logCtx.Infof("CronWorkflow %s added", key.(string))
+	logCtx.WithField("nextScheduledTimes", cronSchedule.NextN(time.Now(), nextScheduledTimesToLog)).Infof("CronWorkflow %s added", key.(string))
 
 	return true
 }
@@ -252,6 +250,10 @@ func (cc *Controller) syncCronWorkflow(ctx context.Context, cronWf *v1alpha1.Cro
 	if err != nil {
 		return err
 	}
+	err = cwoc.resumePendingBackfills(ctx, workflows)
+	if err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -21,6 +21,7 @@ import (
 	typed "github.com/simster7/argo/v2/pkg/client/clientset/versioned/typed/workflow/v1alpha1"
 	argoerr "github.com/simster7/argo/v2/util/errors"
 	"github.com/simster7/argo/v2/workflow/common"
+	"github.com/simster7/argo/v2/workflow/cron/schedule"
 	"github.com/simster7/argo/v2/workflow/metrics"
 	"github.com/simster7/argo/v2/workflow/templateresolution"
 	"github.com/simster7/argo/v2/workflow/util"
@@ -215,28 +216,11 @@ func (woc *cronWfOperationCtx) runOutstandingWorkflows(ctx context.Context) (boo
 func (woc *cronWfOperationCtx) shouldOutstandingWorkflowsBeRun() (time.Time, error) {
 	// If this CronWorkflow has been run before, check if we have missed any scheduled executions
 	if woc.cronWf.Status.LastScheduledTime != nil {
-		var now time.Time
-		var cronSchedule cron.Schedule
-		if woc.cronWf.Spec.Timezone != "" {
-			loc, err := time.LoadLocation(woc.cronWf.Spec.Timezone)
-			if err != nil {
-				return time.Time{}, fmt.Errorf("invalid timezone '%s': %s", woc.cronWf.Spec.Timezone, err)
-			}
-			now = time.Now().In(loc)
-
-			cronScheduleString := "CRON_TZ=" + woc.cronWf.Spec.Timezone + " " + woc.cronWf.Spec.Schedule
-			cronSchedule, err = cron.ParseStandard(cronScheduleString)
-			if err != nil {
-				return time.Time{}, fmt.Errorf("unable to form timezone schedule '%s': %s", cronScheduleString, err)
-			}
-		} else {
-			var err error
-			now = time.Now()
-			cronSchedule, err = cron.ParseStandard(woc.cronWf.Spec.Schedule)
-			if err != nil {
-				return time.Time{}, err
-			}
+		cronSchedule, loc, err := getCronSchedule(woc.cronWf)
+		if err != nil {
+			return time.Time{}, err
 		}
+		now := time.Now().In(loc)
 
 		var missedExecutionTime time.Time
 		nextScheduledRunTime := cronSchedule.Next(woc.cronWf.Status.LastScheduledTime.Time)
@@ -376,6 +360,15 @@ func inferScheduledTime() time.Time {
 	return scheduledTime
 }
 
+// getCronSchedule returns the schedule of the cron workflow, and the location of its timezone
+func getCronSchedule(cronWf *v1alpha1.CronWorkflow) (cron.Schedule, *time.Location, error) {
+	cronSchedule, err := schedule.Parse(&cronWf.Spec)
+	if err != nil {
+		return nil, nil, err
+	}
+	return cronSchedule, cronSchedule.Location(), nil
+}
+
 func getChildWorkflowName(cronWorkflowName string, scheduledRuntime time.Time) string {
 	return fmt.Sprintf("%s-%d", cronWorkflowName, scheduledRuntime.Unix())
 }
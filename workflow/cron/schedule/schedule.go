@@ -0,0 +1,217 @@
+package schedule
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+
+	"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1"
+)
+
+// maxExcluded is the number of consecutive excluded times after which we give up looking for the next time, so that a
+// schedule that is excluded forever does not loop forever
+const maxExcluded = 1000
+
+const dateLayout = "2006-01-02"
+
+// Schedule is the schedule of a CronWorkflow: the union of its schedules, less the times in its exclusion windows. It
+// implements cron.Schedule, and its Next returns the zero time if there is no next time.
+type Schedule struct {
+	schedules []cron.Schedule
+	windows   []window
+	// loc is the location the times are returned in, or nil to return them in the location of the given time
+	loc *time.Location
+}
+
+type window struct {
+	start    cron.Schedule
+	duration time.Duration
+	dates    map[string]bool
+	loc      *time.Location
+}
+
+var _ cron.Schedule = &Schedule{}
+
+// Parse returns the schedule of the CronWorkflow. Schedules without a timezone are calculated in the location of the
+// time they are given.
+func Parse(spec *v1alpha1.CronWorkflowSpec) (*Schedule, error) {
+	s := &Schedule{}
+	if spec.Timezone != "" {
+		loc, err := loadLocation(spec.Timezone)
+		if err != nil {
+			return nil, err
+		}
+		s.loc = loc
+	}
+	schedules := spec.GetSchedules()
+	if len(schedules) == 0 {
+		return nil, fmt.Errorf("cron schedule is malformed: no schedule was given")
+	}
+	for _, schedule := range schedules {
+		cronSchedule, err := parseCron(schedule.Schedule, schedule.Timezone)
+		if err != nil {
+			return nil, err
+		}
+		s.schedules = append(s.schedules, cronSchedule)
+	}
+	for i, exclusionWindow := range spec.ExclusionWindows {
+		w, err := parseWindow(exclusionWindow, spec.Timezone)
+		if err != nil {
+			return nil, fmt.Errorf("exclusionWindows[%d] %w", i, err)
+		}
+		s.windows = append(s.windows, w)
+	}
+	return s, nil
+}
+
+func loadLocation(timezone string) (*time.Location, error) {
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone '%s': %s", timezone, err)
+	}
+	return loc, nil
+}
+
+func parseCron(schedule, timezone string) (cron.Schedule, error) {
+	if timezone == "" {
+		cronSchedule, err := cron.ParseStandard(schedule)
+		if err != nil {
+			return nil, fmt.Errorf("cron schedule is malformed: %s", err)
+		}
+		return cronSchedule, nil
+	}
+	if _, err := loadLocation(timezone); err != nil {
+		return nil, err
+	}
+	cronSchedule, err := cron.ParseStandard("CRON_TZ=" + timezone + " " + schedule)
+	if err != nil {
+		return nil, fmt.Errorf("cron schedule is malformed: %s", err)
+	}
+	return cronSchedule, nil
+}
+
+func parseWindow(exclusionWindow v1alpha1.CronExclusionWindow, defaultTimezone string) (window, error) {
+	timezone := exclusionWindow.Timezone
+	if timezone == "" {
+		timezone = defaultTimezone
+	}
+	w := window{dates: make(map[string]bool)}
+	if timezone != "" {
+		loc, err := loadLocation(timezone)
+		if err != nil {
+			return w, err
+		}
+		w.loc = loc
+	}
+	if exclusionWindow.Start == "" && len(exclusionWindow.Dates) == 0 {
+		return w, fmt.Errorf("must have a start or dates")
+	}
+	if (exclusionWindow.Start == "") != (exclusionWindow.Duration == "") {
+		return w, fmt.Errorf("must have both a start and a duration, or neither")
+	}
+	if exclusionWindow.Start != "" {
+		start, err := parseCron(exclusionWindow.Start, timezone)
+		if err != nil {
+			return w, fmt.Errorf("start %w", err)
+		}
+		duration, err := time.ParseDuration(exclusionWindow.Duration)
+		if err != nil {
+			return w, fmt.Errorf("duration is malformed: %s", err)
+		}
+		if duration <= 0 {
+			return w, fmt.Errorf("duration must be positive")
+		}
+		w.start = start
+		w.duration = duration
+	}
+	for _, date := range exclusionWindow.Dates {
+		if _, err := time.Parse(dateLayout, date); err != nil {
+			return w, fmt.Errorf("date '%s' is malformed, it must be in the format YYYY-MM-DD", date)
+		}
+		w.dates[date] = true
+	}
+	return w, nil
+}
+
+// Next returns the first time after the given one that is in any of the schedules, and is not excluded
+func (s *Schedule) Next(t time.Time) time.Time {
+	origLoc := t.Location()
+	loc := s.loc
+	if loc == nil {
+		loc = origLoc
+	}
+	for i := 0; i < maxExcluded; i++ {
+		var next time.Time
+		for _, schedule := range s.schedules {
+			scheduled := schedule.Next(t)
+			if !scheduled.IsZero() && (next.IsZero() || scheduled.Before(next)) {
+				next = scheduled
+			}
+		}
+		if next.IsZero() {
+			return next
+		}
+		excludedUntil, excluded := s.excludedUntil(next, origLoc)
+		if !excluded {
+			return next.In(loc)
+		}
+		// skip past the window, rather than past each of the times in it
+		t = excludedUntil.Add(-time.Nanosecond).In(origLoc)
+		if !t.After(next) {
+			t = next.In(origLoc)
+		}
+	}
+	return time.Time{}
+}
+
+// NextN returns the next n times after the given one
+func (s *Schedule) NextN(t time.Time, n int) []time.Time {
+	var times []time.Time
+	for len(times) < n {
+		t = s.Next(t)
+		if t.IsZero() {
+			break
+		}
+		times = append(times, t)
+	}
+	return times
+}
+
+// Location returns the location of the CronWorkflow's timezone, or the local time if it has none
+func (s *Schedule) Location() *time.Location {
+	if s.loc == nil {
+		return time.Local
+	}
+	return s.loc
+}
+
+// excludedUntil returns whether the time is in any of the exclusion windows, and if so the end of the window. Windows
+// without a timezone are calculated in the default location.
+func (s *Schedule) excludedUntil(t time.Time, defaultLoc *time.Location) (time.Time, bool) {
+	var until time.Time
+	for _, w := range s.windows {
+		loc := w.loc
+		if loc == nil {
+			loc = defaultLoc
+		}
+		local := t.In(loc)
+		if w.dates[local.Format(dateLayout)] {
+			end := time.Date(local.Year(), local.Month(), local.Day()+1, 0, 0, 0, 0, loc)
+			if end.After(until) {
+				until = end
+			}
+		}
+		if w.start != nil {
+			// the earliest start of an occurrence of the window that is still open at the time, if there is one
+			start := w.start.Next(t.Add(-w.duration).In(loc))
+			if !start.IsZero() && !start.After(t) {
+				end := start.Add(w.duration)
+				if end.After(until) {
+					until = end
+				}
+			}
+		}
+	}
+	return until, !until.IsZero()
+}
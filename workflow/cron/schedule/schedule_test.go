@@ -0,0 +1,107 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1"
+)
+
+func TestParse(t *testing.T) {
+	for name, tt := range map[string]struct {
+		spec v1alpha1.CronWorkflowSpec
+		err  string
+	}{
+		"Schedule":        {spec: v1alpha1.CronWorkflowSpec{Schedule: "* * * * *"}},
+		"Schedules":       {spec: v1alpha1.CronWorkflowSpec{Schedules: []v1alpha1.CronSchedule{{Schedule: "* * * * *", Timezone: "Asia/Tokyo"}}}},
+		"NoSchedule":      {err: "cron schedule is malformed: no schedule was given"},
+		"BadSchedule":     {spec: v1alpha1.CronWorkflowSpec{Schedule: "* * * *"}, err: "cron schedule is malformed: expected exactly 5 fields, found 4: [* * * *]"},
+		"BadTimezone":     {spec: v1alpha1.CronWorkflowSpec{Schedules: []v1alpha1.CronSchedule{{Schedule: "* * * * *", Timezone: "Nowhere/Land"}}}, err: "invalid timezone 'Nowhere/Land': unknown time zone Nowhere/Land"},
+		"EmptyWindow":     {spec: v1alpha1.CronWorkflowSpec{Schedule: "* * * * *", ExclusionWindows: []v1alpha1.CronExclusionWindow{{}}}, err: "exclusionWindows[0] must have a start or dates"},
+		"NoDuration":      {spec: v1alpha1.CronWorkflowSpec{Schedule: "* * * * *", ExclusionWindows: []v1alpha1.CronExclusionWindow{{Start: "0 18 * * 5"}}}, err: "exclusionWindows[0] must have both a start and a duration, or neither"},
+		"BadDuration":     {spec: v1alpha1.CronWorkflowSpec{Schedule: "* * * * *", ExclusionWindows: []v1alpha1.CronExclusionWindow{{Start: "0 18 * * 5", Duration: "-1h"}}}, err: "exclusionWindows[0] duration must be positive"},
+		"BadDate":         {spec: v1alpha1.CronWorkflowSpec{Schedule: "* * * * *", ExclusionWindows: []v1alpha1.CronExclusionWindow{{Dates: []string{"25/12/2020"}}}}, err: "exclusionWindows[0] date '25/12/2020' is malformed, it must be in the format YYYY-MM-DD"},
+		"BadWindowStart":  {spec: v1alpha1.CronWorkflowSpec{Schedule: "* * * * *", ExclusionWindows: []v1alpha1.CronExclusionWindow{{Start: "bad", Duration: "1h"}}}, err: "exclusionWindows[0] start cron schedule is malformed: expected exactly 5 fields, found 1: [bad]"},
+		"WindowAndDates":  {spec: v1alpha1.CronWorkflowSpec{Schedule: "* * * * *", ExclusionWindows: []v1alpha1.CronExclusionWindow{{Start: "0 18 * * 5", Duration: "60h", Dates: []string{"2020-12-25"}}}}},
+		"DefaultTimezone": {spec: v1alpha1.CronWorkflowSpec{Schedule: "* * * * *", Timezone: "Asia/Tokyo", ExclusionWindows: []v1alpha1.CronExclusionWindow{{Dates: []string{"2020-12-25"}}}}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := Parse(&tt.spec)
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestSchedule_Next(t *testing.T) {
+	// Thursday
+	start := time.Date(2020, 12, 24, 0, 0, 0, 0, time.UTC)
+	t.Run("Schedules", func(t *testing.T) {
+		s, err := Parse(&v1alpha1.CronWorkflowSpec{
+			Schedule:  "0 12 * * *",
+			Timezone:  "UTC",
+			Schedules: []v1alpha1.CronSchedule{{Schedule: "0 9 * * *", Timezone: "Asia/Tokyo"}},
+		})
+		if assert.NoError(t, err) {
+			// 09:00 in Tokyo is 00:00 in UTC, and times are returned in the CronWorkflow's timezone
+			assert.Equal(t, []time.Time{
+				time.Date(2020, 12, 24, 12, 0, 0, 0, time.UTC),
+				time.Date(2020, 12, 25, 0, 0, 0, 0, time.UTC),
+				time.Date(2020, 12, 25, 12, 0, 0, 0, time.UTC),
+			}, s.NextN(start, 3))
+		}
+	})
+	t.Run("ExclusionWindow", func(t *testing.T) {
+		s, err := Parse(&v1alpha1.CronWorkflowSpec{
+			Schedule:         "0 * * * *",
+			Timezone:         "UTC",
+			ExclusionWindows: []v1alpha1.CronExclusionWindow{{Start: "0 18 * * 5", Duration: "60h"}},
+		})
+		if assert.NoError(t, err) {
+			assert.Equal(t, time.Date(2020, 12, 25, 17, 0, 0, 0, time.UTC), s.Next(time.Date(2020, 12, 25, 16, 30, 0, 0, time.UTC)))
+			// the change freeze is from Friday 18:00 to Monday 06:00
+			assert.Equal(t, time.Date(2020, 12, 28, 6, 0, 0, 0, time.UTC), s.Next(time.Date(2020, 12, 25, 17, 30, 0, 0, time.UTC)))
+			assert.Equal(t, time.Date(2020, 12, 28, 6, 0, 0, 0, time.UTC), s.Next(time.Date(2020, 12, 27, 0, 0, 0, 0, time.UTC)))
+		}
+	})
+	t.Run("Dates", func(t *testing.T) {
+		s, err := Parse(&v1alpha1.CronWorkflowSpec{
+			Schedule:         "0 9 * * *",
+			Timezone:         "UTC",
+			ExclusionWindows: []v1alpha1.CronExclusionWindow{{Dates: []string{"2020-12-25", "2020-12-26"}}},
+		})
+		if assert.NoError(t, err) {
+			assert.Equal(t, []time.Time{
+				time.Date(2020, 12, 24, 9, 0, 0, 0, time.UTC),
+				time.Date(2020, 12, 27, 9, 0, 0, 0, time.UTC),
+			}, s.NextN(start, 2))
+		}
+	})
+	t.Run("DatesInTimezone", func(t *testing.T) {
+		s, err := Parse(&v1alpha1.CronWorkflowSpec{
+			Schedule:         "0 20 * * *",
+			Timezone:         "UTC",
+			ExclusionWindows: []v1alpha1.CronExclusionWindow{{Dates: []string{"2020-12-25"}, Timezone: "Asia/Tokyo"}},
+		})
+		if assert.NoError(t, err) {
+			// 2020-12-24T20:00:00Z is 2020-12-25 in Tokyo
+			assert.Equal(t, time.Date(2020, 12, 25, 20, 0, 0, 0, time.UTC), s.Next(start))
+		}
+	})
+	t.Run("AlwaysExcluded", func(t *testing.T) {
+		s, err := Parse(&v1alpha1.CronWorkflowSpec{
+			Schedule:         "0 9 * * *",
+			Timezone:         "UTC",
+			ExclusionWindows: []v1alpha1.CronExclusionWindow{{Start: "0 0 * * *", Duration: "24h"}},
+		})
+		if assert.NoError(t, err) {
+			assert.True(t, s.Next(start).IsZero())
+			assert.Empty(t, s.NextN(start, 3))
+		}
+	})
+}
@@ -0,0 +1,274 @@
+package emissary
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/simster7/argo/v2/errors"
+	"github.com/simster7/argo/v2/util/archive"
+	"github.com/simster7/argo/v2/workflow/common"
+	execcommon "github.com/simster7/argo/v2/workflow/executor/common"
+	"github.com/simster7/argo/v2/workflow/executor/common/wait"
+)
+
+// The emissary executor does not need access to the container runtime, the kubelet, or the main container's processes.
+// Instead, `argoexec run`, which the init container copies into the shared /var/run/argo volume, runs the command of
+// each container. It records the container's output and exit code in that volume, copies the outputs in the base image
+// layer into it, and signals the command when a signal is written to it.
+
+// ExitCodePath is the path of the file the exit code of a container is recorded in
+func ExitCodePath(containerName string) string {
+	return filepath.Join(ctrDir(containerName), "exitcode")
+}
+
+// WriteExitCode records the exit code of the container, for the containers that depend on it and for the wait container
+func WriteExitCode(containerName string, exitCode int) error {
+	path := ExitCodePath(containerName)
+	if err := os.MkdirAll(filepath.Dir(path), 0777); err != nil {
+		return err
+	}
+	// write then rename, so that a dependent container never reads a partially written exit code
+	tmp := path + ".tmp"
+	if err := ioutil.WriteFile(tmp, []byte(strconv.Itoa(exitCode)), 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// StdoutPath is the path of the file the standard output of a container is recorded in
+func StdoutPath(containerName string) string {
+	return filepath.Join(ctrDir(containerName), "stdout")
+}
+
+// CombinedPath is the path of the file the standard output and error of a container are recorded in
+func CombinedPath(containerName string) string {
+	return filepath.Join(ctrDir(containerName), "combined")
+}
+
+// SignalPath is the path of the file a signal to send to a container's command is written to
+func SignalPath(containerName string) string {
+	return filepath.Join(ctrDir(containerName), "signal")
+}
+
+// OutputPath is the path that an output in the base image layer is copied to
+func OutputPath(path string) string {
+	return filepath.Join(common.ExecutorVarRunArgoDir, "outputs", path)
+}
+
+func ctrDir(containerName string) string {
+	return filepath.Join(common.ExecutorVarRunArgoDir, "ctr", containerName)
+}
+
+// SaveOutput copies the file or directory at the path to its output path, so that the wait container can read it
+func SaveOutput(path string) error {
+	return filepath.Walk(path, func(src string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		dst := OutputPath(src)
+		switch {
+		case info.IsDir():
+			return os.MkdirAll(dst, info.Mode().Perm()|0700)
+		case info.Mode().IsRegular():
+			if err := os.MkdirAll(filepath.Dir(dst), 0777); err != nil {
+				return err
+			}
+			return copyFile(src, dst, info.Mode().Perm())
+		default:
+			// like the other executors' archives, only regular files and directories are supported
+			log.Warnf("Ignoring %s, which is not a regular file or directory", src)
+			return nil
+		}
+	})
+}
+
+func copyFile(src, dst string, perm os.FileMode) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = in.Close() }()
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, perm)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		_ = out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+// WriteSignal writes a signal for `argoexec run` to send to the container's command
+func WriteSignal(containerName string, sig syscall.Signal) error {
+	return ioutil.WriteFile(SignalPath(containerName), []byte(strconv.Itoa(int(sig))), 0644)
+}
+
+// ReadSignal reads, and removes, the signal written for the container, if there is one
+func ReadSignal(containerName string) (syscall.Signal, bool, error) {
+	data, err := ioutil.ReadFile(SignalPath(containerName))
+	if os.IsNotExist(err) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	if err := os.Remove(SignalPath(containerName)); err != nil {
+		return 0, false, err
+	}
+	sig, err := strconv.Atoi(strings.TrimSpace(string(data)))
+	if err != nil {
+		return 0, false, fmt.Errorf("signal '%s' is malformed: %w", data, err)
+	}
+	return syscall.Signal(sig), true, nil
+}
+
+type EmissaryExecutor struct {
+	clientset kubernetes.Interface
+	podName   string
+	namespace string
+	// containerNames maps the IDs of the pod's containers to their names
+	containerNames map[string]string
+	mu             sync.Mutex
+}
+
+func NewEmissaryExecutor(clientset kubernetes.Interface, podName, namespace string) (*EmissaryExecutor, error) {
+	log.Infof("Creating an emissary executor")
+	return &EmissaryExecutor{
+		clientset:      clientset,
+		podName:        podName,
+		namespace:      namespace,
+		containerNames: map[string]string{},
+	}, nil
+}
+
+func (e *EmissaryExecutor) GetFileContents(containerID string, sourcePath string) (string, error) {
+	data, err := ioutil.ReadFile(OutputPath(sourcePath))
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+func (e *EmissaryExecutor) CopyFile(containerID string, sourcePath string, destPath string, compressionLevel int) (err error) {
+	log.Infof("Archiving %s to %s", sourcePath, destPath)
+	f, err := os.Create(destPath)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		closeErr := f.Close()
+		if err == nil && closeErr != nil {
+			err = errors.InternalWrapError(closeErr)
+		}
+	}()
+	return archive.TarGzToWriter(OutputPath(sourcePath), compressionLevel, bufio.NewWriter(f))
+}
+
+func (e *EmissaryExecutor) GetOutputStream(ctx context.Context, containerID string, combinedOutput bool) (io.ReadCloser, error) {
+	containerName, err := e.getContainerName(ctx, containerID)
+	if err != nil {
+		return nil, err
+	}
+	if combinedOutput {
+		return os.Open(CombinedPath(containerName))
+	}
+	return os.Open(StdoutPath(containerName))
+}
+
+func (e *EmissaryExecutor) GetExitCode(ctx context.Context, containerID string) (string, error) {
+	containerName, err := e.getContainerName(ctx, containerID)
+	if err != nil {
+		return "", err
+	}
+	data, err := ioutil.ReadFile(ExitCodePath(containerName))
+	if os.IsNotExist(err) {
+		// the container was killed before it could record its exit code
+		return "", nil
+	}
+	if err != nil {
+		return "", errors.InternalWrapError(err, "Could not read exit code")
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+func (e *EmissaryExecutor) WaitInit() error {
+	return nil
+}
+
+// Wait for the container to complete
+func (e *EmissaryExecutor) Wait(ctx context.Context, containerID string) error {
+	return wait.UntilTerminated(ctx, e.clientset, e.namespace, e.podName, containerID)
+}
+
+// Kill kills a list of containerIDs first with a SIGTERM then with a SIGKILL after a grace period
+func (e *EmissaryExecutor) Kill(ctx context.Context, containerIDs []string) error {
+	log.Infof("Killing containers %s", containerIDs)
+	for _, containerID := range containerIDs {
+		err := e.killGracefully(ctx, containerID)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *EmissaryExecutor) killGracefully(ctx context.Context, containerID string) error {
+	containerName, err := e.getContainerName(ctx, containerID)
+	if err != nil {
+		return err
+	}
+	for _, sig := range []syscall.Signal{syscall.SIGTERM, syscall.SIGKILL} {
+		log.Infof("%s container %s (%s)", sig, containerName, containerID)
+		if err := WriteSignal(containerName, sig); err != nil {
+			return errors.InternalWrapError(err)
+		}
+		err := e.waitFor(ctx, containerID, time.Second*execcommon.KillGracePeriod)
+		if err == nil {
+			log.Infof("Container %s successfully killed", containerName)
+			return nil
+		}
+	}
+	return fmt.Errorf("container %s was not killed within %ds of SIGKILL", containerName, execcommon.KillGracePeriod)
+}
+
+func (e *EmissaryExecutor) waitFor(ctx context.Context, containerID string, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	return wait.UntilTerminated(ctx, e.clientset, e.namespace, e.podName, containerID)
+}
+
+// getContainerName returns the name of the container with the ID, as the files of a container are named after it
+func (e *EmissaryExecutor) getContainerName(ctx context.Context, containerID string) (string, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if name, ok := e.containerNames[containerID]; ok {
+		return name, nil
+	}
+	pod, err := e.clientset.CoreV1().Pods(e.namespace).Get(ctx, e.podName, metav1.GetOptions{})
+	if err != nil {
+		return "", errors.InternalWrapError(err)
+	}
+	for _, s := range pod.Status.ContainerStatuses {
+		e.containerNames[execcommon.GetContainerID(&s)] = s.Name
+	}
+	name, ok := e.containerNames[containerID]
+	if !ok {
+		return "", errors.InternalErrorf("container %s not found in pod %s", containerID, e.podName)
+	}
+	return name, nil
+}
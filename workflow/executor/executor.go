@@ -34,8 +34,10 @@ import (
 	"github.com/simster7/argo/v2/util"
 	"github.com/simster7/argo/v2/util/archive"
 	"github.com/simster7/argo/v2/util/retry"
+	"github.com/simster7/argo/v2/util/slice"
 	artifact "github.com/simster7/argo/v2/workflow/artifacts"
 	"github.com/simster7/argo/v2/workflow/common"
+	"github.com/simster7/argo/v2/workflow/executor/emissary"
 	os_specific "github.com/simster7/argo/v2/workflow/executor/os-specific"
 )
 
@@ -56,6 +58,9 @@ var ExecutorRetry = wait.Backoff{
 const (
 	// This directory temporarily stores the tarballs of the artifacts before uploading
 	tempOutArtDir = "/tmp/argo/outputs/artifacts"
+	// containerSetExitCodesPeriod is how often the containers of a container set are checked for terminating without
+	// recording their exit codes
+	containerSetExitCodesPeriod = 5 * time.Second
 )
 
 // WorkflowExecutor is program which runs as the init/wait container
@@ -70,6 +75,8 @@ type WorkflowExecutor struct {
 
 	// memoized container ID to prevent multiple lookups
 	mainContainerID string
+	// memoized IDs of the containers that run the work of the template, which are several for a container set
+	mainContainerIDs []string
 	// memoized configmaps
 	memoizedConfigMaps map[string]string
 	// memoized secrets
@@ -241,7 +248,8 @@ func (we *WorkflowExecutor) StageFiles() error {
 	case wfv1.TemplateTypeScript:
 		log.Infof("Loading script source to %s", common.ExecutorScriptSourcePath)
 		filePath = common.ExecutorScriptSourcePath
-		body = []byte(we.Template.Script.Source)
+		// the values of sensitive parameters are in the environment, rather than the source
+		body = []byte(common.ExpandSensitiveParameters(we.Template.Script.Source, os.LookupEnv))
 	case wfv1.TemplateTypeResource:
 		log.Infof("Loading manifest to %s", common.ExecutorResourceManifestPath)
 		filePath = common.ExecutorResourceManifestPath
@@ -256,6 +264,37 @@ func (we *WorkflowExecutor) StageFiles() error {
 	return nil
 }
 
+// InstallExecutable copies argoexec into the volume shared with the containers of a container set, or with all the
+// containers when using the emissary executor, which run their commands through it
+func (we *WorkflowExecutor) InstallExecutable() error {
+	if we.Template.ContainerSet == nil && os.Getenv(common.EnvVarContainerRuntimeExecutor) != common.ContainerRuntimeExecutorEmissary {
+		return nil
+	}
+	src, err := os.Executable()
+	if err != nil {
+		return errors.InternalWrapError(err)
+	}
+	dst := filepath.Join(common.ExecutorVarRunArgoDir, "argoexec")
+	log.Infof("Copying %s to %s", src, dst)
+	in, err := os.Open(src)
+	if err != nil {
+		return errors.InternalWrapError(err)
+	}
+	defer func() { _ = in.Close() }()
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0755)
+	if err != nil {
+		return errors.InternalWrapError(err)
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		_ = out.Close()
+		return errors.InternalWrapError(err)
+	}
+	if err := out.Close(); err != nil {
+		return errors.InternalWrapError(err)
+	}
+	return nil
+}
+
 // SaveArtifacts uploads artifacts to the archive location
 func (we *WorkflowExecutor) SaveArtifacts(ctx context.Context) error {
 	if len(we.Template.Outputs.Artifacts) == 0 {
@@ -327,6 +366,10 @@ func (we *WorkflowExecutor) saveArtifact(ctx context.Context, mainCtrID string,
 			shallowCopy := *we.Template.ArchiveLocation.GCS
 			art.GCS = &shallowCopy
 			art.GCS.Key = path.Join(art.GCS.Key, fileName)
+		} else if we.Template.ArchiveLocation.Azure != nil {
+			shallowCopy := *we.Template.ArchiveLocation.Azure
+			art.Azure = &shallowCopy
+			art.Azure.Blob = path.Join(art.Azure.Blob, fileName)
 		} else {
 			return errors.Errorf(errors.CodeBadRequest, "Unable to determine path to store %s. Archive location provided no information", art.Name)
 		}
@@ -460,12 +503,17 @@ func (we *WorkflowExecutor) stageArchiveFile(mainCtrID string, art *wfv1.Artifac
 // isBaseImagePath checks if the given artifact path resides in the base image layer of the container
 // versus a shared volume mount between the wait and main container
 func (we *WorkflowExecutor) isBaseImagePath(path string) bool {
+	return IsBaseImagePath(&we.Template, path)
+}
+
+// IsBaseImagePath checks if the given path of the template resides in the base image layer of the main container
+func IsBaseImagePath(tmpl *wfv1.Template, path string) bool {
 	// first check if path overlaps with a user-specified volumeMount
-	if common.FindOverlappingVolume(&we.Template, path) != nil {
+	if common.FindOverlappingVolume(tmpl, path) != nil {
 		return false
 	}
 	// next check if path overlaps with a shared input-artifact emptyDir mounted by argo
-	for _, inArt := range we.Template.Inputs.Artifacts {
+	for _, inArt := range tmpl.Inputs.Artifacts {
 		if path == inArt.Path {
 			// The input artifact may have been optional and not supplied. If this is the case, the file won't exist on
 			// the input artifact volume. Since this function was called, we know that we want to use this path as an
@@ -550,6 +598,10 @@ func (we *WorkflowExecutor) SaveLogs(ctx context.Context) (*wfv1.Artifact, error
 	if we.Template.ArchiveLocation == nil || we.Template.ArchiveLocation.ArchiveLogs == nil || !*we.Template.ArchiveLocation.ArchiveLogs {
 		return nil, nil
 	}
+	if !we.hasMainContainer() {
+		log.Infof("No main container. Saving logs ignored")
+		return nil, nil
+	}
 	log.Infof("Saving logs")
 	mainCtrID, err := we.GetMainContainerID(ctx)
 	if err != nil {
@@ -595,6 +647,10 @@ func (we *WorkflowExecutor) SaveLogs(ctx context.Context) (*wfv1.Artifact, error
 		shallowCopy := *we.Template.ArchiveLocation.OSS
 		art.OSS = &shallowCopy
 		art.OSS.Key = path.Join(art.OSS.Key, fileName)
+	} else if we.Template.ArchiveLocation.Azure != nil {
+		shallowCopy := *we.Template.ArchiveLocation.Azure
+		art.Azure = &shallowCopy
+		art.Azure.Blob = path.Join(art.Azure.Blob, fileName)
 	} else {
 		return nil, errors.Errorf(errors.CodeBadRequest, "Unable to determine path to store %s. Archive location provided no information", art.Name)
 	}
@@ -745,6 +801,12 @@ func (we *WorkflowExecutor) GetMainContainerStatus(ctx context.Context) (*apiv1.
 	return nil, nil
 }
 
+// hasMainContainer returns whether the pod has a main container, that outputs are collected from. A container set
+// only has one if one of its containers is named main.
+func (we *WorkflowExecutor) hasMainContainer() bool {
+	return we.Template.ContainerSet == nil || we.Template.ContainerSet.HasContainerNamed(common.MainContainerName)
+}
+
 // GetMainContainerID returns the container id of the main container
 func (we *WorkflowExecutor) GetMainContainerID(ctx context.Context) (string, error) {
 	if we.mainContainerID != "" {
@@ -768,8 +830,12 @@ func (we *WorkflowExecutor) CaptureScriptResult(ctx context.Context) error {
 		log.Infof("No Script output reference in workflow. Capturing script output ignored")
 		return nil
 	}
-	if we.Template.Script == nil && we.Template.Container == nil {
-		log.Infof("Template type is neither of Script or Container. Capturing script output ignored")
+	if we.Template.Script == nil && we.Template.Container == nil && we.Template.ContainerSet == nil {
+		log.Infof("Template type is neither of Script, Container or ContainerSet. Capturing script output ignored")
+		return nil
+	}
+	if !we.hasMainContainer() {
+		log.Infof("No main container. Capturing script output ignored")
 		return nil
 	}
 	log.Infof("Capturing script output")
@@ -806,8 +872,12 @@ func (we *WorkflowExecutor) CaptureScriptResult(ctx context.Context) error {
 
 // CaptureScriptExitCode will add the exit code of a script template as output exit code
 func (we *WorkflowExecutor) CaptureScriptExitCode(ctx context.Context) error {
-	if we.Template.Script == nil && we.Template.Container == nil {
-		log.Infof("Template type is neither of Script or Container. Capturing exit code ignored")
+	if we.Template.Script == nil && we.Template.Container == nil && we.Template.ContainerSet == nil {
+		log.Infof("Template type is neither of Script, Container or ContainerSet. Capturing exit code ignored")
+		return nil
+	}
+	if !we.hasMainContainer() {
+		log.Infof("No main container. Capturing exit code ignored")
 		return nil
 	}
 	log.Infof("Capturing script exit code")
@@ -1036,34 +1106,41 @@ func (we *WorkflowExecutor) Wait(ctx context.Context) error {
 		return err
 	}
 	log.Infof("Waiting on main container")
-	mainContainerID, err := we.waitMainContainerStart(ctx)
+	mainContainerIDs, err := we.waitMainContainerStart(ctx)
 	if err != nil {
 		return err
 	}
-	log.Infof("main container started with container ID: %s", mainContainerID)
+	log.Infof("main container started with container ID: %s", strings.Join(mainContainerIDs, ", "))
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
 	annotationUpdatesCh := we.monitorAnnotations(ctx)
 	go we.monitorDeadline(ctx, annotationUpdatesCh)
+	if we.Template.ContainerSet != nil {
+		go we.monitorContainerSetExitCodes(ctx)
+	}
 
-	_ = wait.ExponentialBackoff(ExecutorRetry, func() (bool, error) {
-		err = we.RuntimeExecutor.Wait(ctx, mainContainerID)
+	for _, mainContainerID := range mainContainerIDs {
+		_ = wait.ExponentialBackoff(ExecutorRetry, func() (bool, error) {
+			err = we.RuntimeExecutor.Wait(ctx, mainContainerID)
+			if err != nil {
+				log.Warnf("Failed to wait for container id '%s': %v", mainContainerID, err)
+				return false, err
+			}
+			return true, nil
+		})
 		if err != nil {
-			log.Warnf("Failed to wait for container id '%s': %v", mainContainerID, err)
-			return false, err
+			return err
 		}
-		return true, nil
-	})
-	if err != nil {
-		return err
 	}
 	log.Infof("Main container completed")
 	return nil
 }
 
-// waitMainContainerStart waits for the main container to start and returns its container ID.
-func (we *WorkflowExecutor) waitMainContainerStart(ctx context.Context) (string, error) {
+// waitMainContainerStart waits for the main container, or every container of a container set, to start and returns
+// their container IDs.
+func (we *WorkflowExecutor) waitMainContainerStart(ctx context.Context) ([]string, error) {
+	mainContainerNames := common.GetMainContainerNames(&we.Template)
 	for {
 		podsIf := we.ClientSet.CoreV1().Pods(we.Namespace)
 		fieldSelector := fields.ParseSelectorOrDie(fmt.Sprintf("metadata.name=%s", we.PodName))
@@ -1083,32 +1160,44 @@ func (we *WorkflowExecutor) waitMainContainerStart(ctx context.Context) (string,
 			return true, nil
 		})
 		if err != nil {
-			return "", errors.InternalWrapErrorf(err, "Failed to establish pod watch: %v", err)
+			return nil, errors.InternalWrapErrorf(err, "Failed to establish pod watch: %v", err)
 		}
 		for watchEv := range watchIf.ResultChan() {
 			if watchEv.Type == watch.Error {
-				return "", errors.InternalErrorf("Pod watch error waiting for main to start: %v", watchEv.Object)
+				return nil, errors.InternalErrorf("Pod watch error waiting for main to start: %v", watchEv.Object)
 			}
 			pod, ok := watchEv.Object.(*apiv1.Pod)
 			if !ok {
 				log.Warnf("Pod watch returned non pod object: %v", watchEv.Object)
 				continue
 			}
+			started := make(map[string]string)
 			for _, ctrStatus := range pod.Status.ContainerStatuses {
-				if ctrStatus.Name == common.MainContainerName {
-					log.Debug(ctrStatus)
-					if ctrStatus.State.Waiting != nil {
-						// main container is still in waiting status
-					} else if ctrStatus.State.Waiting == nil && ctrStatus.State.Running == nil && ctrStatus.State.Terminated == nil {
-						// status still not ready, wait
-					} else if ctrStatus.ContainerID != "" {
-						we.mainContainerID = containerID(ctrStatus.ContainerID)
-						return containerID(ctrStatus.ContainerID), nil
-					} else {
-						// main container in running or terminated state but missing container ID
-						return "", errors.InternalError("Main container ID cannot be found")
-					}
+				if !slice.ContainsString(mainContainerNames, ctrStatus.Name) {
+					continue
+				}
+				log.Debug(ctrStatus)
+				if ctrStatus.State.Waiting != nil {
+					// main container is still in waiting status
+				} else if ctrStatus.State.Waiting == nil && ctrStatus.State.Running == nil && ctrStatus.State.Terminated == nil {
+					// status still not ready, wait
+				} else if ctrStatus.ContainerID != "" {
+					started[ctrStatus.Name] = containerID(ctrStatus.ContainerID)
+				} else {
+					// main container in running or terminated state but missing container ID
+					return nil, errors.InternalError("Main container ID cannot be found")
+				}
+			}
+			if len(started) == len(mainContainerNames) {
+				var mainContainerIDs []string
+				for _, name := range mainContainerNames {
+					mainContainerIDs = append(mainContainerIDs, started[name])
+				}
+				if id, ok := started[common.MainContainerName]; ok {
+					we.mainContainerID = id
 				}
+				we.mainContainerIDs = mainContainerIDs
+				return mainContainerIDs, nil
 			}
 		}
 		log.Warnf("Pod watch closed unexpectedly")
@@ -1239,8 +1328,7 @@ func (we *WorkflowExecutor) monitorDeadline(ctx context.Context, annotationsUpda
 					log.Info(message)
 					_ = we.AddAnnotation(ctx, common.AnnotationKeyNodeMessage, message)
 					log.Infof("Killing main container")
-					mainContainerID, _ := we.GetMainContainerID(ctx)
-					err := we.RuntimeExecutor.Kill(ctx, []string{mainContainerID})
+					err := we.RuntimeExecutor.Kill(ctx, we.mainContainerIDs)
 					if err != nil {
 						log.Warnf("Failed to kill main container: %v", err)
 					}
@@ -1252,6 +1340,58 @@ func (we *WorkflowExecutor) monitorDeadline(ctx context.Context, annotationsUpda
 	}
 }
 
+// monitorContainerSetExitCodes records the exit codes of the containers of the container set that terminated without
+// recording them, e.g. because they were OOM killed, so that the containers that depend on them do not wait forever
+func (we *WorkflowExecutor) monitorContainerSetExitCodes(ctx context.Context) {
+	ticker := time.NewTicker(containerSetExitCodesPeriod)
+	defer ticker.Stop()
+	recorded := map[string]bool{}
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		pod, err := we.getPod(ctx)
+		if err != nil {
+			log.Warnf("Failed to get pod to record exit codes: %v", err)
+			continue
+		}
+		for name, exitCode := range terminatedExitCodes(pod, we.Template.ContainerSet.GetContainerNames()) {
+			if recorded[name] {
+				continue
+			}
+			if _, err := os.Stat(emissary.ExitCodePath(name)); !os.IsNotExist(err) {
+				recorded[name] = true
+				continue
+			}
+			log.WithFields(log.Fields{"container": name, "exitCode": exitCode}).Info("Recording exit code of container that terminated without recording it")
+			if err := emissary.WriteExitCode(name, exitCode); err != nil {
+				log.Warnf("Failed to record exit code of container '%s': %v", name, err)
+				continue
+			}
+			recorded[name] = true
+		}
+	}
+}
+
+// terminatedExitCodes returns the exit codes of the named containers of the pod that have terminated. A container that
+// terminated without recording its exit code did not complete, so its exit code is never 0.
+func terminatedExitCodes(pod *apiv1.Pod, names []string) map[string]int {
+	exitCodes := map[string]int{}
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.State.Terminated == nil || !slice.ContainsString(names, status.Name) {
+			continue
+		}
+		exitCode := int(status.State.Terminated.ExitCode)
+		if exitCode == 0 {
+			exitCode = 1
+		}
+		exitCodes[status.Name] = exitCode
+	}
+	return exitCodes
+}
+
 // KillSidecars kills any sidecars to the main container
 func (we *WorkflowExecutor) KillSidecars(ctx context.Context) error {
 	log.Infof("Killing sidecars")
@@ -1260,8 +1400,9 @@ func (we *WorkflowExecutor) KillSidecars(ctx context.Context) error {
 		return err
 	}
 	sidecarIDs := make([]string, 0)
+	mainContainerNames := common.GetMainContainerNames(&we.Template)
 	for _, ctrStatus := range pod.Status.ContainerStatuses {
-		if ctrStatus.Name == common.MainContainerName || ctrStatus.Name == common.WaitContainerName {
+		if slice.ContainsString(mainContainerNames, ctrStatus.Name) || ctrStatus.Name == common.WaitContainerName {
 			continue
 		}
 		if ctrStatus.State.Terminated != nil {
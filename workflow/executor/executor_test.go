@@ -12,6 +12,7 @@ import (
 	"k8s.io/client-go/kubernetes/fake"
 
 	wfv1 "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1"
+	"github.com/simster7/argo/v2/workflow/common"
 	"github.com/simster7/argo/v2/workflow/executor/mocks"
 )
 
@@ -340,3 +341,16 @@ func TestSaveArtifacts(t *testing.T) {
 	err = we.SaveArtifacts(ctx)
 	assert.Error(t, err)
 }
+
+func TestTerminatedExitCodes(t *testing.T) {
+	terminated := func(name string, exitCode int32) corev1.ContainerStatus {
+		return corev1.ContainerStatus{Name: name, State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: exitCode}}}
+	}
+	pod := &corev1.Pod{Status: corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{
+		terminated("a", 137),
+		terminated("b", 0),
+		{Name: "c", State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}},
+		terminated(common.WaitContainerName, 0),
+	}}}
+	assert.Equal(t, map[string]int{"a": 137, "b": 1}, terminatedExitCodes(pod, []string{"a", "b", "c"}))
+}
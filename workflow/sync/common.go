@@ -3,8 +3,10 @@ package sync
 import "time"
 
 type Semaphore interface {
-	acquire(holderKey string) bool
-	tryAcquire(holderKey string) (bool, string)
+	acquire(holderKey string, weight int) bool
+	// checkAcquire returns whether the holder holds, or may acquire, the given units of the lock, without acquiring them
+	checkAcquire(holderKey string, weight int) (bool, string)
+	tryAcquire(holderKey string, weight int) (bool, string)
 	release(key string) bool
 	addToQueue(holderKey string, priority int32, creationTime time.Time)
 	removeFromQueue(holderKey string)
@@ -0,0 +1,182 @@
+package sync
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/simster7/argo/v2/persist/sqldb"
+)
+
+// DatabaseSemaphore is a semaphore whose holders and waiters are stored in the database, so that its limit applies
+// across all the controllers sharing it. Waiters are only notified when a holder on this controller releases the lock,
+// the manager polls for locks released by other controllers.
+type DatabaseSemaphore struct {
+	name         string
+	limit        int
+	repo         sqldb.SyncRepo
+	nextWorkflow NextWorkflow
+	log          *log.Entry
+}
+
+var _ Semaphore = &DatabaseSemaphore{}
+
+func NewDatabaseSemaphore(name string, limit int, nextWorkflow NextWorkflow, lockType string, repo sqldb.SyncRepo) (*DatabaseSemaphore, error) {
+	err := repo.SetLimit(name, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set the limit of %s %s: %w", lockType, name, err)
+	}
+	return &DatabaseSemaphore{
+		name:         name,
+		limit:        limit,
+		repo:         repo,
+		nextWorkflow: nextWorkflow,
+		log: log.WithFields(log.Fields{
+			lockType: name,
+		}),
+	}, nil
+}
+
+func (s *DatabaseSemaphore) getName() string {
+	return s.name
+}
+
+func (s *DatabaseSemaphore) getLimit() int {
+	return s.limit
+}
+
+func (s *DatabaseSemaphore) getCurrentHolders() []string {
+	holders, err := s.repo.GetHolders(s.name)
+	if err != nil {
+		s.log.WithError(err).Error("Failed to get the current holders")
+	}
+	keys := make([]string, 0, len(holders))
+	for key := range holders {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// getHeld returns the number of units held, by the holders of all the controllers
+func (s *DatabaseSemaphore) getHeld() (int, error) {
+	holders, err := s.repo.GetHolders(s.name)
+	if err != nil {
+		return 0, err
+	}
+	held := 0
+	for _, weight := range holders {
+		held += weight
+	}
+	return held, nil
+}
+
+func (s *DatabaseSemaphore) resize(n int) bool {
+	err := s.repo.SetLimit(s.name, n)
+	if err != nil {
+		s.log.WithError(err).Error("Failed to resize semaphore")
+		return false
+	}
+	s.log.Infof("%s semaphore resized from %d to %d", s.name, s.limit, n)
+	s.limit = n
+	return true
+}
+
+func (s *DatabaseSemaphore) release(key string) bool {
+	err := s.repo.Release(s.name, key)
+	if err != nil {
+		s.log.WithError(err).Errorf("Failed to release lock held by %s", key)
+		return false
+	}
+	s.log.Infof("Lock has been released by %s", key)
+	s.notifyWaiters()
+	return true
+}
+
+// notifyWaiters enqueues the workflows waiting on this controller that may now acquire the lock
+func (s *DatabaseSemaphore) notifyWaiters() {
+	held, err := s.getHeld()
+	if err != nil {
+		s.log.WithError(err).Error("Failed to get the current holders")
+		return
+	}
+	available := s.limit - held
+	if available <= 0 {
+		return
+	}
+	waiters, err := s.repo.GetWaiters(s.name)
+	if err != nil {
+		s.log.WithError(err).Error("Failed to get the waiters")
+		return
+	}
+	if len(waiters) > available {
+		waiters = waiters[:available]
+	}
+	for _, key := range waiters {
+		workflowKey := getWorkflowKey(key)
+		s.log.Debugf("Enqueue the workflow %s", workflowKey)
+		s.nextWorkflow(workflowKey)
+	}
+}
+
+func (s *DatabaseSemaphore) addToQueue(holderKey string, priority int32, creationTime time.Time) {
+	err := s.repo.AddToQueue(s.name, holderKey, priority, creationTime)
+	if err != nil {
+		s.log.WithError(err).Errorf("Failed to add %s into queue", holderKey)
+		return
+	}
+	s.log.Debugf("Added into queue: %s", holderKey)
+}
+
+func (s *DatabaseSemaphore) removeFromQueue(holderKey string) {
+	err := s.repo.RemoveFromQueue(s.name, holderKey)
+	if err != nil {
+		s.log.WithError(err).Errorf("Failed to remove %s from queue", holderKey)
+		return
+	}
+	s.log.Debugf("Removed from queue: %s", holderKey)
+}
+
+func (s *DatabaseSemaphore) acquire(holderKey string, weight int) bool {
+	acquired, err := s.repo.Acquire(s.name, holderKey, weight)
+	if err != nil {
+		s.log.WithError(err).Errorf("Failed to acquire lock for %s", holderKey)
+		return false
+	}
+	return acquired
+}
+
+// checkAcquire cannot tell whether the lock is available without acquiring it, as other controllers may acquire it at
+// any time, so the manager releases the database locks it acquired if any of the others are unavailable
+func (s *DatabaseSemaphore) checkAcquire(string, int) (bool, string) {
+	return true, ""
+}
+
+func (s *DatabaseSemaphore) tryAcquire(holderKey string, weight int) (bool, string) {
+	acquired, err := s.repo.TryAcquire(s.name, holderKey, weight)
+	if err != nil {
+		s.log.WithError(err).Errorf("Failed to acquire lock for %s", holderKey)
+		return false, fmt.Sprintf("Waiting for %s lock. Failed to acquire lock: %v", s.name, err)
+	}
+	if acquired {
+		s.log.Infof("%s acquired by %s ", s.name, holderKey)
+		return true, ""
+	}
+	held, err := s.getHeld()
+	if err != nil {
+		s.log.WithError(err).Error("Failed to get the current holders")
+	}
+	return false, fmt.Sprintf("Waiting for %s lock. Lock status: %d/%d ", s.name, s.limit-held, s.limit)
+}
+
+// getWorkflowKey returns the "namespace/name" key of the workflow of the holder
+func getWorkflowKey(holderKey string) string {
+	items := strings.Split(holderKey, "/")
+	if len(items) == 3 {
+		return fmt.Sprintf("%s/%s", items[0], items[1])
+	}
+	return holderKey
+}
@@ -0,0 +1,97 @@
+package sync
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/simster7/argo/v2/persist/sqldb/mocks"
+	wfv1 "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1"
+)
+
+func TestDatabaseSemaphore(t *testing.T) {
+	repo := &mocks.SyncRepo{}
+	repo.On("SetLimit", "my-sem", 2).Return(nil)
+	var enqueued []string
+	s, err := NewDatabaseSemaphore("my-sem", 2, func(key string) { enqueued = append(enqueued, key) }, "semaphore", repo)
+	assert.NoError(t, err)
+
+	t.Run("TryAcquire", func(t *testing.T) {
+		repo.On("TryAcquire", "my-sem", "default/wf-1", 1).Return(true, nil).Once()
+		acquired, msg := s.tryAcquire("default/wf-1", 1)
+		assert.True(t, acquired)
+		assert.Empty(t, msg)
+		repo.On("TryAcquire", "my-sem", "default/wf-2", 1).Return(false, nil).Once()
+		repo.On("GetHolders", "my-sem").Return(map[string]int{"default/wf-1": 1, "other/wf-3": 1}, nil).Once()
+		acquired, msg = s.tryAcquire("default/wf-2", 1)
+		assert.False(t, acquired)
+		assert.Equal(t, "Waiting for my-sem lock. Lock status: 0/2 ", msg)
+	})
+	t.Run("Release", func(t *testing.T) {
+		enqueued = nil
+		repo.On("Release", "my-sem", "default/wf-1").Return(nil).Once()
+		repo.On("GetHolders", "my-sem").Return(map[string]int{"other/wf-3": 1}, nil).Once()
+		repo.On("GetWaiters", "my-sem").Return([]string{"default/wf-2/node-1", "default/wf-4"}, nil).Once()
+		assert.True(t, s.release("default/wf-1"))
+		assert.Equal(t, []string{"default/wf-2"}, enqueued)
+	})
+	t.Run("Resize", func(t *testing.T) {
+		repo.On("SetLimit", "my-sem", 3).Return(nil).Once()
+		assert.True(t, s.resize(3))
+		assert.Equal(t, 3, s.getLimit())
+	})
+	repo.AssertExpectations(t)
+}
+
+func TestDatabaseLockManager(t *testing.T) {
+	repo := &mocks.SyncRepo{}
+	repo.On("IsEnabled").Return(true)
+	repo.On("SetLimit", "default/Mutex/my-mutex", 1).Return(nil)
+	var enqueued []string
+	cm := NewDatabaseLockManager(func(key string) (int, error) { return 2, nil }, func(key string) { enqueued = append(enqueued, key) }, repo)
+
+	mutex, err := cm.initializeMutex("default/Mutex/my-mutex")
+	if assert.NoError(t, err) {
+		assert.IsType(t, &DatabaseSemaphore{}, mutex)
+		assert.Equal(t, 1, mutex.getLimit())
+	}
+	cm.syncLockMap["default/Mutex/my-mutex"] = mutex
+
+	t.Run("Poll", func(t *testing.T) {
+		repo.On("RenewLease", 5*time.Minute).Return(nil).Once()
+		repo.On("ListWaiting").Return([]string{"default/Mutex/my-mutex", "default/Mutex/unknown"}, nil).Once()
+		repo.On("GetHolders", "default/Mutex/my-mutex").Return(map[string]int{}, nil).Once()
+		repo.On("GetWaiters", "default/Mutex/my-mutex").Return([]string{"default/wf-1", "default/wf-2"}, nil).Once()
+		cm.Poll(5 * time.Minute)
+		assert.Equal(t, []string{"default/wf-1"}, enqueued)
+	})
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "GetWaiters", "default/Mutex/unknown")
+}
+
+func TestDatabaseLockManagerInitialize(t *testing.T) {
+	repo := &mocks.SyncRepo{}
+	repo.On("IsEnabled").Return(true)
+	repo.On("SetLimit", "default/Mutex/my-mutex", 1).Return(nil)
+	repo.On("Acquire", "default/Mutex/my-mutex", "default/running", 1).Return(true, nil)
+	// the orphan's workflow completed while the controller was down
+	repo.On("ListHeld").Return(map[string][]string{
+		"default/Mutex/my-mutex":                   {"default/running", "default/orphan"},
+		"default/ConfigMap/my-config/my-semaphore": {"default/deleted"},
+	}, nil)
+	repo.On("Release", "default/Mutex/my-mutex", "default/orphan").Return(nil).Once()
+	repo.On("Release", "default/ConfigMap/my-config/my-semaphore", "default/deleted").Return(nil).Once()
+	cm := NewDatabaseLockManager(func(key string) (int, error) { return 2, nil }, func(key string) {}, repo)
+
+	wf := wfv1.Workflow{}
+	wf.Namespace = "default"
+	wf.Name = "running"
+	wf.Status.Synchronization = &wfv1.SynchronizationStatus{Mutex: &wfv1.MutexStatus{
+		Holding: []wfv1.MutexHolding{{Mutex: "default/Mutex/my-mutex", Holder: "running"}},
+	}}
+	cm.Initialize([]wfv1.Workflow{wf})
+
+	repo.AssertExpectations(t)
+	repo.AssertNotCalled(t, "Release", "default/Mutex/my-mutex", "default/running")
+}
@@ -2,6 +2,7 @@ package sync
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/simster7/argo/v2/errors"
@@ -31,18 +32,58 @@ func NewLockName(namespace, resourceName, lockKey string, kind LockKind) *LockNa
 	}
 }
 
+// GetLockName returns the name of the first lock of the synchronization
 func GetLockName(sync *v1alpha1.Synchronization, namespace string) (*LockName, error) {
-	switch sync.GetType() {
-	case v1alpha1.SynchronizationTypeSemaphore:
-		if sync.Semaphore.ConfigMapKeyRef != nil {
-			return NewLockName(namespace, sync.Semaphore.ConfigMapKeyRef.Name, sync.Semaphore.ConfigMapKeyRef.Key, LockKindConfigMap), nil
+	requests, err := getLockRequests(sync, namespace)
+	if err != nil {
+		return nil, err
+	}
+	return requests[0].name, nil
+}
+
+// lockRequest is one of the locks that a synchronization acquires
+type lockRequest struct {
+	name     *LockName
+	syncType v1alpha1.SynchronizationType
+	weight   int
+}
+
+// getLockRequests returns the locks that the synchronization acquires, sorted by name so that they are always
+// acquired in the same order
+func getLockRequests(sync *v1alpha1.Synchronization, namespace string) ([]lockRequest, error) {
+	var requests []lockRequest
+	for _, semaphore := range sync.GetSemaphores() {
+		if semaphore.ConfigMapKeyRef == nil {
+			return nil, fmt.Errorf("cannot get LockName for a Semaphore without a ConfigMapRef")
 		}
-		return nil, fmt.Errorf("cannot get LockName for a Semaphore without a ConfigMapRef")
-	case v1alpha1.SynchronizationTypeMutex:
-		return NewLockName(namespace, sync.Mutex.Name, "", LockKindMutex), nil
-	default:
+		if semaphore.Weight < 0 {
+			return nil, fmt.Errorf("the weight of semaphore %s must not be negative", semaphore.ConfigMapKeyRef.Name)
+		}
+		requests = append(requests, lockRequest{
+			name:     NewLockName(namespace, semaphore.ConfigMapKeyRef.Name, semaphore.ConfigMapKeyRef.Key, LockKindConfigMap),
+			syncType: v1alpha1.SynchronizationTypeSemaphore,
+			weight:   int(semaphore.GetWeight()),
+		})
+	}
+	for _, mutex := range sync.GetMutexes() {
+		requests = append(requests, lockRequest{
+			name:     NewLockName(namespace, mutex.Name, "", LockKindMutex),
+			syncType: v1alpha1.SynchronizationTypeMutex,
+			weight:   1,
+		})
+	}
+	if len(requests) == 0 {
 		return nil, fmt.Errorf("cannot get LockName for a Sync of Unknown type")
 	}
+	sort.Slice(requests, func(i, j int) bool {
+		return requests[i].name.EncodeName() < requests[j].name.EncodeName()
+	})
+	for i := 1; i < len(requests); i++ {
+		if requests[i].name.EncodeName() == requests[i-1].name.EncodeName() {
+			return nil, fmt.Errorf("lock %s is requested more than once", requests[i].name.EncodeName())
+		}
+	}
+	return requests, nil
 }
 
 func DecodeLockName(lockName string) (*LockName, error) {
@@ -46,10 +46,16 @@ func (m *PriorityMutex) release(key string) bool {
 	return m.mutex.release(key)
 }
 
-func (m *PriorityMutex) acquire(holderKey string) bool {
+func (m *PriorityMutex) acquire(holderKey string, weight int) bool {
 	m.lock.Lock()
 	defer m.lock.Unlock()
-	return m.mutex.acquire(holderKey)
+	return m.mutex.acquire(holderKey, weight)
+}
+
+func (m *PriorityMutex) checkAcquire(holderKey string, weight int) (bool, string) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.mutex.checkAcquire(holderKey, weight)
 }
 
 func (m *PriorityMutex) addToQueue(holderKey string, priority int32, creationTime time.Time) {
@@ -64,8 +70,8 @@ func (m *PriorityMutex) removeFromQueue(holderKey string) {
 	m.mutex.removeFromQueue(holderKey)
 }
 
-func (m *PriorityMutex) tryAcquire(holderKey string) (bool, string) {
+func (m *PriorityMutex) tryAcquire(holderKey string, weight int) (bool, string) {
 	m.lock.Lock()
 	defer m.lock.Unlock()
-	return m.mutex.tryAcquire(holderKey)
+	return m.mutex.tryAcquire(holderKey, weight)
 }
@@ -2,7 +2,6 @@ package sync
 
 import (
 	"fmt"
-	"strings"
 	"sync"
 	"time"
 
@@ -15,7 +14,7 @@ type PrioritySemaphore struct {
 	limit        int
 	pending      *priorityQueue
 	semaphore    *sema.Weighted
-	lockHolder   map[string]bool
+	lockHolder   map[string]int // the number of units held by each holder
 	lock         *sync.Mutex
 	nextWorkflow NextWorkflow
 	log          *log.Entry
@@ -29,7 +28,7 @@ func NewSemaphore(name string, limit int, nextWorkflow NextWorkflow, lockType st
 		limit:        limit,
 		pending:      &priorityQueue{itemByKey: make(map[string]*item)},
 		semaphore:    sema.NewWeighted(int64(limit)),
-		lockHolder:   make(map[string]bool),
+		lockHolder:   make(map[string]int),
 		lock:         &sync.Mutex{},
 		nextWorkflow: nextWorkflow,
 		log: log.WithFields(log.Fields{
@@ -54,11 +53,20 @@ func (s *PrioritySemaphore) getCurrentHolders() []string {
 	return keys
 }
 
+// getHeld returns the number of units held
+func (s *PrioritySemaphore) getHeld() int {
+	held := 0
+	for _, weight := range s.lockHolder {
+		held += weight
+	}
+	return held
+}
+
 func (s *PrioritySemaphore) resize(n int) bool {
 	s.lock.Lock()
 	defer s.lock.Unlock()
 
-	cur := len(s.lockHolder)
+	cur := s.getHeld()
 	// downward case, acquired n locks
 	if cur > n {
 		cur = n
@@ -77,16 +85,21 @@ func (s *PrioritySemaphore) resize(n int) bool {
 func (s *PrioritySemaphore) release(key string) bool {
 	s.lock.Lock()
 	defer s.lock.Unlock()
-	if _, ok := s.lockHolder[key]; ok {
+	if weight, ok := s.lockHolder[key]; ok {
+		held := s.getHeld()
 		delete(s.lockHolder, key)
 		// When semaphore resized downward
 		// Remove the excess holders from map once the done.
-		if len(s.lockHolder) >= s.limit {
+		if held-weight >= s.limit {
 			return true
 		}
 
-		s.semaphore.Release(1)
-		availableLocks := s.limit - len(s.lockHolder)
+		// only the units within the limit were acquired from the semaphore
+		if held > s.limit {
+			weight -= held - s.limit
+		}
+		s.semaphore.Release(int64(weight))
+		availableLocks := s.limit - s.getHeld()
 		s.log.Infof("Lock has been released by %s. Available locks: %d", key, availableLocks)
 		if s.pending.Len() > 0 {
 			triggerCount := availableLocks
@@ -95,12 +108,7 @@ func (s *PrioritySemaphore) release(key string) bool {
 			}
 			for idx := 0; idx < triggerCount; idx++ {
 				item := s.pending.items[idx]
-				keyStr := fmt.Sprint(item.key)
-				items := strings.Split(keyStr, "/")
-				workflowKey := keyStr
-				if len(items) == 3 {
-					workflowKey = fmt.Sprintf("%s/%s", items[0], items[1])
-				}
+				workflowKey := getWorkflowKey(fmt.Sprint(item.key))
 				s.log.Debugf("Enqueue the workflow %s", workflowKey)
 				s.nextWorkflow(workflowKey)
 			}
@@ -131,46 +139,67 @@ func (s *PrioritySemaphore) removeFromQueue(holderKey string) {
 	s.log.Debugf("Removed from queue: %s", holderKey)
 }
 
-func (s *PrioritySemaphore) acquire(holderKey string) bool {
-	if s.semaphore.TryAcquire(1) {
-		s.lockHolder[holderKey] = true
+func (s *PrioritySemaphore) acquire(holderKey string, weight int) bool {
+	if s.semaphore.TryAcquire(int64(weight)) {
+		s.lockHolder[holderKey] = weight
 		return true
 	}
 	return false
 }
 
-func (s *PrioritySemaphore) tryAcquire(holderKey string) (bool, string) {
+func (s *PrioritySemaphore) checkAcquire(holderKey string, weight int) (bool, string) {
 	s.lock.Lock()
 	defer s.lock.Unlock()
+	return s.checkAcquireLocked(holderKey, weight)
+}
 
+func (s *PrioritySemaphore) checkAcquireLocked(holderKey string, weight int) (bool, string) {
 	if _, ok := s.lockHolder[holderKey]; ok {
-		s.log.Debugf("%s is already holding a lock", holderKey)
 		return true, ""
 	}
-	var nextKey string
 
-	waitingMsg := fmt.Sprintf("Waiting for %s lock. Lock status: %d/%d ", s.name, s.limit-len(s.lockHolder), s.limit)
+	held := s.getHeld()
+	waitingMsg := fmt.Sprintf("Waiting for %s lock. Lock status: %d/%d ", s.name, s.limit-held, s.limit)
 
 	// Check whether requested holdkey is in front of priority queue.
 	// If it is in front position, it will allow to acquire lock.
 	// If it is not a front key, it needs to wait for its turn.
 	if s.pending.Len() > 0 {
 		item := s.pending.peek()
-		nextKey = fmt.Sprintf("%v", item.key)
+		nextKey := fmt.Sprintf("%v", item.key)
 		if holderKey != nextKey {
 			// Enqueue the front workflow if lock is available
-			if len(s.lockHolder) < s.limit {
+			if held < s.limit {
 				s.nextWorkflow(nextKey)
 			}
 			return false, waitingMsg
 		}
 	}
 
-	if s.acquire(holderKey) {
-		s.pending.pop()
-		s.log.Infof("%s acquired by %s ", s.name, nextKey)
+	if held+weight > s.limit {
+		return false, waitingMsg
+	}
+	return true, ""
+}
+
+func (s *PrioritySemaphore) tryAcquire(holderKey string, weight int) (bool, string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if _, ok := s.lockHolder[holderKey]; ok {
+		s.log.Debugf("%s is already holding a lock", holderKey)
+		return true, ""
+	}
+
+	if ok, waitingMsg := s.checkAcquireLocked(holderKey, weight); !ok {
+		return false, waitingMsg
+	}
+
+	if s.acquire(holderKey, weight) {
+		s.pending.remove(holderKey)
+		s.log.Infof("%s acquired by %s ", s.name, holderKey)
 		return true, ""
 	}
 	s.log.Debugf("Current semaphore Holders. %v", s.lockHolder)
-	return false, waitingMsg
+	return false, fmt.Sprintf("Waiting for %s lock. Lock status: %d/%d ", s.name, s.limit-s.getHeld(), s.limit)
 }
@@ -3,10 +3,13 @@ package sync
 import (
 	"fmt"
 	"sync"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 
+	"github.com/simster7/argo/v2/persist/sqldb"
 	wfv1 "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1"
+	"github.com/simster7/argo/v2/util/slice"
 )
 
 type NextWorkflow func(string)
@@ -17,18 +20,62 @@ type Manager struct {
 	lock         *sync.Mutex
 	nextWorkflow NextWorkflow
 	getSyncLimit GetSyncLimit
+	// syncRepo stores the holders and waiters of the locks, if they are shared with other controllers
+	syncRepo sqldb.SyncRepo
 }
 
 func NewLockManager(getSyncLimit GetSyncLimit, nextWorkflow NextWorkflow) *Manager {
+	return NewDatabaseLockManager(getSyncLimit, nextWorkflow, sqldb.NullSyncRepo)
+}
+
+// NewDatabaseLockManager returns a manager whose locks are stored in the database if the repository is enabled, so
+// their limits apply across all the controllers sharing it
+func NewDatabaseLockManager(getSyncLimit GetSyncLimit, nextWorkflow NextWorkflow, syncRepo sqldb.SyncRepo) *Manager {
 	return &Manager{
 		syncLockMap:  make(map[string]Semaphore),
 		lock:         &sync.Mutex{},
 		nextWorkflow: nextWorkflow,
 		getSyncLimit: getSyncLimit,
+		syncRepo:     syncRepo,
 	}
 }
 
+// Poll renews this controller's lease on its database locks, and enqueues the workflows waiting for database locks,
+// as they may have been released by other controllers
+func (cm *Manager) Poll(leaseDuration time.Duration) {
+	if !cm.syncRepo.IsEnabled() {
+		return
+	}
+	err := cm.syncRepo.RenewLease(leaseDuration)
+	if err != nil {
+		log.WithError(err).Error("Failed to renew synchronization lease")
+	}
+	names, err := cm.syncRepo.ListWaiting()
+	if err != nil {
+		log.WithError(err).Error("Failed to list the locks being waited for")
+		return
+	}
+	cm.lock.Lock()
+	defer cm.lock.Unlock()
+	for _, name := range names {
+		if semaphore, ok := cm.syncLockMap[name].(*DatabaseSemaphore); ok {
+			semaphore.notifyWaiters()
+		}
+	}
+}
+
+// Initialize acquires the locks held by the workflows, which are those that are running, for them. The database locks
+// held by this controller for other workflows, e.g. because they completed or were deleted while it was down, are
+// released.
 func (cm *Manager) Initialize(wfs []wfv1.Workflow) {
+	// acquired are the keys of the holders of each lock, according to the statuses of the workflows
+	acquired := make(map[string]map[string]bool)
+	addAcquired := func(name, key string) {
+		if acquired[name] == nil {
+			acquired[name] = make(map[string]bool)
+		}
+		acquired[name][key] = true
+	}
 	for _, wf := range wfs {
 		if wf.Status.Synchronization == nil {
 			continue
@@ -39,7 +86,8 @@ func (cm *Manager) Initialize(wfs []wfv1.Workflow) {
 
 				semaphore := cm.syncLockMap[holding.Semaphore]
 				if semaphore == nil {
-					semaphore, err := cm.initializeSemaphore(holding.Semaphore)
+					var err error
+					semaphore, err = cm.initializeSemaphore(holding.Semaphore)
 					if err != nil {
 						log.Warnf("cannot initialize semaphore '%s': %v", holding.Semaphore, err)
 						continue
@@ -49,7 +97,8 @@ func (cm *Manager) Initialize(wfs []wfv1.Workflow) {
 
 				for _, holders := range holding.Holders {
 					resourceKey := getResourceKey(wf.Namespace, wf.Name, holders)
-					if semaphore != nil && semaphore.acquire(resourceKey) {
+					addAcquired(holding.Semaphore, resourceKey)
+					if semaphore != nil && semaphore.acquire(resourceKey, int(holding.GetWeight(holders))) {
 						log.Infof("Lock acquired by %s from %s", resourceKey, holding.Semaphore)
 					}
 				}
@@ -58,6 +107,9 @@ func (cm *Manager) Initialize(wfs []wfv1.Workflow) {
 
 		if wf.Status.Synchronization.Mutex != nil {
 			for _, holding := range wf.Status.Synchronization.Mutex.Holding {
+				if holding.Holder != "" {
+					addAcquired(holding.Mutex, getResourceKey(wf.Namespace, wf.Name, holding.Holder))
+				}
 
 				mutex := cm.syncLockMap[holding.Mutex]
 				if mutex == nil {
@@ -68,17 +120,44 @@ func (cm *Manager) Initialize(wfs []wfv1.Workflow) {
 					}
 					if holding.Holder != "" {
 						resourceKey := getResourceKey(wf.Namespace, wf.Name, holding.Holder)
-						mutex.acquire(resourceKey)
+						mutex.acquire(resourceKey, 1)
 					}
 					cm.syncLockMap[holding.Mutex] = mutex
 				}
 			}
 		}
 	}
+	if cm.syncRepo.IsEnabled() {
+		cm.releaseOrphanedHolders(acquired)
+	}
 	log.Infof("Manager initialized successfully")
 }
 
-// TryAcquire tries to acquire the lock from semaphore.
+// releaseOrphanedHolders releases the database locks held by this controller for holders that do not hold them
+// according to the statuses of their workflows. Otherwise, as this controller renews its lease on them for as long as it
+// runs, they would never be released.
+func (cm *Manager) releaseOrphanedHolders(acquired map[string]map[string]bool) {
+	held, err := cm.syncRepo.ListHeld()
+	if err != nil {
+		log.WithError(err).Error("Failed to list the locks held by this controller")
+		return
+	}
+	for name, keys := range held {
+		for _, key := range keys {
+			if acquired[name][key] {
+				continue
+			}
+			if err := cm.syncRepo.Release(name, key); err != nil {
+				log.WithError(err).Errorf("Failed to release lock %s held by %s", name, key)
+				continue
+			}
+			log.Infof("Released lock %s held by %s, whose workflow no longer holds it", name, key)
+		}
+	}
+}
+
+// TryAcquire tries to acquire the locks of the synchronization. Either all of them are acquired, or none of them are,
+// so that holders waiting for several locks cannot deadlock.
 // It returns status of acquiring a lock , status of Workflow status updated, waiting message if lock is not available and any error encountered
 func (cm *Manager) TryAcquire(wf *wfv1.Workflow, nodeName string, syncLockRef *wfv1.Synchronization) (bool, bool, string, error) {
 	cm.lock.Lock()
@@ -88,33 +167,21 @@ func (cm *Manager) TryAcquire(wf *wfv1.Workflow, nodeName string, syncLockRef *w
 		return false, false, "", fmt.Errorf("cannot acquire lock from nil Synchronization")
 	}
 
-	syncLockName, err := GetLockName(syncLockRef, wf.Namespace)
+	requests, err := getLockRequests(syncLockRef, wf.Namespace)
 	if err != nil {
 		return false, false, "", fmt.Errorf("requested configuration is invalid: %w", err)
 	}
 
-	lockKey := syncLockName.EncodeName()
-	lock, found := cm.syncLockMap[lockKey]
-	if !found {
-		switch syncLockRef.GetType() {
-		case wfv1.SynchronizationTypeSemaphore:
-			lock, err = cm.initializeSemaphore(lockKey)
-		case wfv1.SynchronizationTypeMutex:
-			lock, err = cm.initializeMutex(lockKey)
-		default:
-			return false, false, "", fmt.Errorf("unknown Synchronization Type")
-		}
+	locks := make([]Semaphore, len(requests))
+	for i, request := range requests {
+		lock, err := cm.getLock(request)
 		if err != nil {
 			return false, false, "", err
 		}
-		cm.syncLockMap[lockKey] = lock
-	}
-
-	if syncLockRef.GetType() == wfv1.SynchronizationTypeSemaphore {
-		err := cm.checkAndUpdateSemaphoreSize(lock)
-		if err != nil {
-			return false, false, "", err
+		if request.weight > lock.getLimit() {
+			return false, false, "", fmt.Errorf("cannot acquire %d units of %s lock, as its limit is %d", request.weight, lock.getName(), lock.getLimit())
 		}
+		locks[i] = lock
 	}
 
 	holderKey := getHolderKey(wf, nodeName)
@@ -125,18 +192,97 @@ func (cm *Manager) TryAcquire(wf *wfv1.Workflow, nodeName string, syncLockRef *w
 		priority = 0
 	}
 	creationTime := wf.CreationTimestamp
-	lock.addToQueue(holderKey, priority, creationTime.Time)
+	for _, lock := range locks {
+		lock.addToQueue(holderKey, priority, creationTime.Time)
+	}
+
+	for _, request := range requests {
+		ensureInit(wf, request.syncType)
+	}
+	currentHolders := make([][]string, len(locks))
+	for i, lock := range locks {
+		currentHolders[i] = lock.getCurrentHolders()
+	}
+
+	// Check that all the locks are available before acquiring any of them, so that no lock is held while waiting for
+	// another.
+	for i, lock := range locks {
+		if ok, msg := lock.checkAcquire(holderKey, requests[i].weight); !ok {
+			return false, cm.lockWaiting(wf, holderKey, requests, currentHolders), msg, nil
+		}
+	}
 
-	ensureInit(wf, syncLockRef.GetType())
-	currentHolders := cm.getCurrentLockHolders(lockKey)
-	acquired, msg := lock.tryAcquire(holderKey)
-	if acquired {
-		updated := wf.Status.Synchronization.GetStatus(syncLockRef.GetType()).LockAcquired(holderKey, lockKey, currentHolders)
-		return true, updated, "", nil
+	var acquired []int
+	for i, lock := range locks {
+		alreadyHeld := slice.ContainsString(currentHolders[i], holderKey)
+		ok, msg := lock.tryAcquire(holderKey, requests[i].weight)
+		if !ok {
+			// a database lock was acquired by another controller after it was checked
+			for _, j := range acquired {
+				locks[j].release(holderKey)
+				locks[j].addToQueue(holderKey, priority, creationTime.Time)
+			}
+			return false, cm.lockWaiting(wf, holderKey, requests, currentHolders), msg, nil
+		}
+		if !alreadyHeld {
+			acquired = append(acquired, i)
+		}
 	}
 
-	updated := wf.Status.Synchronization.GetStatus(syncLockRef.GetType()).LockWaiting(holderKey, lockKey, currentHolders)
-	return false, updated, msg, nil
+	updated := false
+	for i, request := range requests {
+		lockKey := request.name.EncodeName()
+		if wf.Status.Synchronization.GetStatus(request.syncType).LockAcquired(holderKey, lockKey, currentHolders[i]) {
+			updated = true
+		}
+		if request.syncType == wfv1.SynchronizationTypeSemaphore && wf.Status.Synchronization.Semaphore.LockWeighted(holderKey, lockKey, int32(request.weight)) {
+			updated = true
+		}
+	}
+	return true, updated, "", nil
+}
+
+// lockWaiting records that the holder is waiting for the locks it does not hold yet
+func (cm *Manager) lockWaiting(wf *wfv1.Workflow, holderKey string, requests []lockRequest, currentHolders [][]string) bool {
+	updated := false
+	for i, request := range requests {
+		if slice.ContainsString(currentHolders[i], holderKey) {
+			continue
+		}
+		if wf.Status.Synchronization.GetStatus(request.syncType).LockWaiting(holderKey, request.name.EncodeName(), currentHolders[i]) {
+			updated = true
+		}
+	}
+	return updated
+}
+
+// getLock returns the lock of the request, initializing it if needed
+func (cm *Manager) getLock(request lockRequest) (Semaphore, error) {
+	lockKey := request.name.EncodeName()
+	lock, found := cm.syncLockMap[lockKey]
+	if !found {
+		var err error
+		switch request.syncType {
+		case wfv1.SynchronizationTypeSemaphore:
+			lock, err = cm.initializeSemaphore(lockKey)
+		case wfv1.SynchronizationTypeMutex:
+			lock, err = cm.initializeMutex(lockKey)
+		default:
+			return nil, fmt.Errorf("unknown Synchronization Type")
+		}
+		if err != nil {
+			return nil, err
+		}
+		cm.syncLockMap[lockKey] = lock
+	}
+
+	if request.syncType == wfv1.SynchronizationTypeSemaphore {
+		err := cm.checkAndUpdateSemaphoreSize(lock)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return lock, nil
 }
 
 func (cm *Manager) Release(wf *wfv1.Workflow, nodeName string, syncRef *wfv1.Synchronization) {
@@ -148,17 +294,20 @@ func (cm *Manager) Release(wf *wfv1.Workflow, nodeName string, syncRef *wfv1.Syn
 	}
 
 	holderKey := getHolderKey(wf, nodeName)
-	lockName, err := GetLockName(syncRef, wf.Namespace)
+	requests, err := getLockRequests(syncRef, wf.Namespace)
 	if err != nil {
 		return
 	}
 
-	if syncLockHolder, ok := cm.syncLockMap[lockName.EncodeName()]; ok {
-		syncLockHolder.release(holderKey)
-		syncLockHolder.removeFromQueue(holderKey)
-		log.Debugf("%s sync lock is released by %s", lockName.EncodeName(), holderKey)
-		lockKey := lockName.EncodeName()
-		wf.Status.Synchronization.GetStatus(syncRef.GetType()).LockReleased(holderKey, lockKey)
+	for _, request := range requests {
+		lockKey := request.name.EncodeName()
+		if syncLockHolder, ok := cm.syncLockMap[lockKey]; ok {
+			syncLockHolder.release(holderKey)
+			syncLockHolder.removeFromQueue(holderKey)
+			log.Debugf("%s sync lock is released by %s", lockKey, holderKey)
+			ensureInit(wf, request.syncType)
+			wf.Status.Synchronization.GetStatus(request.syncType).LockReleased(holderKey, lockKey)
+		}
 	}
 }
 
@@ -170,6 +319,19 @@ func (cm *Manager) ReleaseAll(wf *wfv1.Workflow) bool {
 		return true
 	}
 
+	// nodes waiting for several locks are in the queues of all of them
+	var waitingLocks []string
+	if wf.Status.Synchronization.Semaphore != nil {
+		for _, waiting := range wf.Status.Synchronization.Semaphore.Waiting {
+			waitingLocks = append(waitingLocks, waiting.Semaphore)
+		}
+	}
+	if wf.Status.Synchronization.Mutex != nil {
+		for _, waiting := range wf.Status.Synchronization.Mutex.Waiting {
+			waitingLocks = append(waitingLocks, waiting.Mutex)
+		}
+	}
+
 	if wf.Status.Synchronization.Semaphore != nil {
 		for _, holding := range wf.Status.Synchronization.Semaphore.Holding {
 			syncLockHolder := cm.syncLockMap[holding.Semaphore]
@@ -224,8 +386,11 @@ func (cm *Manager) ReleaseAll(wf *wfv1.Workflow) bool {
 
 	for _, node := range wf.Status.Nodes {
 		if node.SynchronizationStatus != nil && node.SynchronizationStatus.Waiting != "" {
-			lock := cm.syncLockMap[node.SynchronizationStatus.Waiting]
-			lock.removeFromQueue(getHolderKey(wf, node.ID))
+			for _, lockName := range append(waitingLocks, node.SynchronizationStatus.Waiting) {
+				if lock, ok := cm.syncLockMap[lockName]; ok {
+					lock.removeFromQueue(getHolderKey(wf, node.ID))
+				}
+			}
 
 			node.SynchronizationStatus = nil
 			wf.Status.Nodes[node.ID] = node
@@ -237,11 +402,14 @@ func (cm *Manager) ReleaseAll(wf *wfv1.Workflow) bool {
 }
 
 func ensureInit(wf *wfv1.Workflow, lockType wfv1.SynchronizationType) {
-	if lockType == wfv1.SynchronizationTypeSemaphore && (wf.Status.Synchronization == nil || wf.Status.Synchronization.Semaphore == nil) {
-		wf.Status.Synchronization = &wfv1.SynchronizationStatus{Semaphore: &wfv1.SemaphoreStatus{}}
+	if wf.Status.Synchronization == nil {
+		wf.Status.Synchronization = &wfv1.SynchronizationStatus{}
 	}
-	if lockType == wfv1.SynchronizationTypeMutex && (wf.Status.Synchronization == nil || wf.Status.Synchronization.Mutex == nil) {
-		wf.Status.Synchronization = &wfv1.SynchronizationStatus{Mutex: &wfv1.MutexStatus{}}
+	if lockType == wfv1.SynchronizationTypeSemaphore && wf.Status.Synchronization.Semaphore == nil {
+		wf.Status.Synchronization.Semaphore = &wfv1.SemaphoreStatus{}
+	}
+	if lockType == wfv1.SynchronizationTypeMutex && wf.Status.Synchronization.Mutex == nil {
+		wf.Status.Synchronization.Mutex = &wfv1.MutexStatus{}
 	}
 }
 
@@ -276,10 +444,16 @@ func (cm *Manager) initializeSemaphore(semaphoreName string) (Semaphore, error)
 	if err != nil {
 		return nil, err
 	}
+	if cm.syncRepo.IsEnabled() {
+		return NewDatabaseSemaphore(semaphoreName, limit, cm.nextWorkflow, "semaphore", cm.syncRepo)
+	}
 	return NewSemaphore(semaphoreName, limit, cm.nextWorkflow, "semaphore"), nil
 }
 
 func (cm *Manager) initializeMutex(mutexName string) (Semaphore, error) {
+	if cm.syncRepo.IsEnabled() {
+		return NewDatabaseSemaphore(mutexName, 1, cm.nextWorkflow, "mutex", cm.syncRepo)
+	}
 	return NewMutex(mutexName, cm.nextWorkflow), nil
 }
 
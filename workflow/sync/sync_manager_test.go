@@ -674,3 +674,103 @@ func TestMutexWfLevel(t *testing.T) {
 		assert.Len(t, mutex.mutex.pending.items, 0)
 	})
 }
+
+func TestMultipleLocks(t *testing.T) {
+	kube := fake.NewSimpleClientset(&v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-config", Namespace: "default"},
+		Data:       map[string]string{"licences": "4"},
+	})
+	syncLimitFunc := GetSyncLimitFunc(kube)
+	licences := func(weight int32) wfv1.SemaphoreRef {
+		return wfv1.SemaphoreRef{ConfigMapKeyRef: &v1.ConfigMapKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: "my-config"}, Key: "licences"}, Weight: weight}
+	}
+	newWf := func(name string) *wfv1.Workflow {
+		return &wfv1.Workflow{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", CreationTimestamp: metav1.Now()}}
+	}
+
+	t.Run("Weighted", func(t *testing.T) {
+		concurrenyMgr := NewLockManager(syncLimitFunc, func(key string) {})
+		sync := &wfv1.Synchronization{Semaphore: &wfv1.SemaphoreRef{ConfigMapKeyRef: licences(3).ConfigMapKeyRef, Weight: 3}}
+		wf1 := newWf("one")
+		status, wfUpdate, msg, err := concurrenyMgr.TryAcquire(wf1, "", sync)
+		assert.NoError(t, err)
+		assert.Empty(t, msg)
+		assert.True(t, status)
+		assert.True(t, wfUpdate)
+		if assert.Len(t, wf1.Status.Synchronization.Semaphore.Holding, 1) {
+			assert.Equal(t, int32(3), wf1.Status.Synchronization.Semaphore.Holding[0].GetWeight("one"))
+		}
+
+		wf2 := newWf("two")
+		status, _, msg, err = concurrenyMgr.TryAcquire(wf2, "", sync)
+		assert.NoError(t, err)
+		assert.Equal(t, "Waiting for default/ConfigMap/my-config/licences lock. Lock status: 1/4 ", msg)
+		assert.False(t, status)
+
+		// a holder taking a single unit is queued behind the first waiter
+		wf3 := newWf("three")
+		status, _, _, err = concurrenyMgr.TryAcquire(wf3, "", &wfv1.Synchronization{Semaphore: &wfv1.SemaphoreRef{ConfigMapKeyRef: licences(1).ConfigMapKeyRef}})
+		assert.NoError(t, err)
+		assert.False(t, status)
+
+		concurrenyMgr.Release(wf1, "", sync)
+		assert.Empty(t, wf1.Status.Synchronization.Semaphore.Holding[0].Weights)
+		status, _, _, err = concurrenyMgr.TryAcquire(wf2, "", sync)
+		assert.NoError(t, err)
+		assert.True(t, status)
+
+		// the holders after restarting hold the same units
+		restarted := NewLockManager(syncLimitFunc, func(key string) {})
+		restarted.Initialize([]wfv1.Workflow{*wf2})
+		assert.Equal(t, 3, restarted.syncLockMap["default/ConfigMap/my-config/licences"].(*PrioritySemaphore).getHeld())
+
+		_, _, _, err = concurrenyMgr.TryAcquire(newWf("four"), "", &wfv1.Synchronization{Semaphore: &wfv1.SemaphoreRef{ConfigMapKeyRef: licences(5).ConfigMapKeyRef, Weight: 5}})
+		assert.EqualError(t, err, "cannot acquire 5 units of default/ConfigMap/my-config/licences lock, as its limit is 4")
+	})
+
+	t.Run("AllOrNothing", func(t *testing.T) {
+		concurrenyMgr := NewLockManager(syncLimitFunc, func(key string) {})
+		sync := &wfv1.Synchronization{
+			Mutexes:    []wfv1.Mutex{{Name: "database"}},
+			Semaphores: []wfv1.SemaphoreRef{licences(2)},
+		}
+		database := &wfv1.Synchronization{Mutex: &wfv1.Mutex{Name: "database"}}
+
+		wf1 := newWf("one")
+		status, _, _, err := concurrenyMgr.TryAcquire(wf1, "", database)
+		assert.NoError(t, err)
+		assert.True(t, status)
+
+		// the licences are available, but are not acquired while the mutex is held
+		wf2 := newWf("two")
+		status, wfUpdate, msg, err := concurrenyMgr.TryAcquire(wf2, "", sync)
+		assert.NoError(t, err)
+		assert.False(t, status)
+		assert.True(t, wfUpdate)
+		assert.Equal(t, "Waiting for default/Mutex/database lock. Lock status: 0/1 ", msg)
+		assert.Empty(t, concurrenyMgr.getCurrentLockHolders("default/ConfigMap/my-config/licences"))
+		assert.Len(t, wf2.Status.Synchronization.Semaphore.Waiting, 1)
+		assert.Len(t, wf2.Status.Synchronization.Mutex.Waiting, 1)
+
+		concurrenyMgr.Release(wf1, "", database)
+		status, wfUpdate, msg, err = concurrenyMgr.TryAcquire(wf2, "", sync)
+		assert.NoError(t, err)
+		assert.True(t, status)
+		assert.True(t, wfUpdate)
+		assert.Empty(t, msg)
+		assert.Equal(t, []string{"default/two"}, concurrenyMgr.getCurrentLockHolders("default/ConfigMap/my-config/licences"))
+		assert.Equal(t, "two", wf2.Status.Synchronization.Mutex.Holding[0].Holder)
+		assert.Equal(t, int32(2), wf2.Status.Synchronization.Semaphore.Holding[0].GetWeight("two"))
+
+		concurrenyMgr.ReleaseAll(wf2)
+		assert.Nil(t, wf2.Status.Synchronization)
+		assert.Empty(t, concurrenyMgr.getCurrentLockHolders("default/ConfigMap/my-config/licences"))
+		assert.Empty(t, concurrenyMgr.getCurrentLockHolders("default/Mutex/database"))
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		concurrenyMgr := NewLockManager(syncLimitFunc, func(key string) {})
+		_, _, _, err := concurrenyMgr.TryAcquire(newWf("one"), "", &wfv1.Synchronization{Mutex: &wfv1.Mutex{Name: "database"}, Mutexes: []wfv1.Mutex{{Name: "database"}}})
+		assert.EqualError(t, err, "requested configuration is invalid: lock default/Mutex/database is requested more than once")
+	})
+}
@@ -3,6 +3,7 @@ package sync
 import (
 	"container/heap"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
@@ -78,8 +79,12 @@ type item struct {
 	creationTime time.Time
 	priority     int32
 	index        int
+	seq          uint64
 }
 
+// itemSeq orders the items added to any queue
+var itemSeq uint64
+
 type priorityQueue struct {
 	items     []*item
 	itemByKey map[string]*item
@@ -100,7 +105,7 @@ func (pq *priorityQueue) add(key string, priority int32, creationTime time.Time)
 			heap.Fix(pq, res.index)
 		}
 	} else {
-		heap.Push(pq, &item{key: key, priority: priority, creationTime: creationTime})
+		heap.Push(pq, &item{key: key, priority: priority, creationTime: creationTime, seq: atomic.AddUint64(&itemSeq, 1)})
 	}
 }
 
@@ -115,6 +120,10 @@ func (pq priorityQueue) Len() int { return len(pq.items) }
 
 func (pq priorityQueue) Less(i, j int) bool {
 	if pq.items[i].priority == pq.items[j].priority {
+		// break ties in the order the items were added, which is the same in every queue
+		if pq.items[i].creationTime.Equal(pq.items[j].creationTime) {
+			return pq.items[i].seq < pq.items[j].seq
+		}
 		return pq.items[i].creationTime.Before(pq.items[j].creationTime)
 	}
 	return pq.items[i].priority > pq.items[j].priority
@@ -2,11 +2,13 @@ package templateresolution
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/sirupsen/logrus"
 	log "github.com/sirupsen/logrus"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
 
 	"github.com/simster7/argo/v2/errors"
 	wfv1 "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1"
@@ -111,7 +113,7 @@ func (ctx *Context) GetTemplateByName(name string) (*wfv1.Template, error) {
 	if tmpl == nil {
 		return nil, errors.Errorf(errors.CodeNotFound, "template %s not found", name)
 	}
-	return tmpl.DeepCopy(), nil
+	return mergeTemplateDefaults(ctx.tmplBase, tmpl.DeepCopy())
 }
 
 func (ctx *Context) GetTemplateGetterFromRef(tmplRef *wfv1.TemplateRef) (wfv1.TemplateHolder, error) {
@@ -145,16 +147,72 @@ func (ctx *Context) GetTemplateFromRef(tmplRef *wfv1.TemplateRef) (*wfv1.Templat
 	if template == nil {
 		return nil, errors.Errorf(errors.CodeNotFound, "template %s not found in workflow template %s", tmplRef.Template, tmplRef.Name)
 	}
-	return template.DeepCopy(), nil
+	return mergeTemplateDefaults(wftmpl, template.DeepCopy())
 }
 
-// GetTemplate returns a template found by template name or template ref.
+// GetInlineTemplate returns the inline template of a given template holder.
+func (ctx *Context) GetInlineTemplate(tmplHolder wfv1.TemplateReferenceHolder) (*wfv1.Template, error) {
+	ctx.log.Debug("Getting the inline template")
+	return mergeTemplateDefaults(ctx.tmplBase, tmplHolder.GetTemplate().DeepCopy())
+}
+
+// getTemplateDefaults returns the template defaults of the workflow spec of a template base, if any.
+func getTemplateDefaults(tmplBase wfv1.TemplateHolder) *wfv1.Template {
+	switch base := tmplBase.(type) {
+	case *wfv1.Workflow:
+		if base.Spec.TemplateDefaults == nil && base.Status.StoredWorkflowSpec != nil {
+			return base.Status.StoredWorkflowSpec.TemplateDefaults
+		}
+		return base.Spec.TemplateDefaults
+	case wfv1.WorkflowSpecHolder:
+		return base.GetWorkflowSpec().TemplateDefaults
+	}
+	return nil
+}
+
+// mergeTemplateDefaults strategically merges the template defaults of the template base in which a template is defined
+// into it. The fields of the template take precedence. Templates that refer to other templates are left as they are,
+// as the defaults are merged into the templates they refer to.
+func mergeTemplateDefaults(tmplBase wfv1.TemplateHolder, tmpl *wfv1.Template) (*wfv1.Template, error) {
+	defaults := getTemplateDefaults(tmplBase)
+	if defaults == nil || tmpl.GetType() == wfv1.TemplateTypeUnknown {
+		return tmpl, nil
+	}
+	if !tmpl.IsLeaf() {
+		// activeDeadlineSeconds and timeout are only valid for leaf templates
+		defaults = defaults.DeepCopy()
+		defaults.ActiveDeadlineSeconds = nil
+		defaults.Timeout = ""
+	}
+	defaultsBytes, err := json.Marshal(defaults)
+	if err != nil {
+		return nil, err
+	}
+	tmplBytes, err := json.Marshal(tmpl)
+	if err != nil {
+		return nil, err
+	}
+	mergedBytes, err := strategicpatch.StrategicMergePatch(defaultsBytes, tmplBytes, wfv1.Template{})
+	if err != nil {
+		return nil, errors.Errorf(errors.CodeBadRequest, "failed to merge the template defaults into template %s: %v", tmpl.Name, err)
+	}
+	var mergedTmpl wfv1.Template
+	err = json.Unmarshal(mergedBytes, &mergedTmpl)
+	if err != nil {
+		return nil, err
+	}
+	return &mergedTmpl, nil
+}
+
+// GetTemplate returns a template found by inline template, template name or template ref.
 func (ctx *Context) GetTemplate(tmplHolder wfv1.TemplateReferenceHolder) (*wfv1.Template, error) {
 	ctx.log.Debug("Getting the template")
 
 	tmplName := tmplHolder.GetTemplateName()
 	tmplRef := tmplHolder.GetTemplateRef()
-	if tmplRef != nil {
+	if tmplHolder.GetTemplate() != nil {
+		return ctx.GetInlineTemplate(tmplHolder)
+	} else if tmplRef != nil {
 		return ctx.GetTemplateFromRef(tmplRef)
 	} else if tmplName != "" {
 		return ctx.GetTemplateByName(tmplName)
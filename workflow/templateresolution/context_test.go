@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/yaml"
@@ -187,6 +188,81 @@ func TestGetTemplate(t *testing.T) {
 	tmplHolder = wfv1.WorkflowStep{TemplateRef: &wfv1.TemplateRef{Name: "unknown-workflow-template", Template: "whalesay"}}
 	_, err = ctx.GetTemplate(&tmplHolder)
 	assert.EqualError(t, err, "workflow template unknown-workflow-template not found")
+
+	// Get an inline template.
+	tmplHolder = wfv1.WorkflowStep{Inline: &wfv1.Template{Container: &apiv1.Container{Image: "docker/whalesay"}}}
+	tmpl, err = ctx.GetTemplate(&tmplHolder)
+	if !assert.NoError(t, err) {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "docker/whalesay", tmpl.Container.Image)
+}
+
+var templateDefaultsWorkflowTemplateYaml = `
+apiVersion: argoproj.io/v1alpha1
+kind: WorkflowTemplate
+metadata:
+  name: template-defaults
+spec:
+  templateDefaults:
+    activeDeadlineSeconds: 300
+    podSpecPatch: '{"terminationGracePeriodSeconds": 5}'
+    retryStrategy:
+      limit: 2
+  templates:
+  - name: main
+    steps:
+    - - name: whalesay
+        template: whalesay
+  - name: whalesay
+    retryStrategy:
+      limit: 5
+    container:
+      image: docker/whalesay
+`
+
+func TestTemplateDefaults(t *testing.T) {
+	wfClientset := fakewfclientset.NewSimpleClientset()
+	err := createWorkflowTemplate(wfClientset, templateDefaultsWorkflowTemplateYaml)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wftmpl := unmarshalWftmpl(templateDefaultsWorkflowTemplateYaml)
+	ctx := NewContextFromClientset(wfClientset.ArgoprojV1alpha1().WorkflowTemplates(metav1.NamespaceDefault), wfClientset.ArgoprojV1alpha1().ClusterWorkflowTemplates(), wftmpl, nil)
+
+	t.Run("Leaf", func(t *testing.T) {
+		_, tmpl, _, err := ctx.ResolveTemplate(&wfv1.WorkflowStep{Template: "whalesay"})
+		if assert.NoError(t, err) {
+			assert.Equal(t, "300", tmpl.ActiveDeadlineSeconds.String())
+			assert.Equal(t, `{"terminationGracePeriodSeconds": 5}`, tmpl.PodSpecPatch)
+			assert.Equal(t, "5", tmpl.RetryStrategy.Limit.String())
+			assert.Equal(t, "docker/whalesay", tmpl.Container.Image)
+		}
+	})
+	t.Run("NonLeaf", func(t *testing.T) {
+		_, tmpl, _, err := ctx.ResolveTemplate(&wfv1.WorkflowStep{Template: "main"})
+		if assert.NoError(t, err) {
+			assert.Nil(t, tmpl.ActiveDeadlineSeconds)
+			assert.Equal(t, "2", tmpl.RetryStrategy.Limit.String())
+			assert.Len(t, tmpl.Steps, 1)
+		}
+	})
+	t.Run("Inline", func(t *testing.T) {
+		_, tmpl, _, err := ctx.ResolveTemplate(&wfv1.DAGTask{Inline: &wfv1.Template{Container: &apiv1.Container{Image: "docker/whalesay"}}})
+		if assert.NoError(t, err) {
+			assert.Equal(t, "2", tmpl.RetryStrategy.Limit.String())
+			assert.Equal(t, "docker/whalesay", tmpl.Container.Image)
+		}
+	})
+	t.Run("TemplateRef", func(t *testing.T) {
+		// the defaults of the workflow template that defines the template apply, rather than those of the caller
+		wftmpl := unmarshalWftmpl(baseWorkflowTemplateYaml)
+		ctx := NewContextFromClientset(wfClientset.ArgoprojV1alpha1().WorkflowTemplates(metav1.NamespaceDefault), wfClientset.ArgoprojV1alpha1().ClusterWorkflowTemplates(), wftmpl, nil)
+		_, tmpl, _, err := ctx.ResolveTemplate(&wfv1.WorkflowStep{TemplateRef: &wfv1.TemplateRef{Name: "template-defaults", Template: "whalesay"}})
+		if assert.NoError(t, err) {
+			assert.Equal(t, `{"terminationGracePeriodSeconds": 5}`, tmpl.PodSpecPatch)
+		}
+	})
 }
 
 func TestGetCurrentTemplateBase(t *testing.T) {
@@ -50,6 +50,52 @@ func TestMergeWorkflows(t *testing.T) {
 	assert.Equal(t, "patch", targetWf.Spec.Arguments.Parameters[0].Value.String())
 }
 
+var templateDefaultsPatchWF = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: template-defaults-
+spec:
+  templateDefaults:
+    activeDeadlineSeconds: 300
+    retryStrategy:
+      limit: 2
+`
+
+var templateDefaultsTargetWF = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: template-defaults-
+spec:
+  entrypoint: main
+  templateDefaults:
+    retryStrategy:
+      limit: 3
+  templates:
+  - name: main
+    steps:
+    - - name: hello
+        inline:
+          container:
+            image: docker/whalesay
+`
+
+func TestMergeTemplateDefaultsAndInlineTemplates(t *testing.T) {
+	patchWf := unmarshalWF(templateDefaultsPatchWF)
+	targetWf := unmarshalWF(templateDefaultsTargetWF)
+
+	err := MergeTo(patchWf, targetWf)
+	if assert.NoError(t, err) && assert.NotNil(t, targetWf.Spec.TemplateDefaults) {
+		assert.Equal(t, "300", targetWf.Spec.TemplateDefaults.ActiveDeadlineSeconds.String())
+		assert.Equal(t, "3", targetWf.Spec.TemplateDefaults.RetryStrategy.Limit.String())
+		inline := targetWf.Spec.Templates[0].Steps[0].Steps[0].Inline
+		if assert.NotNil(t, inline) && assert.NotNil(t, inline.Container) {
+			assert.Equal(t, "docker/whalesay", inline.Container.Image)
+		}
+	}
+}
+
 func TestMergeMetaDataTo(t *testing.T) {
 	assert := assert.New(t)
 	meta1 := &metav1.ObjectMeta{
@@ -423,7 +423,9 @@ func SelectorMatchesNode(selector fields.Selector, node wfv1.NodeStatus) bool {
 	}
 	if node.Inputs != nil {
 		for _, inParam := range node.Inputs.Parameters {
-			nodeFields[fmt.Sprintf("inputs.parameters.%s.value", inParam.Name)] = inParam.Value.String()
+			if inParam.Value != nil {
+				nodeFields[fmt.Sprintf("inputs.parameters.%s.value", inParam.Name)] = inParam.Value.String()
+			}
 		}
 	}
 
@@ -620,7 +622,15 @@ func FormulateResubmitWorkflow(wf *wfv1.Workflow, memoized bool) (*wfv1.Workflow
 		if node.BoundaryID != "" {
 			newNode.BoundaryID = convertNodeID(&newWF, replaceRegexp, node.BoundaryID, wf.Status.Nodes)
 		}
-		if newNode.FailedOrError() && newNode.Type == wfv1.NodeTypePod {
+		// HTTP requests and child workflows, like pods, are only run again if they failed
+		isPod := newNode.Type == wfv1.NodeTypePod || newNode.Type == wfv1.NodeTypeHTTP || newNode.Type == wfv1.NodeTypeWorkflow
+		failedOrError := newNode.FailedOrError()
+		if newNode.Type == wfv1.NodeTypeContainer {
+			// the containers of a container set are re-run along with their pod
+			isPod = true
+			failedOrError = wf.Status.Nodes[node.BoundaryID].FailedOrError()
+		}
+		if failedOrError && isPod {
 			newNode.StartedAt = metav1.Time{}
 			newNode.FinishedAt = metav1.Time{}
 		} else {
@@ -637,7 +647,7 @@ func FormulateResubmitWorkflow(wf *wfv1.Workflow, memoized bool) (*wfv1.Workflow
 			newOutboundNodes[i] = convertNodeID(&newWF, replaceRegexp, outboundID, wf.Status.Nodes)
 		}
 		newNode.OutboundNodes = newOutboundNodes
-		if !newNode.FailedOrError() && newNode.Type == wfv1.NodeTypePod {
+		if !failedOrError && isPod {
 			newNode.Phase = wfv1.NodeSkipped
 			newNode.Type = wfv1.NodeTypeSkipped
 			newNode.Message = fmt.Sprintf("original pod: %s", originalID)
@@ -728,6 +738,11 @@ func retryWorkflow(ctx context.Context, kubeClient kubernetes.Interface, hydrato
 			// if we are resetting this node then don't carry it across regardless of its phase
 			doForceResetNode = true
 		}
+		if node.Type == wfv1.NodeTypeContainer && wf.Status.Nodes[node.BoundaryID].Phase != wfv1.NodeSucceeded {
+			// the containers of a container set are re-run along with their pod
+			deletedNodes[node.ID] = true
+			continue
+		}
 		switch node.Phase {
 		case wfv1.NodeSucceeded, wfv1.NodeSkipped:
 			if !strings.HasPrefix(node.Name, onExitNodeName) && !doForceResetNode {
@@ -756,6 +771,13 @@ func retryWorkflow(ctx context.Context, kubeClient kubernetes.Interface, hydrato
 			if err != nil && !apierr.IsNotFound(err) {
 				return nil, errors.InternalWrapError(err)
 			}
+		} else if node.Type == wfv1.NodeTypeWorkflow {
+			// the child workflow is named after its node, so it must be deleted for the node to run again
+			log.Infof("Deleting child workflow: %s", node.ID)
+			err := wfClient.Delete(ctx, node.ID, metav1.DeleteOptions{})
+			if err != nil && !apierr.IsNotFound(err) {
+				return nil, errors.InternalWrapError(err)
+			}
 		} else if node.Name == wf.ObjectMeta.Name {
 			newNode := node.DeepCopy()
 			newNode.Phase = wfv1.NodeRunning
@@ -1033,7 +1055,7 @@ func GetNodeType(tmpl *wfv1.Template) wfv1.NodeType {
 		return wfv1.NodeTypeRetry
 	}
 	switch tmpl.GetType() {
-	case wfv1.TemplateTypeContainer, wfv1.TemplateTypeScript, wfv1.TemplateTypeResource:
+	case wfv1.TemplateTypeContainer, wfv1.TemplateTypeScript, wfv1.TemplateTypeResource, wfv1.TemplateTypeContainerSet:
 		return wfv1.NodeTypePod
 	case wfv1.TemplateTypeDAG:
 		return wfv1.NodeTypeDAG
@@ -1041,6 +1063,10 @@ func GetNodeType(tmpl *wfv1.Template) wfv1.NodeType {
 		return wfv1.NodeTypeSteps
 	case wfv1.TemplateTypeSuspend:
 		return wfv1.NodeTypeSuspend
+	case wfv1.TemplateTypeHTTP:
+		return wfv1.NodeTypeHTTP
+	case wfv1.TemplateTypeWorkflow:
+		return wfv1.NodeTypeWorkflow
 	}
 	return ""
 }
@@ -1,6 +1,7 @@
 package validate
 
 import (
+	"crypto/sha256"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -10,7 +11,7 @@ import (
 	"strings"
 	"time"
 
-	"github.com/robfig/cron/v3"
+	"github.com/antonmedv/expr"
 	"github.com/sirupsen/logrus"
 	"github.com/valyala/fasttemplate"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -21,11 +22,13 @@ import (
 	"github.com/simster7/argo/v2/errors"
 	wfv1 "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1"
 	"github.com/simster7/argo/v2/util"
+	argoexpr "github.com/simster7/argo/v2/util/expr"
 	"github.com/simster7/argo/v2/util/help"
 	"github.com/simster7/argo/v2/util/intstr"
 	"github.com/simster7/argo/v2/util/sorting"
 	"github.com/simster7/argo/v2/workflow/artifacts/hdfs"
 	"github.com/simster7/argo/v2/workflow/common"
+	"github.com/simster7/argo/v2/workflow/cron/schedule"
 	"github.com/simster7/argo/v2/workflow/metrics"
 	"github.com/simster7/argo/v2/workflow/templateresolution"
 )
@@ -61,6 +64,8 @@ type templateValidationCtx struct {
 	// wf is the Workflow resource which is used to validate templates.
 	// It will be omitted in WorkflowTemplate validation.
 	wf *wfv1.Workflow
+	// sensitiveWorkflowParams are the names of the sensitive workflow parameters
+	sensitiveWorkflowParams []string
 }
 
 func newTemplateValidationCtx(wf *wfv1.Workflow, opts ValidateOpts) *templateValidationCtx {
@@ -84,6 +89,8 @@ const (
 	anyItemMagicValue                    = "item.*"
 	anyWorkflowOutputParameterMagicValue = "workflow.outputs.parameters.*"
 	anyWorkflowOutputArtifactMagicValue  = "workflow.outputs.artifacts.*"
+	// inlineTemplateName is the name of inline templates in errors, which is not a valid template name
+	inlineTemplateName = "<inline>"
 )
 
 var (
@@ -142,6 +149,7 @@ func ValidateWorkflow(wftmplGetter templateresolution.WorkflowTemplateNamespaced
 	if wf.Spec.WorkflowTemplateRef != nil {
 		wfArgs.Parameters = util.MergeParameters(wfArgs.Parameters, wfSpecHolder.GetWorkflowSpec().Arguments.Parameters)
 		wfArgs.Artifacts = util.MergeArtifacts(wfArgs.Artifacts, wfSpecHolder.GetWorkflowSpec().Arguments.Artifacts)
+		wfArgs.Parameters = withParameterTypes(wfArgs.Parameters, wfSpecHolder.GetWorkflowSpec().Arguments.Parameters)
 	}
 	if err != nil {
 		return nil, errors.Errorf(errors.CodeBadRequest, "spec.templates%s", err.Error())
@@ -156,11 +164,22 @@ func ValidateWorkflow(wftmplGetter templateresolution.WorkflowTemplateNamespaced
 	if err != nil {
 		return nil, err
 	}
+	err = validateParameterTypes("spec.arguments.parameters.", wfArgs.Parameters)
+	if err != nil {
+		return nil, err
+	}
+	err = validateTemplateDefaults(wf.Spec.TemplateDefaults)
+	if err != nil {
+		return nil, err
+	}
 	if len(wfArgs.Parameters) > 0 {
 		ctx.globalParams[common.GlobalVarWorkflowParameters] = placeholderGenerator.NextPlaceholder()
 	}
 
 	for _, param := range wfArgs.Parameters {
+		if param.Name != "" && param.IsSensitive() {
+			ctx.sensitiveWorkflowParams = append(ctx.sensitiveWorkflowParams, param.Name)
+		}
 		if param.Name != "" {
 			if param.Value != nil {
 				ctx.globalParams["workflow.parameters."+param.Name] = param.Value.String()
@@ -237,6 +256,20 @@ func ValidateWorkflow(wftmplGetter templateresolution.WorkflowTemplateNamespaced
 			return nil, err
 		}
 	}
+	if err := ctx.validateSensitiveWorkflowParameterRefs("spec.hooks", wf.Spec.Hooks); err != nil {
+		return nil, err
+	}
+	if len(wf.Spec.Hooks) > 0 {
+		// the hooks of the workflow may refer to the global parameters, including {{workflow.status}}
+		scope := map[string]interface{}{common.GlobalVarWorkflowStatus: true}
+		for name := range ctx.globalParams {
+			scope[name] = true
+		}
+		err = ctx.validateLifecycleHooks("spec", wf.Spec.Hooks, tmplCtx, scope)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	if wf.Spec.PodGC != nil {
 		switch wf.Spec.PodGC.Strategy {
@@ -245,6 +278,10 @@ func ValidateWorkflow(wftmplGetter templateresolution.WorkflowTemplateNamespaced
 			return nil, errors.Errorf(errors.CodeBadRequest, "podGC.strategy unknown strategy '%s'", wf.Spec.PodGC.Strategy)
 		}
 	}
+	err = validateArtifactGC("artifactGC", wf.Spec.ArtifactGC)
+	if err != nil {
+		return nil, err
+	}
 
 	// Check if all templates can be resolved.
 	for _, template := range wf.Spec.Templates {
@@ -289,8 +326,12 @@ func ValidateClusterWorkflowTemplate(wftmplGetter templateresolution.WorkflowTem
 
 // ValidateCronWorkflow validates a CronWorkflow
 func ValidateCronWorkflow(wftmplGetter templateresolution.WorkflowTemplateNamespacedGetter, cwftmplGetter templateresolution.ClusterWorkflowTemplateGetter, cronWf *wfv1.CronWorkflow) error {
-	if _, err := cron.ParseStandard(cronWf.Spec.Schedule); err != nil {
-		return errors.Errorf(errors.CodeBadRequest, "cron schedule is malformed: %s", err)
+	cronSchedule, err := schedule.Parse(&cronWf.Spec)
+	if err != nil {
+		return errors.New(errors.CodeBadRequest, err.Error())
+	}
+	if cronSchedule.Next(time.Now()).IsZero() {
+		return errors.New(errors.CodeBadRequest, "cron workflow is never scheduled to run, as every scheduled time is excluded")
 	}
 
 	switch cronWf.Spec.ConcurrencyPolicy {
@@ -306,7 +347,7 @@ func ValidateCronWorkflow(wftmplGetter templateresolution.WorkflowTemplateNamesp
 
 	wf := common.ConvertCronWorkflowToWorkflow(cronWf)
 
-	_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	_, err = ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
 	if err != nil {
 		return errors.Errorf(errors.CodeBadRequest, "cannot validate Workflow: %s", err)
 	}
@@ -359,10 +400,13 @@ For more information, see: %s
 	return out
 }
 
-func (ctx *templateValidationCtx) validateTemplate(tmpl *wfv1.Template, tmplCtx *templateresolution.Context, args wfv1.ArgumentsProvider) error {
+func (ctx *templateValidationCtx) validateTemplate(tmplID string, tmpl *wfv1.Template, tmplCtx *templateresolution.Context, args wfv1.ArgumentsProvider) error {
 	if err := validateTemplateType(tmpl); err != nil {
 		return err
 	}
+	if err := ctx.validateSensitiveWorkflowParameters(tmpl); err != nil {
+		return err
+	}
 
 	scope, err := validateInputs(tmpl)
 	if err != nil {
@@ -408,7 +452,6 @@ func (ctx *templateValidationCtx) validateTemplate(tmpl *wfv1.Template, tmplCtx
 
 	}
 
-	tmplID := getTemplateID(tmpl)
 	_, ok := ctx.results[tmplID]
 	if ok {
 		// we can skip the rest since it has been validated.
@@ -438,6 +481,10 @@ func (ctx *templateValidationCtx) validateTemplate(tmpl *wfv1.Template, tmplCtx
 	if err != nil {
 		return err
 	}
+	err = ctx.validateEmissaryCommands(newTmpl)
+	if err != nil {
+		return err
+	}
 	if newTmpl.ArchiveLocation != nil {
 		errPrefix := fmt.Sprintf("templates.%s.archiveLocation", newTmpl.Name)
 		err = validateArtifactLocation(errPrefix, *newTmpl.ArchiveLocation)
@@ -445,6 +492,12 @@ func (ctx *templateValidationCtx) validateTemplate(tmpl *wfv1.Template, tmplCtx
 			return err
 		}
 	}
+	if newTmpl.Memoize != nil {
+		err = validateMemoize(newTmpl)
+		if err != nil {
+			return err
+		}
+	}
 	if newTmpl.Metrics != nil {
 		for _, metric := range newTmpl.Metrics.Prometheus {
 			if !metrics.IsValidMetricName(metric.Name) {
@@ -461,11 +514,30 @@ func (ctx *templateValidationCtx) validateTemplate(tmpl *wfv1.Template, tmplCtx
 	return nil
 }
 
+// validateMemoize validates that the memoized template uses exactly one cache
+func validateMemoize(tmpl *wfv1.Template) error {
+	cache := tmpl.Memoize.Cache
+	if cache == nil || (cache.ConfigMap == nil) == (cache.Database == nil) {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.memoize.cache must specify exactly one of configMap or database", tmpl.Name)
+	}
+	if cache.GetName() == "" {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.memoize.cache name is required", tmpl.Name)
+	}
+	return nil
+}
+
 // validateTemplateHolder validates a template holder and returns the validated template.
 func (ctx *templateValidationCtx) validateTemplateHolder(tmplHolder wfv1.TemplateReferenceHolder, tmplCtx *templateresolution.Context, args wfv1.ArgumentsProvider) (*wfv1.Template, error) {
 	tmplRef := tmplHolder.GetTemplateRef()
 	tmplName := tmplHolder.GetTemplateName()
-	if tmplRef != nil {
+	if inline := tmplHolder.GetTemplate(); inline != nil {
+		if tmplRef != nil || tmplName != "" {
+			return nil, errors.New(errors.CodeBadRequest, "inline template cannot be specified with template or templateRef.")
+		}
+		if err := validateInlineTemplate(inline); err != nil {
+			return nil, err
+		}
+	} else if tmplRef != nil {
 		if tmplName != "" {
 			return nil, errors.New(errors.CodeBadRequest, "template name cannot be specified with templateRef.")
 		}
@@ -514,15 +586,168 @@ func (ctx *templateValidationCtx) validateTemplateHolder(tmplHolder wfv1.Templat
 		default:
 			return nil, fmt.Errorf("%s is not a valid RetryPolicy", resolvedTmpl.RetryStrategy.RetryPolicy)
 		}
+		if err := ctx.validateRetryStrategyExpression(resolvedTmpl.RetryStrategy.Expression); err != nil {
+			return nil, err
+		}
+		if err := validateRetryOn(resolvedTmpl.RetryStrategy.RetryOn); err != nil {
+			return nil, err
+		}
+	}
+
+	tmplID := getTemplateID(resolvedTmpl)
+	if inline := tmplHolder.GetTemplate(); inline != nil {
+		// an inline template has no name, so it is named for its errors, and identified by its content
+		inlineBytes, err := json.Marshal(inline)
+		if err != nil {
+			return nil, errors.InternalWrapError(err)
+		}
+		resolvedTmpl.Name = inlineTemplateName
+		tmplID = fmt.Sprintf("%s %x", inlineTemplateName, sha256.Sum256(inlineBytes))
+	}
+
+	return resolvedTmpl, ctx.validateTemplate(tmplID, resolvedTmpl, tmplCtx, args)
+}
+
+// validateInlineTemplate validates the fields that an inline template cannot have. The nodes of the steps or tasks of
+// a steps or DAG template refer back to it by name, so an inline template cannot be one.
+func validateInlineTemplate(tmpl *wfv1.Template) error {
+	if tmpl.Name != "" {
+		return errors.New(errors.CodeBadRequest, "inline template cannot have a name")
+	}
+	if tmpl.Template != "" || tmpl.TemplateRef != nil {
+		return errors.New(errors.CodeBadRequest, "inline template cannot refer to another template")
+	}
+	switch tmpl.GetType() {
+	case wfv1.TemplateTypeSteps, wfv1.TemplateTypeDAG:
+		return errors.Errorf(errors.CodeBadRequest, "inline template cannot be of type %s", tmpl.GetType())
+	}
+	return nil
+}
+
+// validateTemplateDefaults validates that the template defaults only have fields that can be merged into any template
+func validateTemplateDefaults(tmpl *wfv1.Template) error {
+	if tmpl == nil {
+		return nil
+	}
+	if tmpl.Name != "" {
+		return errors.New(errors.CodeBadRequest, "spec.templateDefaults cannot have a name")
+	}
+	if tmpl.Template != "" || tmpl.TemplateRef != nil {
+		return errors.New(errors.CodeBadRequest, "spec.templateDefaults cannot refer to a template")
+	}
+	if tmpl.GetType() != wfv1.TemplateTypeUnknown {
+		return errors.Errorf(errors.CodeBadRequest, "spec.templateDefaults cannot be of type %s", tmpl.GetType())
+	}
+	return nil
+}
+
+// validateRetryStrategyExpression validates that the expression of a retry strategy evaluates to a boolean
+func (ctx *templateValidationCtx) validateRetryStrategyExpression(expression string) error {
+	if expression == "" {
+		return nil
+	}
+	scope := map[string]interface{}{
+		common.LocalVarRetries:             true,
+		common.LocalVarRetriesLastExitCode: true,
+		common.LocalVarRetriesLastStatus:   true,
+		common.LocalVarRetriesLastDuration: true,
+		common.LocalVarRetriesLastMessage:  true,
+	}
+	for globalVar, val := range ctx.globalParams {
+		scope[globalVar] = val
+	}
+	result, err := resolveExpression(scope, expression)
+	if err != nil {
+		return fmt.Errorf("retryStrategy.expression %w", err)
+	}
+	if _, ok := result.(bool); result != nil && !ok {
+		return fmt.Errorf("retryStrategy.expression '%s' must evaluate to a boolean, rather than %T", expression, result)
+	}
+	return nil
+}
+
+// validateRetryOn validates that each retryOn rule of a retry strategy has a condition, and that its message pattern
+// is a valid regular expression
+func validateRetryOn(rules []wfv1.RetryOn) error {
+	for i, rule := range rules {
+		if len(rule.ExitCodes) == 0 && len(rule.Reasons) == 0 && rule.MessagePattern == "" {
+			return errors.Errorf(errors.CodeBadRequest, "retryStrategy.retryOn[%d] must specify at least one of exitCodes, reasons or messagePattern", i)
+		}
+		if _, err := regexp.Compile(rule.MessagePattern); err != nil {
+			return errors.Errorf(errors.CodeBadRequest, "retryStrategy.retryOn[%d].messagePattern is invalid: %v", i, err)
+		}
 	}
+	return nil
+}
 
-	return resolvedTmpl, ctx.validateTemplate(resolvedTmpl, tmplCtx, args)
+// validateLoop validates the loop of a step or task, which runs the template
+func (ctx *templateValidationCtx) validateLoop(loop *wfv1.Loop, expand bool, tmpl *wfv1.Template) error {
+	if loop == nil {
+		return nil
+	}
+	if expand {
+		return errors.New(errors.CodeBadRequest, "loop cannot be used with withItems, withParam, withSequence or withArtifact")
+	}
+	if loop.MaxIterations <= 0 {
+		return errors.New(errors.CodeBadRequest, "loop.maxIterations must be greater than zero")
+	}
+	if loop.Delay != "" {
+		if _, err := strconv.Atoi(loop.Delay); err != nil {
+			if _, err := time.ParseDuration(loop.Delay); err != nil {
+				return errors.Errorf(errors.CodeBadRequest, "loop.delay '%s' is not a valid duration", loop.Delay)
+			}
+		}
+	}
+	if loop.Until == "" {
+		return nil
+	}
+	scope := map[string]interface{}{common.LocalVarLoopIndex: true}
+	addLoopOutputsToScope(tmpl, "outputs", scope)
+	if tmpl.Script != nil || tmpl.Container != nil || tmpl.ContainerSet != nil {
+		scope["outputs.exitCode"] = true
+	}
+	for globalVar, val := range ctx.globalParams {
+		scope[globalVar] = val
+	}
+	result, err := resolveExpression(scope, loop.Until)
+	if err != nil {
+		return fmt.Errorf("loop.until %w", err)
+	}
+	if _, ok := result.(bool); result != nil && !ok {
+		return fmt.Errorf("loop.until '%s' must evaluate to a boolean, rather than %T", loop.Until, result)
+	}
+	return nil
+}
+
+// loopScope returns the scope of the arguments of a step or task, which is the given scope plus, if the step or task
+// has a loop, the index of the iteration and the outputs of the previous iteration
+func loopScope(loop *wfv1.Loop, tmpl *wfv1.Template, scope map[string]interface{}) map[string]interface{} {
+	if loop == nil {
+		return scope
+	}
+	newScope := make(map[string]interface{}, len(scope))
+	for k, v := range scope {
+		newScope[k] = v
+	}
+	newScope[common.LocalVarLoopIndex] = true
+	addLoopOutputsToScope(tmpl, common.LocalVarLoopOutputsPrefix, newScope)
+	return newScope
+}
+
+// addLoopOutputsToScope adds the outputs of an iteration of a loop of the template to the scope, with the prefix
+func addLoopOutputsToScope(tmpl *wfv1.Template, prefix string, scope map[string]interface{}) {
+	if tmpl.Script != nil || tmpl.Container != nil || tmpl.ContainerSet != nil || tmpl.HTTP != nil {
+		scope[prefix+".result"] = true
+	}
+	for _, param := range tmpl.Outputs.Parameters {
+		scope[fmt.Sprintf("%s.parameters.%s", prefix, param.Name)] = true
+	}
 }
 
 // validateTemplateType validates that only one template type is defined
 func validateTemplateType(tmpl *wfv1.Template) error {
 	numTypes := 0
-	for _, tmplType := range []interface{}{tmpl.TemplateRef, tmpl.Container, tmpl.Steps, tmpl.Script, tmpl.Resource, tmpl.DAG, tmpl.Suspend} {
+	for _, tmplType := range []interface{}{tmpl.TemplateRef, tmpl.Container, tmpl.Steps, tmpl.Script, tmpl.Resource, tmpl.DAG, tmpl.Suspend, tmpl.ContainerSet, tmpl.HTTP, tmpl.Workflow} {
 		if !reflect.ValueOf(tmplType).IsNil() {
 			numTypes++
 		}
@@ -532,11 +757,11 @@ func validateTemplateType(tmpl *wfv1.Template) error {
 	}
 	switch numTypes {
 	case 0:
-		return errors.Errorf(errors.CodeBadRequest, "templates.%s template type unspecified. choose one of: container, steps, script, resource, dag, suspend, template, template ref", tmpl.Name)
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s template type unspecified. choose one of: container, steps, script, resource, dag, suspend, containerSet, http, workflow, template, template ref", tmpl.Name)
 	case 1:
 		// Do nothing
 	default:
-		return errors.Errorf(errors.CodeBadRequest, "templates.%s multiple template types specified. choose one of: container, steps, script, resource, dag, suspend, template, template ref", tmpl.Name)
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s multiple template types specified. choose one of: container, steps, script, resource, dag, suspend, containerSet, http, workflow, template, template ref", tmpl.Name)
 	}
 	return nil
 }
@@ -550,6 +775,23 @@ func validateInputs(tmpl *wfv1.Template) (map[string]interface{}, error) {
 	if err != nil {
 		return nil, errors.Errorf(errors.CodeBadRequest, "templates.%s.inputs.artifacts%s", tmpl.Name, err.Error())
 	}
+	err = validateParameterTypes(fmt.Sprintf("templates.%s.inputs.parameters.", tmpl.Name), tmpl.Inputs.Parameters)
+	if err != nil {
+		return nil, err
+	}
+	for _, param := range tmpl.Inputs.Parameters {
+		err = validateSecretKeyRef(fmt.Sprintf("templates.%s.inputs.parameters.", tmpl.Name), param)
+		if err != nil {
+			return nil, err
+		}
+		err = validateConfigMapKeyRef(fmt.Sprintf("templates.%s.inputs.parameters.", tmpl.Name), param)
+		if err != nil {
+			return nil, err
+		}
+		if param.IsSensitive() && !supportsSensitiveParameters(tmpl) {
+			return nil, errors.Errorf(errors.CodeBadRequest, "templates.%s.inputs.parameters.%s is sensitive, which is only valid in container, script and container set templates", tmpl.Name, param.Name)
+		}
+	}
 	scope := make(map[string]interface{})
 	for _, param := range tmpl.Inputs.Parameters {
 		scope[fmt.Sprintf("inputs.parameters.%s", param.Name)] = true
@@ -602,41 +844,121 @@ func validateArtifactLocation(errPrefix string, art wfv1.ArtifactLocation) error
 // resolveAllVariables is a helper to ensure all {{variables}} are resolveable from current scope
 func resolveAllVariables(scope map[string]interface{}, tmplStr string) error {
 	var unresolvedErr error
-	_, allowAllItemRefs := scope[anyItemMagicValue] // 'item.*' is a magic placeholder value set by addItemsToScope
-	_, allowAllWorkflowOutputParameterRefs := scope[anyWorkflowOutputParameterMagicValue]
-	_, allowAllWorkflowOutputArtifactRefs := scope[anyWorkflowOutputArtifactMagicValue]
 	fstTmpl, err := fasttemplate.NewTemplate(tmplStr, "{{", "}}")
 	if err != nil {
 		return fmt.Errorf("unable to parse argo varaible: %w", err)
 	}
 
 	fstTmpl.ExecuteFuncString(func(w io.Writer, tag string) (int, error) {
+		if expression, ok := common.ExpressionTag(tag); ok {
+			if _, err := resolveExpression(scope, expression); err != nil && unresolvedErr == nil {
+				unresolvedErr = fmt.Errorf("{{= %s}} %w", expression, err)
+			}
+			return 0, nil
+		}
 
 		// Skip the custom variable references
 		if !checkValidWorkflowVariablePrefix(tag) {
 			return 0, nil
 		}
 		_, ok := scope[tag]
-		if !ok && unresolvedErr == nil {
-			if (tag == "item" || strings.HasPrefix(tag, "item.")) && allowAllItemRefs {
-				// we are *probably* referencing a undetermined item using withParam
-				// NOTE: this is far from foolproof.
-			} else if strings.HasPrefix(tag, "workflow.outputs.parameters.") && allowAllWorkflowOutputParameterRefs {
-				// Allow runtime resolution of workflow output parameter names
-			} else if strings.HasPrefix(tag, "workflow.outputs.artifacts.") && allowAllWorkflowOutputArtifactRefs {
-				// Allow runtime resolution of workflow output artifact names
-			} else if strings.HasPrefix(tag, "outputs.") {
-				// We are self referencing for metric emission, allow it.
-			} else if strings.HasPrefix(tag, common.GlobalVarWorkflowCreationTimestamp) {
-			} else {
-				unresolvedErr = fmt.Errorf("failed to resolve {{%s}}", tag)
-			}
+		if !ok && unresolvedErr == nil && !isRuntimeVariable(scope, tag) {
+			unresolvedErr = fmt.Errorf("failed to resolve {{%s}}", tag)
 		}
 		return 0, nil
 	})
 	return unresolvedErr
 }
 
+// isRuntimeVariable returns whether a variable that is not in scope may be resolved at runtime
+func isRuntimeVariable(scope map[string]interface{}, tag string) bool {
+	_, allowAllItemRefs := scope[anyItemMagicValue] // 'item.*' is a magic placeholder value set by addItemsToScope
+	_, allowAllWorkflowOutputParameterRefs := scope[anyWorkflowOutputParameterMagicValue]
+	_, allowAllWorkflowOutputArtifactRefs := scope[anyWorkflowOutputArtifactMagicValue]
+	switch {
+	case (tag == "item" || strings.HasPrefix(tag, "item.")) && allowAllItemRefs:
+		// we are *probably* referencing a undetermined item using withParam
+		// NOTE: this is far from foolproof.
+		return true
+	case strings.HasPrefix(tag, "workflow.outputs.parameters.") && allowAllWorkflowOutputParameterRefs:
+		// Allow runtime resolution of workflow output parameter names
+		return true
+	case strings.HasPrefix(tag, "workflow.outputs.artifacts.") && allowAllWorkflowOutputArtifactRefs:
+		// Allow runtime resolution of workflow output artifact names
+		return true
+	case strings.HasPrefix(tag, "outputs."):
+		// We are self referencing for metric emission, allow it.
+		return true
+	case strings.HasPrefix(tag, common.GlobalVarWorkflowCreationTimestamp):
+		return true
+	}
+	return false
+}
+
+// resolveExpression validates the expression of an expression tag, e.g. `{{= asInt(inputs.parameters.x) + 1}}`. The
+// variables it refers to must be in scope. Unless some of them can only be resolved at runtime, it is evaluated with
+// placeholder values, so that type errors, such as comparing a string to a number, are found. The result is returned
+// if it was evaluated.
+func resolveExpression(scope map[string]interface{}, expression string) (interface{}, error) {
+	names, err := argoexpr.Variables(expression)
+	if err != nil {
+		return nil, err
+	}
+	// a placeholder that can be converted to a number, as any parameter may be one
+	params := make(map[string]string, len(scope))
+	for name := range scope {
+		params[name] = "1"
+	}
+	env := argoexpr.EnvMap(params)
+	evaluate := true
+	for _, name := range names {
+		if argoexpr.HasVariable(env, name) || isVariablePrefix(names, name) {
+			continue
+		}
+		if isRuntimeVariable(scope, name) {
+			evaluate = false
+			continue
+		}
+		return nil, fmt.Errorf("failed to resolve %s", name)
+	}
+	if !evaluate {
+		return nil, nil
+	}
+	return argoexpr.Eval(expression, env)
+}
+
+// isVariablePrefix returns whether the variable is the prefix of another, e.g. `steps.a` of `steps.a.status`, in which
+// case it is resolved if the other is
+func isVariablePrefix(names []string, name string) bool {
+	for _, other := range names {
+		if strings.HasPrefix(other, name+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// validateWhen validates that a `when` that is an expression tag, e.g. `{{= steps.flip.outputs.result == "heads"}}`,
+// evaluates to a boolean
+func validateWhen(scope map[string]interface{}, when string) error {
+	when = strings.TrimSpace(when)
+	if !strings.HasPrefix(when, "{{") || !strings.HasSuffix(when, "}}") || strings.Count(when, "{{") != 1 {
+		return nil
+	}
+	expression, ok := common.ExpressionTag(strings.TrimSuffix(strings.TrimPrefix(when, "{{"), "}}"))
+	if !ok {
+		return nil
+	}
+	result, err := resolveExpression(scope, expression)
+	if err != nil {
+		return fmt.Errorf("when %w", err)
+	}
+	if _, ok := result.(bool); result != nil && !ok {
+		return fmt.Errorf("when '%s' must evaluate to a boolean, rather than %T", when, result)
+	}
+	return nil
+}
+
 // checkValidWorkflowVariablePrefix is a helper methood check variable starts workflow root elements
 func checkValidWorkflowVariablePrefix(tag string) bool {
 	for _, rootTag := range common.GlobalVarValidWorkflowVariablePrefix {
@@ -705,6 +1027,24 @@ func (ctx *templateValidationCtx) validateLeaf(scope map[string]interface{}, tmp
 			return errors.Errorf(errors.CodeBadRequest, "templates.%s.script.image may not be empty", tmpl.Name)
 		}
 	}
+	if tmpl.ContainerSet != nil {
+		err := validateContainerSet(tmpl)
+		if err != nil {
+			return err
+		}
+	}
+	if tmpl.HTTP != nil {
+		err := validateHTTP(tmpl)
+		if err != nil {
+			return err
+		}
+	}
+	if tmpl.Workflow != nil {
+		err := validateChildWorkflow(tmpl)
+		if err != nil {
+			return err
+		}
+	}
 	if tmpl.ActiveDeadlineSeconds != nil {
 		if !intstr.IsValidIntOrArgoVariable(tmpl.ActiveDeadlineSeconds) && !placeholderGenerator.IsPlaceholder(tmpl.ActiveDeadlineSeconds.StrVal) {
 			return errors.Errorf(errors.CodeBadRequest, "templates.%s.activeDeadlineSeconds must be a positive integer > 0 or an argo variable", tmpl.Name)
@@ -734,6 +1074,125 @@ func (ctx *templateValidationCtx) validateLeaf(scope map[string]interface{}, tmp
 	return nil
 }
 
+// validateHTTP validates the request of a HTTP template, and that the template only has outputs the controller can
+// produce from the response
+func validateHTTP(tmpl *wfv1.Template) error {
+	if tmpl.HTTP.URL == "" {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.http.url may not be empty", tmpl.Name)
+	}
+	if !placeholderGenerator.IsPlaceholder(tmpl.HTTP.Method) {
+		switch tmpl.HTTP.GetMethod() {
+		case "GET", "HEAD", "POST", "PUT", "PATCH", "DELETE", "OPTIONS":
+			// OK
+		default:
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.http.method must be one of: GET, HEAD, POST, PUT, PATCH, DELETE, OPTIONS", tmpl.Name)
+		}
+	}
+	if tmpl.HTTP.TimeoutSeconds != nil && (*tmpl.HTTP.TimeoutSeconds <= 0 || *tmpl.HTTP.TimeoutSeconds > 60) {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.http.timeoutSeconds must be between 1 and 60", tmpl.Name)
+	}
+	for i, header := range tmpl.HTTP.Headers {
+		if header.Name == "" {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.http.headers[%d].name may not be empty", tmpl.Name, i)
+		}
+		if header.ValueFrom != nil {
+			if header.Value != "" {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.http.headers.%s may not have both a value and valueFrom", tmpl.Name, header.Name)
+			}
+			if header.ValueFrom.SecretKeyRef == nil || header.ValueFrom.SecretKeyRef.Name == "" || header.ValueFrom.SecretKeyRef.Key == "" {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.http.headers.%s.valueFrom.secretKeyRef must have a name and a key", tmpl.Name, header.Name)
+			}
+		}
+	}
+	if tmpl.HTTP.SuccessCondition != "" {
+		if _, err := expr.Compile(tmpl.HTTP.SuccessCondition); err != nil {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.http.successCondition is invalid: %s", tmpl.Name, err)
+		}
+	}
+	if len(tmpl.Inputs.Artifacts) > 0 || len(tmpl.Outputs.Artifacts) > 0 {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s artifacts are not supported by http templates", tmpl.Name)
+	}
+	if len(tmpl.Outputs.Parameters) > 0 {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.outputs.parameters are not supported by http templates, use outputs.result", tmpl.Name)
+	}
+	return nil
+}
+
+// validateChildWorkflow validates the workflow template reference and the arguments of the child workflow
+func validateChildWorkflow(tmpl *wfv1.Template) error {
+	if tmpl.Workflow.WorkflowTemplateRef == nil || tmpl.Workflow.WorkflowTemplateRef.Name == "" {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.workflow.workflowTemplateRef.name is required", tmpl.Name)
+	}
+	return validateArguments(fmt.Sprintf("templates.%s.workflow.arguments.", tmpl.Name), tmpl.Workflow.Arguments)
+}
+
+// validateContainerSet validates the containers of a container set, and that their dependencies do not form a cycle
+func validateContainerSet(tmpl *wfv1.Template) error {
+	if len(tmpl.ContainerSet.Containers) == 0 {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.containerSet.containers must have at least one container", tmpl.Name)
+	}
+	dependencies := make(map[string][]string)
+	for i, ctr := range tmpl.ContainerSet.Containers {
+		if ctr.Name == "" {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.containerSet.containers[%d].name may not be empty", tmpl.Name, i)
+		}
+		if ctr.Name == common.WaitContainerName || ctr.Name == common.InitContainerName {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.containerSet.containers[%d].name '%s' is reserved", tmpl.Name, i, ctr.Name)
+		}
+		if _, ok := dependencies[ctr.Name]; ok {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.containerSet.containers[%d].name '%s' is not unique", tmpl.Name, i, ctr.Name)
+		}
+		if ctr.Image == "" {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.containerSet.containers.%s.image may not be empty", tmpl.Name, ctr.Name)
+		}
+		// the command is wrapped so that the container waits for its dependencies, so it cannot be left to the image
+		if len(ctr.Command) == 0 {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.containerSet.containers.%s.command must be specified", tmpl.Name, ctr.Name)
+		}
+		dependencies[ctr.Name] = ctr.Dependencies
+	}
+	for _, ctr := range tmpl.ContainerSet.Containers {
+		for _, depName := range ctr.Dependencies {
+			if _, ok := dependencies[depName]; !ok {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.containerSet.containers.%s.dependencies references non-existent container '%s'", tmpl.Name, ctr.Name, depName)
+			}
+		}
+	}
+	visited := make(map[string]bool)
+	var noCyclesHelper func(name string, cycle []string) error
+	noCyclesHelper = func(name string, cycle []string) error {
+		if visited[name] {
+			return nil
+		}
+		for _, depName := range dependencies[name] {
+			for _, n := range cycle {
+				if n == depName {
+					return errors.Errorf(errors.CodeBadRequest,
+						"templates.%s.containerSet.containers dependency cycle detected: %s->%s",
+						tmpl.Name, strings.Join(cycle, "->"), depName)
+				}
+			}
+			err := noCyclesHelper(depName, append(cycle, depName))
+			if err != nil {
+				return err
+			}
+		}
+		visited[name] = true
+		return nil
+	}
+	for _, ctr := range tmpl.ContainerSet.Containers {
+		err := noCyclesHelper(ctr.Name, []string{ctr.Name})
+		if err != nil {
+			return err
+		}
+	}
+	hasOutputs := len(tmpl.Outputs.Parameters) > 0 || len(tmpl.Outputs.Artifacts) > 0 || (tmpl.ArchiveLocation != nil && tmpl.ArchiveLocation.ArchiveLogs != nil && *tmpl.ArchiveLocation.ArchiveLogs)
+	if hasOutputs && !tmpl.ContainerSet.HasContainerNamed(common.MainContainerName) {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.containerSet.containers must have a container named '%s' for the outputs to be collected from", tmpl.Name, common.MainContainerName)
+	}
+	return nil
+}
+
 func validateArguments(prefix string, arguments wfv1.Arguments) error {
 	err := validateArgumentsFieldNames(prefix, arguments)
 	if err != nil {
@@ -756,10 +1215,153 @@ func validateArgumentsFieldNames(prefix string, arguments wfv1.Arguments) error
 	return nil
 }
 
+// withParameterTypes returns the parameters with the types and schemas they are declared with, as the parameters that a
+// workflow passes to its workflow template only have values
+func withParameterTypes(params, declaredParams []wfv1.Parameter) []wfv1.Parameter {
+	result := make([]wfv1.Parameter, len(params))
+	for i, param := range params {
+		for _, declaredParam := range declaredParams {
+			if declaredParam.Name != param.Name {
+				continue
+			}
+			if param.Type == "" {
+				param.Type = declaredParam.Type
+			}
+			if param.Schema == nil {
+				param.Schema = declaredParam.Schema
+			}
+		}
+		result[i] = param
+	}
+	return result
+}
+
+// validateParameterTypes validates the types and schemas of the parameters, and their values, where they are known
+func validateParameterTypes(prefix string, params []wfv1.Parameter) error {
+	for _, param := range params {
+		if err := common.ValidateParameterDeclaration(param); err != nil {
+			return errors.Errorf(errors.CodeBadRequest, "%s%s.%v", prefix, param.Name, err)
+		}
+		if param.Default != nil {
+			if err := common.ValidateParameterValue(param, param.Default.String()); err != nil {
+				return errors.Errorf(errors.CodeBadRequest, "%s%s.default: %v", prefix, param.Name, err)
+			}
+		}
+		if param.Value != nil {
+			if err := common.ValidateParameterValue(param, param.Value.String()); err != nil {
+				return errors.Errorf(errors.CodeBadRequest, "%s%s.value: %v", prefix, param.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// validateSecretKeyRef validates the secret that the value of the parameter is from, if any
+func validateSecretKeyRef(prefix string, param wfv1.Parameter) error {
+	if param.ValueFrom == nil || param.ValueFrom.SecretKeyRef == nil {
+		return nil
+	}
+	if param.ValueFrom.SecretKeyRef.Name == "" {
+		return errors.Errorf(errors.CodeBadRequest, "%s%s.valueFrom.secretKeyRef.name is required", prefix, param.Name)
+	}
+	if param.ValueFrom.SecretKeyRef.Key == "" {
+		return errors.Errorf(errors.CodeBadRequest, "%s%s.valueFrom.secretKeyRef.key is required", prefix, param.Name)
+	}
+	return nil
+}
+
+// validateConfigMapKeyRef validates the config map that the value of the parameter is from, if any
+func validateConfigMapKeyRef(prefix string, param wfv1.Parameter) error {
+	if param.ValueFrom == nil || param.ValueFrom.ConfigMapKeyRef == nil {
+		return nil
+	}
+	if param.ValueFrom.SecretKeyRef != nil {
+		return errors.Errorf(errors.CodeBadRequest, "%s%s.valueFrom only one of secretKeyRef or configMapKeyRef can be specified", prefix, param.Name)
+	}
+	if param.ValueFrom.ConfigMapKeyRef.Name == "" {
+		return errors.Errorf(errors.CodeBadRequest, "%s%s.valueFrom.configMapKeyRef.name is required", prefix, param.Name)
+	}
+	if param.ValueFrom.ConfigMapKeyRef.Key == "" {
+		return errors.Errorf(errors.CodeBadRequest, "%s%s.valueFrom.configMapKeyRef.key is required", prefix, param.Name)
+	}
+	return nil
+}
+
+// supportsSensitiveParameters returns whether the template runs a pod whose containers the values of sensitive
+// parameters can be made available to, as they are not substituted by the controller
+func supportsSensitiveParameters(tmpl *wfv1.Template) bool {
+	switch tmpl.GetType() {
+	case wfv1.TemplateTypeContainer, wfv1.TemplateTypeScript, wfv1.TemplateTypeContainerSet:
+		return true
+	}
+	return false
+}
+
+// validateSensitiveWorkflowParameters checks that the sensitive workflow parameters are only referred to where their
+// values are made available: in the inputs and containers of container, script and container set templates, and in the
+// arguments passed to other templates. They are substituted with a reference to an environment variable of the pod,
+// which is not expanded elsewhere, e.g. in the URL of an HTTP request, a manifest or a when expression.
+func (ctx *templateValidationCtx) validateSensitiveWorkflowParameters(tmpl *wfv1.Template) error {
+	if len(ctx.sensitiveWorkflowParams) == 0 {
+		return nil
+	}
+	prefix := fmt.Sprintf("templates.%s", tmpl.Name)
+	other := tmpl.DeepCopy()
+	switch tmpl.GetType() {
+	case wfv1.TemplateTypeContainer, wfv1.TemplateTypeScript, wfv1.TemplateTypeContainerSet:
+		other.Inputs = wfv1.Inputs{}
+		other.Container = nil
+		other.Script = nil
+		other.ContainerSet = nil
+	case wfv1.TemplateTypeSteps:
+		other.Steps = nil
+		for i, parallelSteps := range tmpl.Steps {
+			for _, step := range parallelSteps.Steps {
+				// inline templates are validated as templates
+				step.Arguments = wfv1.Arguments{}
+				step.Inline = nil
+				if err := ctx.validateSensitiveWorkflowParameterRefs(fmt.Sprintf("%s.steps[%d].%s", prefix, i, step.Name), step); err != nil {
+					return err
+				}
+			}
+		}
+	case wfv1.TemplateTypeDAG:
+		other.DAG = nil
+		for _, task := range tmpl.DAG.Tasks {
+			task.Arguments = wfv1.Arguments{}
+			task.Inline = nil
+			if err := ctx.validateSensitiveWorkflowParameterRefs(fmt.Sprintf("%s.dag.tasks.%s", prefix, task.Name), task); err != nil {
+				return err
+			}
+		}
+	}
+	return ctx.validateSensitiveWorkflowParameterRefs(prefix, other)
+}
+
+// validateSensitiveWorkflowParameterRefs checks that the value does not refer to any sensitive workflow parameter
+func (ctx *templateValidationCtx) validateSensitiveWorkflowParameterRefs(prefix string, value interface{}) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return errors.InternalWrapError(err)
+	}
+	for _, name := range ctx.sensitiveWorkflowParams {
+		if regexp.MustCompile(`{{[^}]*\bworkflow\.parameters\.` + regexp.QuoteMeta(name) + `([^a-zA-Z0-9_.-][^}]*)?}}`).Match(data) {
+			return errors.Errorf(errors.CodeBadRequest, "%s refers to the sensitive workflow parameter '%s', which is only valid in the inputs and containers of container, script and container set templates, and in arguments", prefix, name)
+		}
+	}
+	return nil
+}
+
 // validateArgumentsValues ensures that all arguments have parameter values or artifact locations
 func validateArgumentsValues(prefix string, arguments wfv1.Arguments) error {
 	for _, param := range arguments.Parameters {
-		if param.Value == nil {
+		if err := validateSecretKeyRef(prefix, param); err != nil {
+			return err
+		}
+		if err := validateConfigMapKeyRef(prefix, param); err != nil {
+			return err
+		}
+		if param.Value == nil && (param.ValueFrom == nil || (param.ValueFrom.SecretKeyRef == nil && param.ValueFrom.ConfigMapKeyRef == nil)) {
 			return errors.Errorf(errors.CodeBadRequest, "%s%s.value is required", prefix, param.Name)
 		}
 		if param.Enum != nil {
@@ -808,10 +1410,19 @@ func (ctx *templateValidationCtx) validateSteps(scope map[string]interface{}, tm
 			stepNames[step.Name] = true
 			prefix := fmt.Sprintf("steps.%s", step.Name)
 			scope[fmt.Sprintf("%s.status", prefix)] = true
-			err := addItemsToScope(prefix, step.WithItems, step.WithParam, step.WithSequence, scope)
+			err := addItemsToScope(prefix, step.WithItems, step.WithParam, step.WithSequence, step.WithArtifact, scope)
 			if err != nil {
 				return errors.Errorf(errors.CodeBadRequest, "templates.%s.steps[%d].%s %s", tmpl.Name, i, step.Name, err.Error())
 			}
+			if step.WithArtifact != nil {
+				other := step
+				other.Arguments = wfv1.Arguments{}
+				other.When = ""
+				other.Inline = nil
+				if err := validateWithArtifactItems(other); err != nil {
+					return errors.Errorf(errors.CodeBadRequest, "templates.%s.steps[%d].%s %s", tmpl.Name, i, step.Name, err.Error())
+				}
+			}
 			err = validateArguments(fmt.Sprintf("templates.%s.steps[%d].%s.arguments.", tmpl.Name, i, step.Name), step.Arguments)
 			if err != nil {
 				return err
@@ -820,20 +1431,35 @@ func (ctx *templateValidationCtx) validateSteps(scope map[string]interface{}, tm
 			if err != nil {
 				return errors.Errorf(errors.CodeBadRequest, "templates.%s.steps[%d].%s %s", tmpl.Name, i, step.Name, err.Error())
 			}
+			if err := ctx.validateLoop(step.Loop, step.ShouldExpand(), resolvedTmpl); err != nil {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.steps[%d].%s %s", tmpl.Name, i, step.Name, err.Error())
+			}
 			resolvedTemplates[step.Name] = resolvedTmpl
 		}
 
-		stepBytes, err := json.Marshal(stepGroup)
-		if err != nil {
-			return errors.InternalWrapError(err)
+		// the hooks are validated once the outputs of their steps are in scope, as they may refer to them, and the
+		// inline templates are validated with their own inputs
+		for _, step := range stepGroup.Steps {
+			stepWithoutHooks := step.DeepCopy()
+			stepWithoutHooks.Hooks = nil
+			stepWithoutHooks.Inline = nil
+			stepBytes, err := json.Marshal(stepWithoutHooks)
+			if err != nil {
+				return errors.InternalWrapError(err)
+			}
+			err = resolveAllVariables(loopScope(step.Loop, resolvedTemplates[step.Name], scope), string(stepBytes))
+			if err != nil {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.steps %s", tmpl.Name, err.Error())
+			}
 		}
-		err = resolveAllVariables(scope, string(stepBytes))
-		if err != nil {
-			return errors.Errorf(errors.CodeBadRequest, "templates.%s.steps %s", tmpl.Name, err.Error())
+		for _, step := range stepGroup.Steps {
+			if err := validateWhen(scope, step.When); err != nil {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.steps.%s %s", tmpl.Name, step.Name, err.Error())
+			}
 		}
 
 		for _, step := range stepGroup.Steps {
-			aggregate := len(step.WithItems) > 0 || step.WithParam != ""
+			aggregate := len(step.WithItems) > 0 || step.WithParam != "" || step.WithArtifact != nil
 			resolvedTmpl := resolvedTemplates[step.Name]
 			ctx.addOutputsToScope(resolvedTmpl, fmt.Sprintf("steps.%s", step.Name), scope, aggregate, false)
 
@@ -842,12 +1468,52 @@ func (ctx *templateValidationCtx) validateSteps(scope map[string]interface{}, tm
 			if err != nil {
 				return errors.Errorf(errors.CodeBadRequest, "templates.%s.steps[%d].%s %s", tmpl.Name, i, step.Name, err.Error())
 			}
+			err = ctx.validateLifecycleHooks(fmt.Sprintf("templates.%s.steps[%d].%s", tmpl.Name, i, step.Name), step.Hooks, tmplCtx, scope)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// validateLifecycleHooks validates the hooks of the workflow, a step or a task, whose arguments may refer to the scope
+func (ctx *templateValidationCtx) validateLifecycleHooks(prefix string, hooks wfv1.LifecycleHooks, tmplCtx *templateresolution.Context, scope map[string]interface{}) error {
+	for name, hook := range hooks {
+		hookRef := fmt.Sprintf("%s.hooks.%s", prefix, name)
+		if errs := isValidWorkflowFieldName(name); len(errs) != 0 {
+			return errors.Errorf(errors.CodeBadRequest, "%s name is invalid: %s", hookRef, strings.Join(errs, ";"))
+		}
+		if hook.Expression == "" {
+			return errors.Errorf(errors.CodeBadRequest, "%s.expression is required", hookRef)
+		}
+		if _, err := expr.Compile(hook.Expression); err != nil {
+			return errors.Errorf(errors.CodeBadRequest, "%s.expression is invalid: %s", hookRef, err)
+		}
+		hookBytes, err := json.Marshal(hook)
+		if err != nil {
+			return errors.InternalWrapError(err)
+		}
+		err = resolveAllVariables(scope, string(hookBytes))
+		if err != nil {
+			return errors.Errorf(errors.CodeBadRequest, "%s %s", hookRef, err.Error())
+		}
+		err = validateArguments(fmt.Sprintf("%s.arguments.", hookRef), hook.Arguments)
+		if err != nil {
+			return err
+		}
+		// validate the template with fake arguments first, as a step does, then again with the actual arguments
+		for _, args := range []wfv1.ArgumentsProvider{&FakeArguments{}, &hook.Arguments} {
+			_, err = ctx.validateTemplateHolder(&wfv1.WorkflowStep{Template: hook.Template, TemplateRef: hook.TemplateRef}, tmplCtx, args)
+			if err != nil {
+				return errors.Errorf(errors.CodeBadRequest, "%s %s", hookRef, err.Error())
+			}
 		}
 	}
 	return nil
 }
 
-func addItemsToScope(prefix string, withItems []wfv1.Item, withParam string, withSequence *wfv1.Sequence, scope map[string]interface{}) error {
+func addItemsToScope(prefix string, withItems []wfv1.Item, withParam string, withSequence *wfv1.Sequence, withArtifact *wfv1.WithArtifact, scope map[string]interface{}) error {
 	defined := 0
 	if len(withItems) > 0 {
 		defined++
@@ -858,8 +1524,11 @@ func addItemsToScope(prefix string, withItems []wfv1.Item, withParam string, wit
 	if withSequence != nil {
 		defined++
 	}
+	if withArtifact != nil {
+		defined++
+	}
 	if defined > 1 {
-		return fmt.Errorf("only one of withItems, withParam, withSequence can be specified")
+		return fmt.Errorf("only one of withItems, withParam, withSequence, withArtifact can be specified")
 	}
 	if len(withItems) > 0 {
 		for i := range withItems {
@@ -889,6 +1558,32 @@ func addItemsToScope(prefix string, withItems []wfv1.Item, withParam string, wit
 			return errors.New(errors.CodeBadRequest, "only one of count or end can be defined in withSequence")
 		}
 		scope["item"] = true
+	} else if withArtifact != nil {
+		if withArtifact.From == "" && !withArtifact.HasLocation() {
+			return errors.New(errors.CodeBadRequest, "withArtifact must have either from or an artifact location")
+		}
+		if withArtifact.BatchSize < 0 {
+			return errors.New(errors.CodeBadRequest, "withArtifact.batchSize cannot be negative")
+		}
+		// as with withParam, the items are only known at runtime
+		scope["item"] = true
+		scope[anyItemMagicValue] = true
+	}
+	return nil
+}
+
+// withArtifactItemRegex matches a reference to the item of a withArtifact, e.g. `{{item}}` or `{{item.name}}`
+var withArtifactItemRegex = regexp.MustCompile(`{{\s*item\b`)
+
+// validateWithArtifactItems checks that the items of a withArtifact are only used in the arguments and the when of the
+// step or task, which is given without them, as the other fields are not rebuilt from the nodes of its children
+func validateWithArtifactItems(stepOrTask interface{}) error {
+	data, err := json.Marshal(stepOrTask)
+	if err != nil {
+		return errors.InternalWrapError(err)
+	}
+	if withArtifactItemRegex.Match(data) {
+		return errors.New(errors.CodeBadRequest, "withArtifact items can only be used in the arguments and the when")
 	}
 	return nil
 }
@@ -897,10 +1592,13 @@ func (ctx *templateValidationCtx) addOutputsToScope(tmpl *wfv1.Template, prefix
 	if tmpl.Daemon != nil && *tmpl.Daemon {
 		scope[fmt.Sprintf("%s.ip", prefix)] = true
 	}
-	if tmpl.Script != nil || tmpl.Container != nil {
+	if tmpl.Script != nil || tmpl.Container != nil || tmpl.ContainerSet != nil {
 		scope[fmt.Sprintf("%s.outputs.result", prefix)] = true
 		scope[fmt.Sprintf("%s.exitCode", prefix)] = true
 	}
+	if tmpl.HTTP != nil {
+		scope[fmt.Sprintf("%s.outputs.result", prefix)] = true
+	}
 	for _, param := range tmpl.Outputs.Parameters {
 		scope[fmt.Sprintf("%s.outputs.parameters.%s", prefix, param.Name)] = true
 		if param.GlobalName != "" {
@@ -934,6 +1632,8 @@ func (ctx *templateValidationCtx) addOutputsToScope(tmpl *wfv1.Template, prefix
 		case wfv1.TemplateTypeScript:
 			scope[fmt.Sprintf("%s.outputs.result", prefix)] = true
 			scope[fmt.Sprintf("%s.exitCode", prefix)] = true
+		case wfv1.TemplateTypeHTTP:
+			scope[fmt.Sprintf("%s.outputs.result", prefix)] = true
 		default:
 			scope[fmt.Sprintf("%s.outputs.parameters", prefix)] = true
 		}
@@ -943,6 +1643,20 @@ func (ctx *templateValidationCtx) addOutputsToScope(tmpl *wfv1.Template, prefix
 	}
 }
 
+// validateArtifactGC checks that the strategy is one of the known ones, as artifacts with any other strategy would never
+// be deleted. An empty strategy is that of the workflow, or Never.
+func validateArtifactGC(prefix string, artifactGC *wfv1.ArtifactGC) error {
+	if artifactGC == nil {
+		return nil
+	}
+	switch artifactGC.Strategy {
+	case "", wfv1.ArtifactGCOnWorkflowCompletion, wfv1.ArtifactGCOnWorkflowDeletion, wfv1.ArtifactGCNever:
+		return nil
+	default:
+		return errors.Errorf(errors.CodeBadRequest, "%s.strategy unknown strategy '%s'", prefix, artifactGC.Strategy)
+	}
+}
+
 func validateOutputs(scope map[string]interface{}, tmpl *wfv1.Template) error {
 	err := validateWorkflowFieldNames(tmpl.Outputs.Parameters)
 	if err != nil {
@@ -978,17 +1692,28 @@ func validateOutputs(scope map[string]interface{}, tmpl *wfv1.Template) error {
 				return errors.Errorf(errors.CodeBadRequest, "templates.%s.%s.globalName: %s", tmpl.Name, artRef, errs[0])
 			}
 		}
+		err = validateArtifactGC(fmt.Sprintf("templates.%s.%s.artifactGC", tmpl.Name, artRef), art.ArtifactGC)
+		if err != nil {
+			return err
+		}
 	}
 	for _, param := range tmpl.Outputs.Parameters {
 		paramRef := fmt.Sprintf("templates.%s.outputs.parameters.%s", tmpl.Name, param.Name)
-		err = validateOutputParameter(paramRef, &param)
-		if err != nil {
-			return err
+		if tmpl.GetType() == wfv1.TemplateTypeWorkflow {
+			// the value is that of the output parameter of the child workflow with the same name
+			if param.Value != nil || param.ValueFrom != nil {
+				return errors.Errorf(errors.CodeBadRequest, "%s may not have value or valueFrom specified, its value is the output parameter of the child workflow with the same name", paramRef)
+			}
+		} else {
+			err = validateOutputParameter(paramRef, &param)
+			if err != nil {
+				return err
+			}
 		}
 		if param.ValueFrom != nil {
 			tmplType := tmpl.GetType()
 			switch tmplType {
-			case wfv1.TemplateTypeContainer, wfv1.TemplateTypeScript:
+			case wfv1.TemplateTypeContainer, wfv1.TemplateTypeScript, wfv1.TemplateTypeContainerSet:
 				if param.ValueFrom.Path == "" {
 					return errors.Errorf(errors.CodeBadRequest, "%s.path must be specified for %s templates", paramRef, tmplType)
 				}
@@ -1008,6 +1733,16 @@ func validateOutputs(scope map[string]interface{}, tmpl *wfv1.Template) error {
 				return errors.Errorf(errors.CodeBadRequest, "%s.globalName: %s", paramRef, errs[0])
 			}
 		}
+		if param.ExportTo != nil {
+			switch {
+			case param.ExportTo.ConfigMapKeyRef == nil:
+				return errors.Errorf(errors.CodeBadRequest, "%s.exportTo.configMapKeyRef is required", paramRef)
+			case param.ExportTo.ConfigMapKeyRef.Name == "":
+				return errors.Errorf(errors.CodeBadRequest, "%s.exportTo.configMapKeyRef.name is required", paramRef)
+			case param.ExportTo.ConfigMapKeyRef.Key == "":
+				return errors.Errorf(errors.CodeBadRequest, "%s.exportTo.configMapKeyRef.key is required", paramRef)
+			}
+		}
 	}
 	return nil
 }
@@ -1019,8 +1754,8 @@ func (ctx *templateValidationCtx) validateBaseImageOutputs(tmpl *wfv1.Template)
 		return nil
 	}
 	switch ctx.ContainerRuntimeExecutor {
-	case "", common.ContainerRuntimeExecutorDocker:
-		// docker executor supports all modes of artifact outputs
+	case "", common.ContainerRuntimeExecutorDocker, common.ContainerRuntimeExecutorEmissary:
+		// docker and emissary executors support all modes of artifact outputs
 	case common.ContainerRuntimeExecutorPNS:
 		// pns supports copying from the base image, but only if there is no volume mount underneath it
 		errMsg := "pns executor does not support outputs from base image layer with volume mounts. Use an emptyDir: https://argoproj.github.io/argo/empty-dir/"
@@ -1042,6 +1777,13 @@ func (ctx *templateValidationCtx) validateBaseImageOutputs(tmpl *wfv1.Template)
 						}
 					}
 				}
+				if tmpl.ContainerSet != nil {
+					for _, volMnt := range tmpl.ContainerSet.VolumeMounts {
+						if strings.HasPrefix(volMnt.MountPath, out.Path+"/") {
+							return errors.Errorf(errors.CodeBadRequest, "templates.%s.outputs.artifacts.%s: %s", tmpl.Name, out.Name, errMsg)
+						}
+					}
+				}
 			}
 		}
 	case common.ContainerRuntimeExecutorK8sAPI, common.ContainerRuntimeExecutorKubelet:
@@ -1066,6 +1808,34 @@ func (ctx *templateValidationCtx) validateBaseImageOutputs(tmpl *wfv1.Template)
 	return nil
 }
 
+// validateEmissaryCommands verifies that the containers specify their commands when using the emissary executor, which
+// runs them through argoexec, as it cannot look up the entrypoint of their images
+func (ctx *templateValidationCtx) validateEmissaryCommands(tmpl *wfv1.Template) error {
+	if ctx.ContainerRuntimeExecutor != common.ContainerRuntimeExecutorEmissary {
+		return nil
+	}
+	errMsg := "must be specified when using the emissary executor"
+	if tmpl.Container != nil && len(tmpl.Container.Command) == 0 {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.container.command %s", tmpl.Name, errMsg)
+	}
+	if tmpl.Script != nil && len(tmpl.Script.Command) == 0 {
+		return errors.Errorf(errors.CodeBadRequest, "templates.%s.script.command %s", tmpl.Name, errMsg)
+	}
+	if tmpl.ContainerSet != nil {
+		for _, ctr := range tmpl.ContainerSet.Containers {
+			if len(ctr.Command) == 0 {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.containerSet.containers.%s.command %s", tmpl.Name, ctr.Name, errMsg)
+			}
+		}
+	}
+	for _, sidecar := range tmpl.Sidecars {
+		if len(sidecar.Command) == 0 {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.sidecars.%s.command %s", tmpl.Name, sidecar.Name, errMsg)
+		}
+	}
+	return nil
+}
+
 // validateOutputParameter verifies that only one of valueFrom is defined in an output
 func validateOutputParameter(paramRef string, param *wfv1.Parameter) error {
 	if param.ValueFrom != nil && param.Value != nil {
@@ -1239,6 +2009,10 @@ func (ctx *templateValidationCtx) validateDAG(scope map[string]interface{}, tmpl
 			return errors.Errorf(errors.CodeBadRequest, "templates.%s.tasks.%s %s", tmpl.Name, task.Name, err.Error())
 		}
 
+		if err := ctx.validateLoop(task.Loop, task.ShouldExpand(), resolvedTmpl); err != nil {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.tasks.%s %s", tmpl.Name, task.Name, err.Error())
+		}
+
 		resolvedTemplates[task.Name] = resolvedTmpl
 
 		prefix := fmt.Sprintf("tasks.%s", task.Name)
@@ -1256,7 +2030,7 @@ func (ctx *templateValidationCtx) validateDAG(scope map[string]interface{}, tmpl
 					"templates.%s.tasks.%s dependency '%s' not defined",
 					tmpl.Name, task.Name, depName)
 
-			} else if depType == common.DependencyTypeItems && len(task.WithItems) == 0 && task.WithParam == "" && task.WithSequence == nil {
+			} else if depType == common.DependencyTypeItems && !task.ShouldExpand() {
 				return errors.Errorf(errors.CodeBadRequest,
 					"templates.%s.tasks.%s dependency '%s' uses an items-based condition such as .AnySucceeded or .AllFailed but does not contain any items",
 					tmpl.Name, task.Name, depName)
@@ -1281,7 +2055,12 @@ func (ctx *templateValidationCtx) validateDAG(scope map[string]interface{}, tmpl
 		// add all tasks outputs to scope so that a nested DAGs can have outputs
 		prefix := fmt.Sprintf("tasks.%s", task.Name)
 		ctx.addOutputsToScope(resolvedTmpl, prefix, scope, false, false)
-		taskBytes, err := json.Marshal(task)
+		// the hooks are validated separately, as they may also refer to the status of the task, and the inline template
+		// is validated with its own inputs
+		taskWithoutHooks := task.DeepCopy()
+		taskWithoutHooks.Hooks = nil
+		taskWithoutHooks.Inline = nil
+		taskBytes, err := json.Marshal(taskWithoutHooks)
 		if err != nil {
 			return errors.InternalWrapError(err)
 		}
@@ -1294,17 +2073,29 @@ func (ctx *templateValidationCtx) validateDAG(scope map[string]interface{}, tmpl
 			ancestorTask := dagValidationCtx.GetTask(ancestor)
 			resolvedTmpl := resolvedTemplates[ancestor]
 			ancestorPrefix := fmt.Sprintf("tasks.%s", ancestor)
-			aggregate := len(ancestorTask.WithItems) > 0 || ancestorTask.WithParam != ""
+			aggregate := len(ancestorTask.WithItems) > 0 || ancestorTask.WithParam != "" || ancestorTask.WithArtifact != nil
 			ctx.addOutputsToScope(resolvedTmpl, ancestorPrefix, taskScope, aggregate, true)
 		}
-		err = addItemsToScope(prefix, task.WithItems, task.WithParam, task.WithSequence, taskScope)
+		err = addItemsToScope(prefix, task.WithItems, task.WithParam, task.WithSequence, task.WithArtifact, taskScope)
 		if err != nil {
 			return errors.Errorf(errors.CodeBadRequest, "templates.%s.tasks.%s %s", tmpl.Name, task.Name, err.Error())
 		}
-		err = resolveAllVariables(taskScope, string(taskBytes))
+		if task.WithArtifact != nil {
+			other := task
+			other.Arguments = wfv1.Arguments{}
+			other.When = ""
+			other.Inline = nil
+			if err := validateWithArtifactItems(other); err != nil {
+				return errors.Errorf(errors.CodeBadRequest, "templates.%s.tasks.%s %s", tmpl.Name, task.Name, err.Error())
+			}
+		}
+		err = resolveAllVariables(loopScope(task.Loop, resolvedTmpl, taskScope), string(taskBytes))
 		if err != nil {
 			return errors.Errorf(errors.CodeBadRequest, "templates.%s.tasks.%s %s", tmpl.Name, task.Name, err.Error())
 		}
+		if err := validateWhen(taskScope, task.When); err != nil {
+			return errors.Errorf(errors.CodeBadRequest, "templates.%s.tasks.%s %s", tmpl.Name, task.Name, err.Error())
+		}
 		err = validateArguments(fmt.Sprintf("templates.%s.tasks.%s.arguments.", tmpl.Name, task.Name), task.Arguments)
 		if err != nil {
 			return err
@@ -1314,6 +2105,11 @@ func (ctx *templateValidationCtx) validateDAG(scope map[string]interface{}, tmpl
 		if err != nil {
 			return errors.Errorf(errors.CodeBadRequest, "templates.%s.tasks.%s %s", tmpl.Name, task.Name, err.Error())
 		}
+		taskScope[fmt.Sprintf("%s.status", prefix)] = true
+		err = ctx.validateLifecycleHooks(fmt.Sprintf("templates.%s.tasks.%s", tmpl.Name, task.Name), task.Hooks, tmplCtx, taskScope)
+		if err != nil {
+			return err
+		}
 	}
 
 	return nil
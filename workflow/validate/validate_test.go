@@ -2,16 +2,20 @@ package validate
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/api/core/v1"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
 	"sigs.k8s.io/yaml"
 
 	wfv1 "github.com/simster7/argo/v2/pkg/apis/workflow/v1alpha1"
 	fakewfclientset "github.com/simster7/argo/v2/pkg/client/clientset/versioned/fake"
 	"github.com/simster7/argo/v2/test"
+	"github.com/simster7/argo/v2/util/intstr"
 	"github.com/simster7/argo/v2/workflow/common"
 	"github.com/simster7/argo/v2/workflow/templateresolution"
 )
@@ -1949,6 +1953,53 @@ func TestUnknownPodGCStrategy(t *testing.T) {
 	}
 }
 
+var invalidArtifactGC = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: artifact-gc-strategy-unknown-
+spec:
+  artifactGC:
+    strategy: OnWorkflowCompletion
+  entrypoint: main
+  templates:
+  - name: main
+    container:
+      image: argoproj/argosay:v2
+    outputs:
+      artifacts:
+      - name: out
+        path: /tmp/out
+        artifactGC:
+          strategy: OnWorkflowDeletion
+`
+
+func TestUnknownArtifactGCStrategy(t *testing.T) {
+	wf := unmarshalWf(invalidArtifactGC)
+	_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.NoError(t, err)
+
+	t.Run("Workflow", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		wf.Spec.ArtifactGC.Strategy = "OnWorkflowCompleteion"
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, "artifactGC.strategy unknown strategy 'OnWorkflowCompleteion'")
+	})
+	t.Run("Artifact", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		wf.Spec.Templates[0].Outputs.Artifacts[0].ArtifactGC.Strategy = "Always"
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, "templates.main.outputs.artifacts.out.artifactGC.strategy unknown strategy 'Always'")
+	})
+	for _, strategy := range []wfv1.ArtifactGCStrategy{"", wfv1.ArtifactGCOnWorkflowCompletion, wfv1.ArtifactGCOnWorkflowDeletion, wfv1.ArtifactGCNever} {
+		wf := wf.DeepCopy()
+		wf.Spec.ArtifactGC.Strategy = strategy
+		wf.Spec.Templates[0].Outputs.Artifacts[0].ArtifactGC.Strategy = strategy
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.NoError(t, err, strategy)
+	}
+}
+
 var validAutomountServiceAccountTokenUseWfLevel = `
 apiVersion: argoproj.io/v1alpha1
 kind: Workflow
@@ -2927,3 +2978,1116 @@ func TestValidActiveDeadlineSecondsArgoVariable(t *testing.T) {
 	err := validateWorkflowTemplate(validActiveDeadlineSecondsArgoVariable)
 	assert.NoError(t, err)
 }
+
+var memoizeCache = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: memoize-
+spec:
+  entrypoint: whalesay
+  templates:
+  - name: whalesay
+    memoize:
+      key: my-key
+      cache:
+        database:
+          name: whalesay-cache
+    container:
+      image: docker/whalesay:latest
+`
+
+func TestMemoizeCache(t *testing.T) {
+	t.Run("Database", func(t *testing.T) {
+		_, err := validate(memoizeCache)
+		assert.NoError(t, err)
+	})
+	t.Run("ConfigMapAndDatabase", func(t *testing.T) {
+		wf := unmarshalWf(memoizeCache)
+		wf.Spec.Templates[0].Memoize.Cache.ConfigMap = &apiv1.ConfigMapKeySelector{LocalObjectReference: apiv1.LocalObjectReference{Name: "whalesay-cache"}}
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, "templates.whalesay.memoize.cache must specify exactly one of configMap or database")
+	})
+	t.Run("NoName", func(t *testing.T) {
+		wf := unmarshalWf(memoizeCache)
+		wf.Spec.Templates[0].Memoize.Cache.Database.Name = ""
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, "templates.whalesay.memoize.cache name is required")
+	})
+}
+
+var cronWorkflowSchedules = `
+apiVersion: argoproj.io/v1alpha1
+kind: CronWorkflow
+metadata:
+  name: schedules
+spec:
+  schedules:
+  - schedule: "0 9 * * 1-5"
+    timezone: America/New_York
+  - schedule: "0 9 * * 1-5"
+    timezone: Europe/London
+  exclusionWindows:
+  - start: "0 18 * * 5"
+    duration: 60h
+  - dates: ["2020-12-25", "2021-01-01"]
+  workflowSpec:
+    entrypoint: whalesay
+    templates:
+    - name: whalesay
+      container:
+        image: docker/whalesay:latest
+`
+
+func TestValidateCronWorkflowSchedules(t *testing.T) {
+	var cronWf wfv1.CronWorkflow
+	err := yaml.Unmarshal([]byte(cronWorkflowSchedules), &cronWf)
+	if assert.NoError(t, err) {
+		assert.NoError(t, ValidateCronWorkflow(wftmplGetter, cwftmplGetter, &cronWf))
+	}
+	t.Run("BadWindow", func(t *testing.T) {
+		cronWf := cronWf.DeepCopy()
+		cronWf.Spec.ExclusionWindows[0].Duration = ""
+		assert.EqualError(t, ValidateCronWorkflow(wftmplGetter, cwftmplGetter, cronWf), "exclusionWindows[0] must have both a start and a duration, or neither")
+	})
+	t.Run("AlwaysExcluded", func(t *testing.T) {
+		cronWf := cronWf.DeepCopy()
+		cronWf.Spec.ExclusionWindows[0] = wfv1.CronExclusionWindow{Start: "0 0 * * *", Duration: "24h"}
+		assert.EqualError(t, ValidateCronWorkflow(wftmplGetter, cwftmplGetter, cronWf), "cron workflow is never scheduled to run, as every scheduled time is excluded")
+	})
+}
+
+var containerSetWorkflow = `apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: container-set
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    containerSet:
+      volumeMounts:
+      - name: workspace
+        mountPath: /workspace
+      containers:
+      - name: a
+        image: alpine
+        command: [sh, -c, "echo a > /workspace/a"]
+      - name: b
+        image: alpine
+        command: [sh, -c, "echo b > /workspace/b"]
+      - name: main
+        image: alpine
+        command: [sh, -c, "cat /workspace/a /workspace/b > /workspace/message"]
+        dependencies: [a, b]
+    outputs:
+      parameters:
+      - name: message
+        valueFrom:
+          path: /workspace/message
+  volumes:
+  - name: workspace
+    emptyDir: {}
+`
+
+func TestValidateContainerSet(t *testing.T) {
+	wf := unmarshalWf(containerSetWorkflow)
+	_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.NoError(t, err)
+	for name, tt := range map[string]struct {
+		modify func(containerSet *wfv1.ContainerSetTemplate)
+		err    string
+	}{
+		"NoContainers": {func(containerSet *wfv1.ContainerSetTemplate) { containerSet.Containers = nil }, "templates.main.containerSet.containers must have at least one container"},
+		"Reserved":     {func(containerSet *wfv1.ContainerSetTemplate) { containerSet.Containers[0].Name = "wait" }, "templates.main.containerSet.containers[0].name 'wait' is reserved"},
+		"NotUnique":    {func(containerSet *wfv1.ContainerSetTemplate) { containerSet.Containers[1].Name = "a" }, "templates.main.containerSet.containers[1].name 'a' is not unique"},
+		"NoCommand":    {func(containerSet *wfv1.ContainerSetTemplate) { containerSet.Containers[0].Command = nil }, "templates.main.containerSet.containers.a.command must be specified"},
+		"NoImage":      {func(containerSet *wfv1.ContainerSetTemplate) { containerSet.Containers[0].Image = "" }, "templates.main.containerSet.containers.a.image may not be empty"},
+		"UnknownDependency": {func(containerSet *wfv1.ContainerSetTemplate) { containerSet.Containers[2].Dependencies = []string{"c"} },
+			"templates.main.containerSet.containers.main.dependencies references non-existent container 'c'"},
+		"Cycle": {func(containerSet *wfv1.ContainerSetTemplate) {
+			containerSet.Containers[0].Dependencies = []string{"main"}
+		},
+			"templates.main.containerSet.containers dependency cycle detected: a->main->a"},
+		"NoMain": {func(containerSet *wfv1.ContainerSetTemplate) { containerSet.Containers[2].Name = "c" },
+			"templates.main.containerSet.containers must have a container named 'main' for the outputs to be collected from"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			wf := wf.DeepCopy()
+			tt.modify(wf.Spec.Templates[0].ContainerSet)
+			_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+			assert.EqualError(t, err, tt.err)
+		})
+	}
+	t.Run("MultipleTypes", func(t *testing.T) {
+		wf := wf.DeepCopy()
+		wf.Spec.Templates[0].Container = &apiv1.Container{Image: "alpine"}
+		_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+		assert.EqualError(t, err, "templates.main multiple template types specified. choose one of: container, steps, script, resource, dag, suspend, containerSet, http, workflow, template, template ref")
+	})
+}
+
+var httpWorkflow = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: http-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: request
+        template: request
+    - - name: print
+        template: print
+        arguments:
+          parameters:
+          - name: message
+            value: "{{steps.request.outputs.result}}"
+  - name: request
+    http:
+      url: https://example.com
+      headers:
+      - name: Authorization
+        valueFrom:
+          secretKeyRef:
+            name: my-secret
+            key: token
+      successCondition: response.statusCode == 200
+  - name: print
+    inputs:
+      parameters:
+      - name: message
+    container:
+      image: alpine
+      command: [echo, "{{inputs.parameters.message}}"]
+`
+
+func TestValidateHTTP(t *testing.T) {
+	wf := unmarshalWf(httpWorkflow)
+	_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.NoError(t, err)
+	for name, tt := range map[string]struct {
+		modify func(tmpl *wfv1.Template)
+		err    string
+	}{
+		"NoURL":            {func(tmpl *wfv1.Template) { tmpl.HTTP.URL = "" }, "templates.request.http.url may not be empty"},
+		"BadMethod":        {func(tmpl *wfv1.Template) { tmpl.HTTP.Method = "FETCH" }, "templates.request.http.method must be one of: GET, HEAD, POST, PUT, PATCH, DELETE, OPTIONS"},
+		"BadTimeout":       {func(tmpl *wfv1.Template) { tmpl.HTTP.TimeoutSeconds = pointer.Int64Ptr(61) }, "templates.request.http.timeoutSeconds must be between 1 and 60"},
+		"NoHeaderName":     {func(tmpl *wfv1.Template) { tmpl.HTTP.Headers[0].Name = "" }, "templates.request.http.headers[0].name may not be empty"},
+		"ValueAndFrom":     {func(tmpl *wfv1.Template) { tmpl.HTTP.Headers[0].Value = "x" }, "templates.request.http.headers.Authorization may not have both a value and valueFrom"},
+		"NoSecretKey":      {func(tmpl *wfv1.Template) { tmpl.HTTP.Headers[0].ValueFrom.SecretKeyRef.Key = "" }, "templates.request.http.headers.Authorization.valueFrom.secretKeyRef must have a name and a key"},
+		"BadCondition":     {func(tmpl *wfv1.Template) { tmpl.HTTP.SuccessCondition = "response.statusCode ==" }, "templates.request.http.successCondition is invalid"},
+		"OutputParameters": {func(tmpl *wfv1.Template) { tmpl.Outputs.Parameters = []wfv1.Parameter{{Name: "p"}} }, "templates.request.outputs.parameters are not supported by http templates, use outputs.result"},
+		"Timeout":          {func(tmpl *wfv1.Template) { tmpl.Timeout = "10s" }, "HTTP template doesn't support timeout field."},
+	} {
+		t.Run(name, func(t *testing.T) {
+			wf := wf.DeepCopy()
+			tt.modify(&wf.Spec.Templates[1])
+			_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+			if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), tt.err)
+			}
+		})
+	}
+}
+
+var emissaryWorkflow = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: emissary-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    container:
+      image: argoproj/argosay:v2
+      command: [/argosay]
+    sidecars:
+    - name: nginx
+      image: nginx:1.13
+`
+
+func TestValidateEmissaryCommands(t *testing.T) {
+	_, err := validateWithOptions(emissaryWorkflow, ValidateOpts{})
+	assert.NoError(t, err)
+	_, err = validateWithOptions(emissaryWorkflow, ValidateOpts{ContainerRuntimeExecutor: common.ContainerRuntimeExecutorEmissary})
+	assert.EqualError(t, err, "templates.main.sidecars.nginx.command must be specified when using the emissary executor")
+	_, err = validateWithOptions(strings.Replace(emissaryWorkflow, "command: [/argosay]", "", 1), ValidateOpts{ContainerRuntimeExecutor: common.ContainerRuntimeExecutorEmissary})
+	assert.EqualError(t, err, "templates.main.container.command must be specified when using the emissary executor")
+}
+
+var lifecycleHooksWorkflow = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: lifecycle-hooks-
+spec:
+  entrypoint: main
+  hooks:
+    started:
+      expression: workflow.status == "Running"
+      template: notify
+      arguments:
+        parameters:
+        - name: message
+          value: "{{workflow.name}} is {{workflow.status}}"
+  templates:
+  - name: main
+    dag:
+      tasks:
+      - name: deploy
+        template: deploy
+        hooks:
+          failed:
+            expression: tasks.deploy.status == "Failed"
+            template: notify
+            arguments:
+              parameters:
+              - name: message
+                value: "{{tasks.deploy.status}}: {{tasks.deploy.outputs.result}}"
+  - name: deploy
+    container:
+      image: alpine:3.7
+      command: [sh, -c, "exit 1"]
+  - name: notify
+    inputs:
+      parameters:
+      - name: message
+    container:
+      image: alpine:3.7
+      command: [echo, "{{inputs.parameters.message}}"]
+`
+
+func TestValidateLifecycleHooks(t *testing.T) {
+	wf := unmarshalWf(lifecycleHooksWorkflow)
+	_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.NoError(t, err)
+	for name, tt := range map[string]struct {
+		modify func(wf *wfv1.Workflow)
+		err    string
+	}{
+		"BadName": {func(wf *wfv1.Workflow) { wf.Spec.Hooks["bad_name"] = wf.Spec.Hooks["started"] }, "spec.hooks.bad_name name is invalid"},
+		"NoExpression": {func(wf *wfv1.Workflow) {
+			hook := wf.Spec.Hooks["started"]
+			hook.Expression = ""
+			wf.Spec.Hooks["started"] = hook
+		}, "spec.hooks.started.expression is required"},
+		"BadExpression": {func(wf *wfv1.Workflow) {
+			hook := wf.Spec.Hooks["started"]
+			hook.Expression = "workflow.status =="
+			wf.Spec.Hooks["started"] = hook
+		}, "spec.hooks.started.expression is invalid"},
+		"UnknownTemplate": {func(wf *wfv1.Workflow) {
+			hook := wf.Spec.Templates[0].DAG.Tasks[0].Hooks["failed"]
+			hook.Template = "unknown"
+			wf.Spec.Templates[0].DAG.Tasks[0].Hooks["failed"] = hook
+		}, "templates.main.tasks.deploy.hooks.failed template name 'unknown' undefined"},
+		"UnresolvedArgument": {func(wf *wfv1.Workflow) {
+			hook := wf.Spec.Hooks["started"]
+			hook.Arguments.Parameters[0].Value = wfv1.AnyStringPtr("{{tasks.deploy.status}}")
+			wf.Spec.Hooks["started"] = hook
+		}, "spec.hooks.started failed to resolve {{tasks.deploy.status}}"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			wf := wf.DeepCopy()
+			tt.modify(wf)
+			_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+			if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), tt.err)
+			}
+		})
+	}
+}
+
+var expressionsWorkflow = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: expressions-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: count
+        template: count
+    - - name: print
+        template: print
+        when: "{{= asInt(steps.count.outputs.result) > 1}}"
+        arguments:
+          parameters:
+          - name: message
+            value: "{{= asInt(steps.count.outputs.result) + 1}}"
+  - name: count
+    retryStrategy:
+      limit: 3
+      expression: lastRetry.exitCode == 1 && lastRetry.duration < 60
+    script:
+      image: python:alpine3.6
+      command: [python]
+      source: print(2)
+  - name: print
+    inputs:
+      parameters:
+      - name: message
+    container:
+      image: alpine:3.7
+      command: [echo, "{{inputs.parameters.message}}"]
+`
+
+func TestValidateExpressions(t *testing.T) {
+	wf := unmarshalWf(expressionsWorkflow)
+	_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.NoError(t, err)
+	for name, tt := range map[string]struct {
+		modify func(wf *wfv1.Workflow)
+		err    string
+	}{
+		"TypeError": {func(wf *wfv1.Workflow) {
+			wf.Spec.Templates[0].Steps[1].Steps[0].Arguments.Parameters[0].Value = wfv1.AnyStringPtr("{{= steps.count.outputs.result + 1}}")
+		}, "unable to evaluate expression 'steps.count.outputs.result + 1'"},
+		"Unresolved": {func(wf *wfv1.Workflow) {
+			wf.Spec.Templates[0].Steps[1].Steps[0].Arguments.Parameters[0].Value = wfv1.AnyStringPtr("{{= steps.other.outputs.result}}")
+		}, "failed to resolve steps.other.outputs.result"},
+		"WhenNotBoolean": {func(wf *wfv1.Workflow) {
+			wf.Spec.Templates[0].Steps[1].Steps[0].When = "{{= steps.count.outputs.result}}"
+		}, "templates.main.steps.print when '{{= steps.count.outputs.result}}' must evaluate to a boolean"},
+		"RetryNotBoolean": {func(wf *wfv1.Workflow) {
+			wf.Spec.Templates[1].RetryStrategy.Expression = "retries + 1"
+		}, "retryStrategy.expression 'retries + 1' must evaluate to a boolean"},
+		"RetryUnresolved": {func(wf *wfv1.Workflow) {
+			wf.Spec.Templates[1].RetryStrategy.Expression = `lastRetry.reason == "OOMKilled"`
+		}, "retryStrategy.expression failed to resolve lastRetry.reason"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			wf := wf.DeepCopy()
+			tt.modify(wf)
+			_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+			if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), tt.err)
+			}
+		})
+	}
+}
+
+func TestValidateRetryOn(t *testing.T) {
+	wf := unmarshalWf(expressionsWorkflow)
+	wf.Spec.Templates[1].RetryStrategy.RetryOn = []wfv1.RetryOn{
+		{ExitCodes: []int32{137, 143}},
+		{Reasons: []string{"Evicted"}, MessagePattern: "low on resource"},
+	}
+	_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.NoError(t, err)
+	for name, tt := range map[string]struct {
+		modify func(wf *wfv1.Workflow)
+		err    string
+	}{
+		"NoCondition": {func(wf *wfv1.Workflow) {
+			wf.Spec.Templates[1].RetryStrategy.RetryOn[1] = wfv1.RetryOn{}
+		}, "retryStrategy.retryOn[1] must specify at least one of exitCodes, reasons or messagePattern"},
+		"InvalidPattern": {func(wf *wfv1.Workflow) {
+			wf.Spec.Templates[1].RetryStrategy.RetryOn[1].MessagePattern = "low on ("
+		}, "retryStrategy.retryOn[1].messagePattern is invalid"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			wf := wf.DeepCopy()
+			tt.modify(wf)
+			_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+			if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), tt.err)
+			}
+		})
+	}
+}
+
+var typedParametersWorkflow = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: typed-parameters-
+spec:
+  entrypoint: main
+  arguments:
+    parameters:
+    - name: replicas
+      value: "3"
+      type: int
+      schema:
+        minimum: 1
+        maximum: 5
+    - name: config
+      value: '{"debug": true}'
+      type: json
+  templates:
+  - name: main
+    inputs:
+      parameters:
+      - name: verbose
+        default: "false"
+        type: bool
+    container:
+      image: alpine:3.7
+      command: [echo, "{{workflow.parameters.replicas}} {{workflow.parameters.config}} {{inputs.parameters.verbose}}"]
+`
+
+func TestValidateTypedParameters(t *testing.T) {
+	wf := unmarshalWf(typedParametersWorkflow)
+	_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.NoError(t, err)
+	for name, tt := range map[string]struct {
+		modify func(wf *wfv1.Workflow)
+		err    string
+	}{
+		"InvalidType": {func(wf *wfv1.Workflow) {
+			wf.Spec.Arguments.Parameters[0].Type = "float"
+		}, "spec.arguments.parameters.replicas.type 'float' is invalid"},
+		"NotAnInt": {func(wf *wfv1.Workflow) {
+			wf.Spec.Arguments.Parameters[0].Value = wfv1.AnyStringPtr("three")
+		}, "spec.arguments.parameters.replicas.value: parameter 'replicas' is not a valid int"},
+		"AgainstSchema": {func(wf *wfv1.Workflow) {
+			wf.Spec.Arguments.Parameters[0].Value = wfv1.AnyStringPtr("6")
+		}, "spec.arguments.parameters.replicas.value: parameter 'replicas' is not valid against the schema"},
+		"NotJSON": {func(wf *wfv1.Workflow) {
+			wf.Spec.Arguments.Parameters[1].Value = wfv1.AnyStringPtr("{debug}")
+		}, "spec.arguments.parameters.config.value: parameter 'config' is not a valid json"},
+		"InputDefault": {func(wf *wfv1.Workflow) {
+			wf.Spec.Templates[0].Inputs.Parameters[0].Default = wfv1.AnyStringPtr("no")
+		}, "templates.main.inputs.parameters.verbose.default: parameter 'verbose' is not a valid bool"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			wf := wf.DeepCopy()
+			tt.modify(wf)
+			_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+			if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), tt.err)
+			}
+		})
+	}
+}
+
+var sensitiveParametersWorkflow = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: sensitive-parameters-
+spec:
+  entrypoint: main
+  arguments:
+    parameters:
+    - name: password
+      valueFrom:
+        secretKeyRef:
+          name: my-secret
+          key: password
+  templates:
+  - name: main
+    steps:
+    - - name: login
+        template: login
+        arguments:
+          parameters:
+          - name: token
+            value: "{{workflow.parameters.password}}"
+  - name: login
+    inputs:
+      parameters:
+      - name: token
+        sensitive: true
+    container:
+      image: alpine:3.7
+      command: [sh, -c]
+      args: ["login {{inputs.parameters.token}}"]
+`
+
+func TestValidateSensitiveParameters(t *testing.T) {
+	wf := unmarshalWf(sensitiveParametersWorkflow)
+	_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.NoError(t, err)
+	for name, tt := range map[string]struct {
+		modify func(wf *wfv1.Workflow)
+		err    string
+	}{
+		"NoSecretName": {func(wf *wfv1.Workflow) {
+			wf.Spec.Arguments.Parameters[0].ValueFrom.SecretKeyRef.Name = ""
+		}, "spec.arguments.password.valueFrom.secretKeyRef.name is required"},
+		"NoSecretKey": {func(wf *wfv1.Workflow) {
+			wf.Spec.Arguments.Parameters[0].ValueFrom.SecretKeyRef.Key = ""
+		}, "spec.arguments.password.valueFrom.secretKeyRef.key is required"},
+		"NoValue": {func(wf *wfv1.Workflow) {
+			wf.Spec.Arguments.Parameters[0].ValueFrom = nil
+		}, "spec.arguments.password.value is required"},
+		"NotAPod": {func(wf *wfv1.Workflow) {
+			wf.Spec.Templates[0].Inputs.Parameters = []wfv1.Parameter{{Name: "token", Sensitive: true, Default: wfv1.AnyStringPtr("my-token")}}
+		}, "templates.main.inputs.parameters.token is sensitive, which is only valid in container, script and container set templates"},
+		"HTTP": {func(wf *wfv1.Workflow) {
+			wf.Spec.Templates[1].Inputs = wfv1.Inputs{}
+			wf.Spec.Templates[1].Container = nil
+			wf.Spec.Templates[1].HTTP = &wfv1.HTTP{
+				URL:     "https://example.com/login",
+				Headers: []wfv1.HTTPHeader{{Name: "Authorization", Value: "{{workflow.parameters.password}}"}},
+			}
+		}, "templates.login refers to the sensitive workflow parameter 'password'"},
+		"Resource": {func(wf *wfv1.Workflow) {
+			wf.Spec.Templates[1].Inputs = wfv1.Inputs{}
+			wf.Spec.Templates[1].Container = nil
+			wf.Spec.Templates[1].Resource = &wfv1.ResourceTemplate{
+				Action:   "create",
+				Manifest: "apiVersion: v1\nkind: Secret\nmetadata:\n  generateName: login-\nstringData:\n  password: '{{ workflow.parameters.password }}'\n",
+			}
+		}, "templates.login refers to the sensitive workflow parameter 'password'"},
+		"When": {func(wf *wfv1.Workflow) {
+			wf.Spec.Templates[0].Steps[0].Steps[0].When = "{{workflow.parameters.password}} != ''"
+		}, "templates.main.steps[0].login refers to the sensitive workflow parameter 'password'"},
+		"Sidecar": {func(wf *wfv1.Workflow) {
+			wf.Spec.Templates[1].Sidecars = []wfv1.UserContainer{{Container: apiv1.Container{Name: "sidecar", Image: "alpine:3.7", Args: []string{"{{workflow.parameters.password}}"}}}}
+		}, "templates.login refers to the sensitive workflow parameter 'password'"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			wf := wf.DeepCopy()
+			tt.modify(wf)
+			_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+			if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), tt.err)
+			}
+		})
+	}
+}
+
+var configMapParametersWorkflow = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: config-map-parameters-
+spec:
+  entrypoint: main
+  arguments:
+    parameters:
+    - name: region
+      valueFrom:
+        configMapKeyRef:
+          name: settings
+          key: region
+  templates:
+  - name: main
+    inputs:
+      parameters:
+      - name: replicas
+        type: int
+        valueFrom:
+          configMapKeyRef:
+            name: settings
+            key: replicas
+    container:
+      image: alpine:3.7
+      command: [echo, "{{workflow.parameters.region}}", "{{inputs.parameters.replicas}}"]
+`
+
+func TestValidateConfigMapParameters(t *testing.T) {
+	wf := unmarshalWf(configMapParametersWorkflow)
+	_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.NoError(t, err)
+	for name, tt := range map[string]struct {
+		modify func(wf *wfv1.Workflow)
+		err    string
+	}{
+		"NoName": {func(wf *wfv1.Workflow) {
+			wf.Spec.Arguments.Parameters[0].ValueFrom.ConfigMapKeyRef.Name = ""
+		}, "spec.arguments.region.valueFrom.configMapKeyRef.name is required"},
+		"NoKey": {func(wf *wfv1.Workflow) {
+			wf.Spec.Templates[0].Inputs.Parameters[0].ValueFrom.ConfigMapKeyRef.Key = ""
+		}, "templates.main.inputs.parameters.replicas.valueFrom.configMapKeyRef.key is required"},
+		"AndSecret": {func(wf *wfv1.Workflow) {
+			wf.Spec.Arguments.Parameters[0].ValueFrom.SecretKeyRef = &apiv1.SecretKeySelector{LocalObjectReference: apiv1.LocalObjectReference{Name: "my-secret"}, Key: "region"}
+		}, "spec.arguments.region.valueFrom only one of secretKeyRef or configMapKeyRef can be specified"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			wf := wf.DeepCopy()
+			tt.modify(wf)
+			_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+			if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), tt.err)
+			}
+		})
+	}
+}
+
+func TestValidateExportTo(t *testing.T) {
+	wf := unmarshalWf(`
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: export-to-
+spec:
+  entrypoint: build
+  templates:
+  - name: build
+    container:
+      image: alpine:3.7
+      command: [sh, -c, "echo sha256:abc > /tmp/digest"]
+    outputs:
+      parameters:
+      - name: digest
+        valueFrom:
+          path: /tmp/digest
+        exportTo:
+          configMapKeyRef:
+            name: build
+            key: digest
+`)
+	_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.NoError(t, err)
+	wf.Spec.Templates[0].Outputs.Parameters[0].ExportTo.ConfigMapKeyRef.Key = ""
+	_, err = ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.EqualError(t, err, "templates.build.outputs.parameters.digest.exportTo.configMapKeyRef.key is required")
+	wf.Spec.Templates[0].Outputs.Parameters[0].ExportTo.ConfigMapKeyRef = nil
+	_, err = ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.EqualError(t, err, "templates.build.outputs.parameters.digest.exportTo.configMapKeyRef is required")
+}
+
+var inlineTemplates = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: inline-templates-
+spec:
+  entrypoint: main
+  arguments:
+    parameters:
+    - name: greeting
+      value: hello
+  templates:
+  - name: main
+    inputs:
+      parameters:
+      - name: message
+        value: "{{workflow.parameters.greeting}}"
+    steps:
+    - - name: say
+        arguments:
+          parameters:
+          - name: message
+            value: "{{inputs.parameters.message}} {{item}}"
+        withItems: [a, b]
+        inline:
+          inputs:
+            parameters:
+            - name: message
+          container:
+            image: docker/whalesay
+            args: ["{{inputs.parameters.message}}"]
+    - - name: dag
+        template: dag
+  - name: dag
+    dag:
+      tasks:
+      - name: say
+        inline:
+          container:
+            image: docker/whalesay
+            args: ["{{workflow.parameters.greeting}}"]
+`
+
+func TestValidateInlineTemplates(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		modify func(wf *wfv1.Workflow)
+		err    string
+	}{
+		{name: "Valid", modify: func(wf *wfv1.Workflow) {}},
+		{
+			name: "UndefinedInput",
+			modify: func(wf *wfv1.Workflow) {
+				wf.Spec.Templates[0].Steps[0].Steps[0].Inline.Container.Args = []string{"{{inputs.parameters.other}}"}
+			},
+			err: "templates.main.steps[0].say templates.<inline>: failed to resolve {{inputs.parameters.other}}",
+		},
+		{
+			name: "TemplateAndInline",
+			modify: func(wf *wfv1.Workflow) {
+				wf.Spec.Templates[1].DAG.Tasks[0].Template = "main"
+			},
+			err: "templates.main.steps[1].dag templates.dag.tasks.say inline template cannot be specified with template or templateRef.",
+		},
+		{
+			name: "Name",
+			modify: func(wf *wfv1.Workflow) {
+				wf.Spec.Templates[1].DAG.Tasks[0].Inline.Name = "say"
+			},
+			err: "templates.main.steps[1].dag templates.dag.tasks.say inline template cannot have a name",
+		},
+		{
+			name: "Steps",
+			modify: func(wf *wfv1.Workflow) {
+				wf.Spec.Templates[1].DAG.Tasks[0].Inline = &wfv1.Template{Steps: []wfv1.ParallelSteps{{Steps: []wfv1.WorkflowStep{{Name: "a", Template: "dag"}}}}}
+			},
+			err: "templates.main.steps[1].dag templates.dag.tasks.say inline template cannot be of type Steps",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			wf := unmarshalWf(inlineTemplates)
+			tt.modify(wf)
+			_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestValidateTemplateDefaults(t *testing.T) {
+	wf := unmarshalWf(`
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: template-defaults-
+spec:
+  entrypoint: main
+  templateDefaults:
+    activeDeadlineSeconds: 300
+    retryStrategy:
+      limit: 2
+  templates:
+  - name: main
+    steps:
+    - - name: hello
+        template: hello
+  - name: hello
+    container:
+      image: docker/whalesay
+`)
+	_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.NoError(t, err)
+	wf.Spec.TemplateDefaults.Container = &apiv1.Container{Image: "docker/whalesay"}
+	_, err = ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.EqualError(t, err, "spec.templateDefaults cannot be of type Container")
+	wf.Spec.TemplateDefaults.Name = "defaults"
+	_, err = ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+	assert.EqualError(t, err, "spec.templateDefaults cannot have a name")
+}
+
+var loops = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: loops-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: poll
+        template: poll
+        arguments:
+          parameters:
+          - name: previous
+            value: "{{loop.index}} {{loop.outputs.parameters.status}} {{loop.outputs.result}}"
+        loop:
+          until: outputs.parameters.status == "ready" && outputs.exitCode == 0
+          maxIterations: 3
+          delay: 10s
+    - - name: dag
+        template: dag
+        arguments:
+          parameters:
+          - name: previous
+            value: "{{steps.poll.outputs.parameters.status}}"
+  - name: dag
+    inputs:
+      parameters:
+      - name: previous
+    dag:
+      tasks:
+      - name: poll
+        template: poll
+        arguments:
+          parameters:
+          - name: previous
+            value: "{{loop.outputs.parameters.status}}"
+        loop:
+          maxIterations: 2
+  - name: poll
+    inputs:
+      parameters:
+      - name: previous
+    container:
+      image: docker/whalesay
+      args: ["{{inputs.parameters.previous}}"]
+    outputs:
+      parameters:
+      - name: status
+        valueFrom:
+          path: /tmp/status
+`
+
+func TestValidateLoops(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		modify func(wf *wfv1.Workflow)
+		err    string
+	}{
+		{name: "Valid", modify: func(wf *wfv1.Workflow) {}},
+		{
+			name: "MaxIterations",
+			modify: func(wf *wfv1.Workflow) {
+				wf.Spec.Templates[0].Steps[0].Steps[0].Loop.MaxIterations = 0
+			},
+			err: "templates.main.steps[0].poll loop.maxIterations must be greater than zero",
+		},
+		{
+			name: "Delay",
+			modify: func(wf *wfv1.Workflow) {
+				wf.Spec.Templates[0].Steps[0].Steps[0].Loop.Delay = "soon"
+			},
+			err: "templates.main.steps[0].poll loop.delay 'soon' is not a valid duration",
+		},
+		{
+			name: "UndefinedVariable",
+			modify: func(wf *wfv1.Workflow) {
+				wf.Spec.Templates[0].Steps[0].Steps[0].Loop.Until = "loop.count > 2"
+			},
+			err: "templates.main.steps[0].poll loop.until failed to resolve loop.count",
+		},
+		{
+			name: "NotBoolean",
+			modify: func(wf *wfv1.Workflow) {
+				wf.Spec.Templates[0].Steps[0].Steps[0].Loop.Until = "loop.index + 1"
+			},
+			err: "templates.main.steps[0].poll loop.until 'loop.index + 1' must evaluate to a boolean, rather than int",
+		},
+		{
+			name: "WithItems",
+			modify: func(wf *wfv1.Workflow) {
+				wf.Spec.Templates[1].DAG.Tasks[0].WithSequence = &wfv1.Sequence{Count: intstr.ParsePtr("2")}
+			},
+			err: "templates.main.steps[1].dag templates.dag.tasks.poll loop cannot be used with withItems, withParam, withSequence or withArtifact",
+		},
+		{
+			name: "LoopVariableOutsideLoop",
+			modify: func(wf *wfv1.Workflow) {
+				wf.Spec.Templates[0].Steps[1].Steps[0].Arguments.Parameters[0].Value = wfv1.AnyStringPtr("{{loop.index}}")
+			},
+			err: "templates.main.steps failed to resolve {{loop.index}}",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			wf := unmarshalWf(loops)
+			tt.modify(wf)
+			_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.err)
+			}
+		})
+	}
+}
+
+var withArtifact = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: with-artifact-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: generate
+        template: generate
+    - - name: process
+        template: process
+        arguments:
+          parameters:
+          - name: items
+            value: "{{item}}"
+        withArtifact:
+          from: "{{steps.generate.outputs.artifacts.items}}"
+          batchSize: 100
+    - - name: print
+        template: process
+        arguments:
+          parameters:
+          - name: items
+            value: "{{steps.process.outputs.parameters.count}}"
+  - name: generate
+    container:
+      image: docker/whalesay
+    outputs:
+      artifacts:
+      - name: items
+        path: /tmp/items.json
+  - name: process
+    inputs:
+      parameters:
+      - name: items
+    container:
+      image: docker/whalesay
+      args: ["{{inputs.parameters.items}}"]
+    outputs:
+      parameters:
+      - name: count
+        valueFrom:
+          path: /tmp/count
+`
+
+func TestValidateWithArtifact(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		modify func(wf *wfv1.Workflow)
+		err    string
+	}{
+		{name: "Valid", modify: func(wf *wfv1.Workflow) {}},
+		{
+			name: "Location",
+			modify: func(wf *wfv1.Workflow) {
+				wf.Spec.Templates[0].Steps[1].Steps[0].WithArtifact = &wfv1.WithArtifact{ArtifactLocation: wfv1.ArtifactLocation{
+					S3: &wfv1.S3Artifact{S3Bucket: wfv1.S3Bucket{Endpoint: "minio:9000", Bucket: "my-bucket"}, Key: "items.json"},
+				}}
+			},
+		},
+		{
+			name: "UndefinedArtifact",
+			modify: func(wf *wfv1.Workflow) {
+				wf.Spec.Templates[0].Steps[1].Steps[0].WithArtifact.From = "{{steps.generate.outputs.artifacts.other}}"
+			},
+			err: "templates.main.steps failed to resolve {{steps.generate.outputs.artifacts.other}}",
+		},
+		{
+			name: "NoArtifact",
+			modify: func(wf *wfv1.Workflow) {
+				wf.Spec.Templates[0].Steps[1].Steps[0].WithArtifact.From = ""
+			},
+			err: "templates.main.steps[1].process withArtifact must have either from or an artifact location",
+		},
+		{
+			name: "WithParam",
+			modify: func(wf *wfv1.Workflow) {
+				wf.Spec.Templates[0].Steps[1].Steps[0].WithParam = "[1, 2]"
+			},
+			err: "templates.main.steps[1].process only one of withItems, withParam, withSequence, withArtifact can be specified",
+		},
+		{
+			name: "ItemInWhen",
+			modify: func(wf *wfv1.Workflow) {
+				wf.Spec.Templates[0].Steps[1].Steps[0].When = "{{item}} != 1"
+			},
+		},
+		{
+			name: "ItemOutsideArguments",
+			modify: func(wf *wfv1.Workflow) {
+				wf.Spec.Templates[0].Steps[1].Steps[0].OnExit = "{{ item.exit }}"
+			},
+			err: "templates.main.steps[1].process withArtifact items can only be used in the arguments and the when",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			wf := unmarshalWf(withArtifact)
+			tt.modify(wf)
+			_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.err)
+			}
+		})
+	}
+}
+
+var childWorkflow = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  generateName: child-workflow-
+spec:
+  entrypoint: main
+  templates:
+  - name: main
+    steps:
+    - - name: child
+        template: child
+    - - name: print
+        template: print
+        arguments:
+          parameters:
+          - name: message
+            value: "{{steps.child.outputs.parameters.result}}"
+  - name: child
+    workflow:
+      workflowTemplateRef:
+        name: my-template
+      arguments:
+        parameters:
+        - name: message
+          value: hello
+    outputs:
+      parameters:
+      - name: result
+  - name: print
+    inputs:
+      parameters:
+      - name: message
+    container:
+      image: docker/whalesay
+      args: ["{{inputs.parameters.message}}"]
+`
+
+func TestValidateChildWorkflow(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		modify func(wf *wfv1.Workflow)
+		err    string
+	}{
+		{name: "Valid", modify: func(wf *wfv1.Workflow) {}},
+		{
+			name: "NoWorkflowTemplateRef",
+			modify: func(wf *wfv1.Workflow) {
+				wf.Spec.Templates[1].Workflow.WorkflowTemplateRef = nil
+			},
+			err: "templates.main.steps[0].child templates.child.workflow.workflowTemplateRef.name is required",
+		},
+		{
+			name: "NoArgumentValue",
+			modify: func(wf *wfv1.Workflow) {
+				wf.Spec.Templates[1].Workflow.Arguments.Parameters[0].Value = nil
+			},
+			err: "templates.main.steps[0].child templates.child.workflow.arguments.message.value is required",
+		},
+		{
+			name: "OutputValueFrom",
+			modify: func(wf *wfv1.Workflow) {
+				wf.Spec.Templates[1].Outputs.Parameters[0].ValueFrom = &wfv1.ValueFrom{Path: "/tmp/result"}
+			},
+			err: "templates.main.steps[0].child templates.child.outputs.parameters.result may not have value or valueFrom specified, its value is the output parameter of the child workflow with the same name",
+		},
+		{
+			name: "UndeclaredOutput",
+			modify: func(wf *wfv1.Workflow) {
+				wf.Spec.Templates[1].Outputs.Parameters = nil
+			},
+			err: "templates.main.steps failed to resolve {{steps.child.outputs.parameters.result}}",
+		},
+		{
+			name: "Timeout",
+			modify: func(wf *wfv1.Workflow) {
+				wf.Spec.Templates[1].Timeout = "1h"
+			},
+			err: "templates.main.steps[0].child Workflow template doesn't support timeout field.",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			wf := unmarshalWf(childWorkflow)
+			tt.modify(wf)
+			_, err := ValidateWorkflow(wftmplGetter, cwftmplGetter, wf, ValidateOpts{})
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.err)
+			}
+		})
+	}
+}